@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/user2410/rrms-backend/cmd/migrate"
+	"github.com/user2410/rrms-backend/cmd/search"
 	"github.com/user2410/rrms-backend/cmd/seed"
 	"github.com/user2410/rrms-backend/cmd/server"
 	"github.com/user2410/rrms-backend/cmd/version"
@@ -47,6 +48,7 @@ func Execute() {
 	root := newRootCommand()
 	root.Command.AddCommand(
 		migrate.NewMigrateCommand().Command,
+		search.NewSearchCommand().Command,
 		seed.NewSeedCommand().Command,
 		server.NewServerCommand().Command,
 		version.NewVersionCommand().Command,
@@ -0,0 +1,153 @@
+package search
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/robfig/cron/v3"
+	"github.com/spf13/cobra"
+	repos "github.com/user2410/rrms-backend/internal/domain/_repos"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	listing_search "github.com/user2410/rrms-backend/internal/domain/listing/search"
+	listing_service "github.com/user2410/rrms-backend/internal/domain/listing/service"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/infrastructure/es"
+	"github.com/user2410/rrms-backend/internal/infrastructure/redisd"
+)
+
+const MAX_PRINTED_IDS = 20
+
+type reindexCommand struct {
+	*cobra.Command
+	config    *searchConfig
+	statePath string
+	resume    bool
+	dryRun    bool
+}
+
+func newReindexCommand() *reindexCommand {
+	c := &reindexCommand{}
+	c.Command = &cobra.Command{
+		Use:   "reindex",
+		Short: "Rebuild the listings index into a new versioned index and swap the listings alias to it",
+		Long: `Rebuild the listings index into a new versioned index and swap the listings alias to it.
+Progress is saved to the state file after every batch, run again with --resume to continue an interrupted reindex.
+With --dry-run, nothing is written and the differences between the database and the current index are printed instead.`,
+		Run: c.run,
+	}
+	c.Command.Flags().StringVar(&c.statePath, "state", "search-reindex.json", "file the reindex progress is saved to")
+	c.Command.Flags().BoolVar(&c.resume, "resume", false, "continue the reindex saved in the state file")
+	c.Command.Flags().BoolVar(&c.dryRun, "dry-run", false, "print the differences between the database and the index without reindexing")
+	c.config = newSearchConfig()
+	return c
+}
+
+func (c *reindexCommand) run(cmd *cobra.Command, args []string) {
+	dao, err := database.NewPostgresDAO(c.config.DatabaseURL)
+	if err != nil {
+		log.Fatal("Error while initializing database connection: ", err)
+	}
+	defer dao.Close()
+
+	esClient, err := es.NewElasticSearchClient(es.ElasticSearchClientParams{
+		Addresses:  c.config.ElasticsearchAddresses,
+		Username:   c.config.ElasticsearchUsername,
+		Password:   c.config.ElasticsearchPassword,
+		CACertPath: c.config.ElasticsearchCACertPath,
+		Url:        c.config.ElasticsearchURL,
+		CloudID:    c.config.ElasticsearchCloudID,
+		APIKey:     c.config.ElasticsearchAPIKey,
+	})
+	if err != nil {
+		log.Fatal("Error while initializing Elasticsearch client: ", err)
+	}
+
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     c.config.RedisAddr,
+		Password: c.config.RedisPassword,
+		DB:       c.config.RedisDB,
+	})
+	defer rdb.Close()
+
+	domainRepo := repos.NewDomainRepo(dao, redisd.NewRedisClient(rdb))
+	// the cron scheduler is never started, the outbox is left to the server
+	service := listing_service.NewService(domainRepo, "", esClient, listing_search.NewElasticSearcher(esClient), nil, nil, cron.New(), "", "")
+
+	if c.dryRun {
+		c.diff(service)
+		return
+	}
+
+	state, err := c.loadState()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if state.Completed {
+		log.Printf("reindex into %s is already completed, remove %s to start a new one\n", state.Index, c.statePath)
+		return
+	}
+	if err = service.ReindexListings(state, c.saveState); err != nil {
+		log.Fatalf("reindex into %s failed, run again with --resume to continue: %v", state.Index, err)
+	}
+	log.Printf("reindex completed: %d listings indexed into %s\n", state.Indexed, state.Index)
+}
+
+func (c *reindexCommand) diff(service listing_service.Service) {
+	diff, err := service.DiffListingsIndex("")
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("index %s: %d documents, database: %d listings\n", diff.Index, diff.IndexCount, diff.DBCount)
+	log.Printf("missing from the index: %d %v\n", len(diff.Missing), truncate(diff.Missing))
+	log.Printf("outdated in the index: %d %v\n", len(diff.Outdated), truncate(diff.Outdated))
+	log.Printf("orphaned in the index: %d %v\n", len(diff.Orphaned), truncate(diff.Orphaned))
+}
+
+func truncate[T any](ids []T) []T {
+	if len(ids) > MAX_PRINTED_IDS {
+		return ids[:MAX_PRINTED_IDS]
+	}
+	return ids
+}
+
+// loadState reads the saved state when resuming. A new reindex refuses to overwrite an unfinished one.
+func (c *reindexCommand) loadState() (*dto.ReindexListingsState, error) {
+	state := new(dto.ReindexListingsState)
+	data, err := os.ReadFile(c.statePath)
+	if errors.Is(err, os.ErrNotExist) {
+		if c.resume {
+			return nil, errors.New("no reindex to resume, state file " + c.statePath + " not found")
+		}
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, state); err != nil {
+		return nil, err
+	}
+	if !c.resume && !state.Completed {
+		return nil, errors.New("an unfinished reindex into " + state.Index + " was found in " + c.statePath + ", run with --resume to continue it")
+	}
+	if !c.resume {
+		// the previous reindex is completed, start a new one
+		return new(dto.ReindexListingsState), nil
+	}
+	return state, nil
+}
+
+// saveState writes the state to a temporary file first so that an interruption never leaves a truncated state file
+func (c *reindexCommand) saveState(state *dto.ReindexListingsState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := c.statePath + ".tmp"
+	if err = os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.statePath)
+}
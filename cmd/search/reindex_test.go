@@ -0,0 +1,64 @@
+package search
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+)
+
+func TestReindexState(t *testing.T) {
+	c := &reindexCommand{statePath: filepath.Join(t.TempDir(), "search-reindex.json")}
+
+	// nothing to resume
+	c.resume = true
+	_, err := c.loadState()
+	require.Error(t, err)
+
+	// a new reindex starts from scratch
+	c.resume = false
+	state, err := c.loadState()
+	require.NoError(t, err)
+	require.Equal(t, &dto.ReindexListingsState{}, state)
+
+	// an interrupted reindex is resumed from its last checkpoint
+	saved := &dto.ReindexListingsState{
+		Index:     "listings_v20240101000000",
+		StartedAt: time.Now().UTC().Truncate(time.Second),
+		LastID:    uuid.New(),
+		Indexed:   1500,
+	}
+	require.NoError(t, c.saveState(saved))
+	_, err = os.Stat(c.statePath + ".tmp")
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = c.loadState()
+	require.Error(t, err, "an unfinished reindex must not be overwritten")
+
+	c.resume = true
+	state, err = c.loadState()
+	require.NoError(t, err)
+	require.Equal(t, saved.Index, state.Index)
+	require.True(t, saved.StartedAt.Equal(state.StartedAt))
+	require.Equal(t, saved.LastID, state.LastID)
+	require.Equal(t, saved.Indexed, state.Indexed)
+	require.False(t, state.Swapped)
+
+	// once completed, a new reindex can start
+	state.Swapped = true
+	state.Completed = true
+	require.NoError(t, c.saveState(state))
+
+	state, err = c.loadState()
+	require.NoError(t, err)
+	require.True(t, state.Completed)
+
+	c.resume = false
+	state, err = c.loadState()
+	require.NoError(t, err)
+	require.Equal(t, &dto.ReindexListingsState{}, state)
+}
@@ -0,0 +1,208 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/user2410/rrms-backend/cmd/version"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+type searchConfig struct {
+	DatabaseURL string `mapstructure:"DB_URL" validate:"required,uri"`
+
+	// Elasticsearch
+	ElasticsearchAddresses  *string `mapstructure:"ELASTICSEARCH_ADDRESSES" validate:"omitempty"`
+	ElasticsearchUsername   *string `mapstructure:"ELASTICSEARCH_USERNAME" validate:"omitempty"`
+	ElasticsearchPassword   *string `mapstructure:"ELASTICSEARCH_PASSWORD" validate:"omitempty"`
+	ElasticsearchCACertPath *string `mapstructure:"ELASTICSEARCH_CACERT_PATH" validate:"omitempty"`
+	ElasticsearchURL        *string `mapstructure:"ELASTICSEARCH_URL" validate:"omitempty"`
+	ElasticsearchCloudID    *string `mapstructure:"ELASTICSEARCH_CLOUD_ID" validate:"omitempty"`
+	ElasticsearchAPIKey     *string `mapstructure:"ELASTICSEARCH_API_KEY" validate:"omitempty"`
+
+	// Redis
+	RedisAddr     string `mapstructure:"REDIS_ADDR" validate:"required"`
+	RedisPassword string `mapstructure:"REDIS_PASSWORD" validate:"omitempty"`
+	RedisDB       int    `mapstructure:"REDIS_DB" validate:"omitempty"`
+}
+
+type searchCommand struct {
+	*cobra.Command
+}
+
+func NewSearchCommand() *searchCommand {
+	c := &searchCommand{}
+	c.Command = &cobra.Command{
+		Use:   "search",
+		Short: fmt.Sprintf("Search index manager for %s", version.ReadableName),
+		Long: fmt.Sprintf(`%s
+Manage the search index of %s from the command line`, version.Art(), version.ReadableName),
+		Run: c.run,
+	}
+	c.Command.AddCommand(
+		newOutboxCommand().Command,
+		newReindexCommand().Command,
+	)
+	return c
+}
+
+func (c *searchCommand) run(cmd *cobra.Command, args []string) {
+	c.Help()
+}
+
+func newSearchConfig() *searchConfig {
+	var conf searchConfig
+	viper.AddConfigPath(".")
+	viper.SetConfigName("app")
+	viper.SetConfigType("env")
+	viper.AutomaticEnv()
+
+	err := viper.ReadInConfig()
+	if err != nil {
+		log.Fatal("Failed to read config file:", err)
+	}
+	err = viper.Unmarshal(&conf)
+	if err != nil {
+		log.Fatal("Failed to unmarshal config file:", err)
+	}
+
+	v := validator.New()
+	err = v.Struct(&conf)
+	if err != nil {
+		log.Fatal("Invalid or missing fields in config file: ", err)
+	}
+
+	return &conf
+}
+
+// outbox command
+
+type outboxCommand struct {
+	*cobra.Command
+}
+
+func newOutboxCommand() *outboxCommand {
+	c := &outboxCommand{}
+	c.Command = &cobra.Command{
+		Use:   "outbox",
+		Short: "Inspect and replay the outbox that keeps the listings index in sync with the database",
+		Run:   c.run,
+	}
+	config := newSearchConfig()
+	c.Command.AddCommand(
+		newOutboxStatsCommand(config).Command,
+		newOutboxReplayCommand(config).Command,
+	)
+	return c
+}
+
+func (c *outboxCommand) run(cmd *cobra.Command, args []string) {
+	c.Help()
+}
+
+// outbox stats command
+
+type outboxStatsCommand struct {
+	*cobra.Command
+	config *searchConfig
+}
+
+func newOutboxStatsCommand(config *searchConfig) *outboxStatsCommand {
+	c := &outboxStatsCommand{config: config}
+	c.Command = &cobra.Command{
+		Use:   "stats",
+		Short: "Print the number of pending and dead-lettered events and the projection lag",
+		Run:   c.run,
+	}
+	return c
+}
+
+func (c *outboxStatsCommand) run(cmd *cobra.Command, args []string) {
+	dao, err := database.NewPostgresDAO(c.config.DatabaseURL)
+	if err != nil {
+		log.Fatal("Error while initializing database connection: ", err)
+	}
+	defer dao.Close()
+
+	stats, err := dao.GetSearchOutboxStats(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	var lag time.Duration
+	if stats.Pending > 0 {
+		lag = time.Since(stats.OldestPendingAt).Round(time.Second)
+	}
+	log.Printf("pending: %d, dead: %d, lag: %s\n", stats.Pending, stats.Dead, lag)
+}
+
+// outbox replay command
+
+type outboxReplayCommand struct {
+	*cobra.Command
+	config   *searchConfig
+	since    string
+	listings []string
+}
+
+func newOutboxReplayCommand(config *searchConfig) *outboxReplayCommand {
+	c := &outboxReplayCommand{config: config}
+	c.Command = &cobra.Command{
+		Use:   "replay",
+		Short: "Requeue dead-lettered events, or every event since a point in time, for projection",
+		Run:   c.run,
+	}
+	c.Command.Flags().StringVar(&c.since, "since", "", "replay every event created at or after this RFC3339 time, including the processed ones")
+	c.Command.Flags().StringSliceVar(&c.listings, "listing", nil, "queue a new event for the given listing ids instead")
+	return c
+}
+
+func (c *outboxReplayCommand) run(cmd *cobra.Command, args []string) {
+	dao, err := database.NewPostgresDAO(c.config.DatabaseURL)
+	if err != nil {
+		log.Fatal("Error while initializing database connection: ", err)
+	}
+	defer dao.Close()
+	ctx := context.Background()
+
+	if len(c.listings) > 0 {
+		for _, l := range c.listings {
+			lid, err := uuid.Parse(l)
+			if err != nil {
+				log.Fatalf("invalid listing id %s: %v", l, err)
+			}
+			err = dao.CreateSearchOutboxEvent(ctx, database.CreateSearchOutboxEventParams{
+				ListingID: lid,
+				Source:    database.SEARCHOUTBOXSOURCELISTING,
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+		log.Printf("%d listings queued for projection\n", len(c.listings))
+		return
+	}
+
+	var n int64
+	if c.since != "" {
+		since, err := time.Parse(time.RFC3339, c.since)
+		if err != nil {
+			log.Fatal("invalid --since: ", err)
+		}
+		n, err = dao.ReplaySearchOutboxEventsSince(ctx, since)
+		if err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		n, err = dao.ReplayDeadSearchOutboxEvents(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	log.Printf("%d events requeued for projection\n", n)
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	application_asynctask "github.com/user2410/rrms-backend/internal/domain/application/asynctask"
+	listing_asynctask "github.com/user2410/rrms-backend/internal/domain/listing/asynctask"
 	property_asynctask "github.com/user2410/rrms-backend/internal/domain/property/asynctask"
 	rental_asynctask "github.com/user2410/rrms-backend/internal/domain/rental/asynctask"
 )
@@ -12,6 +13,9 @@ func (c *serverCommand) setupAsyncTaskProcessor() {
 	property_asynctask.
 		NewAdapter(c.internalServices.PropertyService).
 		Register(c.asyncTaskProcessor)
+	listing_asynctask.
+		NewAdapter(c.internalServices.ListingService).
+		Register(c.asyncTaskProcessor)
 	application_asynctask.
 		NewAdapter(c.internalServices.ApplicationService).
 		Register(c.asyncTaskProcessor)
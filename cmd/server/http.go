@@ -30,7 +30,7 @@ func (c *serverCommand) setupHttpServer() {
 		RegisterServer(apiRoute, c.tokenMaker)
 	listing_http.
 		NewAdapter(c.internalServices.ListingService, c.internalServices.PropertyService, c.internalServices.UnitService).
-		RegisterServer(apiRoute, c.tokenMaker)
+		RegisterServer(apiRoute, c.tokenMaker, c.internalServices.AuthService)
 	rental_http.
 		NewAdapter(c.internalServices.RentalService).
 		RegisterServer(apiRoute, c.tokenMaker)
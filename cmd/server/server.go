@@ -19,6 +19,7 @@ import (
 	"github.com/spf13/viper"
 	"github.com/user2410/rrms-backend/cmd/version"
 	services "github.com/user2410/rrms-backend/internal/domain/_services"
+	listing_search "github.com/user2410/rrms-backend/internal/domain/listing/search"
 	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
 	"github.com/user2410/rrms-backend/internal/infrastructure/aws"
 	"github.com/user2410/rrms-backend/internal/infrastructure/aws/s3"
@@ -28,6 +29,7 @@ import (
 	"github.com/user2410/rrms-backend/internal/infrastructure/http"
 	"github.com/user2410/rrms-backend/internal/infrastructure/notification"
 	"github.com/user2410/rrms-backend/internal/infrastructure/redisd"
+	"github.com/user2410/rrms-backend/internal/utils"
 	"github.com/user2410/rrms-backend/internal/utils/token"
 )
 
@@ -62,6 +64,12 @@ type serverConfig struct {
 	VnpHashSecret string `mapstructure:"VNP_HASHSECRET" validate:"required"`
 	VnpUrl        string `mapstructure:"VNP_URL" validate:"required"`
 	VnpApi        string `mapstructure:"VNP_API" validate:"required"`
+	// Token API, needed to save cards for the automatic renewals of listings
+	VnpTokenUrl string `mapstructure:"VNP_TOKENURL" validate:"omitempty"`
+
+	// Listing search backend, "elasticsearch" or "postgres".
+	// Defaults to elasticsearch when an Elasticsearch node is configured, postgres otherwise.
+	SearchBackend string `mapstructure:"SEARCH_BACKEND" validate:"omitempty,oneof=elasticsearch postgres"`
 
 	// Elasticsearch
 	ElasticsearchAddresses  *string `mapstructure:"ELASTICSEARCH_ADDRESSES" validate:"omitempty"`
@@ -218,18 +226,29 @@ func (c *serverCommand) setup() {
 	)
 
 	// setup elasticsearch client
-	c.elasticsearch, err = es.NewElasticSearchClient(es.ElasticSearchClientParams{
-		Addresses:  c.config.ElasticsearchAddresses,
-		Username:   c.config.ElasticsearchUsername,
-		Password:   c.config.ElasticsearchPassword,
-		CACertPath: c.config.ElasticsearchCACertPath,
-		Url:        c.config.ElasticsearchURL,
-		CloudID:    c.config.ElasticsearchCloudID,
-		APIKey:     c.config.ElasticsearchAPIKey,
-	})
-	if err != nil {
-		log.Fatal("Error while initializing Elasticsearch client", err)
+	if c.config.SearchBackend == "" {
+		c.config.SearchBackend = listing_search.BACKEND_POSTGRES
+		if utils.PtrDerefence(c.config.ElasticsearchAddresses, "") != "" ||
+			utils.PtrDerefence(c.config.ElasticsearchURL, "") != "" ||
+			utils.PtrDerefence(c.config.ElasticsearchCloudID, "") != "" {
+			c.config.SearchBackend = listing_search.BACKEND_ELASTICSEARCH
+		}
+	}
+	if c.config.SearchBackend == listing_search.BACKEND_ELASTICSEARCH {
+		c.elasticsearch, err = es.NewElasticSearchClient(es.ElasticSearchClientParams{
+			Addresses:  c.config.ElasticsearchAddresses,
+			Username:   c.config.ElasticsearchUsername,
+			Password:   c.config.ElasticsearchPassword,
+			CACertPath: c.config.ElasticsearchCACertPath,
+			Url:        c.config.ElasticsearchURL,
+			CloudID:    c.config.ElasticsearchCloudID,
+			APIKey:     c.config.ElasticsearchAPIKey,
+		})
+		if err != nil {
+			log.Fatal("Error while initializing Elasticsearch client", err)
+		}
 	}
+	log.Println("Listings are searched with", c.config.SearchBackend)
 
 	// setup redis client
 	rdb := redis.NewClient(&redis.Options{
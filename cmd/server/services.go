@@ -1,10 +1,13 @@
 package server
 
 import (
+	"log"
+
 	repos "github.com/user2410/rrms-backend/internal/domain/_repos"
 	application_service "github.com/user2410/rrms-backend/internal/domain/application/service"
 	auth_service "github.com/user2410/rrms-backend/internal/domain/auth/service"
 	"github.com/user2410/rrms-backend/internal/domain/chat"
+	listing_search "github.com/user2410/rrms-backend/internal/domain/listing/search"
 	listing_service "github.com/user2410/rrms-backend/internal/domain/listing/service"
 	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
 	vnp_service "github.com/user2410/rrms-backend/internal/domain/payment/service/vnpay"
@@ -30,6 +33,11 @@ func (c *serverCommand) setupInternalServices() {
 	// domainRepo.StatisticRepo = statistic_repo.NewRepo(c.dao)
 	// domainRepo.MiscRepo = misc_repo.NewRepo(c.dao)
 
+	searcher, err := listing_search.NewSearcher(c.config.SearchBackend, c.dao, domainRepo, c.elasticsearch)
+	if err != nil {
+		log.Fatal("Error while initializing listing search: ", err)
+	}
+
 	// Initialize internal services
 	c.internalServices.MiscService = misc_service.NewService(domainRepo, c.notificationEndpoint, c.cronScheduler)
 	c.internalServices.AuthService = auth_service.NewService(
@@ -49,6 +57,12 @@ func (c *serverCommand) setupInternalServices() {
 		domainRepo,
 		c.config.TokenSecreteKey,
 		c.elasticsearch,
+		searcher,
+		c.internalServices.MiscService,
+		c.asyncTaskDistributor,
+		c.cronScheduler,
+		c.config.FESite,
+		c.config.AWSS3ImageBucket,
 	)
 	c.internalServices.RentalService = rental_service.NewService(
 		domainRepo,
@@ -63,19 +77,28 @@ func (c *serverCommand) setupInternalServices() {
 	)
 	c.internalServices.ApplicationService = application_service.NewService(
 		domainRepo,
+		c.config.TokenSecreteKey,
 		c.internalServices.ReminderService, c.internalServices.MiscService,
 		c.s3Client, c.config.AWSS3ImageBucket,
 		c.asyncTaskDistributor,
+		c.cronScheduler,
 		c.config.FESite,
 	)
 	c.internalServices.PaymentService = vnp_service.NewVnpayService(
 		domainRepo,
 		c.internalServices.ListingService,
-		c.config.VnpTmnCode, c.config.VnpHashSecret, c.config.VnpUrl, c.config.VnpApi,
+		c.config.VnpTmnCode, c.config.VnpHashSecret, c.config.VnpUrl, c.config.VnpApi, c.config.VnpTokenUrl,
 	)
+	// rejected listings are refunded and listings are renewed through the payment service
+	c.internalServices.ListingService.SetRefundHook(c.internalServices.PaymentService.RefundListing)
+	c.internalServices.ListingService.SetRenewHook(c.internalServices.PaymentService.RenewListing)
 	c.internalServices.ChatService = chat.NewService(domainRepo.ChatRepo)
 	c.internalServices.StatisticService = statistic_service.NewService(
 		domainRepo,
-		c.elasticsearch,
+		searcher,
+		c.internalServices.MiscService,
+		c.s3Client, c.config.AWSS3ImageBucket,
+		c.cronScheduler,
+		c.config.FESite,
 	)
 }
@@ -30,6 +30,10 @@ func NewAdapter(service service.Service) Adapter {
 func (a *adapter) Register(processor asynctask.Processor) {
 	processor.RegisterHandler(asynctask.APPLICATION_NEW, a.notifyCreateApplication)
 	processor.RegisterHandler(asynctask.APPLICATION_UPDATE, a.notifyUpdateApplication)
+	processor.RegisterHandler(asynctask.APPLICATION_DOCUMENTS_REMIND, a.remindMissingDocuments)
+	processor.RegisterHandler(asynctask.APPLICATION_WAITLIST_CREATE, a.waitlistCompetingApplications)
+	processor.RegisterHandler(asynctask.APPLICATION_WAITLIST_PROMOTE, a.promoteWaitlistedApplication)
+	processor.RegisterHandler(asynctask.APPLICATION_EXPIRE, a.expireStaleApplications)
 }
 
 func (a *adapter) notifyCreateApplication(ctx context.Context, task *asynq.Task) error {
@@ -47,5 +51,29 @@ func (a *adapter) notifyUpdateApplication(ctx context.Context, task *asynq.Task)
 	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
 		return err
 	}
-	return a.service.SendNotificationOnUpdateApplication(payload.Application, payload.Status)
+	return a.service.SendNotificationOnUpdateApplication(&payload)
+}
+
+func (a *adapter) remindMissingDocuments(ctx context.Context, task *asynq.Task) error {
+	return a.service.RemindMissingDocuments()
+}
+
+func (a *adapter) waitlistCompetingApplications(ctx context.Context, task *asynq.Task) error {
+	var aid int64
+	if err := json.Unmarshal(task.Payload(), &aid); err != nil {
+		return err
+	}
+	return a.service.WaitlistCompetingApplications(aid)
+}
+
+func (a *adapter) promoteWaitlistedApplication(ctx context.Context, task *asynq.Task) error {
+	var aid int64
+	if err := json.Unmarshal(task.Payload(), &aid); err != nil {
+		return err
+	}
+	return a.service.PromoteWaitlistedApplication(aid)
+}
+
+func (a *adapter) expireStaleApplications(ctx context.Context, task *asynq.Task) error {
+	return a.service.ExpireStaleApplications()
 }
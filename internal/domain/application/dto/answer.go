@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type CreateApplicationAnswer struct {
+	QuestionID int64    `json:"questionId" validate:"required"`
+	Values     []string `json:"values"`
+	// filled from the question once the answer is validated
+	Question string                           `json:"-"`
+	Type     database.APPLICATIONQUESTIONTYPE `json:"-"`
+}
+
+func (a *CreateApplicationAnswer) ToCreateApplicationAnswerDB(aid int64) database.CreateApplicationAnswerParams {
+	return database.CreateApplicationAnswerParams{
+		ApplicationID: aid,
+		QuestionID:    types.Int64N(&a.QuestionID),
+		Question:      a.Question,
+		Type:          a.Type,
+		Values:        a.Values,
+	}
+}
+
+// PreCreateApplicationAnswerFile is a file to be uploaded as the answer to a FILE question
+type PreCreateApplicationAnswerFile struct {
+	QuestionID int64                     `json:"questionId" validate:"required"`
+	File       PreCreateApplicationMedia `json:"file" validate:"required"`
+}
+
+const (
+	ANSWERFILTER_EQ       = "eq"
+	ANSWERFILTER_CONTAINS = "contains"
+	ANSWERFILTER_GTE      = "gte"
+	ANSWERFILTER_LTE      = "lte"
+)
+
+// ApplicationAnswerFilter keeps the applications whose answer to the question matches the value.
+// Ranges apply to NUMBER and DATE questions, Type tells which one the value is meant for.
+type ApplicationAnswerFilter struct {
+	QuestionID int64
+	Op         string
+	Value      string
+	Type       database.APPLICATIONQUESTIONTYPE
+}
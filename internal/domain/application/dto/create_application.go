@@ -92,6 +92,10 @@ type PreCreateApplicationMedia struct {
 
 type PreCreateApplication struct {
 	Avatar PreCreateApplicationMedia `json:"avatar" validate:"required"`
+	// the answers are checked against the questions of the listing before anything is uploaded
+	ListingID   uuid.UUID                        `json:"listingId" validate:"omitempty"`
+	Answers     []CreateApplicationAnswer        `json:"answers" validate:"dive"`
+	AnswerFiles []PreCreateApplicationAnswerFile `json:"answerFiles" validate:"unique=QuestionID,dive"`
 }
 
 type CreateApplication struct {
@@ -130,6 +134,7 @@ type CreateApplication struct {
 	Coaps    []CreateApplicationCoapModel `json:"coaps" validate:"dive"`
 	Pets     []CreateApplicationPet       `json:"pets" validate:"dive"`
 	Vehicles []CreateApplicationVehicle   `json:"vehicles" validate:"dive"`
+	Answers  []CreateApplicationAnswer    `json:"answers" validate:"dive"`
 
 	ApplicationKey string `json:"k" validate:"omitempty"`
 }
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type PreCreateApplicationDocument struct {
+	DocumentType database.APPLICATIONDOCUMENTTYPE `json:"documentType" validate:"required,oneof=ID_CARD PROOF_OF_INCOME EMPLOYMENT_LETTER LANDLORD_REFERENCE"`
+	File         PreCreateApplicationMedia        `json:"file" validate:"required"`
+}
+
+type CreateApplicationDocument struct {
+	Type database.APPLICATIONDOCUMENTTYPE `json:"type" validate:"required,oneof=ID_CARD PROOF_OF_INCOME EMPLOYMENT_LETTER LANDLORD_REFERENCE"`
+	Url  string                           `json:"url" validate:"required,url"`
+}
+
+func (c *CreateApplicationDocument) ToUpsertApplicationDocumentDB(aid int64) database.UpsertApplicationDocumentParams {
+	return database.UpsertApplicationDocumentParams{
+		ApplicationID: aid,
+		Type:          c.Type,
+		Url:           c.Url,
+	}
+}
+
+type UpdateApplicationDocumentStatus struct {
+	Status database.APPLICATIONDOCUMENTSTATUS `json:"status" validate:"required,oneof=VERIFIED REJECTED"`
+	// required to tell the applicant why the document is rejected
+	Note *string `json:"note" validate:"required_if=Status REJECTED"`
+}
+
+func (u *UpdateApplicationDocumentStatus) ToUpdateApplicationDocumentStatusDB(aid int64, dtype database.APPLICATIONDOCUMENTTYPE) database.UpdateApplicationDocumentStatusParams {
+	return database.UpdateApplicationDocumentStatusParams{
+		Status:        u.Status,
+		Note:          types.StrN(u.Note),
+		ApplicationID: aid,
+		Type:          dtype,
+	}
+}
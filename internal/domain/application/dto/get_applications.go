@@ -12,7 +12,7 @@ import (
 
 const ApplicationFieldsLocalKey = "applicationFields"
 
-var retrievableFields = []string{"creator_id", "listing_id", "property_id", "unit_id", "listing_price", "offered_price", "status", "created_at", "updated_at", "full_name", "email", "phone", "dob", "profile_image", "movein_date", "preferred_term", "rental_intention", "organization_name", "organization_hq_address", "organization_scale", "rh_address", "rh_city", "rh_district", "rh_ward", "rh_rental_duration", "rh_monthly_payment", "rh_reason_for_leaving", "employment_status", "employment_company_name", "employment_position", "employment_monthly_income", "employment_comment", "minors", "coaps", "pets", "vehicles"}
+var retrievableFields = []string{"creator_id", "listing_id", "property_id", "unit_id", "listing_price", "offered_price", "status", "created_at", "updated_at", "full_name", "email", "phone", "dob", "profile_image", "movein_date", "preferred_term", "rental_intention", "organization_name", "organization_hq_address", "organization_scale", "rh_address", "rh_city", "rh_district", "rh_ward", "rh_rental_duration", "rh_monthly_payment", "rh_reason_for_leaving", "employment_status", "employment_company_name", "employment_position", "employment_monthly_income", "employment_comment", "minors", "coaps", "pets", "vehicles", "answers", "references"}
 
 func GetRetrievableFields() []string {
 	rfs := make([]string, len(retrievableFields))
@@ -90,6 +90,9 @@ type GetApplicationsOfPropertyQuery struct {
 	ListingIds []uuid.UUID `query:"listingIds" validate:"dive,uuid4"`
 	Limit      *int32      `query:"limit" validate:"omitempty,gte=0"`
 	Offset     *int32      `json:"offset" validate:"omitempty,gte=0"`
+	// filters on the answers to the questions of the listings, in the form <questionId>:<eq|contains|gte|lte>:<value>
+	Answers       []string                  `query:"answers"`
+	AnswerFilters []ApplicationAnswerFilter `query:"-"`
 }
 
 func (q *GetApplicationsOfPropertyQuery) QueryParser(ctx *fiber.Ctx) error {
@@ -8,4 +8,10 @@ import (
 type NotificationOnUpdateApplication struct {
 	Application *model.ApplicationModel    `json:"application"`
 	Status      database.APPLICATIONSTATUS `json:"status"`
+	// message of the managers to the applicant
+	Message *string `json:"message"`
+	// the status is changed by the system rather than by a user
+	Automatic bool `json:"automatic"`
+	// the managers of the property are notified as well
+	NotifyManagers bool `json:"notifyManagers"`
 }
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// CreateApplicationReference is the contact of a previous landlord of the applicant
+type CreateApplicationReference struct {
+	FullName string  `json:"fullName" validate:"required"`
+	Email    string  `json:"email" validate:"required,email"`
+	Phone    *string `json:"phone" validate:"omitempty"`
+	// defaults to the previous address of the application
+	RentalAddress *string `json:"rentalAddress" validate:"omitempty"`
+}
+
+func (c *CreateApplicationReference) ToCreateApplicationReferenceDB(aid int64, expiresAt time.Time) database.CreateApplicationReferenceParams {
+	return database.CreateApplicationReferenceParams{
+		ApplicationID: aid,
+		RefereeName:   c.FullName,
+		RefereeEmail:  c.Email,
+		RefereePhone:  types.StrN(c.Phone),
+		RentalAddress: types.StrN(c.RentalAddress),
+		ExpiresAt:     expiresAt,
+	}
+}
+
+type GetApplicationReferenceForm struct {
+	Key string `query:"k" validate:"required"`
+}
+
+// RespondApplicationReference is the questionnaire answered by the referee
+type RespondApplicationReference struct {
+	PaidOnTime     *bool   `json:"paidOnTime" validate:"required"`
+	CausedDamage   *bool   `json:"causedDamage" validate:"required"`
+	WouldRentAgain *bool   `json:"wouldRentAgain" validate:"required"`
+	RentalDuration *int32  `json:"rentalDuration" validate:"omitempty,gt=0"`
+	Comment        *string `json:"comment" validate:"omitempty"`
+}
+
+func (r *RespondApplicationReference) ToRespondApplicationReferenceDB(id int64, refereeId uuid.UUID) database.RespondApplicationReferenceParams {
+	return database.RespondApplicationReferenceParams{
+		RefereeID:      types.UUIDN(refereeId),
+		PaidOnTime:     types.BoolN(r.PaidOnTime),
+		CausedDamage:   types.BoolN(r.CausedDamage),
+		WouldRentAgain: types.BoolN(r.WouldRentAgain),
+		RentalDuration: types.Int32N(r.RentalDuration),
+		Comment:        types.StrN(r.Comment),
+		ID:             id,
+	}
+}
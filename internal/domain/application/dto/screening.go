@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+type UpdateScreeningConfig struct {
+	IncomeWeight            float32 `json:"incomeWeight" validate:"gte=0"`
+	HouseholdIncomeWeight   float32 `json:"householdIncomeWeight" validate:"gte=0"`
+	RentalHistoryWeight     float32 `json:"rentalHistoryWeight" validate:"gte=0"`
+	PolicyWeight            float32 `json:"policyWeight" validate:"gte=0"`
+	PaymentHistoryWeight    float32 `json:"paymentHistoryWeight" validate:"gte=0"`
+	MinIncomeRatio          float32 `json:"minIncomeRatio" validate:"gt=0"`
+	MinHouseholdIncomeRatio float32 `json:"minHouseholdIncomeRatio" validate:"gt=0"`
+	MinRentalHistoryMonths  int32   `json:"minRentalHistoryMonths" validate:"gte=0"`
+	MinOnTimeRate           float32 `json:"minOnTimeRate" validate:"gte=0,lte=1"`
+}
+
+// HasWeight tells whether at least one factor counts towards the score
+func (u *UpdateScreeningConfig) HasWeight() bool {
+	return u.IncomeWeight+u.HouseholdIncomeWeight+u.RentalHistoryWeight+u.PolicyWeight+u.PaymentHistoryWeight > 0
+}
+
+func (u *UpdateScreeningConfig) ToUpsertPropertyScreeningConfigDB(pid uuid.UUID) database.UpsertPropertyScreeningConfigParams {
+	return database.UpsertPropertyScreeningConfigParams{
+		PropertyID:              pid,
+		IncomeWeight:            u.IncomeWeight,
+		HouseholdIncomeWeight:   u.HouseholdIncomeWeight,
+		RentalHistoryWeight:     u.RentalHistoryWeight,
+		PolicyWeight:            u.PolicyWeight,
+		PaymentHistoryWeight:    u.PaymentHistoryWeight,
+		MinIncomeRatio:          u.MinIncomeRatio,
+		MinHouseholdIncomeRatio: u.MinHouseholdIncomeRatio,
+		MinRentalHistoryMonths:  u.MinRentalHistoryMonths,
+		MinOnTimeRate:           u.MinOnTimeRate,
+	}
+}
@@ -6,8 +6,10 @@ import (
 )
 
 type UpdateApplicationStatus struct {
-	Status  database.APPLICATIONSTATUS `json:"status" validate:"required,oneof=WITHDRAWN PENDING CONDITIONALLY_APPROVED APPROVED REJECTED"`
+	Status  database.APPLICATIONSTATUS `json:"status" validate:"required,oneof=WITHDRAWN PENDING CONDITIONALLY_APPROVED APPROVED REJECTED WAITLISTED"`
 	Message *string                    `json:"message" validate:"omitempty"`
+	// approve the application even though its mandatory documents are not all verified
+	OverrideDocuments bool `json:"overrideDocuments"`
 }
 
 func (u *UpdateApplicationStatus) Validate() error {
@@ -21,3 +23,9 @@ func (u *UpdateApplicationStatus) Validate() error {
 	}
 	return nil
 }
+
+type RejectApplications struct {
+	IDs []int64 `json:"ids" validate:"required,min=1,max=100,unique,dive,gt=0"`
+	// template of the message sent to every applicant, see utils.RenderRejectionMessage
+	Message string `json:"message" validate:"required"`
+}
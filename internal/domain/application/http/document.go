@@ -0,0 +1,122 @@
+package http
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/user2410/rrms-backend/internal/domain/application/dto"
+	application_service "github.com/user2410/rrms-backend/internal/domain/application/service"
+	auth_http "github.com/user2410/rrms-backend/internal/domain/auth/http"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/token"
+	"github.com/user2410/rrms-backend/internal/utils/validation"
+)
+
+// applicantErrorStatus maps the errors of the applicant actions on documents to http statuses
+func applicantErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, database.ErrRecordNotFound):
+		return fiber.StatusNotFound
+	case errors.Is(err, application_service.ErrNotApplicant):
+		return fiber.StatusForbidden
+	case errors.Is(err, application_service.ErrApplicationClosed):
+		return fiber.StatusConflict
+	case errors.Is(err, application_service.ErrInvalidDocumentUrl):
+		return fiber.StatusBadRequest
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
+
+func (a *adapter) getApplicationDocumentChecklist() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		aid := ctx.Locals(ApplicationIdLocalKey).(int64)
+
+		res, err := a.aService.GetApplicationDocumentChecklist(aid)
+		if err != nil {
+			if errors.Is(err, database.ErrRecordNotFound) {
+				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{"message": "application not found"})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func (a *adapter) preCreateApplicationDocument() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		aid := ctx.Locals(ApplicationIdLocalKey).(int64)
+
+		var payload dto.PreCreateApplicationDocument
+		if err := ctx.BodyParser(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		tkPayload := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload)
+		if err := a.aService.PreCreateApplicationDocument(aid, tkPayload.UserID, &payload); err != nil {
+			return ctx.Status(applicantErrorStatus(err)).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(payload)
+	}
+}
+
+func (a *adapter) createApplicationDocument() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		aid := ctx.Locals(ApplicationIdLocalKey).(int64)
+
+		var payload dto.CreateApplicationDocument
+		if err := ctx.BodyParser(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		tkPayload := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload)
+		res, err := a.aService.CreateApplicationDocument(aid, tkPayload.UserID, &payload)
+		if err != nil {
+			return ctx.Status(applicantErrorStatus(err)).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusCreated).JSON(res)
+	}
+}
+
+func (a *adapter) updateApplicationDocumentStatus() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		aid := ctx.Locals(ApplicationIdLocalKey).(int64)
+		dtype := database.APPLICATIONDOCUMENTTYPE(ctx.Params("type"))
+		if !slices.Contains([]database.APPLICATIONDOCUMENTTYPE{
+			database.APPLICATIONDOCUMENTTYPEIDCARD,
+			database.APPLICATIONDOCUMENTTYPEPROOFOFINCOME,
+			database.APPLICATIONDOCUMENTTYPEEMPLOYMENTLETTER,
+			database.APPLICATIONDOCUMENTTYPELANDLORDREFERENCE,
+		}, dtype) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": "invalid document type"})
+		}
+
+		var payload dto.UpdateApplicationDocumentStatus
+		if err := ctx.BodyParser(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		tkPayload := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload)
+		if err := a.aService.UpdateApplicationDocumentStatus(aid, dtype, tkPayload.UserID, &payload); err != nil {
+			if errors.Is(err, database.ErrRecordNotFound) {
+				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{"message": "document not found"})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	application_service "github.com/user2410/rrms-backend/internal/domain/application/service"
+	application_utils "github.com/user2410/rrms-backend/internal/domain/application/utils"
 	listing_service "github.com/user2410/rrms-backend/internal/domain/listing/service"
 
 	"github.com/user2410/rrms-backend/internal/utils"
@@ -56,6 +57,13 @@ func (a *adapter) RegisterServer(route *fiber.Router, tokenMaker token.Maker) {
 		auth_http.AuthorizedMiddleware(tokenMaker),
 		a.getApplicationsByIds(),
 	)
+	applicationRoute.Post("/reject",
+		auth_http.AuthorizedMiddleware(tokenMaker),
+		a.rejectApplications(),
+	)
+	// questionnaire links sent to the previous landlords, no account required
+	applicationRoute.Get("/references/_form", a.getApplicationReferenceForm())
+	applicationRoute.Post("/references/_form", a.respondApplicationReference())
 	applicationRoute.Group("/application/:id").Use(GetApplicationId())
 	applicationRoute.Get("/application/:id",
 		auth_http.AuthorizedMiddleware(tokenMaker),
@@ -82,6 +90,46 @@ func (a *adapter) RegisterServer(route *fiber.Router, tokenMaker token.Maker) {
 		CheckApplicationVisibilty(a.aService),
 		a.getRentalByApplicationId(),
 	)
+	applicationRoute.Get("/application/:id/screening",
+		auth_http.AuthorizedMiddleware(tokenMaker),
+		CheckApplicationUpdatability(a.aService),
+		a.getApplicationScreeningReport(),
+	)
+	applicationRoute.Get("/application/:id/ranking",
+		auth_http.AuthorizedMiddleware(tokenMaker),
+		CheckApplicationUpdatability(a.aService),
+		a.getUnitApplicationRanking(),
+	)
+	applicationRoute.Get("/application/:id/documents",
+		auth_http.AuthorizedMiddleware(tokenMaker),
+		CheckApplicationVisibilty(a.aService),
+		a.getApplicationDocumentChecklist(),
+	)
+	applicationRoute.Post("/application/:id/documents/_pre",
+		auth_http.AuthorizedMiddleware(tokenMaker),
+		CheckApplicationVisibilty(a.aService),
+		a.preCreateApplicationDocument(),
+	)
+	applicationRoute.Post("/application/:id/documents",
+		auth_http.AuthorizedMiddleware(tokenMaker),
+		CheckApplicationVisibilty(a.aService),
+		a.createApplicationDocument(),
+	)
+	applicationRoute.Patch("/application/:id/documents/:type",
+		auth_http.AuthorizedMiddleware(tokenMaker),
+		CheckApplicationUpdatability(a.aService),
+		a.updateApplicationDocumentStatus(),
+	)
+	applicationRoute.Get("/application/:id/references",
+		auth_http.AuthorizedMiddleware(tokenMaker),
+		CheckApplicationVisibilty(a.aService),
+		a.getApplicationReferences(),
+	)
+	applicationRoute.Post("/application/:id/references",
+		auth_http.AuthorizedMiddleware(tokenMaker),
+		CheckApplicationVisibilty(a.aService),
+		a.createApplicationReference(),
+	)
 }
 
 func NewAdapter(lService listing_service.Service, aService application_service.Service) Adapter {
@@ -105,6 +153,9 @@ func (a *adapter) preCreateApplications() fiber.Handler {
 
 		err := a.aService.PreCreateApplication(&payload, tkPayload.UserID)
 		if err != nil {
+			if errors.Is(err, application_utils.ErrInvalidAnswer) {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+			}
 			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
 		}
 
@@ -156,6 +207,9 @@ func (a *adapter) createApplications() fiber.Handler {
 				return responses.DBErrorResponse(ctx, dbErr)
 			}
 
+			if errors.Is(err, application_utils.ErrInvalidAnswer) {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+			}
 			if errors.Is(err, application_service.ErrAlreadyApplied) ||
 				errors.Is(err, application_service.ErrListingIsClosed) ||
 				errors.Is(err, application_service.ErrInvalidApplicant) {
@@ -296,7 +350,7 @@ func (a *adapter) updateApplicationStatus() fiber.Handler {
 			if err == database.ErrRecordNotFound {
 				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{"message": "application not found"})
 			}
-			if errors.Is(err, application_service.ErrInvalidStatusTransition) {
+			if errors.Is(err, application_service.ErrInvalidStatusTransition) || errors.Is(err, application_service.ErrMandatoryDocumentsNotVerified) {
 				return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{"message": err.Error()})
 			}
 			if errors.Is(err, application_service.ErrUnauthorizedUpdate) {
@@ -309,6 +363,29 @@ func (a *adapter) updateApplicationStatus() fiber.Handler {
 	}
 }
 
+func (a *adapter) rejectApplications() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		var payload dto.RejectApplications
+		if err := ctx.BodyParser(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		tkPayload := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload)
+		res, err := a.aService.RejectApplications(tkPayload.UserID, &payload)
+		if err != nil {
+			if errors.Is(err, application_utils.ErrInvalidRejectionMessage) {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
 func (a *adapter) createApplicationMsgGroup() fiber.Handler {
 	return func(ctx *fiber.Ctx) error {
 		aid, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
@@ -372,3 +449,35 @@ func (a *adapter) getRentalByApplicationId() fiber.Handler {
 		return ctx.Status(fiber.StatusOK).JSON(res)
 	}
 }
+
+func (a *adapter) getApplicationScreeningReport() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		aid := ctx.Locals(ApplicationIdLocalKey).(int64)
+
+		res, err := a.aService.GetApplicationScreeningReport(aid)
+		if err != nil {
+			if errors.Is(err, database.ErrRecordNotFound) {
+				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{"message": "application not found"})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func (a *adapter) getUnitApplicationRanking() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		aid := ctx.Locals(ApplicationIdLocalKey).(int64)
+
+		res, err := a.aService.GetUnitApplicationRanking(aid)
+		if err != nil {
+			if errors.Is(err, database.ErrRecordNotFound) {
+				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{"message": "application not found"})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
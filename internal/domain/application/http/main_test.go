@@ -45,8 +45,8 @@ func newTestServer(
 	miscService := misc_service.NewService(domainRepo, nil, cron.New())
 	// TODO: mock s3 client
 	s3Client := s3.NewMockS3Client(mockCtrl)
-	applicationService := application.NewService(domainRepo, reminderService, miscService, s3Client, "", nil, "https://rrms.rental.vn/")
-	lService := listing_service.NewService(domainRepo, "", nil) // NOTE: leave esClient nil for now
+	applicationService := application.NewService(domainRepo, "", reminderService, miscService, s3Client, "", nil, cron.New(), "https://rrms.rental.vn/")
+	lService := listing_service.NewService(domainRepo, "", nil, nil, nil, nil, cron.New(), "", "") // NOTE: leave esClient nil for now
 
 	// initialize http router
 	httpServer := http.NewServer(
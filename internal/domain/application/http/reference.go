@@ -0,0 +1,110 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/user2410/rrms-backend/internal/domain/application/dto"
+	application_service "github.com/user2410/rrms-backend/internal/domain/application/service"
+	application_utils "github.com/user2410/rrms-backend/internal/domain/application/utils"
+	auth_http "github.com/user2410/rrms-backend/internal/domain/auth/http"
+	"github.com/user2410/rrms-backend/internal/utils/token"
+	"github.com/user2410/rrms-backend/internal/utils/validation"
+)
+
+// refereeErrorStatus maps the errors of the questionnaire links to http statuses
+func refereeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, application_utils.ErrInvalidReferenceLink):
+		return fiber.StatusForbidden
+	case errors.Is(err, application_utils.ErrReferenceLinkExpired):
+		return fiber.StatusGone
+	case errors.Is(err, application_service.ErrReferenceAnswered):
+		return fiber.StatusConflict
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
+
+func (a *adapter) getApplicationReferences() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		aid := ctx.Locals(ApplicationIdLocalKey).(int64)
+
+		res, err := a.aService.GetApplicationReferences(aid)
+		if err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func (a *adapter) createApplicationReference() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		aid := ctx.Locals(ApplicationIdLocalKey).(int64)
+
+		var payload dto.CreateApplicationReference
+		if err := ctx.BodyParser(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		tkPayload := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload)
+		res, err := a.aService.CreateApplicationReference(aid, tkPayload.UserID, &payload)
+		if err != nil {
+			if errors.Is(err, application_service.ErrSelfReference) ||
+				errors.Is(err, application_service.ErrReferenceLimitReached) {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+			}
+			return ctx.Status(applicantErrorStatus(err)).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusCreated).JSON(res)
+	}
+}
+
+func (a *adapter) getApplicationReferenceForm() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		var query dto.GetApplicationReferenceForm
+		if err := ctx.QueryParser(&query); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, query); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		res, err := a.aService.GetApplicationReferenceForm(query.Key)
+		if err != nil {
+			return ctx.Status(refereeErrorStatus(err)).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func (a *adapter) respondApplicationReference() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		var query dto.GetApplicationReferenceForm
+		if err := ctx.QueryParser(&query); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		var payload dto.RespondApplicationReference
+		if err := ctx.BodyParser(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, query); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		if err := a.aService.RespondApplicationReference(query.Key, &payload); err != nil {
+			return ctx.Status(refereeErrorStatus(err)).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
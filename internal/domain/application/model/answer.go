@@ -0,0 +1,29 @@
+package model
+
+import (
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// ApplicationAnswerModel is the answer of the applicant to a question of the listing
+type ApplicationAnswerModel struct {
+	ID            int64 `json:"id"`
+	ApplicationID int64 `json:"applicationId"`
+	// nil if the question has been deleted
+	QuestionID *int64 `json:"questionId"`
+	// the question as it was asked
+	Question string                           `json:"question"`
+	Type     database.APPLICATIONQUESTIONTYPE `json:"type"`
+	Values   []string                         `json:"values"`
+}
+
+func ToApplicationAnswerModel(a *database.ApplicationAnswer) ApplicationAnswerModel {
+	return ApplicationAnswerModel{
+		ID:            a.ID,
+		ApplicationID: a.ApplicationID,
+		QuestionID:    types.PNInt64(a.QuestionID),
+		Question:      a.Question,
+		Type:          a.Type,
+		Values:        a.Values,
+	}
+}
@@ -139,6 +139,10 @@ type ApplicationModel struct {
 	Coaps    []ApplicationCoapModel  `json:"coaps"`
 	Pets     []ApplicationPetModel   `json:"pets"`
 	Vehicles []ApplicationVehicle    `json:"vehicles"`
+	// answers to the questions of the listing
+	Answers []ApplicationAnswerModel `json:"answers"`
+	// references requested from previous landlords
+	References []ApplicationReferenceModel `json:"references"`
 }
 
 func ToApplicationModel(a *database.Application) *ApplicationModel {
@@ -181,5 +185,18 @@ func ToApplicationModel(a *database.Application) *ApplicationModel {
 		Coaps:                   make([]ApplicationCoapModel, 0),
 		Pets:                    make([]ApplicationPetModel, 0),
 		Vehicles:                make([]ApplicationVehicle, 0),
+		Answers:                 make([]ApplicationAnswerModel, 0),
+		References:              make([]ApplicationReferenceModel, 0),
 	}
 }
+
+// RejectApplicationsResultModel is the outcome of rejecting many applications at once
+type RejectApplicationsResultModel struct {
+	Rejected []int64                    `json:"rejected"`
+	Failed   []RejectApplicationFailure `json:"failed"`
+}
+
+type RejectApplicationFailure struct {
+	ApplicationID int64  `json:"applicationId"`
+	Message       string `json:"message"`
+}
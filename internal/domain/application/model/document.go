@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type ApplicationDocumentModel struct {
+	ID            int64                              `json:"id"`
+	ApplicationID int64                              `json:"applicationId"`
+	Type          database.APPLICATIONDOCUMENTTYPE   `json:"type"`
+	Url           string                             `json:"url"`
+	Status        database.APPLICATIONDOCUMENTSTATUS `json:"status"`
+	// remark of the manager, e.g. why the document is rejected
+	Note       *string    `json:"note"`
+	VerifiedBy *uuid.UUID `json:"verifiedBy"`
+	VerifiedAt *time.Time `json:"verifiedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+func ToApplicationDocumentModel(d *database.ApplicationDocument) ApplicationDocumentModel {
+	m := ApplicationDocumentModel{
+		ID:            d.ID,
+		ApplicationID: d.ApplicationID,
+		Type:          d.Type,
+		Url:           d.Url,
+		Status:        d.Status,
+		Note:          types.PNStr(d.Note),
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+	}
+	if d.VerifiedBy.Valid {
+		m.VerifiedBy = types.Ptr(uuid.UUID(d.VerifiedBy.Bytes))
+	}
+	if d.VerifiedAt.Valid {
+		m.VerifiedAt = &d.VerifiedAt.Time
+	}
+	return m
+}
+
+// ApplicationDocumentChecklistItem is a document the applicant is asked to upload, or has uploaded without being asked
+type ApplicationDocumentChecklistItem struct {
+	Type database.APPLICATIONDOCUMENTTYPE `json:"type"`
+	// the document is in the checklist of the listing
+	Required  bool    `json:"required"`
+	Mandatory bool    `json:"mandatory"`
+	Note      *string `json:"note"`
+	// nil if not uploaded
+	Document *ApplicationDocumentModel `json:"document"`
+}
+
+type ApplicationDocumentChecklist struct {
+	Items []ApplicationDocumentChecklistItem `json:"items"`
+	// mandatory documents not uploaded, or rejected
+	Missing []database.APPLICATIONDOCUMENTTYPE `json:"missing"`
+	// mandatory documents not verified yet, including the missing ones
+	Unverified []database.APPLICATIONDOCUMENTTYPE `json:"unverified"`
+}
+
+// Complete tells whether all mandatory documents are verified
+func (c *ApplicationDocumentChecklist) Complete() bool {
+	return len(c.Unverified) == 0
+}
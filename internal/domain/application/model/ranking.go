@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// ApplicationRankingModel is the position of an application among the applications competing for the same unit
+type ApplicationRankingModel struct {
+	ApplicationID int64                      `json:"applicationId"`
+	Rank          int                        `json:"rank"`
+	Status        database.APPLICATIONSTATUS `json:"status"`
+	FullName      string                     `json:"fullName"`
+	OfferedPrice  float32                    `json:"offeredPrice"`
+	MoveinDate    time.Time                  `json:"moveinDate"`
+	CreatedAt     time.Time                  `json:"createdAt"`
+	// weighted sum of the scores below, from 0 to 100
+	Score          float64 `json:"score"`
+	ScreeningScore float64 `json:"screeningScore"`
+	// from 0 to 100, how the offered price compares to the listing price
+	PriceScore float64 `json:"priceScore"`
+	// from 0 to 100, how close the move-in date is to the date the unit becomes available
+	MoveinScore float64 `json:"moveinScore"`
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"time"
+
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// ApplicationReferenceResponseModel is the questionnaire answered by the previous landlord
+type ApplicationReferenceResponseModel struct {
+	PaidOnTime     bool `json:"paidOnTime"`
+	CausedDamage   bool `json:"causedDamage"`
+	WouldRentAgain bool `json:"wouldRentAgain"`
+	// in months
+	RentalDuration *int32    `json:"rentalDuration"`
+	Comment        *string   `json:"comment"`
+	RespondedAt    time.Time `json:"respondedAt"`
+}
+
+// ApplicationReferenceModel is a reference requested from a previous landlord of the applicant
+type ApplicationReferenceModel struct {
+	ID            int64     `json:"id"`
+	ApplicationID int64     `json:"applicationId"`
+	RefereeName   string    `json:"refereeName"`
+	RefereeEmail  string    `json:"refereeEmail"`
+	RefereePhone  *string   `json:"refereePhone"`
+	RentalAddress *string   `json:"rentalAddress"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+	CreatedAt     time.Time `json:"createdAt"`
+	// the referee is a user of the platform
+	VerifiedOnPlatform bool `json:"verifiedOnPlatform"`
+	// nil until the referee responds
+	Response *ApplicationReferenceResponseModel `json:"response"`
+}
+
+func ToApplicationReferenceModel(r *database.ApplicationReference) ApplicationReferenceModel {
+	m := ApplicationReferenceModel{
+		ID:                 r.ID,
+		ApplicationID:      r.ApplicationID,
+		RefereeName:        r.RefereeName,
+		RefereeEmail:       r.RefereeEmail,
+		RefereePhone:       types.PNStr(r.RefereePhone),
+		RentalAddress:      types.PNStr(r.RentalAddress),
+		ExpiresAt:          r.ExpiresAt,
+		CreatedAt:          r.CreatedAt,
+		VerifiedOnPlatform: r.RefereeID.Valid,
+	}
+	if r.RespondedAt.Valid {
+		m.Response = &ApplicationReferenceResponseModel{
+			PaidOnTime:     r.PaidOnTime.Bool,
+			CausedDamage:   r.CausedDamage.Bool,
+			WouldRentAgain: r.WouldRentAgain.Bool,
+			RentalDuration: types.PNInt32(r.RentalDuration),
+			Comment:        types.PNStr(r.Comment),
+			RespondedAt:    r.RespondedAt.Time,
+		}
+	}
+	return m
+}
+
+// ApplicationReferenceFormModel is what the referee sees before answering the questionnaire
+type ApplicationReferenceFormModel struct {
+	RefereeName   string    `json:"refereeName"`
+	ApplicantName string    `json:"applicantName"`
+	RentalAddress *string   `json:"rentalAddress"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+	Responded     bool      `json:"responded"`
+}
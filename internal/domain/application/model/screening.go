@@ -0,0 +1,104 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// ScreeningConfigModel holds the weights of the screening factors and the thresholds the applications to a property are screened against
+type ScreeningConfigModel struct {
+	PropertyID            uuid.UUID `json:"propertyId"`
+	IncomeWeight          float32   `json:"incomeWeight"`
+	HouseholdIncomeWeight float32   `json:"householdIncomeWeight"`
+	RentalHistoryWeight   float32   `json:"rentalHistoryWeight"`
+	PolicyWeight          float32   `json:"policyWeight"`
+	PaymentHistoryWeight  float32   `json:"paymentHistoryWeight"`
+	// minimum ratio of the monthly income of the applicant to the offered price
+	MinIncomeRatio float32 `json:"minIncomeRatio"`
+	// minimum ratio of the monthly income of the applicant and the co-applicants to the offered price
+	MinHouseholdIncomeRatio float32 `json:"minHouseholdIncomeRatio"`
+	MinRentalHistoryMonths  int32   `json:"minRentalHistoryMonths"`
+	// minimum share of the payments of previous rentals paid on time
+	MinOnTimeRate float32 `json:"minOnTimeRate"`
+	// nil until the managers of the property set their own config
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+func ToScreeningConfigModel(c *database.PropertyScreeningConfig) ScreeningConfigModel {
+	return ScreeningConfigModel{
+		PropertyID:              c.PropertyID,
+		IncomeWeight:            c.IncomeWeight,
+		HouseholdIncomeWeight:   c.HouseholdIncomeWeight,
+		RentalHistoryWeight:     c.RentalHistoryWeight,
+		PolicyWeight:            c.PolicyWeight,
+		PaymentHistoryWeight:    c.PaymentHistoryWeight,
+		MinIncomeRatio:          c.MinIncomeRatio,
+		MinHouseholdIncomeRatio: c.MinHouseholdIncomeRatio,
+		MinRentalHistoryMonths:  c.MinRentalHistoryMonths,
+		MinOnTimeRate:           c.MinOnTimeRate,
+		UpdatedAt:               &c.UpdatedAt,
+	}
+}
+
+// DefaultScreeningConfigModel returns the config of a property whose managers have not set one, matching the defaults of the property_screening_configs table
+func DefaultScreeningConfigModel(pid uuid.UUID) ScreeningConfigModel {
+	return ScreeningConfigModel{
+		PropertyID:              pid,
+		IncomeWeight:            30,
+		HouseholdIncomeWeight:   20,
+		RentalHistoryWeight:     15,
+		PolicyWeight:            15,
+		PaymentHistoryWeight:    20,
+		MinIncomeRatio:          3,
+		MinHouseholdIncomeRatio: 3,
+		MinRentalHistoryMonths:  12,
+		MinOnTimeRate:           0.9,
+	}
+}
+
+// TenantPaymentStatsModel sums up the payments of the previous rentals of a tenant on the platform
+type TenantPaymentStatsModel struct {
+	Rentals        int32 `json:"rentals"`
+	PaidPayments   int32 `json:"paidPayments"`
+	OnTimePayments int32 `json:"onTimePayments"`
+	// payments past their expiry date and not paid yet
+	OverduePayments int32 `json:"overduePayments"`
+}
+
+type ScreeningFactor string
+
+const (
+	SCREENING_FACTOR_INCOME           ScreeningFactor = "INCOME"
+	SCREENING_FACTOR_HOUSEHOLD_INCOME ScreeningFactor = "HOUSEHOLD_INCOME"
+	SCREENING_FACTOR_RENTAL_HISTORY   ScreeningFactor = "RENTAL_HISTORY"
+	SCREENING_FACTOR_POLICIES         ScreeningFactor = "POLICIES"
+	SCREENING_FACTOR_PAYMENT_HISTORY  ScreeningFactor = "PAYMENT_HISTORY"
+)
+
+// ScreeningFactorResult is the outcome of one factor of a screening report
+type ScreeningFactorResult struct {
+	Factor ScreeningFactor `json:"factor"`
+	Weight float32         `json:"weight"`
+	// false when the application lacks the data to assess the factor, which is then left out of the score
+	Available bool `json:"available"`
+	// from 0 to 1
+	Score  float64 `json:"score"`
+	Passed bool    `json:"passed"`
+	// the measured value and the threshold it is compared with, if any
+	Value     *float64 `json:"value"`
+	Threshold *float64 `json:"threshold"`
+	Notes     []string `json:"notes"`
+}
+
+// ScreeningReportModel breaks down the screening of an application
+type ScreeningReportModel struct {
+	ApplicationID int64 `json:"applicationId"`
+	// weighted average of the scores of the available factors, from 0 to 100
+	Score           float64                  `json:"score"`
+	HouseholdIncome int64                    `json:"householdIncome"`
+	PaymentStats    *TenantPaymentStatsModel `json:"paymentStats"`
+	Factors         []ScreeningFactorResult  `json:"factors"`
+	Config          ScreeningConfigModel     `json:"config"`
+}
@@ -0,0 +1,56 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/user2410/rrms-backend/internal/domain/application/dto"
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// UpsertApplicationDocument creates the document or replaces the one of the same type, which then has to be verified again
+func (r *repo) UpsertApplicationDocument(ctx context.Context, aid int64, data *dto.CreateApplicationDocument) (model.ApplicationDocumentModel, error) {
+	res, err := r.dao.UpsertApplicationDocument(ctx, data.ToUpsertApplicationDocumentDB(aid))
+	if err != nil {
+		return model.ApplicationDocumentModel{}, err
+	}
+	return model.ToApplicationDocumentModel(&res), nil
+}
+
+func (r *repo) GetApplicationDocuments(ctx context.Context, aid int64) ([]model.ApplicationDocumentModel, error) {
+	res, err := r.dao.GetApplicationDocuments(ctx, aid)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.ApplicationDocumentModel, 0, len(res))
+	for i := range res {
+		items = append(items, model.ToApplicationDocumentModel(&res[i]))
+	}
+	return items, nil
+}
+
+// UpdateApplicationDocumentStatus returns false if the application has no document of the type
+func (r *repo) UpdateApplicationDocumentStatus(ctx context.Context, aid int64, dtype database.APPLICATIONDOCUMENTTYPE, managerId uuid.UUID, data *dto.UpdateApplicationDocumentStatus) (bool, error) {
+	params := data.ToUpdateApplicationDocumentStatusDB(aid, dtype)
+	params.VerifiedBy = pgtype.UUID{
+		Bytes: managerId,
+		Valid: true,
+	}
+	n, err := r.dao.UpdateApplicationDocumentStatus(ctx, params)
+	return n > 0, err
+}
+
+// GetApplicationsMissingDocuments returns the open applications missing mandatory documents,
+// whose applicants have not been reminded of them within the days
+func (r *repo) GetApplicationsMissingDocuments(ctx context.Context, days int32, limit int32) ([]int64, error) {
+	return r.dao.GetApplicationsMissingDocuments(ctx, database.GetApplicationsMissingDocumentsParams{
+		Days: days,
+		Lim:  limit,
+	})
+}
+
+func (r *repo) MarkApplicationDocumentsReminded(ctx context.Context, aid int64) error {
+	return r.dao.MarkApplicationDocumentsReminded(ctx, aid)
+}
@@ -12,6 +12,7 @@ package repo
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	dto "github.com/user2410/rrms-backend/internal/domain/application/dto"
@@ -89,6 +90,21 @@ func (mr *MockRepoMockRecorder) CreateApplication(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplication", reflect.TypeOf((*MockRepo)(nil).CreateApplication), arg0, arg1)
 }
 
+// CreateApplicationReference mocks base method.
+func (m *MockRepo) CreateApplicationReference(arg0 context.Context, arg1 int64, arg2 *dto.CreateApplicationReference, arg3 time.Time) (model.ApplicationReferenceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApplicationReference", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(model.ApplicationReferenceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApplicationReference indicates an expected call of CreateApplicationReference.
+func (mr *MockRepoMockRecorder) CreateApplicationReference(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplicationReference", reflect.TypeOf((*MockRepo)(nil).CreateApplicationReference), arg0, arg1, arg2, arg3)
+}
+
 // DeleteApplication mocks base method.
 func (m *MockRepo) DeleteApplication(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -103,6 +119,21 @@ func (mr *MockRepoMockRecorder) DeleteApplication(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplication", reflect.TypeOf((*MockRepo)(nil).DeleteApplication), arg0, arg1)
 }
 
+// ExpireStaleApplications mocks base method.
+func (m *MockRepo) ExpireStaleApplications(arg0 context.Context, arg1 int32) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireStaleApplications", arg0, arg1)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireStaleApplications indicates an expected call of ExpireStaleApplications.
+func (mr *MockRepoMockRecorder) ExpireStaleApplications(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireStaleApplications", reflect.TypeOf((*MockRepo)(nil).ExpireStaleApplications), arg0, arg1)
+}
+
 // GetApplicationById mocks base method.
 func (m *MockRepo) GetApplicationById(arg0 context.Context, arg1 int64) (*model.ApplicationModel, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +149,51 @@ func (mr *MockRepoMockRecorder) GetApplicationById(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationById", reflect.TypeOf((*MockRepo)(nil).GetApplicationById), arg0, arg1)
 }
 
+// GetApplicationDocuments mocks base method.
+func (m *MockRepo) GetApplicationDocuments(arg0 context.Context, arg1 int64) ([]model.ApplicationDocumentModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationDocuments", arg0, arg1)
+	ret0, _ := ret[0].([]model.ApplicationDocumentModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationDocuments indicates an expected call of GetApplicationDocuments.
+func (mr *MockRepoMockRecorder) GetApplicationDocuments(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationDocuments", reflect.TypeOf((*MockRepo)(nil).GetApplicationDocuments), arg0, arg1)
+}
+
+// GetApplicationReference mocks base method.
+func (m *MockRepo) GetApplicationReference(arg0 context.Context, arg1 int64) (model.ApplicationReferenceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationReference", arg0, arg1)
+	ret0, _ := ret[0].(model.ApplicationReferenceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationReference indicates an expected call of GetApplicationReference.
+func (mr *MockRepoMockRecorder) GetApplicationReference(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationReference", reflect.TypeOf((*MockRepo)(nil).GetApplicationReference), arg0, arg1)
+}
+
+// GetApplicationReferences mocks base method.
+func (m *MockRepo) GetApplicationReferences(arg0 context.Context, arg1 int64) ([]model.ApplicationReferenceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationReferences", arg0, arg1)
+	ret0, _ := ret[0].([]model.ApplicationReferenceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationReferences indicates an expected call of GetApplicationReferences.
+func (mr *MockRepoMockRecorder) GetApplicationReferences(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationReferences", reflect.TypeOf((*MockRepo)(nil).GetApplicationReferences), arg0, arg1)
+}
+
 // GetApplicationsByIds mocks base method.
 func (m *MockRepo) GetApplicationsByIds(arg0 context.Context, arg1 []int64, arg2 []string) ([]model.ApplicationModel, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +224,36 @@ func (mr *MockRepoMockRecorder) GetApplicationsByUserId(arg0, arg1, arg2, arg3 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationsByUserId", reflect.TypeOf((*MockRepo)(nil).GetApplicationsByUserId), arg0, arg1, arg2, arg3)
 }
 
+// GetApplicationsMissingDocuments mocks base method.
+func (m *MockRepo) GetApplicationsMissingDocuments(arg0 context.Context, arg1, arg2 int32) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationsMissingDocuments", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationsMissingDocuments indicates an expected call of GetApplicationsMissingDocuments.
+func (mr *MockRepoMockRecorder) GetApplicationsMissingDocuments(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationsMissingDocuments", reflect.TypeOf((*MockRepo)(nil).GetApplicationsMissingDocuments), arg0, arg1, arg2)
+}
+
+// GetApplicationsOfUnit mocks base method.
+func (m *MockRepo) GetApplicationsOfUnit(arg0 context.Context, arg1 uuid.UUID, arg2 []database.APPLICATIONSTATUS) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationsOfUnit", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationsOfUnit indicates an expected call of GetApplicationsOfUnit.
+func (mr *MockRepoMockRecorder) GetApplicationsOfUnit(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationsOfUnit", reflect.TypeOf((*MockRepo)(nil).GetApplicationsOfUnit), arg0, arg1, arg2)
+}
+
 // GetApplicationsToUser mocks base method.
 func (m *MockRepo) GetApplicationsToUser(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int32) ([]int64, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +284,95 @@ func (mr *MockRepoMockRecorder) GetRentalByApplicationId(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRentalByApplicationId", reflect.TypeOf((*MockRepo)(nil).GetRentalByApplicationId), arg0, arg1)
 }
 
+// GetScreeningConfig mocks base method.
+func (m *MockRepo) GetScreeningConfig(arg0 context.Context, arg1 uuid.UUID) (model.ScreeningConfigModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScreeningConfig", arg0, arg1)
+	ret0, _ := ret[0].(model.ScreeningConfigModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScreeningConfig indicates an expected call of GetScreeningConfig.
+func (mr *MockRepoMockRecorder) GetScreeningConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScreeningConfig", reflect.TypeOf((*MockRepo)(nil).GetScreeningConfig), arg0, arg1)
+}
+
+// GetTenantPaymentStats mocks base method.
+func (m *MockRepo) GetTenantPaymentStats(arg0 context.Context, arg1 uuid.UUID) (model.TenantPaymentStatsModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantPaymentStats", arg0, arg1)
+	ret0, _ := ret[0].(model.TenantPaymentStatsModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantPaymentStats indicates an expected call of GetTenantPaymentStats.
+func (mr *MockRepoMockRecorder) GetTenantPaymentStats(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantPaymentStats", reflect.TypeOf((*MockRepo)(nil).GetTenantPaymentStats), arg0, arg1)
+}
+
+// MarkApplicationDocumentsReminded mocks base method.
+func (m *MockRepo) MarkApplicationDocumentsReminded(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkApplicationDocumentsReminded", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkApplicationDocumentsReminded indicates an expected call of MarkApplicationDocumentsReminded.
+func (mr *MockRepoMockRecorder) MarkApplicationDocumentsReminded(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkApplicationDocumentsReminded", reflect.TypeOf((*MockRepo)(nil).MarkApplicationDocumentsReminded), arg0, arg1)
+}
+
+// RespondApplicationReference mocks base method.
+func (m *MockRepo) RespondApplicationReference(arg0 context.Context, arg1 int64, arg2 uuid.UUID, arg3 *dto.RespondApplicationReference) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondApplicationReference", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RespondApplicationReference indicates an expected call of RespondApplicationReference.
+func (mr *MockRepoMockRecorder) RespondApplicationReference(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondApplicationReference", reflect.TypeOf((*MockRepo)(nil).RespondApplicationReference), arg0, arg1, arg2, arg3)
+}
+
+// TransitApplicationStatus mocks base method.
+func (m *MockRepo) TransitApplicationStatus(arg0 context.Context, arg1 int64, arg2 []database.APPLICATIONSTATUS, arg3 database.APPLICATIONSTATUS) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitApplicationStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitApplicationStatus indicates an expected call of TransitApplicationStatus.
+func (mr *MockRepoMockRecorder) TransitApplicationStatus(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitApplicationStatus", reflect.TypeOf((*MockRepo)(nil).TransitApplicationStatus), arg0, arg1, arg2, arg3)
+}
+
+// UpdateApplicationDocumentStatus mocks base method.
+func (m *MockRepo) UpdateApplicationDocumentStatus(arg0 context.Context, arg1 int64, arg2 database.APPLICATIONDOCUMENTTYPE, arg3 uuid.UUID, arg4 *dto.UpdateApplicationDocumentStatus) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplicationDocumentStatus", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateApplicationDocumentStatus indicates an expected call of UpdateApplicationDocumentStatus.
+func (mr *MockRepoMockRecorder) UpdateApplicationDocumentStatus(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationDocumentStatus", reflect.TypeOf((*MockRepo)(nil).UpdateApplicationDocumentStatus), arg0, arg1, arg2, arg3, arg4)
+}
+
 // UpdateApplicationStatus mocks base method.
 func (m *MockRepo) UpdateApplicationStatus(arg0 context.Context, arg1 int64, arg2 uuid.UUID, arg3 database.APPLICATIONSTATUS) (int, error) {
 	m.ctrl.T.Helper()
@@ -192,3 +387,48 @@ func (mr *MockRepoMockRecorder) UpdateApplicationStatus(arg0, arg1, arg2, arg3 a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationStatus", reflect.TypeOf((*MockRepo)(nil).UpdateApplicationStatus), arg0, arg1, arg2, arg3)
 }
+
+// UpsertApplicationDocument mocks base method.
+func (m *MockRepo) UpsertApplicationDocument(arg0 context.Context, arg1 int64, arg2 *dto.CreateApplicationDocument) (model.ApplicationDocumentModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertApplicationDocument", arg0, arg1, arg2)
+	ret0, _ := ret[0].(model.ApplicationDocumentModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertApplicationDocument indicates an expected call of UpsertApplicationDocument.
+func (mr *MockRepoMockRecorder) UpsertApplicationDocument(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertApplicationDocument", reflect.TypeOf((*MockRepo)(nil).UpsertApplicationDocument), arg0, arg1, arg2)
+}
+
+// UpsertScreeningConfig mocks base method.
+func (m *MockRepo) UpsertScreeningConfig(arg0 context.Context, arg1 uuid.UUID, arg2 *dto.UpdateScreeningConfig) (model.ScreeningConfigModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertScreeningConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(model.ScreeningConfigModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertScreeningConfig indicates an expected call of UpsertScreeningConfig.
+func (mr *MockRepoMockRecorder) UpsertScreeningConfig(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertScreeningConfig", reflect.TypeOf((*MockRepo)(nil).UpsertScreeningConfig), arg0, arg1, arg2)
+}
+
+// WithdrawApplicationsOfListing mocks base method.
+func (m *MockRepo) WithdrawApplicationsOfListing(arg0 context.Context, arg1 uuid.UUID) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawApplicationsOfListing", arg0, arg1)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawApplicationsOfListing indicates an expected call of WithdrawApplicationsOfListing.
+func (mr *MockRepoMockRecorder) WithdrawApplicationsOfListing(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawApplicationsOfListing", reflect.TypeOf((*MockRepo)(nil).WithdrawApplicationsOfListing), arg0, arg1)
+}
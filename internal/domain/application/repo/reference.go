@@ -0,0 +1,44 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/application/dto"
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+)
+
+func (r *repo) CreateApplicationReference(ctx context.Context, aid int64, data *dto.CreateApplicationReference, expiresAt time.Time) (model.ApplicationReferenceModel, error) {
+	res, err := r.dao.CreateApplicationReference(ctx, data.ToCreateApplicationReferenceDB(aid, expiresAt))
+	if err != nil {
+		return model.ApplicationReferenceModel{}, err
+	}
+	return model.ToApplicationReferenceModel(&res), nil
+}
+
+func (r *repo) GetApplicationReference(ctx context.Context, id int64) (model.ApplicationReferenceModel, error) {
+	res, err := r.dao.GetApplicationReference(ctx, id)
+	if err != nil {
+		return model.ApplicationReferenceModel{}, err
+	}
+	return model.ToApplicationReferenceModel(&res), nil
+}
+
+func (r *repo) GetApplicationReferences(ctx context.Context, aid int64) ([]model.ApplicationReferenceModel, error) {
+	res, err := r.dao.GetApplicationReferences(ctx, aid)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.ApplicationReferenceModel, 0, len(res))
+	for i := range res {
+		items = append(items, model.ToApplicationReferenceModel(&res[i]))
+	}
+	return items, nil
+}
+
+// RespondApplicationReference records the answers of the referee, unless the reference is already answered or expired
+func (r *repo) RespondApplicationReference(ctx context.Context, id int64, refereeId uuid.UUID, data *dto.RespondApplicationReference) (bool, error) {
+	n, err := r.dao.RespondApplicationReference(ctx, data.ToRespondApplicationReferenceDB(id, refereeId))
+	return n > 0, err
+}
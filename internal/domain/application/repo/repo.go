@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/huandu/go-sqlbuilder"
@@ -22,8 +23,26 @@ type Repo interface {
 	CheckVisibility(ctx context.Context, id int64, uid uuid.UUID) (bool, error)
 	CheckUpdatability(ctx context.Context, id int64, uid uuid.UUID) (bool, error)
 	UpdateApplicationStatus(ctx context.Context, aid int64, userId uuid.UUID, status database.APPLICATIONSTATUS) (int, error)
+	GetApplicationsOfUnit(ctx context.Context, uid uuid.UUID, statuses []database.APPLICATIONSTATUS) ([]int64, error)
+	TransitApplicationStatus(ctx context.Context, aid int64, from []database.APPLICATIONSTATUS, to database.APPLICATIONSTATUS) (bool, error) // Update application status only if it's currently in one of the given statuses
 	DeleteApplication(ctx context.Context, id int64) error
 	GetRentalByApplicationId(ctx context.Context, aid int64) (rental_model.RentalModel, error)
+	ExpireStaleApplications(ctx context.Context, limit int32) ([]int64, error)         // Expire pending applications not updated within the validity window of their listing
+	WithdrawApplicationsOfListing(ctx context.Context, lid uuid.UUID) ([]int64, error) // Withdraw the open applications to the listing
+
+	GetScreeningConfig(ctx context.Context, pid uuid.UUID) (model.ScreeningConfigModel, error)
+	UpsertScreeningConfig(ctx context.Context, pid uuid.UUID, data *dto.UpdateScreeningConfig) (model.ScreeningConfigModel, error)
+	GetTenantPaymentStats(ctx context.Context, tenantId uuid.UUID) (model.TenantPaymentStatsModel, error)
+
+	UpsertApplicationDocument(ctx context.Context, aid int64, data *dto.CreateApplicationDocument) (model.ApplicationDocumentModel, error)
+	GetApplicationDocuments(ctx context.Context, aid int64) ([]model.ApplicationDocumentModel, error)
+	UpdateApplicationDocumentStatus(ctx context.Context, aid int64, dtype database.APPLICATIONDOCUMENTTYPE, managerId uuid.UUID, data *dto.UpdateApplicationDocumentStatus) (bool, error)
+	GetApplicationsMissingDocuments(ctx context.Context, days int32, limit int32) ([]int64, error)
+	MarkApplicationDocumentsReminded(ctx context.Context, aid int64) error
+	CreateApplicationReference(ctx context.Context, aid int64, data *dto.CreateApplicationReference, expiresAt time.Time) (model.ApplicationReferenceModel, error)
+	GetApplicationReference(ctx context.Context, id int64) (model.ApplicationReferenceModel, error)
+	GetApplicationReferences(ctx context.Context, aid int64) ([]model.ApplicationReferenceModel, error)
+	RespondApplicationReference(ctx context.Context, id int64, refereeId uuid.UUID, data *dto.RespondApplicationReference) (bool, error)
 }
 
 type repo struct {
@@ -75,6 +94,13 @@ func (r *repo) CreateApplication(ctx context.Context, data *dto.CreateApplicatio
 			}
 			am.Vehicles = append(am.Vehicles, model.ToApplicationVehicleModel(&res))
 		}
+		for _, a := range data.Answers {
+			res, err := r.dao.CreateApplicationAnswer(ctx, a.ToCreateApplicationAnswerDB(am.ID))
+			if err != nil {
+				return err
+			}
+			am.Answers = append(am.Answers, model.ToApplicationAnswerModel(&res))
+		}
 
 		return nil
 	}()
@@ -127,6 +153,22 @@ func (r *repo) GetApplicationById(ctx context.Context, id int64) (*model.Applica
 		a.Vehicles = append(a.Vehicles, model.ToApplicationVehicleModel(&av))
 	}
 
+	applicationAnswers, err := r.dao.GetApplicationAnswers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, aa := range applicationAnswers {
+		a.Answers = append(a.Answers, model.ToApplicationAnswerModel(&aa))
+	}
+
+	applicationReferences, err := r.dao.GetApplicationReferences(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, ar := range applicationReferences {
+		a.References = append(a.References, model.ToApplicationReferenceModel(&ar))
+	}
+
 	return a, nil
 }
 
@@ -138,7 +180,7 @@ func (r *repo) GetApplicationsByIds(ctx context.Context, ids []int64, fields []s
 	var nonFKFields []string = []string{"id"}
 	var fkFields []string
 	for _, f := range fields {
-		if slices.Contains([]string{"minors", "coaps", "tags", "media"}, f) {
+		if slices.Contains([]string{"minors", "coaps", "tags", "media", "answers", "references"}, f) {
 			fkFields = append(fkFields, f)
 		} else {
 			nonFKFields = append(nonFKFields, f)
@@ -273,6 +315,24 @@ func (r *repo) GetApplicationsByIds(ctx context.Context, ids []int64, fields []s
 				p.Vehicles = append(p.Vehicles, model.ToApplicationVehicleModel(&vdb))
 			}
 		}
+		if slices.Contains(fkFields, "answers") {
+			a, err := r.dao.GetApplicationAnswers(ctx, p.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, adb := range a {
+				p.Answers = append(p.Answers, model.ToApplicationAnswerModel(&adb))
+			}
+		}
+		if slices.Contains(fkFields, "references") {
+			refs, err := r.dao.GetApplicationReferences(ctx, p.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, rdb := range refs {
+				p.References = append(p.References, model.ToApplicationReferenceModel(&rdb))
+			}
+		}
 
 	}
 	return items, nil
@@ -323,6 +383,41 @@ func (r *repo) UpdateApplicationStatus(ctx context.Context, aid int64, userId uu
 	return len(res), nil
 }
 
+func (r *repo) GetApplicationsOfUnit(ctx context.Context, uid uuid.UUID, statuses []database.APPLICATIONSTATUS) ([]int64, error) {
+	ss := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		ss = append(ss, string(s))
+	}
+	return r.dao.GetApplicationsOfUnit(ctx, database.GetApplicationsOfUnitParams{
+		UnitID:   uid,
+		Statuses: ss,
+	})
+}
+
+func (r *repo) TransitApplicationStatus(ctx context.Context, aid int64, from []database.APPLICATIONSTATUS, to database.APPLICATIONSTATUS) (bool, error) {
+	ss := make([]string, 0, len(from))
+	for _, s := range from {
+		ss = append(ss, string(s))
+	}
+	n, err := r.dao.TransitApplicationStatus(ctx, database.TransitApplicationStatusParams{
+		ID:           aid,
+		Status:       to,
+		FromStatuses: ss,
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *repo) ExpireStaleApplications(ctx context.Context, limit int32) ([]int64, error) {
+	return r.dao.ExpireStaleApplications(ctx, limit)
+}
+
+func (r *repo) WithdrawApplicationsOfListing(ctx context.Context, lid uuid.UUID) ([]int64, error) {
+	return r.dao.WithdrawApplicationsOfListing(ctx, lid)
+}
+
 func (r *repo) DeleteApplication(ctx context.Context, id int64) error {
 	return r.dao.DeleteApplication(ctx, id)
 }
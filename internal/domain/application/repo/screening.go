@@ -0,0 +1,43 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/user2410/rrms-backend/internal/domain/application/dto"
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// GetScreeningConfig returns the screening config of the property, or the default one if its managers have not set any
+func (r *repo) GetScreeningConfig(ctx context.Context, pid uuid.UUID) (model.ScreeningConfigModel, error) {
+	res, err := r.dao.GetPropertyScreeningConfig(ctx, pid)
+	if err != nil {
+		if errors.Is(err, database.ErrRecordNotFound) {
+			return model.DefaultScreeningConfigModel(pid), nil
+		}
+		return model.ScreeningConfigModel{}, err
+	}
+	return model.ToScreeningConfigModel(&res), nil
+}
+
+func (r *repo) UpsertScreeningConfig(ctx context.Context, pid uuid.UUID, data *dto.UpdateScreeningConfig) (model.ScreeningConfigModel, error) {
+	res, err := r.dao.UpsertPropertyScreeningConfig(ctx, data.ToUpsertPropertyScreeningConfigDB(pid))
+	if err != nil {
+		return model.ScreeningConfigModel{}, err
+	}
+	return model.ToScreeningConfigModel(&res), nil
+}
+
+func (r *repo) GetTenantPaymentStats(ctx context.Context, tenantId uuid.UUID) (model.TenantPaymentStatsModel, error) {
+	res, err := r.dao.GetTenantPaymentStats(ctx, pgtype.UUID{
+		Bytes: tenantId,
+		Valid: true,
+	})
+	if err != nil {
+		return model.TenantPaymentStatsModel{}, err
+	}
+	return model.TenantPaymentStatsModel(res), nil
+}
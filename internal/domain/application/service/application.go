@@ -3,8 +3,10 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
 	"path/filepath"
 	"slices"
+	"strconv"
 	"time"
 
 	property_model "github.com/user2410/rrms-backend/internal/domain/property/model"
@@ -13,6 +15,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/user2410/rrms-backend/internal/domain/application/dto"
 	"github.com/user2410/rrms-backend/internal/domain/application/model"
+	"github.com/user2410/rrms-backend/internal/domain/application/utils"
+	listing_dto "github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	listing_model "github.com/user2410/rrms-backend/internal/domain/listing/model"
 	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
 	"github.com/user2410/rrms-backend/internal/infrastructure/database"
 )
@@ -31,6 +36,26 @@ var (
 )
 
 func (s *service) PreCreateApplication(data *dto.PreCreateApplication, creatorID uuid.UUID) error {
+	// FILE questions are answered by the files about to be uploaded
+	uploading := make([]int64, 0, len(data.AnswerFiles))
+	for _, f := range data.AnswerFiles {
+		uploading = append(uploading, f.QuestionID)
+	}
+	questions, err := s.getListingQuestions(data.ListingID)
+	if err != nil {
+		return err
+	}
+	for _, qid := range uploading {
+		if slices.IndexFunc(questions, func(q listing_model.ListingQuestionModel) bool {
+			return q.ID == qid && q.Type == database.APPLICATIONQUESTIONTYPEFILE
+		}) < 0 {
+			return fmt.Errorf("%w: question %d does not ask for a file", utils.ErrInvalidAnswer, qid)
+		}
+	}
+	if _, err = utils.NormalizeApplicationAnswers(questions, data.Answers, uploading); err != nil {
+		return err
+	}
+
 	ext := filepath.Ext(data.Avatar.Name)
 	fname := data.Avatar.Name[:len(data.Avatar.Name)-len(ext)]
 	// key = creatorID + "/" + "/property" + filename
@@ -43,9 +68,29 @@ func (s *service) PreCreateApplication(data *dto.PreCreateApplication, creatorID
 		return err
 	}
 	data.Avatar.Url = url.URL
+
+	for i := range data.AnswerFiles {
+		f := &data.AnswerFiles[i]
+		objKey := utils.GetApplicationAnswerFileKey(creatorID, f.QuestionID, f.File.Name, time.Now().Unix())
+		url, err := s.s3Client.GetPutObjectPresignedURL(
+			s.imageBucketName, objKey, f.File.Type, f.File.Size, UPLOAD_URL_LIFETIME*time.Minute,
+		)
+		if err != nil {
+			return err
+		}
+		f.File.Url = url.URL
+	}
 	return nil
 }
 
+// getListingQuestions returns the questions the applicants to the listing answer, none if applying without a listing
+func (s *service) getListingQuestions(listingId uuid.UUID) ([]listing_model.ListingQuestionModel, error) {
+	if listingId == uuid.Nil {
+		return nil, nil
+	}
+	return s.domainRepo.ListingRepo.GetListingQuestions(context.Background(), listingId)
+}
+
 func (s *service) CreateApplication(data *dto.CreateApplication) (*model.ApplicationModel, error) {
 	// Check eligibility of the user to apply for this listing
 	// Check if the listing is still open
@@ -58,6 +103,15 @@ func (s *service) CreateApplication(data *dto.CreateApplication) (*model.Applica
 			return nil, ErrListingIsClosed
 		}
 	}
+	// Check the answers to the questions of the listing
+	questions, err := s.getListingQuestions(data.ListingID)
+	if err != nil {
+		return nil, err
+	}
+	data.Answers, err = utils.NormalizeApplicationAnswers(questions, data.Answers, nil)
+	if err != nil {
+		return nil, err
+	}
 	// Check if the current user is a manager of the property
 	pManagers, err := s.domainRepo.PropertyRepo.GetPropertyManagers(context.Background(), data.PropertyID)
 	if err != nil {
@@ -72,6 +126,14 @@ func (s *service) CreateApplication(data *dto.CreateApplication) (*model.Applica
 		return nil, err
 	}
 
+	if am.ListingID != uuid.Nil {
+		err = s.domainRepo.ListingRepo.RecordListingEvent(context.Background(), listing_dto.LISTINGEVENT_APPLICATION,
+			[]uuid.UUID{am.ListingID}, strconv.FormatInt(am.ID, 10), time.Now())
+		if err != nil {
+			log.Println("failed to record listing event", listing_dto.LISTINGEVENT_APPLICATION, ":", err)
+		}
+	}
+
 	// Send notifications
 	// err = s.SendNotificationOnNewApplication(am)
 	err = s.asynctaskDistributor.DistributeTaskJSON(context.Background(), asynctask.APPLICATION_NEW, am)
@@ -112,6 +174,11 @@ func (s *service) UpdateApplicationStatus(aid int64, userId uuid.UUID, data *dto
 
 	switch data.Status {
 	case database.APPLICATIONSTATUSWITHDRAWN:
+		if a.Status != database.APPLICATIONSTATUSPENDING && a.Status != database.APPLICATIONSTATUSCONDITIONALLYAPPROVED &&
+			a.Status != database.APPLICATIONSTATUSAPPROVED && a.Status != database.APPLICATIONSTATUSWAITLISTED {
+			return ErrInvalidStatusTransition
+		}
+	case database.APPLICATIONSTATUSWAITLISTED:
 		if a.Status != database.APPLICATIONSTATUSPENDING && a.Status != database.APPLICATIONSTATUSCONDITIONALLYAPPROVED {
 			return ErrInvalidStatusTransition
 		}
@@ -124,8 +191,18 @@ func (s *service) UpdateApplicationStatus(aid int64, userId uuid.UUID, data *dto
 		if a.Status != database.APPLICATIONSTATUSPENDING && a.Status != database.APPLICATIONSTATUSCONDITIONALLYAPPROVED {
 			return ErrInvalidStatusTransition
 		}
+		if !data.OverrideDocuments {
+			checklist, err := s.getDocumentChecklist(a)
+			if err != nil {
+				return err
+			}
+			if !checklist.Complete() {
+				return ErrMandatoryDocumentsNotVerified
+			}
+		}
 	case database.APPLICATIONSTATUSREJECTED:
-		if a.Status != database.APPLICATIONSTATUSPENDING && a.Status != database.APPLICATIONSTATUSCONDITIONALLYAPPROVED {
+		if a.Status != database.APPLICATIONSTATUSPENDING && a.Status != database.APPLICATIONSTATUSCONDITIONALLYAPPROVED &&
+			a.Status != database.APPLICATIONSTATUSWAITLISTED {
 			return ErrInvalidStatusTransition
 		}
 	}
@@ -143,8 +220,17 @@ func (s *service) UpdateApplicationStatus(aid int64, userId uuid.UUID, data *dto
 		data := dto.NotificationOnUpdateApplication{
 			Application: a,
 			Status:      data.Status,
+			Message:     data.Message,
 		}
 		err = s.asynctaskDistributor.DistributeTaskJSON(context.Background(), asynctask.APPLICATION_UPDATE, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the chosen applicant dropped out, give the unit to the next one on the waitlist
+	if data.Status == database.APPLICATIONSTATUSWITHDRAWN && a.Status == database.APPLICATIONSTATUSAPPROVED {
+		err = s.asynctaskDistributor.DistributeTaskJSON(context.Background(), asynctask.APPLICATION_WAITLIST_PROMOTE, aid)
 	}
 
 	return err
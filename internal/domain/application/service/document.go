@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/application/dto"
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+	"github.com/user2410/rrms-backend/internal/domain/application/utils"
+	listing_model "github.com/user2410/rrms-backend/internal/domain/listing/model"
+	misc_dto "github.com/user2410/rrms-backend/internal/domain/misc/dto"
+	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	html_util "github.com/user2410/rrms-backend/internal/utils/template/html"
+	text_util "github.com/user2410/rrms-backend/internal/utils/template/text"
+)
+
+const (
+	// applicants missing mandatory documents are reminded every this many days
+	DOCUMENT_REMINDER_DAYS      = 2
+	DOCUMENT_REMINDER_BATCHSIZE = 100
+)
+
+var (
+	ErrNotApplicant                  = errors.New("only the applicant can upload documents")
+	ErrApplicationClosed             = errors.New("application is no longer open")
+	ErrInvalidDocumentUrl            = errors.New("document url does not belong to the application")
+	ErrMandatoryDocumentsNotVerified = errors.New("mandatory documents are not verified")
+	applicationDocumentTypeNames     = map[database.APPLICATIONDOCUMENTTYPE]string{
+		database.APPLICATIONDOCUMENTTYPEIDCARD:            "Căn cước công dân",
+		database.APPLICATIONDOCUMENTTYPEPROOFOFINCOME:     "Giấy tờ chứng minh thu nhập",
+		database.APPLICATIONDOCUMENTTYPEEMPLOYMENTLETTER:  "Xác nhận việc làm",
+		database.APPLICATIONDOCUMENTTYPELANDLORDREFERENCE: "Thư giới thiệu của chủ nhà cũ",
+	}
+)
+
+// getOpenApplicationOfApplicant returns the application if the user is its applicant and it is still open
+func (s *service) getOpenApplicationOfApplicant(aid int64, userId uuid.UUID) (*model.ApplicationModel, error) {
+	a, err := s.domainRepo.ApplicationRepo.GetApplicationById(context.Background(), aid)
+	if err != nil {
+		return nil, err
+	}
+	if a.CreatorID == uuid.Nil || a.CreatorID != userId {
+		return nil, ErrNotApplicant
+	}
+	if a.Status != database.APPLICATIONSTATUSPENDING && a.Status != database.APPLICATIONSTATUSCONDITIONALLYAPPROVED {
+		return nil, ErrApplicationClosed
+	}
+	return a, nil
+}
+
+func (s *service) PreCreateApplicationDocument(aid int64, userId uuid.UUID, data *dto.PreCreateApplicationDocument) error {
+	if _, err := s.getOpenApplicationOfApplicant(aid, userId); err != nil {
+		return err
+	}
+
+	objKey := utils.GetApplicationDocumentKey(userId, aid, data.DocumentType, data.File.Name, time.Now().Unix())
+
+	url, err := s.s3Client.GetPutObjectPresignedURL(
+		s.imageBucketName, objKey, data.File.Type, data.File.Size, UPLOAD_URL_LIFETIME*time.Minute,
+	)
+	if err != nil {
+		return err
+	}
+	data.File.Url = url.URL
+	return nil
+}
+
+// CreateApplicationDocument saves the document uploaded through the url presigned for the application
+func (s *service) CreateApplicationDocument(aid int64, userId uuid.UUID, data *dto.CreateApplicationDocument) (model.ApplicationDocumentModel, error) {
+	if _, err := s.getOpenApplicationOfApplicant(aid, userId); err != nil {
+		return model.ApplicationDocumentModel{}, err
+	}
+	url, ok := utils.NormalizeApplicationDocumentUrl(data.Url, aid)
+	if !ok {
+		return model.ApplicationDocumentModel{}, ErrInvalidDocumentUrl
+	}
+	data.Url = url
+	return s.domainRepo.ApplicationRepo.UpsertApplicationDocument(context.Background(), aid, data)
+}
+
+func (s *service) getDocumentChecklist(a *model.ApplicationModel) (model.ApplicationDocumentChecklist, error) {
+	var required []listing_model.ListingRequiredDocumentModel
+	if a.ListingID != uuid.Nil {
+		var err error
+		required, err = s.domainRepo.ListingRepo.GetListingRequiredDocuments(context.Background(), a.ListingID)
+		if err != nil {
+			return model.ApplicationDocumentChecklist{}, err
+		}
+	}
+	documents, err := s.domainRepo.ApplicationRepo.GetApplicationDocuments(context.Background(), a.ID)
+	if err != nil {
+		return model.ApplicationDocumentChecklist{}, err
+	}
+	return utils.BuildDocumentChecklist(required, documents), nil
+}
+
+func (s *service) GetApplicationDocumentChecklist(aid int64) (model.ApplicationDocumentChecklist, error) {
+	a, err := s.domainRepo.ApplicationRepo.GetApplicationById(context.Background(), aid)
+	if err != nil {
+		return model.ApplicationDocumentChecklist{}, err
+	}
+	return s.getDocumentChecklist(a)
+}
+
+func (s *service) UpdateApplicationDocumentStatus(aid int64, dtype database.APPLICATIONDOCUMENTTYPE, managerId uuid.UUID, data *dto.UpdateApplicationDocumentStatus) error {
+	updated, err := s.domainRepo.ApplicationRepo.UpdateApplicationDocumentStatus(context.Background(), aid, dtype, managerId, data)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return database.ErrRecordNotFound
+	}
+	return nil
+}
+
+// RemindMissingDocuments reminds the applicants of open applications of the mandatory documents they have yet to upload
+func (s *service) RemindMissingDocuments() error {
+	ids, err := s.domainRepo.ApplicationRepo.GetApplicationsMissingDocuments(context.Background(), DOCUMENT_REMINDER_DAYS, DOCUMENT_REMINDER_BATCHSIZE)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, id := range ids {
+		if err = s.remindMissingDocuments(id); err != nil {
+			errs = append(errs, fmt.Errorf("application %d: %w", id, err))
+			continue
+		}
+		if err = s.domainRepo.ApplicationRepo.MarkApplicationDocumentsReminded(context.Background(), id); err != nil {
+			errs = append(errs, fmt.Errorf("application %d: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *service) remindMissingDocuments(aid int64) error {
+	am, err := s.domainRepo.ApplicationRepo.GetApplicationById(context.Background(), aid)
+	if err != nil {
+		return err
+	}
+	checklist, err := s.getDocumentChecklist(am)
+	if err != nil {
+		return err
+	}
+	if len(checklist.Missing) == 0 {
+		return nil
+	}
+
+	data := struct {
+		FESite      string
+		Application *model.ApplicationModel
+		Documents   []string
+	}{
+		FESite:      s.feSite,
+		Application: am,
+	}
+	for _, t := range checklist.Missing {
+		data.Documents = append(data.Documents, applicationDocumentTypeNames[t])
+	}
+
+	title, err := text_util.RenderText(
+		data,
+		fmt.Sprintf("%s/title/missing_documents.txt", basePath),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	emailContent, err := html_util.RenderHtml(
+		data,
+		fmt.Sprintf("%s/email/missing_documents_tenant.html", basePath),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	pushContent, err := text_util.RenderText(
+		data,
+		fmt.Sprintf("%s/push/missing_documents_tenant.txt", basePath),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	target, err := s.miscService.GetNotificationTenantTargets(am.CreatorID, am.Email)
+	if err != nil {
+		return err
+	}
+
+	cn := misc_dto.CreateNotification{
+		Title:   string(title),
+		Content: string(emailContent),
+		Data: map[string]interface{}{
+			"notificationType": misc_service.NOTIFICATIONTYPE_APPLICATIONDOCUMENTS,
+			"applicationId":    am.ID,
+		},
+		Targets: []misc_dto.CreateNotificationTarget{
+			{
+				UserId: am.CreatorID,
+				Emails: target.Emails,
+			},
+		},
+	}
+	err = s.miscService.SendNotification(&cn)
+	if err != nil {
+		return err
+	}
+
+	cn.Content = string(pushContent)
+	cn.Targets = []misc_dto.CreateNotificationTarget{
+		{
+			UserId: am.CreatorID,
+			Tokens: target.Tokens,
+		},
+	}
+	return s.miscService.SendNotification(&cn)
+}
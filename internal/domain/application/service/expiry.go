@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/application/dto"
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+	"github.com/user2410/rrms-backend/internal/domain/application/utils"
+	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+const APPLICATION_EXPIRY_BATCHSIZE = 100
+
+// ExpireStaleApplications expires the pending applications not updated within the validity window of their listing,
+// and notifies both the applicants and the managers
+func (s *service) ExpireStaleApplications() error {
+	ctx := context.Background()
+	for {
+		ids, err := s.domainRepo.ApplicationRepo.ExpireStaleApplications(ctx, APPLICATION_EXPIRY_BATCHSIZE)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			a, err := s.domainRepo.ApplicationRepo.GetApplicationById(ctx, id)
+			if err != nil {
+				return err
+			}
+			err = s.asynctaskDistributor.DistributeTaskJSON(ctx, asynctask.APPLICATION_UPDATE, dto.NotificationOnUpdateApplication{
+				Application:    a,
+				Status:         database.APPLICATIONSTATUSEXPIRED,
+				Automatic:      true,
+				NotifyManagers: true,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(ids) < APPLICATION_EXPIRY_BATCHSIZE {
+			return nil
+		}
+	}
+}
+
+// RejectApplications rejects the applications one by one with the same templated message,
+// an application failing to be rejected does not prevent the others from being rejected
+func (s *service) RejectApplications(userId uuid.UUID, data *dto.RejectApplications) (model.RejectApplicationsResultModel, error) {
+	res := model.RejectApplicationsResultModel{
+		Rejected: []int64{},
+		Failed:   []model.RejectApplicationFailure{},
+	}
+	tmpl, err := utils.ParseRejectionMessage(data.Message)
+	if err != nil {
+		return res, err
+	}
+
+	for _, id := range data.IDs {
+		err := func() error {
+			a, err := s.domainRepo.ApplicationRepo.GetApplicationById(context.Background(), id)
+			if err != nil {
+				return err
+			}
+			msg, err := utils.RenderRejectionMessage(tmpl, a)
+			if err != nil {
+				return err
+			}
+			return s.UpdateApplicationStatus(id, userId, &dto.UpdateApplicationStatus{
+				Status:  database.APPLICATIONSTATUSREJECTED,
+				Message: &msg,
+			})
+		}()
+		if err != nil {
+			if errors.Is(err, database.ErrRecordNotFound) {
+				err = errors.New("application not found")
+			}
+			res.Failed = append(res.Failed, model.RejectApplicationFailure{
+				ApplicationID: id,
+				Message:       err.Error(),
+			})
+			continue
+		}
+		res.Rejected = append(res.Rejected, id)
+	}
+	return res, nil
+}
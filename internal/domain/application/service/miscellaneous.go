@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/application/dto"
 	application_model "github.com/user2410/rrms-backend/internal/domain/application/model"
 	misc_dto "github.com/user2410/rrms-backend/internal/domain/misc/dto"
 	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
@@ -120,17 +121,22 @@ func (s *service) SendNotificationOnNewApplication(am *application_model.Applica
 	return err
 }
 
-func (s *service) SendNotificationOnUpdateApplication(am *application_model.ApplicationModel, status database.APPLICATIONSTATUS) error {
+func (s *service) SendNotificationOnUpdateApplication(n *dto.NotificationOnUpdateApplication) error {
 	var (
+		am   = n.Application
 		data = struct {
 			Application *application_model.ApplicationModel
 			Property    *property_model.PropertyModel
 			Unit        *unit_model.UnitModel
 			Status      database.APPLICATIONSTATUS
+			Message     *string
+			Automatic   bool
 			FESite      string
 		}{
 			Application: am,
-			Status:      status,
+			Status:      n.Status,
+			Message:     n.Message,
+			Automatic:   n.Automatic,
 			FESite:      s.feSite,
 		}
 		err error
@@ -212,6 +218,59 @@ func (s *service) SendNotificationOnUpdateApplication(am *application_model.Appl
 		},
 	}
 	err = s.miscService.SendNotification(&cn)
+	if err != nil || !n.NotifyManagers {
+		return err
+	}
+
+	emailContent, err = html_util.RenderHtml(
+		data,
+		fmt.Sprintf("%s/email/update_application_manager.html", basePath),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	pushContent, err = text_util.RenderText(
+		data,
+		fmt.Sprintf("%s/push/update_application_manager.txt", basePath),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	targets, err := s.miscService.GetNotificationManagersTargets(am.PropertyID)
+	if err != nil {
+		return err
+	}
+
+	cn.Content = string(emailContent)
+	cn.Targets = func() []misc_dto.CreateNotificationTarget {
+		var ts []misc_dto.CreateNotificationTarget
+		for _, t := range targets {
+			ts = append(ts, misc_dto.CreateNotificationTarget{
+				UserId: t.UserId,
+				Emails: t.Emails,
+			})
+		}
+		return ts
+	}()
+	err = s.miscService.SendNotification(&cn)
+	if err != nil {
+		return err
+	}
+
+	cn.Content = string(pushContent)
+	cn.Targets = func() []misc_dto.CreateNotificationTarget {
+		var ts []misc_dto.CreateNotificationTarget
+		for _, t := range targets {
+			ts = append(ts, misc_dto.CreateNotificationTarget{
+				UserId: t.UserId,
+				Tokens: t.Tokens,
+			})
+		}
+		return ts
+	}()
+	err = s.miscService.SendNotification(&cn)
 
 	return err
 }
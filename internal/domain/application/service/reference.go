@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/application/dto"
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+	"github.com/user2410/rrms-backend/internal/domain/application/utils"
+	misc_dto "github.com/user2410/rrms-backend/internal/domain/misc/dto"
+	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	html_util "github.com/user2410/rrms-backend/internal/utils/template/html"
+	text_util "github.com/user2410/rrms-backend/internal/utils/template/text"
+)
+
+const (
+	REFERENCE_LINK_LIFETIME = 14 * 24 * time.Hour
+	// references an applicant can request for an application
+	APPLICATION_REFERENCE_LIMIT = 3
+)
+
+var (
+	ErrSelfReference         = errors.New("the applicant cannot be their own referee")
+	ErrReferenceLimitReached = fmt.Errorf("at most %d references can be requested for an application", APPLICATION_REFERENCE_LIMIT)
+	ErrReferenceAnswered     = errors.New("the reference has already been answered or has expired")
+)
+
+// CreateApplicationReference records the contact of a previous landlord and sends them the questionnaire link
+func (s *service) CreateApplicationReference(aid int64, userId uuid.UUID, data *dto.CreateApplicationReference) (model.ApplicationReferenceModel, error) {
+	a, err := s.getOpenApplicationOfApplicant(aid, userId)
+	if err != nil {
+		return model.ApplicationReferenceModel{}, err
+	}
+	if strings.EqualFold(data.Email, a.Email) {
+		return model.ApplicationReferenceModel{}, ErrSelfReference
+	}
+	refs, err := s.domainRepo.ApplicationRepo.GetApplicationReferences(context.Background(), aid)
+	if err != nil {
+		return model.ApplicationReferenceModel{}, err
+	}
+	if len(refs) >= APPLICATION_REFERENCE_LIMIT {
+		return model.ApplicationReferenceModel{}, ErrReferenceLimitReached
+	}
+	if data.RentalAddress == nil {
+		data.RentalAddress = a.RhAddress
+	}
+
+	r, err := s.domainRepo.ApplicationRepo.CreateApplicationReference(context.Background(), aid, data, time.Now().Add(REFERENCE_LINK_LIFETIME))
+	if err != nil {
+		return model.ApplicationReferenceModel{}, err
+	}
+	return r, s.sendReferenceRequest(a, &r)
+}
+
+func (s *service) GetApplicationReferences(aid int64) ([]model.ApplicationReferenceModel, error) {
+	return s.domainRepo.ApplicationRepo.GetApplicationReferences(context.Background(), aid)
+}
+
+// getReferenceOfLink returns the reference the questionnaire link is sent for
+func (s *service) getReferenceOfLink(key string) (model.ApplicationReferenceModel, error) {
+	c, err := utils.DecryptReferenceLink(s.hashSecret, key, time.Now())
+	if err != nil {
+		return model.ApplicationReferenceModel{}, err
+	}
+	r, err := s.domainRepo.ApplicationRepo.GetApplicationReference(context.Background(), c.ReferenceID)
+	if err != nil {
+		if errors.Is(err, database.ErrRecordNotFound) {
+			return model.ApplicationReferenceModel{}, utils.ErrInvalidReferenceLink
+		}
+		return model.ApplicationReferenceModel{}, err
+	}
+	if r.ApplicationID != c.ApplicationID || r.RefereeEmail != c.Email {
+		return model.ApplicationReferenceModel{}, utils.ErrInvalidReferenceLink
+	}
+	return r, nil
+}
+
+func (s *service) GetApplicationReferenceForm(key string) (model.ApplicationReferenceFormModel, error) {
+	r, err := s.getReferenceOfLink(key)
+	if err != nil {
+		return model.ApplicationReferenceFormModel{}, err
+	}
+	a, err := s.domainRepo.ApplicationRepo.GetApplicationById(context.Background(), r.ApplicationID)
+	if err != nil {
+		return model.ApplicationReferenceFormModel{}, err
+	}
+	return model.ApplicationReferenceFormModel{
+		RefereeName:   r.RefereeName,
+		ApplicantName: a.FullName,
+		RentalAddress: r.RentalAddress,
+		ExpiresAt:     r.ExpiresAt,
+		Responded:     r.Response != nil,
+	}, nil
+}
+
+// RespondApplicationReference attaches the answers of the referee to the application.
+// The reference is verified on the platform when the referee is one of its users.
+func (s *service) RespondApplicationReference(key string, data *dto.RespondApplicationReference) error {
+	r, err := s.getReferenceOfLink(key)
+	if err != nil {
+		return err
+	}
+	if r.Response != nil {
+		return ErrReferenceAnswered
+	}
+
+	refereeId := uuid.Nil
+	u, err := s.domainRepo.AuthRepo.GetUserByEmail(context.Background(), r.RefereeEmail)
+	if err == nil {
+		refereeId = u.ID
+	} else if !errors.Is(err, database.ErrRecordNotFound) {
+		return err
+	}
+
+	responded, err := s.domainRepo.ApplicationRepo.RespondApplicationReference(context.Background(), r.ID, refereeId, data)
+	if err != nil {
+		return err
+	}
+	if !responded {
+		return ErrReferenceAnswered
+	}
+	return nil
+}
+
+func (s *service) sendReferenceRequest(a *model.ApplicationModel, r *model.ApplicationReferenceModel) error {
+	key, err := utils.EncryptReferenceLink(s.hashSecret, &utils.ReferenceLinkClaims{
+		ReferenceID:   r.ID,
+		ApplicationID: r.ApplicationID,
+		Email:         r.RefereeEmail,
+		ExpiresAt:     r.ExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+	urlValues := url.Values{}
+	urlValues.Add("k", key)
+
+	data := struct {
+		FESite        string
+		Link          string
+		ExpiresAt     string
+		RentalAddress string
+		Application   *model.ApplicationModel
+		Reference     *model.ApplicationReferenceModel
+	}{
+		FESite:      s.feSite,
+		Link:        fmt.Sprintf("%s/application-references?%s", s.feSite, urlValues.Encode()),
+		ExpiresAt:   r.ExpiresAt.Format("02/01/2006"),
+		Application: a,
+		Reference:   r,
+	}
+	if r.RentalAddress != nil {
+		data.RentalAddress = *r.RentalAddress
+	}
+
+	title, err := text_util.RenderText(
+		data,
+		fmt.Sprintf("%s/title/reference_request.txt", basePath),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	emailContent, err := html_util.RenderHtml(
+		data,
+		fmt.Sprintf("%s/email/reference_request.html", basePath),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the referee may have no account, the request is only sent by email
+	return s.miscService.SendNotification(&misc_dto.CreateNotification{
+		Title:   string(title),
+		Content: string(emailContent),
+		Data: map[string]interface{}{
+			"notificationType": misc_service.NOTIFICATIONTYPE_APPLICATIONREFERENCE,
+			"applicationId":    a.ID,
+		},
+		Targets: []misc_dto.CreateNotificationTarget{
+			{
+				Emails: []string{r.RefereeEmail},
+			},
+		},
+	})
+}
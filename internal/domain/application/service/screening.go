@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+	"github.com/user2410/rrms-backend/internal/domain/application/utils"
+)
+
+func (s *service) GetApplicationScreeningReport(aid int64) (model.ScreeningReportModel, error) {
+	ctx := context.Background()
+
+	a, err := s.domainRepo.ApplicationRepo.GetApplicationById(ctx, aid)
+	if err != nil {
+		return model.ScreeningReportModel{}, err
+	}
+	return s.screenApplication(ctx, a)
+}
+
+func (s *service) screenApplication(ctx context.Context, a *model.ApplicationModel) (model.ScreeningReportModel, error) {
+	c, err := s.domainRepo.ApplicationRepo.GetScreeningConfig(ctx, a.PropertyID)
+	if err != nil {
+		return model.ScreeningReportModel{}, err
+	}
+
+	var policies utils.ScreeningPolicies
+	if a.ListingID != uuid.Nil {
+		l, err := s.domainRepo.ListingRepo.GetListingByID(ctx, a.ListingID)
+		if err != nil {
+			return model.ScreeningReportModel{}, err
+		}
+		policies.PetsAllowed = l.PetsAllowed
+		for _, p := range l.Policies {
+			if p.PolicyID == utils.LISTING_POLICY_PARKING {
+				policies.Parking = true
+			}
+		}
+	}
+	if !policies.Parking {
+		p, err := s.domainRepo.PropertyRepo.GetPropertyById(ctx, a.PropertyID)
+		if err != nil {
+			return model.ScreeningReportModel{}, err
+		}
+		for _, f := range p.Features {
+			if f.FeatureID == utils.PROPERTY_FEATURE_PARKING {
+				policies.Parking = true
+			}
+		}
+	}
+
+	var stats *model.TenantPaymentStatsModel
+	if a.CreatorID != uuid.Nil {
+		res, err := s.domainRepo.ApplicationRepo.GetTenantPaymentStats(ctx, a.CreatorID)
+		if err != nil {
+			return model.ScreeningReportModel{}, err
+		}
+		stats = &res
+	}
+
+	return utils.ScreenApplication(a, &c, policies, stats), nil
+}
@@ -1,6 +1,13 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/robfig/cron/v3"
 	repos "github.com/user2410/rrms-backend/internal/domain/_repos"
 	chat_model "github.com/user2410/rrms-backend/internal/domain/chat/model"
 	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
@@ -28,13 +35,32 @@ type Service interface {
 	CreateApplicationMsgGroup(aid int64, userId uuid.UUID) (*chat_model.MsgGroup, error)
 	GetApplicationMsgGroup(aid int64, userId uuid.UUID) (*chat_model.MsgGroupExtended, error)
 	GetRentalByApplicationId(aid int64) (rental_model.RentalModel, error)
+	GetApplicationScreeningReport(aid int64) (model.ScreeningReportModel, error)
+	GetUnitApplicationRanking(aid int64) ([]model.ApplicationRankingModel, error)
+	WaitlistCompetingApplications(aid int64) error
+	PromoteWaitlistedApplication(aid int64) error
+
+	PreCreateApplicationDocument(aid int64, userId uuid.UUID, data *dto.PreCreateApplicationDocument) error
+	CreateApplicationDocument(aid int64, userId uuid.UUID, data *dto.CreateApplicationDocument) (model.ApplicationDocumentModel, error)
+	GetApplicationDocumentChecklist(aid int64) (model.ApplicationDocumentChecklist, error)
+	UpdateApplicationDocumentStatus(aid int64, dtype database.APPLICATIONDOCUMENTTYPE, managerId uuid.UUID, data *dto.UpdateApplicationDocumentStatus) error
+	RemindMissingDocuments() error
+
+	CreateApplicationReference(aid int64, userId uuid.UUID, data *dto.CreateApplicationReference) (model.ApplicationReferenceModel, error)
+	GetApplicationReferences(aid int64) ([]model.ApplicationReferenceModel, error)
+	GetApplicationReferenceForm(key string) (model.ApplicationReferenceFormModel, error)
+	RespondApplicationReference(key string, data *dto.RespondApplicationReference) error
+
+	ExpireStaleApplications() error
+	RejectApplications(userId uuid.UUID, data *dto.RejectApplications) (model.RejectApplicationsResultModel, error)
 
 	SendNotificationOnNewApplication(am *model.ApplicationModel) error
-	SendNotificationOnUpdateApplication(am *model.ApplicationModel, status database.APPLICATIONSTATUS) error
+	SendNotificationOnUpdateApplication(n *dto.NotificationOnUpdateApplication) error
 }
 
 type service struct {
 	domainRepo      repos.DomainRepo
+	hashSecret      string
 	reminderService reminder_service.Service
 	miscService     misc_service.Service
 
@@ -43,24 +69,61 @@ type service struct {
 
 	asynctaskDistributor asynctask.Distributor
 	feSite               string
+
+	cronEntries []cron.EntryID
 }
 
 func NewService(
 	domainRepo repos.DomainRepo,
+	hashSecret string,
 	reminderService reminder_service.Service,
 	miscService misc_service.Service,
 	s3Client s3.S3Client,
 	imageBucketName string,
 	asynctaskDistributor asynctask.Distributor,
+	c *cron.Cron,
 	feSite string,
 ) Service {
-	return &service{
+	res := &service{
 		domainRepo:           domainRepo,
+		hashSecret:           hashSecret,
 		reminderService:      reminderService,
 		miscService:          miscService,
 		s3Client:             s3Client,
 		imageBucketName:      imageBucketName,
 		asynctaskDistributor: asynctaskDistributor,
 		feSite:               feSite,
+		cronEntries:          []cron.EntryID{},
 	}
+	res.setupCronjob(c)
+	return res
+}
+
+// setupCronjob periodically reminds applicants of their missing documents and expires stale applications
+func (s *service) setupCronjob(c *cron.Cron) ([]cron.EntryID, error) {
+	entryID, err := c.AddFunc("0 9 * * *", func() {
+		err := s.asynctaskDistributor.DistributeTask(context.Background(), asynctask.APPLICATION_DOCUMENTS_REMIND, nil,
+			asynq.Unique(time.Hour), asynq.MaxRetry(3))
+		if err != nil && !errors.Is(err, asynq.ErrDuplicateTask) {
+			log.Println("failed to enqueue", asynctask.APPLICATION_DOCUMENTS_REMIND, err)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.cronEntries = append(s.cronEntries, entryID)
+
+	entryID, err = c.AddFunc("0 1 * * *", func() {
+		err := s.asynctaskDistributor.DistributeTask(context.Background(), asynctask.APPLICATION_EXPIRE, nil,
+			asynq.Unique(time.Hour), asynq.MaxRetry(3))
+		if err != nil && !errors.Is(err, asynq.ErrDuplicateTask) {
+			log.Println("failed to enqueue", asynctask.APPLICATION_EXPIRE, err)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.cronEntries = append(s.cronEntries, entryID)
+
+	return s.cronEntries, nil
 }
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/application/dto"
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+	"github.com/user2410/rrms-backend/internal/domain/application/utils"
+	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+var (
+	// statuses of the applications still competing for a unit
+	competingApplicationStatuses = []database.APPLICATIONSTATUS{
+		database.APPLICATIONSTATUSPENDING,
+		database.APPLICATIONSTATUSCONDITIONALLYAPPROVED,
+		database.APPLICATIONSTATUSAPPROVED,
+		database.APPLICATIONSTATUSWAITLISTED,
+	}
+	// statuses of the applications moved to the waitlist once another application of the unit is converted to a prerental
+	waitlistableApplicationStatuses = []database.APPLICATIONSTATUS{
+		database.APPLICATIONSTATUSPENDING,
+		database.APPLICATIONSTATUSCONDITIONALLYAPPROVED,
+		database.APPLICATIONSTATUSAPPROVED,
+	}
+)
+
+func (s *service) GetUnitApplicationRanking(aid int64) ([]model.ApplicationRankingModel, error) {
+	a, err := s.domainRepo.ApplicationRepo.GetApplicationById(context.Background(), aid)
+	if err != nil {
+		return nil, err
+	}
+	return s.rankUnitApplications(a.UnitID, competingApplicationStatuses)
+}
+
+// rankUnitApplications ranks the applications of the unit having one of the given statuses
+func (s *service) rankUnitApplications(unitId uuid.UUID, statuses []database.APPLICATIONSTATUS) ([]model.ApplicationRankingModel, error) {
+	ctx := context.Background()
+
+	ids, err := s.domainRepo.ApplicationRepo.GetApplicationsOfUnit(ctx, unitId, statuses)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]utils.RankingCandidate, 0, len(ids))
+	for _, id := range ids {
+		a, err := s.domainRepo.ApplicationRepo.GetApplicationById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		r, err := s.screenApplication(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, utils.RankingCandidate{
+			Application:    a,
+			ScreeningScore: r.Score,
+		})
+	}
+
+	today := time.Now().Truncate(24 * time.Hour)
+	availableFrom := today
+	if len(candidates) > 0 {
+		res, err := s.domainRepo.UnitRepo.GetUnitsAvailableFrom(ctx, []uuid.UUID{unitId}, today)
+		if err != nil {
+			return nil, err
+		}
+		if t, ok := res[unitId]; ok && t.After(today) {
+			availableFrom = t
+		}
+	}
+
+	return utils.RankApplications(candidates, availableFrom), nil
+}
+
+// WaitlistCompetingApplications moves the other applications to the unit of the given application to the waitlist
+// once the given application is converted to a prerental, and notifies their applicants
+func (s *service) WaitlistCompetingApplications(aid int64) error {
+	ctx := context.Background()
+
+	a, err := s.domainRepo.ApplicationRepo.GetApplicationById(ctx, aid)
+	if err != nil {
+		return err
+	}
+	ids, err := s.domainRepo.ApplicationRepo.GetApplicationsOfUnit(ctx, a.UnitID, waitlistableApplicationStatuses)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if id == aid {
+			continue
+		}
+		if err := s.transitApplicationStatus(id, waitlistableApplicationStatuses, database.APPLICATIONSTATUSWAITLISTED); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PromoteWaitlistedApplication gets the best ranked waitlisted application to the unit of the given application back to pending
+// once the given application drops out, either withdrawn or its prerental rejected
+func (s *service) PromoteWaitlistedApplication(aid int64) error {
+	a, err := s.domainRepo.ApplicationRepo.GetApplicationById(context.Background(), aid)
+	if err != nil {
+		return err
+	}
+	ranking, err := s.rankUnitApplications(a.UnitID, []database.APPLICATIONSTATUS{database.APPLICATIONSTATUSWAITLISTED})
+	if err != nil {
+		return err
+	}
+	for _, r := range ranking {
+		if r.ApplicationID == aid {
+			continue
+		}
+		return s.transitApplicationStatus(r.ApplicationID, []database.APPLICATIONSTATUS{database.APPLICATIONSTATUSWAITLISTED}, database.APPLICATIONSTATUSPENDING)
+	}
+	return nil
+}
+
+// transitApplicationStatus updates the status of the application if it's still in one of the given statuses, then notifies its applicant
+func (s *service) transitApplicationStatus(aid int64, from []database.APPLICATIONSTATUS, to database.APPLICATIONSTATUS) error {
+	ctx := context.Background()
+
+	ok, err := s.domainRepo.ApplicationRepo.TransitApplicationStatus(ctx, aid, from, to)
+	if err != nil || !ok {
+		return err
+	}
+	a, err := s.domainRepo.ApplicationRepo.GetApplicationById(ctx, aid)
+	if err != nil {
+		return err
+	}
+	return s.asynctaskDistributor.DistributeTaskJSON(ctx, asynctask.APPLICATION_UPDATE, dto.NotificationOnUpdateApplication{
+		Application: a,
+		Status:      to,
+		Automatic:   true,
+	})
+}
@@ -0,0 +1,190 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/application/dto"
+	listing_model "github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+const ANSWER_DATE_LAYOUT = "2006-01-02"
+
+var (
+	ErrInvalidAnswer       = errors.New("invalid answer")
+	ErrInvalidAnswerFilter = errors.New("invalid answer filter")
+)
+
+// GetApplicationAnswerFileKey returns the S3 object key of a file answering a question of a listing
+func GetApplicationAnswerFileKey(creatorId uuid.UUID, qid int64, name string, timestamp int64) string {
+	return fmt.Sprintf("%s/applications/answers/%d/%d_%s", creatorId.String(), qid, timestamp, name)
+}
+
+// NormalizeApplicationAnswerFileUrl strips the query of the url of an uploaded file,
+// and tells whether the url belongs to the files answering the question
+func NormalizeApplicationAnswerFileUrl(rawUrl string, qid int64) (string, bool) {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return "", false
+	}
+	if !strings.Contains(u.Path, fmt.Sprintf("/applications/answers/%d/", qid)) {
+		return "", false
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String(), true
+}
+
+func parseAnswerNumber(v string) (string, bool) {
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", false
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64), true
+}
+
+func parseAnswerDate(v string) (string, bool) {
+	if t, err := time.Parse(ANSWER_DATE_LAYOUT, v); err == nil {
+		return t.Format(ANSWER_DATE_LAYOUT), true
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t.Format(ANSWER_DATE_LAYOUT), true
+	}
+	return "", false
+}
+
+func normalizeAnswerValues(q *listing_model.ListingQuestionModel, values []string) ([]string, error) {
+	if q.Type != database.APPLICATIONQUESTIONTYPEMULTICHOICE && len(values) > 1 {
+		return nil, fmt.Errorf("%w to question %d: a single value is expected", ErrInvalidAnswer, q.ID)
+	}
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		switch q.Type {
+		case database.APPLICATIONQUESTIONTYPENUMBER:
+			n, ok := parseAnswerNumber(v)
+			if !ok {
+				return nil, fmt.Errorf("%w to question %d: %q is not a number", ErrInvalidAnswer, q.ID, v)
+			}
+			v = n
+		case database.APPLICATIONQUESTIONTYPEDATE:
+			d, ok := parseAnswerDate(v)
+			if !ok {
+				return nil, fmt.Errorf("%w to question %d: %q is not a date", ErrInvalidAnswer, q.ID, v)
+			}
+			v = d
+		case database.APPLICATIONQUESTIONTYPESINGLECHOICE, database.APPLICATIONQUESTIONTYPEMULTICHOICE:
+			if !slices.Contains(q.Options, v) {
+				return nil, fmt.Errorf("%w to question %d: %q is not an option", ErrInvalidAnswer, q.ID, v)
+			}
+			if slices.Contains(res, v) {
+				continue
+			}
+		case database.APPLICATIONQUESTIONTYPEFILE:
+			u, ok := NormalizeApplicationAnswerFileUrl(v, q.ID)
+			if !ok {
+				return nil, fmt.Errorf("%w to question %d: the file is not uploaded for this question", ErrInvalidAnswer, q.ID)
+			}
+			v = u
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// NormalizeApplicationAnswers checks the answers against the questions of the listing and returns them normalized:
+// numbers in decimal notation, dates as YYYY-MM-DD and file urls without query.
+// Unanswered optional questions are left out. Required FILE questions whose file is still being uploaded are
+// considered answered.
+func NormalizeApplicationAnswers(questions []listing_model.ListingQuestionModel, answers []dto.CreateApplicationAnswer, uploading []int64) ([]dto.CreateApplicationAnswer, error) {
+	res := make([]dto.CreateApplicationAnswer, 0, len(answers))
+	answered := make(map[int64]bool, len(answers))
+	for _, a := range answers {
+		idx := slices.IndexFunc(questions, func(q listing_model.ListingQuestionModel) bool { return q.ID == a.QuestionID })
+		if idx < 0 {
+			return nil, fmt.Errorf("%w: question %d is not asked by the listing", ErrInvalidAnswer, a.QuestionID)
+		}
+		if _, ok := answered[a.QuestionID]; ok {
+			return nil, fmt.Errorf("%w: question %d is answered more than once", ErrInvalidAnswer, a.QuestionID)
+		}
+		q := &questions[idx]
+
+		values := make([]string, 0, len(a.Values))
+		for _, v := range a.Values {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+		values, err := normalizeAnswerValues(q, values)
+		if err != nil {
+			return nil, err
+		}
+		answered[a.QuestionID] = len(values) > 0
+		if len(values) == 0 {
+			continue
+		}
+		res = append(res, dto.CreateApplicationAnswer{
+			QuestionID: q.ID,
+			Values:     values,
+			Question:   q.Question,
+			Type:       q.Type,
+		})
+	}
+
+	for _, q := range questions {
+		if !q.Required || answered[q.ID] {
+			continue
+		}
+		if q.Type == database.APPLICATIONQUESTIONTYPEFILE && slices.Contains(uploading, q.ID) {
+			continue
+		}
+		return nil, fmt.Errorf("%w: question %d is required", ErrInvalidAnswer, q.ID)
+	}
+	return res, nil
+}
+
+// ParseApplicationAnswerFilters parses filters in the form <questionId>:<op>:<value>.
+// The bounds of ranges are either dates (YYYY-MM-DD) or numbers.
+func ParseApplicationAnswerFilters(raw []string) ([]dto.ApplicationAnswerFilter, error) {
+	res := make([]dto.ApplicationAnswerFilter, 0, len(raw))
+	for _, r := range raw {
+		parts := strings.SplitN(r, ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidAnswerFilter, r)
+		}
+		qid, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil || qid <= 0 {
+			return nil, fmt.Errorf("%w: invalid question id in %q", ErrInvalidAnswerFilter, r)
+		}
+		f := dto.ApplicationAnswerFilter{
+			QuestionID: qid,
+			Op:         parts[1],
+			Value:      strings.TrimSpace(parts[2]),
+		}
+		if f.Value == "" {
+			return nil, fmt.Errorf("%w: empty value in %q", ErrInvalidAnswerFilter, r)
+		}
+		switch f.Op {
+		case dto.ANSWERFILTER_EQ, dto.ANSWERFILTER_CONTAINS:
+		case dto.ANSWERFILTER_GTE, dto.ANSWERFILTER_LTE:
+			if d, ok := parseAnswerDate(f.Value); ok {
+				f.Value, f.Type = d, database.APPLICATIONQUESTIONTYPEDATE
+			} else if n, ok := parseAnswerNumber(f.Value); ok {
+				f.Value, f.Type = n, database.APPLICATIONQUESTIONTYPENUMBER
+			} else {
+				return nil, fmt.Errorf("%w: %q is neither a date nor a number", ErrInvalidAnswerFilter, f.Value)
+			}
+		default:
+			return nil, fmt.Errorf("%w: unknown operator %q", ErrInvalidAnswerFilter, f.Op)
+		}
+		res = append(res, f)
+	}
+	return res, nil
+}
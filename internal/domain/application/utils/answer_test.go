@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/application/dto"
+	listing_model "github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+func TestNormalizeApplicationAnswers(t *testing.T) {
+	questions := []listing_model.ListingQuestionModel{
+		{ID: 1, Question: "Nghề nghiệp", Type: database.APPLICATIONQUESTIONTYPETEXT, Required: true},
+		{ID: 2, Question: "Số người ở", Type: database.APPLICATIONQUESTIONTYPENUMBER, Required: true},
+		{ID: 3, Question: "Hút thuốc", Type: database.APPLICATIONQUESTIONTYPESINGLECHOICE, Options: []string{"Có", "Không"}},
+		{ID: 4, Question: "Tiện ích cần", Type: database.APPLICATIONQUESTIONTYPEMULTICHOICE, Options: []string{"Wifi", "Máy giặt", "Điều hòa"}},
+		{ID: 5, Question: "Ngày dọn vào", Type: database.APPLICATIONQUESTIONTYPEDATE},
+		{ID: 6, Question: "Hợp đồng lao động", Type: database.APPLICATIONQUESTIONTYPEFILE, Required: true},
+	}
+	file := "https://bucket.s3.amazonaws.com/" + GetApplicationAnswerFileKey(uuid.New(), 6, "hd.pdf", 1700000000)
+
+	res, err := NormalizeApplicationAnswers(questions, []dto.CreateApplicationAnswer{
+		{QuestionID: 1, Values: []string{" Kỹ sư "}},
+		{QuestionID: 2, Values: []string{"2.0"}},
+		{QuestionID: 3, Values: []string{}},
+		{QuestionID: 4, Values: []string{"Wifi", "Điều hòa", "Wifi"}},
+		{QuestionID: 5, Values: []string{"2024-06-01T00:00:00Z"}},
+		{QuestionID: 6, Values: []string{file + "?X-Amz-Signature=abc"}},
+	}, nil)
+	require.NoError(t, err)
+	// the unanswered optional question is left out
+	require.Len(t, res, 5)
+	require.Equal(t, []string{"Kỹ sư"}, res[0].Values)
+	require.Equal(t, "Nghề nghiệp", res[0].Question)
+	require.Equal(t, []string{"2"}, res[1].Values)
+	require.Equal(t, database.APPLICATIONQUESTIONTYPENUMBER, res[1].Type)
+	require.Equal(t, []string{"Wifi", "Điều hòa"}, res[2].Values)
+	require.Equal(t, []string{"2024-06-01"}, res[3].Values)
+	require.Equal(t, []string{file}, res[4].Values)
+
+	required := []dto.CreateApplicationAnswer{
+		{QuestionID: 1, Values: []string{"Kỹ sư"}},
+		{QuestionID: 2, Values: []string{"1"}},
+	}
+	// the file is still to be uploaded
+	_, err = NormalizeApplicationAnswers(questions, required, nil)
+	require.ErrorIs(t, err, ErrInvalidAnswer)
+	_, err = NormalizeApplicationAnswers(questions, required, []int64{6})
+	require.NoError(t, err)
+
+	for _, a := range []dto.CreateApplicationAnswer{
+		{QuestionID: 9, Values: []string{"x"}},
+		{QuestionID: 2, Values: []string{"hai"}},
+		{QuestionID: 2, Values: []string{"1", "2"}},
+		{QuestionID: 3, Values: []string{"Thỉnh thoảng"}},
+		{QuestionID: 5, Values: []string{"01/06/2024"}},
+		{QuestionID: 6, Values: []string{"https://bucket.s3.amazonaws.com/avatar.jpg"}},
+		{QuestionID: 1, Values: []string{"Kỹ sư"}},
+	} {
+		_, err = NormalizeApplicationAnswers(questions, append(slices.Clone(required), a), []int64{6})
+		require.ErrorIs(t, err, ErrInvalidAnswer, a)
+	}
+}
+
+func TestParseApplicationAnswerFilters(t *testing.T) {
+	fs, err := ParseApplicationAnswerFilters([]string{"3:eq:Không", "1:contains:kỹ sư", "2:gte:2.50", "5:lte:2024-06-01", "7:eq:a:b"})
+	require.NoError(t, err)
+	require.Equal(t, []dto.ApplicationAnswerFilter{
+		{QuestionID: 3, Op: dto.ANSWERFILTER_EQ, Value: "Không"},
+		{QuestionID: 1, Op: dto.ANSWERFILTER_CONTAINS, Value: "kỹ sư"},
+		{QuestionID: 2, Op: dto.ANSWERFILTER_GTE, Value: "2.5", Type: database.APPLICATIONQUESTIONTYPENUMBER},
+		{QuestionID: 5, Op: dto.ANSWERFILTER_LTE, Value: "2024-06-01", Type: database.APPLICATIONQUESTIONTYPEDATE},
+		{QuestionID: 7, Op: dto.ANSWERFILTER_EQ, Value: "a:b"},
+	}, fs)
+
+	for _, r := range []string{"3", "x:eq:a", "3:eq:", "3:like:a", "2:gte:abc"} {
+		_, err = ParseApplicationAnswerFilters([]string{r})
+		require.ErrorIs(t, err, ErrInvalidAnswerFilter, r)
+	}
+}
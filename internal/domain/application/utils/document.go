@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+	listing_model "github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// GetApplicationDocumentKey returns the S3 object key of a document of the application
+func GetApplicationDocumentKey(creatorId uuid.UUID, aid int64, dtype database.APPLICATIONDOCUMENTTYPE, name string, timestamp int64) string {
+	return fmt.Sprintf("%s/applications/%d/documents/%s_%d_%s", creatorId.String(), aid, dtype, timestamp, name)
+}
+
+// NormalizeApplicationDocumentUrl strips the query of the url of an uploaded document,
+// and tells whether the url belongs to the documents of the application
+func NormalizeApplicationDocumentUrl(rawUrl string, aid int64) (string, bool) {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return "", false
+	}
+	if !strings.Contains(u.Path, fmt.Sprintf("/applications/%d/documents/", aid)) {
+		return "", false
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String(), true
+}
+
+// BuildDocumentChecklist matches the documents uploaded to an application against the checklist of its listing
+func BuildDocumentChecklist(required []listing_model.ListingRequiredDocumentModel, documents []model.ApplicationDocumentModel) model.ApplicationDocumentChecklist {
+	c := model.ApplicationDocumentChecklist{
+		Items:      make([]model.ApplicationDocumentChecklistItem, 0, len(required)),
+		Missing:    []database.APPLICATIONDOCUMENTTYPE{},
+		Unverified: []database.APPLICATIONDOCUMENTTYPE{},
+	}
+	uploaded := make(map[database.APPLICATIONDOCUMENTTYPE]*model.ApplicationDocumentModel, len(documents))
+	for i := range documents {
+		uploaded[documents[i].Type] = &documents[i]
+	}
+
+	for _, r := range required {
+		d := uploaded[r.Type]
+		delete(uploaded, r.Type)
+		c.Items = append(c.Items, model.ApplicationDocumentChecklistItem{
+			Type:      r.Type,
+			Required:  true,
+			Mandatory: r.Mandatory,
+			Note:      r.Note,
+			Document:  d,
+		})
+		if !r.Mandatory {
+			continue
+		}
+		if d == nil || d.Status == database.APPLICATIONDOCUMENTSTATUSREJECTED {
+			c.Missing = append(c.Missing, r.Type)
+		}
+		if d == nil || d.Status != database.APPLICATIONDOCUMENTSTATUSVERIFIED {
+			c.Unverified = append(c.Unverified, r.Type)
+		}
+	}
+
+	// documents uploaded without being asked, in the order they were given
+	for i := range documents {
+		if d, ok := uploaded[documents[i].Type]; ok {
+			c.Items = append(c.Items, model.ApplicationDocumentChecklistItem{
+				Type:     d.Type,
+				Document: d,
+			})
+		}
+	}
+	return c
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+	listing_model "github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+func TestApplicationDocumentUrl(t *testing.T) {
+	key := GetApplicationDocumentKey(uuid.New(), 12, database.APPLICATIONDOCUMENTTYPEIDCARD, "front.jpg", 1700000000)
+	presigned := "https://bucket.s3.ap-southeast-1.amazonaws.com/" + key + "?X-Amz-Signature=abc"
+
+	u, ok := NormalizeApplicationDocumentUrl(presigned, 12)
+	require.True(t, ok)
+	require.Equal(t, "https://bucket.s3.ap-southeast-1.amazonaws.com/"+key, u)
+
+	// bound to another application
+	_, ok = NormalizeApplicationDocumentUrl(presigned, 1)
+	require.False(t, ok)
+	_, ok = NormalizeApplicationDocumentUrl("https://bucket.s3.amazonaws.com/avatar.jpg", 12)
+	require.False(t, ok)
+	_, ok = NormalizeApplicationDocumentUrl("not a url", 12)
+	require.False(t, ok)
+}
+
+func TestBuildDocumentChecklist(t *testing.T) {
+	required := []listing_model.ListingRequiredDocumentModel{
+		{Type: database.APPLICATIONDOCUMENTTYPEIDCARD, Mandatory: true},
+		{Type: database.APPLICATIONDOCUMENTTYPEPROOFOFINCOME, Mandatory: true},
+		{Type: database.APPLICATIONDOCUMENTTYPEEMPLOYMENTLETTER, Mandatory: false},
+	}
+
+	c := BuildDocumentChecklist(required, nil)
+	require.Len(t, c.Items, 3)
+	require.Equal(t, []database.APPLICATIONDOCUMENTTYPE{database.APPLICATIONDOCUMENTTYPEIDCARD, database.APPLICATIONDOCUMENTTYPEPROOFOFINCOME}, c.Missing)
+	require.Equal(t, c.Missing, c.Unverified)
+	require.False(t, c.Complete())
+
+	documents := []model.ApplicationDocumentModel{
+		{Type: database.APPLICATIONDOCUMENTTYPEIDCARD, Status: database.APPLICATIONDOCUMENTSTATUSVERIFIED},
+		{Type: database.APPLICATIONDOCUMENTTYPEPROOFOFINCOME, Status: database.APPLICATIONDOCUMENTSTATUSUPLOADED},
+		{Type: database.APPLICATIONDOCUMENTTYPELANDLORDREFERENCE, Status: database.APPLICATIONDOCUMENTSTATUSUPLOADED},
+	}
+	c = BuildDocumentChecklist(required, documents)
+	require.Len(t, c.Items, 4)
+	require.Empty(t, c.Missing)
+	require.Equal(t, []database.APPLICATIONDOCUMENTTYPE{database.APPLICATIONDOCUMENTTYPEPROOFOFINCOME}, c.Unverified)
+	// the reference was uploaded without being asked
+	require.False(t, c.Items[3].Required)
+	require.NotNil(t, c.Items[3].Document)
+	require.Nil(t, c.Items[2].Document)
+
+	// a rejected document has to be uploaded again
+	documents[1].Status = database.APPLICATIONDOCUMENTSTATUSREJECTED
+	c = BuildDocumentChecklist(required, documents)
+	require.Equal(t, []database.APPLICATIONDOCUMENTTYPE{database.APPLICATIONDOCUMENTTYPEPROOFOFINCOME}, c.Missing)
+
+	documents[1].Status = database.APPLICATIONDOCUMENTSTATUSVERIFIED
+	c = BuildDocumentChecklist(required, documents)
+	require.True(t, c.Complete())
+
+	// no checklist
+	c = BuildDocumentChecklist(nil, nil)
+	require.True(t, c.Complete())
+	require.Empty(t, c.Items)
+}
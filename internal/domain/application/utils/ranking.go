@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+)
+
+const (
+	RANKING_SCREENING_WEIGHT = 0.5
+	RANKING_PRICE_WEIGHT     = 0.3
+	RANKING_MOVEIN_WEIGHT    = 0.2
+	// offers above this ratio of the listing price do not rank any higher
+	RANKING_MAX_PRICE_RATIO = 1.2
+	// move-in dates this many days or more away from the available date get no move-in score
+	RANKING_MOVEIN_TOLERANCE_DAYS = 60
+)
+
+// RankingCandidate is an application competing for a unit along with its screening score
+type RankingCandidate struct {
+	Application    *model.ApplicationModel
+	ScreeningScore float64
+}
+
+// RankApplications orders the candidates by their weighted score, best first.
+// availableFrom is the date the unit becomes available, the move-in dates are compared against it.
+// Ties go to the earliest application.
+func RankApplications(candidates []RankingCandidate, availableFrom time.Time) []model.ApplicationRankingModel {
+	res := make([]model.ApplicationRankingModel, 0, len(candidates))
+	for _, c := range candidates {
+		a := c.Application
+		r := model.ApplicationRankingModel{
+			ApplicationID:  a.ID,
+			Status:         a.Status,
+			FullName:       a.FullName,
+			OfferedPrice:   a.OfferedPrice,
+			MoveinDate:     a.MoveinDate,
+			CreatedAt:      a.CreatedAt,
+			ScreeningScore: c.ScreeningScore,
+			PriceScore:     rankPrice(a),
+			MoveinScore:    rankMovein(a, availableFrom),
+		}
+		r.Score = math.Round((RANKING_SCREENING_WEIGHT*r.ScreeningScore+
+			RANKING_PRICE_WEIGHT*r.PriceScore+
+			RANKING_MOVEIN_WEIGHT*r.MoveinScore)*10) / 10
+		res = append(res, r)
+	}
+
+	slices.SortStableFunc(res, func(a, b model.ApplicationRankingModel) int {
+		if a.Score != b.Score {
+			if a.Score > b.Score {
+				return -1
+			}
+			return 1
+		}
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	for i := range res {
+		res[i].Rank = i + 1
+	}
+	return res
+}
+
+// rankPrice scores the offered price relative to the listing price, reaching 100 at RANKING_MAX_PRICE_RATIO
+func rankPrice(a *model.ApplicationModel) float64 {
+	if a.ListingPrice <= 0 {
+		return 0
+	}
+	ratio := math.Min(float64(a.OfferedPrice)/float64(a.ListingPrice), RANKING_MAX_PRICE_RATIO)
+	return math.Max(ratio, 0) / RANKING_MAX_PRICE_RATIO * 100
+}
+
+// rankMovein scores the move-in date by its distance in days to the date the unit becomes available, either way
+func rankMovein(a *model.ApplicationModel, availableFrom time.Time) float64 {
+	days := math.Abs(a.MoveinDate.Sub(availableFrom).Hours() / 24)
+	return math.Max(1-days/RANKING_MOVEIN_TOLERANCE_DAYS, 0) * 100
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+)
+
+func TestRankApplications(t *testing.T) {
+	availableFrom := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	created := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	candidates := []RankingCandidate{
+		{
+			// good screening, offers the listing price, moves in a month late
+			Application: &model.ApplicationModel{
+				ID:           1,
+				ListingPrice: 5000000,
+				OfferedPrice: 5000000,
+				MoveinDate:   availableFrom.AddDate(0, 0, 30),
+				CreatedAt:    created,
+			},
+			ScreeningScore: 80,
+		},
+		{
+			// same screening, offers more and moves in on time
+			Application: &model.ApplicationModel{
+				ID:           2,
+				ListingPrice: 5000000,
+				OfferedPrice: 7000000,
+				MoveinDate:   availableFrom,
+				CreatedAt:    created.Add(time.Hour),
+			},
+			ScreeningScore: 80,
+		},
+		{
+			// same as the first one but applied later
+			Application: &model.ApplicationModel{
+				ID:           3,
+				ListingPrice: 5000000,
+				OfferedPrice: 5000000,
+				MoveinDate:   availableFrom.AddDate(0, 0, -30),
+				CreatedAt:    created.Add(2 * time.Hour),
+			},
+			ScreeningScore: 80,
+		},
+	}
+
+	r := RankApplications(candidates, availableFrom)
+	require.Len(t, r, 3)
+	require.Equal(t, []int64{2, 1, 3}, []int64{r[0].ApplicationID, r[1].ApplicationID, r[2].ApplicationID})
+	for i := range r {
+		require.Equal(t, i+1, r[i].Rank)
+	}
+
+	// offers above the cap count as the cap
+	require.Equal(t, float64(100), r[0].PriceScore)
+	require.Equal(t, float64(100), r[0].MoveinScore)
+	require.Equal(t, float64(90), r[0].Score)
+
+	require.InDelta(t, 83.33, r[1].PriceScore, 0.01)
+	require.InDelta(t, 50, r[1].MoveinScore, 0.01)
+	require.Equal(t, r[1].Score, r[2].Score)
+}
+
+func TestRankApplicationsMissingListingPrice(t *testing.T) {
+	now := time.Now()
+	r := RankApplications([]RankingCandidate{
+		{
+			Application: &model.ApplicationModel{
+				ID:           1,
+				OfferedPrice: 5000000,
+				MoveinDate:   now.AddDate(0, 0, 120),
+			},
+			ScreeningScore: 50,
+		},
+	}, now)
+	require.Len(t, r, 1)
+	require.Equal(t, float64(0), r[0].PriceScore)
+	require.Equal(t, float64(0), r[0].MoveinScore)
+	require.Equal(t, float64(25), r[0].Score)
+}
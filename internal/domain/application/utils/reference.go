@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	aes_util "github.com/user2410/rrms-backend/internal/utils/encryption/aes"
+)
+
+var (
+	ErrInvalidReferenceLink = errors.New("invalid reference link")
+	ErrReferenceLinkExpired = errors.New("reference link expired")
+)
+
+// ReferenceLinkClaims identifies the reference request a questionnaire link is sent for
+type ReferenceLinkClaims struct {
+	ReferenceID   int64
+	ApplicationID int64
+	Email         string
+	ExpiresAt     time.Time
+}
+
+// EncryptReferenceLink returns the key of the questionnaire link sent to a referee,
+// the link requires no account and is valid until the claims expire
+func EncryptReferenceLink(secret string, c *ReferenceLinkClaims) (string, error) {
+	urlValues := url.Values{}
+	urlValues.Add("referenceId", strconv.FormatInt(c.ReferenceID, 10))
+	urlValues.Add("applicationId", strconv.FormatInt(c.ApplicationID, 10))
+	urlValues.Add("email", c.Email)
+	urlValues.Add("expiresAt", c.ExpiresAt.Format(time.RFC3339Nano))
+
+	signedData, err := aes_util.Encrypt(secret, []byte(urlValues.Encode()))
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(signedData), nil
+}
+
+// DecryptReferenceLink returns the claims of the key of a questionnaire link if it is still valid at the given time
+func DecryptReferenceLink(secret string, key string, now time.Time) (ReferenceLinkClaims, error) {
+	cipherData, err := hex.DecodeString(key)
+	if err != nil {
+		return ReferenceLinkClaims{}, ErrInvalidReferenceLink
+	}
+	data, err := aes_util.Decrypt(secret, cipherData)
+	if err != nil {
+		return ReferenceLinkClaims{}, ErrInvalidReferenceLink
+	}
+	params, err := url.ParseQuery(string(data))
+	if err != nil {
+		return ReferenceLinkClaims{}, ErrInvalidReferenceLink
+	}
+
+	var c ReferenceLinkClaims
+	c.Email = params.Get("email")
+	if c.ReferenceID, err = strconv.ParseInt(params.Get("referenceId"), 10, 64); err != nil {
+		return ReferenceLinkClaims{}, ErrInvalidReferenceLink
+	}
+	if c.ApplicationID, err = strconv.ParseInt(params.Get("applicationId"), 10, 64); err != nil {
+		return ReferenceLinkClaims{}, ErrInvalidReferenceLink
+	}
+	if c.ExpiresAt, err = time.Parse(time.RFC3339Nano, params.Get("expiresAt")); err != nil {
+		return ReferenceLinkClaims{}, ErrInvalidReferenceLink
+	}
+	if !now.Before(c.ExpiresAt) {
+		return c, ErrReferenceLinkExpired
+	}
+	return c, nil
+}
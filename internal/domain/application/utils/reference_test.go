@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/utils/random"
+)
+
+func TestReferenceLink(t *testing.T) {
+	secret := random.RandomAlphabetStr(32)
+	now := time.Now()
+	claims := ReferenceLinkClaims{
+		ReferenceID:   3,
+		ApplicationID: 12,
+		Email:         "landlord@email.com",
+		ExpiresAt:     now.Add(14 * 24 * time.Hour),
+	}
+	key, err := EncryptReferenceLink(secret, &claims)
+	require.NoError(t, err)
+
+	c, err := DecryptReferenceLink(secret, key, now)
+	require.NoError(t, err)
+	require.Equal(t, claims.ReferenceID, c.ReferenceID)
+	require.Equal(t, claims.ApplicationID, c.ApplicationID)
+	require.Equal(t, claims.Email, c.Email)
+	require.True(t, claims.ExpiresAt.Equal(c.ExpiresAt))
+
+	_, err = DecryptReferenceLink(secret, key, claims.ExpiresAt)
+	require.ErrorIs(t, err, ErrReferenceLinkExpired)
+
+	_, err = DecryptReferenceLink(secret, key[:len(key)-2], now)
+	require.ErrorIs(t, err, ErrInvalidReferenceLink)
+	_, err = DecryptReferenceLink(random.RandomAlphabetStr(32), key, now)
+	require.ErrorIs(t, err, ErrInvalidReferenceLink)
+	_, err = DecryptReferenceLink(secret, "not hex", now)
+	require.ErrorIs(t, err, ErrInvalidReferenceLink)
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+	"text/template"
+
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+)
+
+var ErrInvalidRejectionMessage = errors.New("invalid rejection message")
+
+// RejectionMessageData holds the placeholders available to the rejection message sent to many applicants at once
+type RejectionMessageData struct {
+	FullName string
+	// formatted as dd/mm/yyyy
+	MoveinDate string
+}
+
+// ParseRejectionMessage parses the message template, e.g. "Chào {{.FullName}}, ...".
+// Unknown placeholders are rejected here rather than when rendering the message of each applicant.
+func ParseRejectionMessage(msg string) (*template.Template, error) {
+	t, err := template.New("rejection").Option("missingkey=error").Parse(msg)
+	if err != nil {
+		return nil, ErrInvalidRejectionMessage
+	}
+	if err = t.Execute(&strings.Builder{}, RejectionMessageData{}); err != nil {
+		return nil, ErrInvalidRejectionMessage
+	}
+	return t, nil
+}
+
+// RenderRejectionMessage renders the message sent to the applicant of the application
+func RenderRejectionMessage(t *template.Template, a *model.ApplicationModel) (string, error) {
+	var sb strings.Builder
+	err := t.Execute(&sb, RejectionMessageData{
+		FullName:   a.FullName,
+		MoveinDate: a.MoveinDate.Format("02/01/2006"),
+	})
+	if err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+)
+
+func TestRenderRejectionMessage(t *testing.T) {
+	tmpl, err := ParseRejectionMessage("Chào {{.FullName}}, phòng đã có người thuê trước ngày {{.MoveinDate}}.")
+	require.NoError(t, err)
+
+	msg, err := RenderRejectionMessage(tmpl, &model.ApplicationModel{
+		FullName:   "Nguyễn Văn A",
+		MoveinDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Chào Nguyễn Văn A, phòng đã có người thuê trước ngày 01/06/2024.", msg)
+
+	// plain messages are sent as is
+	tmpl, err = ParseRejectionMessage("Phòng đã có người thuê.")
+	require.NoError(t, err)
+	msg, err = RenderRejectionMessage(tmpl, &model.ApplicationModel{FullName: "Nguyễn Văn A"})
+	require.NoError(t, err)
+	require.Equal(t, "Phòng đã có người thuê.", msg)
+}
+
+func TestParseRejectionMessageInvalid(t *testing.T) {
+	_, err := ParseRejectionMessage("Chào {{.FullName")
+	require.ErrorIs(t, err, ErrInvalidRejectionMessage)
+
+	_, err = ParseRejectionMessage("Chào {{.Email}}")
+	require.ErrorIs(t, err, ErrInvalidRejectionMessage)
+}
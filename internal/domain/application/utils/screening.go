@@ -0,0 +1,192 @@
+package utils
+
+import (
+	"math"
+
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+)
+
+const (
+	// id of p-feature_parking in p_features
+	PROPERTY_FEATURE_PARKING = 8
+	// id of l_policy-parking in l_policies
+	LISTING_POLICY_PARKING = 7
+)
+
+// ScreeningPolicies are the rules of the listing the pets and vehicles of an application are checked against
+type ScreeningPolicies struct {
+	// nil if the listing does not tell whether pets are allowed
+	PetsAllowed *bool
+	// the property has a parking lot or the listing has a parking policy
+	Parking bool
+}
+
+// ScreenApplication computes the screening report of the application.
+// stats is nil if the applicant has no account, hence no previous rentals on the platform.
+func ScreenApplication(a *model.ApplicationModel, c *model.ScreeningConfigModel, p ScreeningPolicies, stats *model.TenantPaymentStatsModel) model.ScreeningReportModel {
+	r := model.ScreeningReportModel{
+		ApplicationID:   a.ID,
+		HouseholdIncome: householdIncome(a),
+		PaymentStats:    stats,
+		Config:          *c,
+	}
+	r.Factors = []model.ScreeningFactorResult{
+		screenIncome(a, c),
+		screenHouseholdIncome(a, c, r.HouseholdIncome),
+		screenRentalHistory(a, c),
+		screenPolicies(a, c, p),
+		screenPaymentHistory(c, stats),
+	}
+
+	var weights, total float64
+	for _, f := range r.Factors {
+		if !f.Available || f.Weight <= 0 {
+			continue
+		}
+		weights += float64(f.Weight)
+		total += float64(f.Weight) * f.Score
+	}
+	if weights > 0 {
+		r.Score = math.Round(total/weights*1000) / 10
+	}
+	return r
+}
+
+func householdIncome(a *model.ApplicationModel) int64 {
+	var income int64
+	if a.EmploymentMonthlyIncome != nil {
+		income = *a.EmploymentMonthlyIncome
+	}
+	for _, c := range a.Coaps {
+		income += int64(c.Income)
+	}
+	return income
+}
+
+// screenRatio scores value against the minimum threshold, linearly up to 1 when the threshold is met
+func screenRatio(f *model.ScreeningFactorResult, value, threshold float64) {
+	f.Available = true
+	f.Value = &value
+	f.Threshold = &threshold
+	f.Passed = value >= threshold
+	if f.Passed {
+		f.Score = 1
+	} else {
+		f.Score = math.Max(value/threshold, 0)
+	}
+}
+
+func screenIncome(a *model.ApplicationModel, c *model.ScreeningConfigModel) model.ScreeningFactorResult {
+	f := model.ScreeningFactorResult{
+		Factor: model.SCREENING_FACTOR_INCOME,
+		Weight: c.IncomeWeight,
+		Notes:  []string{},
+	}
+	if a.OfferedPrice <= 0 {
+		f.Notes = append(f.Notes, "no offered price")
+		return f
+	}
+	if a.EmploymentMonthlyIncome == nil {
+		f.Notes = append(f.Notes, "monthly income not declared")
+		return f
+	}
+	screenRatio(&f, float64(*a.EmploymentMonthlyIncome)/float64(a.OfferedPrice), float64(c.MinIncomeRatio))
+	return f
+}
+
+func screenHouseholdIncome(a *model.ApplicationModel, c *model.ScreeningConfigModel, income int64) model.ScreeningFactorResult {
+	f := model.ScreeningFactorResult{
+		Factor: model.SCREENING_FACTOR_HOUSEHOLD_INCOME,
+		Weight: c.HouseholdIncomeWeight,
+		Notes:  []string{},
+	}
+	if a.OfferedPrice <= 0 {
+		f.Notes = append(f.Notes, "no offered price")
+		return f
+	}
+	if a.EmploymentMonthlyIncome == nil && len(a.Coaps) == 0 {
+		f.Notes = append(f.Notes, "monthly income not declared")
+		return f
+	}
+	if len(a.Coaps) == 0 {
+		f.Notes = append(f.Notes, "no co-applicants")
+	}
+	screenRatio(&f, float64(income)/float64(a.OfferedPrice), float64(c.MinHouseholdIncomeRatio))
+	return f
+}
+
+func screenRentalHistory(a *model.ApplicationModel, c *model.ScreeningConfigModel) model.ScreeningFactorResult {
+	f := model.ScreeningFactorResult{
+		Factor: model.SCREENING_FACTOR_RENTAL_HISTORY,
+		Weight: c.RentalHistoryWeight,
+		Notes:  []string{},
+	}
+	if a.RhRentalDuration == nil {
+		f.Notes = append(f.Notes, "no rental history")
+		return f
+	}
+	months := float64(*a.RhRentalDuration)
+	if c.MinRentalHistoryMonths <= 0 {
+		f.Available = true
+		f.Value = &months
+		f.Passed = true
+		f.Score = 1
+		return f
+	}
+	screenRatio(&f, months, float64(c.MinRentalHistoryMonths))
+	return f
+}
+
+// screenPolicies averages the checks of the pets and of the vehicles of the application
+func screenPolicies(a *model.ApplicationModel, c *model.ScreeningConfigModel, p ScreeningPolicies) model.ScreeningFactorResult {
+	f := model.ScreeningFactorResult{
+		Factor:    model.SCREENING_FACTOR_POLICIES,
+		Weight:    c.PolicyWeight,
+		Available: true,
+		Notes:     []string{},
+	}
+
+	pets := 1.0
+	if len(a.Pets) > 0 {
+		switch {
+		case p.PetsAllowed == nil:
+			pets = 0.5
+			f.Notes = append(f.Notes, "pets not covered by the listing")
+		case !*p.PetsAllowed:
+			pets = 0
+			f.Notes = append(f.Notes, "pets not allowed")
+		}
+	}
+
+	vehicles := 1.0
+	if len(a.Vehicles) > 0 && !p.Parking {
+		vehicles = 0
+		f.Notes = append(f.Notes, "no parking for vehicles")
+	}
+
+	f.Score = (pets + vehicles) / 2
+	f.Passed = f.Score == 1
+	return f
+}
+
+func screenPaymentHistory(c *model.ScreeningConfigModel, stats *model.TenantPaymentStatsModel) model.ScreeningFactorResult {
+	f := model.ScreeningFactorResult{
+		Factor: model.SCREENING_FACTOR_PAYMENT_HISTORY,
+		Weight: c.PaymentHistoryWeight,
+		Notes:  []string{},
+	}
+	if stats == nil || stats.Rentals == 0 {
+		f.Notes = append(f.Notes, "no previous rentals on the platform")
+		return f
+	}
+	due := stats.PaidPayments + stats.OverduePayments
+	if due == 0 {
+		f.Notes = append(f.Notes, "no payments due in previous rentals")
+		return f
+	}
+	if stats.OverduePayments > 0 {
+		f.Notes = append(f.Notes, "overdue payments")
+	}
+	screenRatio(&f, float64(stats.OnTimePayments)/float64(due), float64(c.MinOnTimeRate))
+	return f
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/application/model"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func getFactor(r *model.ScreeningReportModel, factor model.ScreeningFactor) model.ScreeningFactorResult {
+	for _, f := range r.Factors {
+		if f.Factor == factor {
+			return f
+		}
+	}
+	return model.ScreeningFactorResult{}
+}
+
+func TestScreenApplication(t *testing.T) {
+	c := model.DefaultScreeningConfigModel(uuid.New())
+	a := model.ApplicationModel{
+		ID:                      1,
+		OfferedPrice:            5000000,
+		EmploymentMonthlyIncome: types.Ptr[int64](20000000),
+		RhRentalDuration:        types.Ptr[int32](24),
+		Coaps: []model.ApplicationCoapModel{
+			{Income: 10000000},
+		},
+		Pets:     []model.ApplicationPetModel{{Type: "dog"}},
+		Vehicles: []model.ApplicationVehicle{{Type: "motorbike"}},
+	}
+	stats := model.TenantPaymentStatsModel{
+		Rentals:        1,
+		PaidPayments:   10,
+		OnTimePayments: 10,
+	}
+
+	r := ScreenApplication(&a, &c, ScreeningPolicies{PetsAllowed: types.Ptr(true), Parking: true}, &stats)
+	require.Equal(t, int64(30000000), r.HouseholdIncome)
+	require.Len(t, r.Factors, 5)
+	for _, f := range r.Factors {
+		require.True(t, f.Available, f.Factor)
+		require.True(t, f.Passed, f.Factor)
+	}
+	require.Equal(t, 4.0, *getFactor(&r, model.SCREENING_FACTOR_INCOME).Value)
+	require.Equal(t, 6.0, *getFactor(&r, model.SCREENING_FACTOR_HOUSEHOLD_INCOME).Value)
+	require.Equal(t, 100.0, r.Score)
+
+	// income below the threshold scores in proportion
+	a.EmploymentMonthlyIncome = types.Ptr[int64](7500000)
+	r = ScreenApplication(&a, &c, ScreeningPolicies{PetsAllowed: types.Ptr(true), Parking: true}, &stats)
+	f := getFactor(&r, model.SCREENING_FACTOR_INCOME)
+	require.False(t, f.Passed)
+	require.InDelta(t, 0.5, f.Score, 1e-9)
+	require.True(t, getFactor(&r, model.SCREENING_FACTOR_HOUSEHOLD_INCOME).Passed)
+	require.Equal(t, 85.0, r.Score)
+
+	// pets and vehicles against the policies of the listing
+	r = ScreenApplication(&a, &c, ScreeningPolicies{PetsAllowed: types.Ptr(false)}, &stats)
+	f = getFactor(&r, model.SCREENING_FACTOR_POLICIES)
+	require.False(t, f.Passed)
+	require.Equal(t, 0.0, f.Score)
+	require.Len(t, f.Notes, 2)
+	r = ScreenApplication(&a, &c, ScreeningPolicies{Parking: true}, &stats)
+	require.Equal(t, 0.75, getFactor(&r, model.SCREENING_FACTOR_POLICIES).Score)
+}
+
+func TestScreenApplicationMissingData(t *testing.T) {
+	c := model.DefaultScreeningConfigModel(uuid.New())
+	a := model.ApplicationModel{
+		ID:           1,
+		OfferedPrice: 5000000,
+	}
+
+	// only the policies can be assessed, and nothing conflicts with them
+	r := ScreenApplication(&a, &c, ScreeningPolicies{}, nil)
+	for _, f := range r.Factors {
+		require.Equal(t, f.Factor == model.SCREENING_FACTOR_POLICIES, f.Available, f.Factor)
+	}
+	require.Equal(t, 100.0, r.Score)
+
+	// no payments due yet
+	r = ScreenApplication(&a, &c, ScreeningPolicies{}, &model.TenantPaymentStatsModel{Rentals: 1})
+	require.False(t, getFactor(&r, model.SCREENING_FACTOR_PAYMENT_HISTORY).Available)
+
+	// overdue payments count against the on-time rate
+	r = ScreenApplication(&a, &c, ScreeningPolicies{}, &model.TenantPaymentStatsModel{
+		Rentals:         2,
+		PaidPayments:    8,
+		OnTimePayments:  6,
+		OverduePayments: 2,
+	})
+	f := getFactor(&r, model.SCREENING_FACTOR_PAYMENT_HISTORY)
+	require.True(t, f.Available)
+	require.False(t, f.Passed)
+	require.InDelta(t, 0.6, *f.Value, 1e-9)
+
+	// factors without weight are left out of the score
+	c.PolicyWeight = 0
+	c.PaymentHistoryWeight = 0
+	r = ScreenApplication(&a, &c, ScreeningPolicies{}, nil)
+	require.Equal(t, 0.0, r.Score)
+}
@@ -0,0 +1,115 @@
+package asynctask
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/hibiken/asynq"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/service"
+	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
+)
+
+type Adapter interface {
+	Register(processor asynctask.Processor)
+}
+
+type adapter struct {
+	service service.Service
+}
+
+func NewAdapter(service service.Service) Adapter {
+	return &adapter{
+		service: service,
+	}
+}
+
+func (a *adapter) Register(processor asynctask.Processor) {
+	processor.RegisterHandler(asynctask.LISTING_SEARCH_OUTBOX_PROCESS, a.processSearchOutbox)
+	processor.RegisterHandler(asynctask.LISTING_SAVED_SEARCH_MATCH, a.matchSavedSearches)
+	processor.RegisterHandler(asynctask.LISTING_SAVED_SEARCH_ALERT, a.sendSavedSearchAlerts)
+	processor.RegisterHandler(asynctask.LISTING_MODERATION_CHECK, a.checkListingModeration)
+	processor.RegisterHandler(asynctask.LISTING_MODERATION_NOTIFY, a.notifyListingModeration)
+	processor.RegisterHandler(asynctask.LISTING_MODERATION_REFUND, a.refundListing)
+	processor.RegisterHandler(asynctask.LISTING_DUPLICATE_DETECT, a.detectListingDuplicates)
+	processor.RegisterHandler(asynctask.LISTING_LIFECYCLE_PROCESS, a.processListingLifecycle)
+	processor.RegisterHandler(asynctask.LISTING_EXPIRY_WARN, a.warnListingsExpiry)
+	processor.RegisterHandler(asynctask.LISTING_VIEWING_NOTIFY, a.notifyListingViewing)
+	processor.RegisterHandler(asynctask.LISTING_VIEWING_REMIND, a.remindListingViewings)
+	processor.RegisterHandler(asynctask.LISTING_ANALYTICS_FLUSH, a.flushListingAnalytics)
+}
+
+func (a *adapter) processSearchOutbox(ctx context.Context, task *asynq.Task) error {
+	return a.service.ProcessSearchOutbox()
+}
+
+func (a *adapter) matchSavedSearches(ctx context.Context, task *asynq.Task) error {
+	return a.service.MatchSavedSearches()
+}
+
+func (a *adapter) sendSavedSearchAlerts(ctx context.Context, task *asynq.Task) error {
+	var payload dto.SendSavedSearchAlerts
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return err
+	}
+	return a.service.SendSavedSearchAlerts(payload.Frequency)
+}
+
+func (a *adapter) checkListingModeration(ctx context.Context, task *asynq.Task) error {
+	var payload dto.CheckListingModeration
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return err
+	}
+	return a.service.CheckListingModeration(payload.ModerationID)
+}
+
+func (a *adapter) notifyListingModeration(ctx context.Context, task *asynq.Task) error {
+	var payload dto.NotifyListingModeration
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return err
+	}
+	return a.service.NotifyListingModeration(payload.ModerationID)
+}
+
+func (a *adapter) refundListing(ctx context.Context, task *asynq.Task) error {
+	var payload dto.RefundListing
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return err
+	}
+	return a.service.RefundListing(&payload)
+}
+
+func (a *adapter) detectListingDuplicates(ctx context.Context, task *asynq.Task) error {
+	var payload dto.DetectListingDuplicates
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return err
+	}
+	_, err := a.service.DetectListingDuplicates(payload.ListingID)
+	return err
+}
+
+// processListingLifecycle publishes the scheduled listings then expires the listings past their expiry
+func (a *adapter) processListingLifecycle(ctx context.Context, task *asynq.Task) error {
+	return errors.Join(a.service.PublishScheduledListings(), a.service.ExpireListings())
+}
+
+func (a *adapter) warnListingsExpiry(ctx context.Context, task *asynq.Task) error {
+	return a.service.WarnListingsExpiry()
+}
+
+func (a *adapter) notifyListingViewing(ctx context.Context, task *asynq.Task) error {
+	var payload dto.NotifyListingViewing
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return err
+	}
+	return a.service.NotifyListingViewing(payload.ViewingID, payload.Event)
+}
+
+func (a *adapter) remindListingViewings(ctx context.Context, task *asynq.Task) error {
+	return a.service.RemindListingViewings()
+}
+
+func (a *adapter) flushListingAnalytics(ctx context.Context, task *asynq.Task) error {
+	return a.service.FlushListingAnalytics()
+}
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LISTINGEVENT string
+
+const (
+	// the listing appeared in search results
+	LISTINGEVENT_IMPRESSION      LISTINGEVENT = "impression"
+	LISTINGEVENT_VIEW            LISTINGEVENT = "view"
+	LISTINGEVENT_CONTACT         LISTINGEVENT = "contact"
+	LISTINGEVENT_APPLICATIONLINK LISTINGEVENT = "application_link"
+	LISTINGEVENT_APPLICATION     LISTINGEVENT = "application"
+	// the tenant attended a viewing of the listing
+	LISTINGEVENT_VIEWING LISTINGEVENT = "viewing"
+)
+
+// ListingDailyStats are the deduplicated event counts of a listing in a day
+type ListingDailyStats struct {
+	ListingID        uuid.UUID
+	Date             time.Time
+	Impressions      int64
+	Views            int64
+	Contacts         int64
+	ApplicationLinks int64
+	Applications     int64
+	Viewings         int64
+}
+
+// Set sets the count of the given event
+func (s *ListingDailyStats) Set(event LISTINGEVENT, count int64) {
+	switch event {
+	case LISTINGEVENT_IMPRESSION:
+		s.Impressions = count
+	case LISTINGEVENT_VIEW:
+		s.Views = count
+	case LISTINGEVENT_CONTACT:
+		s.Contacts = count
+	case LISTINGEVENT_APPLICATIONLINK:
+		s.ApplicationLinks = count
+	case LISTINGEVENT_APPLICATION:
+		s.Applications = count
+	case LISTINGEVENT_VIEWING:
+		s.Viewings = count
+	}
+}
+
+type ListingContact struct {
+	FullName    string `json:"fullName"`
+	Email       string `json:"email"`
+	Phone       string `json:"phone"`
+	ContactType string `json:"contactType"`
+}
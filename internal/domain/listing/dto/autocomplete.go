@@ -0,0 +1,24 @@
+package dto
+
+import "github.com/google/uuid"
+
+const AUTOCOMPLETE_DEFAULT_LIMIT = 8
+
+type ListingAutocompleteQuery struct {
+	// the text typed so far, its last word may be incomplete
+	Q     string `query:"q" validate:"required,max=200"`
+	Limit *int   `query:"limit" validate:"omitempty,gte=1,lte=20"`
+}
+
+func (q *ListingAutocompleteQuery) GetLimit() int {
+	if q.Limit == nil {
+		return AUTOCOMPLETE_DEFAULT_LIMIT
+	}
+	return *q.Limit
+}
+
+type ListingSuggestion struct {
+	ListingID   uuid.UUID `json:"listingId"`
+	Title       string    `json:"title"`
+	FullAddress string    `json:"fullAddress"`
+}
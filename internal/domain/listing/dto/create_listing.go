@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/user2410/rrms-backend/internal/domain/listing/model"
@@ -44,29 +46,38 @@ type CreateListing struct {
 	NumberOfResidents *int32                `json:"numberOfResidents" validate:"omitempty,gte=0"`
 	Priority          int32                 `json:"priority" validate:"required,gte=1,lte=5"`
 	PostDuration      int                   `json:"postDuration" validate:"required"`
+	PostAt            *time.Time            `json:"postAt" validate:"omitempty,gt"`
+	AutoRenew         *bool                 `json:"autoRenew"`
 	Policies          []CreateListingPolicy `json:"policies" validate:"dive"`
 	Units             []CreateListingUnit   `json:"units" validate:"required,dive"`
 	Tags              []string              `json:"tags" validate:"dive"`
+	// the title and description in other languages
+	Translations []CreateListingTranslation `json:"translations" validate:"omitempty,unique=Language,dive"`
+	// number of days a pending application stays valid without any update, 30 by default
+	ApplicationValidityDays *int32 `json:"applicationValidityDays" validate:"omitempty,gte=1,lte=365"`
 }
 
 func (c *CreateListing) ToCreateListingDB() *database.CreateListingParams {
 	ldb := &database.CreateListingParams{
-		CreatorID:         c.CreatorID,
-		PropertyID:        c.PropertyID,
-		Title:             c.Title,
-		Description:       c.Description,
-		FullName:          c.FullName,
-		Email:             c.Email,
-		Phone:             c.Phone,
-		ContactType:       c.ContactType,
-		Price:             c.Price,
-		PriceNegotiable:   pgtype.Bool{Valid: true, Bool: c.PriceNegotiable},
-		SecurityDeposit:   types.Float32N(c.SecurityDeposit),
-		LeaseTerm:         types.Int32N(c.LeaseTerm),
-		PetsAllowed:       types.BoolN(c.PetsAllowed),
-		NumberOfResidents: types.Int32N(c.NumberOfResidents),
-		Priority:          c.Priority,
-		PostDuration:      c.PostDuration,
+		CreatorID:               c.CreatorID,
+		PropertyID:              c.PropertyID,
+		Title:                   c.Title,
+		Description:             c.Description,
+		FullName:                c.FullName,
+		Email:                   c.Email,
+		Phone:                   c.Phone,
+		ContactType:             c.ContactType,
+		Price:                   c.Price,
+		PriceNegotiable:         pgtype.Bool{Valid: true, Bool: c.PriceNegotiable},
+		SecurityDeposit:         types.Float32N(c.SecurityDeposit),
+		LeaseTerm:               types.Int32N(c.LeaseTerm),
+		PetsAllowed:             types.BoolN(c.PetsAllowed),
+		NumberOfResidents:       types.Int32N(c.NumberOfResidents),
+		Priority:                c.Priority,
+		PostAt:                  types.TimestamptzN(c.PostAt),
+		AutoRenew:               types.BoolN(c.AutoRenew),
+		PostDuration:            c.PostDuration,
+		ApplicationValidityDays: types.Int32N(c.ApplicationValidityDays),
 	}
 	return ldb
 }
@@ -74,4 +85,8 @@ func (c *CreateListing) ToCreateListingDB() *database.CreateListingParams {
 type CreateListingResponse struct {
 	Listing *model.ListingModel         `json:"listing"`
 	Payment *payment_model.PaymentModel `json:"payment"`
+	// the listings suspected to advertise the same unit, nil if there is none
+	Duplicates *model.ListingDuplicateClusterModel `json:"duplicates"`
+	// the estimated rent of each unit of the listing
+	PriceEstimates []model.ListingUnitPriceEstimate `json:"priceEstimates"`
 }
@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/google/uuid"
+
+type GetListingDuplicateClustersQuery struct {
+	Limit  *int32 `query:"limit" validate:"omitempty,gte=1,lte=100"`
+	Offset *int32 `query:"offset" validate:"omitempty,gte=0"`
+}
+
+type DetectListingDuplicates struct {
+	ListingID uuid.UUID `json:"listingId"`
+}
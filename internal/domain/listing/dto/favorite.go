@@ -0,0 +1,89 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+)
+
+type CreateFavoriteFolder struct {
+	UserID uuid.UUID `json:"userId"`
+	Name   string    `json:"name" validate:"required,max=64"`
+}
+
+type UpdateFavoriteFolder struct {
+	Name string `json:"name" validate:"required,max=64"`
+}
+
+// SaveFavoriteListing adds a listing to the favorites of a user, or updates its folder and note
+type SaveFavoriteListing struct {
+	UserID    uuid.UUID `json:"userId"`
+	ListingID uuid.UUID `json:"listingId"`
+	FolderID  *int64    `json:"folderId" validate:"omitempty,gte=1"`
+	Note      *string   `json:"note" validate:"omitempty,max=1000"`
+}
+
+type GetFavoriteListingsQuery struct {
+	FolderID *int64 `query:"folderId" validate:"omitempty,gte=1"`
+}
+
+// FavoriteListing is a favorite along with the current state of its listing
+type FavoriteListing struct {
+	model.FavoriteListingModel
+	Title     string    `json:"title"`
+	Price     float32   `json:"price"`
+	Active    bool      `json:"active"`
+	ExpiredAt time.Time `json:"expiredAt"`
+	Expired   bool      `json:"expired"`
+	// the listing was deactivated or expired since it was added to the favorites
+	Unavailable  bool `json:"unavailable"`
+	PriceChanged bool `json:"priceChanged"`
+}
+
+type CompareListingsQuery struct {
+	IDs []uuid.UUID `query:"listingIds" validate:"required,min=2,max=5,unique"`
+}
+
+type ComparedListingUnit struct {
+	UnitID            uuid.UUID `json:"unitId"`
+	Name              string    `json:"name"`
+	Type              string    `json:"type"`
+	Price             int64     `json:"price"`
+	Area              float32   `json:"area"`
+	PricePerM2        *float32  `json:"pricePerM2"`
+	NumberOfBedrooms  *int32    `json:"numberOfBedrooms"`
+	NumberOfBathrooms *int32    `json:"numberOfBathrooms"`
+}
+
+type ComparedListing struct {
+	ID           uuid.UUID `json:"id"`
+	Title        string    `json:"title"`
+	FullAddress  string    `json:"fullAddress"`
+	City         string    `json:"city"`
+	District     string    `json:"district"`
+	PropertyType string    `json:"propertyType"`
+
+	Price             float32  `json:"price"`
+	PriceNegotiable   bool     `json:"priceNegotiable"`
+	SecurityDeposit   *float32 `json:"securityDeposit"`
+	LeaseTerm         *int32   `json:"leaseTerm"`
+	PetsAllowed       *bool    `json:"petsAllowed"`
+	NumberOfResidents *int32   `json:"numberOfResidents"`
+	// total area of the listed units, in m²
+	Area       float32  `json:"area"`
+	PricePerM2 *float32 `json:"pricePerM2"`
+
+	Units []ComparedListingUnit `json:"units"`
+	// amenities of any of the listed units
+	AmenityIDs []int64                    `json:"amenityIds"`
+	Policies   []model.ListingPolicyModel `json:"policies"`
+}
+
+// ListingComparison holds the compared listings side by side.
+// AmenityIDs and PolicyIDs are the union of the amenities and policies of all the listings, the rows of the comparison.
+type ListingComparison struct {
+	Listings   []ComparedListing `json:"listings"`
+	AmenityIDs []int64           `json:"amenityIds"`
+	PolicyIDs  []int64           `json:"policyIds"`
+}
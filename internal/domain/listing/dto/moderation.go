@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+type GetListingModerationsQuery struct {
+	Status database.LISTINGMODERATIONSTATUS `query:"status" validate:"omitempty,oneof=PENDING APPROVED REJECTED CHANGES_REQUESTED"`
+	Limit  *int32                           `query:"limit" validate:"omitempty,gte=1,lte=100"`
+	Offset *int32                           `query:"offset" validate:"omitempty,gte=0"`
+}
+
+// ReviewListingModeration is the decision of a moderator, the reason is required unless the listing is approved
+type ReviewListingModeration struct {
+	ReviewerID uuid.UUID                        `json:"reviewerId"`
+	Status     database.LISTINGMODERATIONSTATUS `json:"status" validate:"required,oneof=APPROVED REJECTED CHANGES_REQUESTED"`
+	Reason     *string                          `json:"reason" validate:"required_unless=Status APPROVED,omitempty,max=2000"`
+}
+
+type CheckListingModeration struct {
+	ModerationID int64 `json:"moderationId"`
+}
+
+type NotifyListingModeration struct {
+	ModerationID int64 `json:"moderationId"`
+}
+
+type RefundListing struct {
+	ListingID uuid.UUID `json:"listingId"`
+	Reason    string    `json:"reason"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+type GetRentEstimateQuery struct {
+	City              string  `query:"city" validate:"required"`
+	District          string  `query:"district" validate:"required"`
+	Ward              *string `query:"ward" validate:"omitempty"`
+	PropertyType      string  `query:"propertyType" validate:"required,oneof=APARTMENT PRIVATE ROOM STORE OFFICE VILLA MINIAPARTMENT"`
+	Area              float32 `query:"area" validate:"required,gt=0"`
+	NumberOfBedrooms  *int32  `query:"numberOfBedrooms" validate:"omitempty,gte=0"`
+	NumberOfBathrooms *int32  `query:"numberOfBathrooms" validate:"omitempty,gte=0"`
+}
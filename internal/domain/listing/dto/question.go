@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+var (
+	ErrQuestionOptionsRequired   = errors.New("choice questions require at least 2 options")
+	ErrQuestionOptionsNotAllowed = errors.New("only choice questions have options")
+)
+
+type CreateListingQuestion struct {
+	Question string                           `json:"question" validate:"required"`
+	Type     database.APPLICATIONQUESTIONTYPE `json:"type" validate:"required,oneof=TEXT NUMBER SINGLE_CHOICE MULTI_CHOICE DATE FILE"`
+	Required bool                             `json:"required"`
+	Options  []string                         `json:"options" validate:"omitempty,unique,dive,required"`
+	Position int32                            `json:"position" validate:"gte=0"`
+}
+
+// Validate checks that choice questions, and only them, come with options
+func (c *CreateListingQuestion) Validate() error {
+	isChoice := c.Type == database.APPLICATIONQUESTIONTYPESINGLECHOICE || c.Type == database.APPLICATIONQUESTIONTYPEMULTICHOICE
+	if isChoice && len(c.Options) < 2 {
+		return ErrQuestionOptionsRequired
+	}
+	if !isChoice && len(c.Options) > 0 {
+		return ErrQuestionOptionsNotAllowed
+	}
+	return nil
+}
+
+func (c *CreateListingQuestion) ToCreateListingQuestionDB(listingId uuid.UUID) database.CreateListingQuestionParams {
+	options := c.Options
+	if options == nil {
+		options = []string{}
+	}
+	return database.CreateListingQuestionParams{
+		ListingID: listingId,
+		Question:  c.Question,
+		Type:      c.Type,
+		Required:  c.Required,
+		Options:   options,
+		Position:  c.Position,
+	}
+}
+
+// UpdateListingQuestion updates a question of a listing, its type cannot be changed
+type UpdateListingQuestion struct {
+	Question *string  `json:"question" validate:"omitempty,min=1"`
+	Required *bool    `json:"required"`
+	Options  []string `json:"options" validate:"omitempty,min=2,unique,dive,required"`
+	Position *int32   `json:"position" validate:"omitempty,gte=0"`
+}
+
+func (u *UpdateListingQuestion) ToUpdateListingQuestionDB(listingId uuid.UUID, id int64) database.UpdateListingQuestionParams {
+	return database.UpdateListingQuestionParams{
+		Question:  types.StrN(u.Question),
+		Required:  types.BoolN(u.Required),
+		Options:   u.Options,
+		Position:  types.Int32N(u.Position),
+		ID:        id,
+		ListingID: listingId,
+	}
+}
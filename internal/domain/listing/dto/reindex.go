@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ListingRevision struct {
+	ID        uuid.UUID `json:"id"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ReindexListingsState is checkpointed after every batch so that an interrupted reindex can be resumed
+type ReindexListingsState struct {
+	Index     string    `json:"index"`
+	StartedAt time.Time `json:"startedAt"`
+	LastID    uuid.UUID `json:"lastId"`
+	Indexed   int64     `json:"indexed"`
+	Swapped   bool      `json:"swapped"`
+	Completed bool      `json:"completed"`
+}
+
+type ListingsIndexDiff struct {
+	Index      string `json:"index"`
+	DBCount    int64  `json:"dbCount"`
+	IndexCount int64  `json:"indexCount"`
+	// Missing are the listings that are not in the index
+	Missing []uuid.UUID `json:"missing"`
+	// Outdated are the listings whose indexed updated_at differs from the database
+	Outdated []uuid.UUID `json:"outdated"`
+	// Orphaned are the documents whose listing no longer exists
+	Orphaned []string `json:"orphaned"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type ListingRequiredDocument struct {
+	Type      database.APPLICATIONDOCUMENTTYPE `json:"type" validate:"required,oneof=ID_CARD PROOF_OF_INCOME EMPLOYMENT_LETTER LANDLORD_REFERENCE"`
+	Mandatory bool                             `json:"mandatory"`
+	Note      *string                          `json:"note" validate:"omitempty"`
+}
+
+func (d *ListingRequiredDocument) ToCreateListingRequiredDocumentDB(listingId uuid.UUID) database.CreateListingRequiredDocumentParams {
+	return database.CreateListingRequiredDocumentParams{
+		ListingID: listingId,
+		Type:      d.Type,
+		Mandatory: d.Mandatory,
+		Note:      types.StrN(d.Note),
+	}
+}
+
+// UpdateListingRequiredDocuments replaces the document checklist of a listing, an empty list clears it
+type UpdateListingRequiredDocuments struct {
+	Documents []ListingRequiredDocument `json:"documents" validate:"unique=Type,dive"`
+}
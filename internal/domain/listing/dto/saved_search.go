@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// CreateSavedSearch saves the search parameters given in the url query, the same as the listing search endpoint
+type CreateSavedSearch struct {
+	UserID    uuid.UUID                     `json:"userId"`
+	Name      string                        `json:"name" validate:"required,max=128"`
+	Frequency database.SAVEDSEARCHFREQUENCY `json:"frequency" validate:"required,oneof=INSTANT DAILY WEEKLY"`
+	Query     SearchListingCombinationQuery `json:"-"`
+}
+
+type UpdateSavedSearch struct {
+	Name      *string                        `json:"name" validate:"omitempty,max=128"`
+	Frequency *database.SAVEDSEARCHFREQUENCY `json:"frequency" validate:"omitempty,oneof=INSTANT DAILY WEEKLY"`
+	Active    *bool                          `json:"active"`
+	// replaces the search criteria, parsed from the query string like those of a new saved search
+	Query *SearchListingCombinationQuery `json:"-"`
+}
+
+// SavedSearchMatches are the new listings matched by a saved search and not notified yet
+type SavedSearchMatches struct {
+	SavedSearchID int64
+	ListingIDs    []uuid.UUID
+}
+
+type SendSavedSearchAlerts struct {
+	Frequency database.SAVEDSEARCHFREQUENCY `json:"frequency"`
+}
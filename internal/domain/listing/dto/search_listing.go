@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"slices"
 	"strings"
 	"time"
 
@@ -12,7 +13,9 @@ import (
 )
 
 type SearchListingQuery struct {
-	LTitle                *string    `json:"ltitle"`
+	LTitle *string `json:"ltitle"`
+	// full-text query on the title and description, and the address of the property, evaluated by the search backend
+	LQuery                *string    `query:"lquery" validate:"omitempty"`
 	LCreatorID            *string    `json:"lcreatorId"`
 	LPropertyID           *string    `json:"lpropertyId"`
 	LMinPrice             *float32   `json:"lminPrice"`
@@ -34,13 +37,71 @@ type SearchListingQuery struct {
 	LMaxPostAt            *time.Time `json:"lmaxPostAt"`
 	LMinExpiredAt         *time.Time `json:"lminExpiredAt"`
 	LMaxExpiredAt         *time.Time `json:"lmaxExpiredAt"`
+	LAvailableFrom        *time.Time `json:"lavailableFrom"`
+	// restricts the search to these listings, filled from the result of the geo search
+	LIds []string `query:"-" json:"-"`
+	// restricts the search to the listings activated since, set when matching saved searches
+	LMinActivatedAt *time.Time `query:"-" json:"-"`
+	// keeps only the highest-priority visible listing of each duplicate cluster, set for public searches
+	LCollapseDuplicates *bool `query:"-" json:"-"`
+}
+
+const (
+	// SORTBY_DISTANCE sorts the listings by their distance to (GLat, GLng)
+	SORTBY_DISTANCE = "distance"
+	// SORTBY_RELEVANCE sorts the listings by their relevance to LQuery
+	SORTBY_RELEVANCE = "relevance"
+)
+
+// Geo filters, evaluated by Elasticsearch against the listing location:
+// a radius (in km) around (GLat, GLng), a bounding box, and a polygon given as "lat,lng;lat,lng;..."
+type SearchListingGeoQuery struct {
+	GLat     *float64 `query:"glat" validate:"omitempty,gte=-90,lte=90"`
+	GLng     *float64 `query:"glng" validate:"omitempty,gte=-180,lte=180"`
+	GRadius  *float64 `query:"gradius" validate:"omitempty,gt=0"`
+	GMinLat  *float64 `query:"gminLat" validate:"omitempty,gte=-90,lte=90"`
+	GMaxLat  *float64 `query:"gmaxLat" validate:"omitempty,gte=-90,lte=90"`
+	GMinLng  *float64 `query:"gminLng" validate:"omitempty,gte=-180,lte=180"`
+	GMaxLng  *float64 `query:"gmaxLng" validate:"omitempty,gte=-180,lte=180"`
+	GPolygon *string  `query:"gpolygon" validate:"omitempty"`
+}
+
+var (
+	ErrMissingGeoCenter    = fiber.NewError(400, "glat and glng are required for radius search and distance sorting")
+	ErrIncompleteGeoBounds = fiber.NewError(400, "gminLat, gmaxLat, gminLng and gmaxLng are required together")
+)
+
+func (q *SearchListingGeoQuery) HasCenter() bool {
+	return q.GLat != nil && q.GLng != nil
+}
+
+func (q *SearchListingGeoQuery) HasBounds() bool {
+	return q.GMinLat != nil && q.GMaxLat != nil && q.GMinLng != nil && q.GMaxLng != nil
+}
+
+func (q *SearchListingGeoQuery) HasGeoFilter() bool {
+	return q.GRadius != nil || q.HasBounds() || (q.GPolygon != nil && len(*q.GPolygon) > 0)
+}
+
+// validate checks the combination of geo parameters, the polygon itself is parsed by the service
+func (q *SearchListingGeoQuery) validate() error {
+	if q.GRadius != nil && !q.HasCenter() {
+		return ErrMissingGeoCenter
+	}
+	if (q.GMinLat != nil || q.GMaxLat != nil || q.GMinLng != nil || q.GMaxLng != nil) && !q.HasBounds() {
+		return ErrIncompleteGeoBounds
+	}
+	return nil
 }
 
 type SearchListingCombinationQuery struct {
 	requests.SearchSortPaginationQuery
 	SearchListingQuery
+	SearchListingGeoQuery
 	property_dto.SearchPropertyQuery
 	unit_dto.SearchUnitQuery
+	// language of the user, picked from the Accept-Language header
+	Language string `query:"-" json:"-"`
 }
 
 var ErrMismatchSortOrder = fiber.NewError(400, "mismatch sort order")
@@ -64,8 +125,40 @@ func (q *SearchListingCombinationQuery) QueryParser(ctx *fiber.Ctx) error {
 		q.SortBy = q.SortBy[:3]
 		q.Order = q.Order[:3]
 	}
+	if q.SortByDistance() && !q.HasCenter() {
+		return ErrMissingGeoCenter
+	}
 
-	return nil
+	return q.SearchListingGeoQuery.validate()
+}
+
+func (q *SearchListingCombinationQuery) SortByDistance() bool {
+	return slices.Contains(q.SortBy, SORTBY_DISTANCE)
+}
+
+func (q *SearchListingCombinationQuery) HasTextQuery() bool {
+	return q.LQuery != nil && len(strings.TrimSpace(*q.LQuery)) > 0
+}
+
+type ListingGeoGridQuery struct {
+	SearchListingGeoQuery
+	// map zoom level, each cell of the grid is a map tile at this zoom
+	Zoom int `query:"zoom" validate:"gte=0,lte=29"`
+}
+
+func (q *ListingGeoGridQuery) QueryParser(ctx *fiber.Ctx) error {
+	if err := ctx.QueryParser(q); err != nil {
+		return err
+	}
+	return q.SearchListingGeoQuery.validate()
+}
+
+// A cell of the listing map grid, Lat and Lng are the centroid of the listings in the cell
+type ListingGeoGridCell struct {
+	Key   string  `json:"key"`
+	Count int64   `json:"count"`
+	Lat   float64 `json:"lat"`
+	Lng   float64 `json:"lng"`
 }
 
 type SearchListingCombinationItem struct {
@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+type SearchOutboxStats struct {
+	Pending         int64     `json:"pending"`
+	Dead            int64     `json:"dead"`
+	OldestPendingAt time.Time `json:"oldestPendingAt"`
+	// LagSeconds is the age of the oldest event not yet projected into the search index
+	LagSeconds float64 `json:"lagSeconds"`
+}
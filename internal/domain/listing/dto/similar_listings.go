@@ -0,0 +1,27 @@
+package dto
+
+import "github.com/google/uuid"
+
+// SimilarListingsQuery describes the listing the results should resemble.
+// Every criterion adds to the similarity score of a listing, none of them is required to match.
+type SimilarListingsQuery struct {
+	// the listing the similar listings are looked up for, it is left out of the results
+	ExcludeID            uuid.UUID
+	PTypes               []string
+	PFeatures            []int64
+	PMinArea             *float32
+	PMaxArea             *float32
+	PCity                []string
+	PDistrict            []string
+	PWard                []string
+	POrientation         []string
+	UNumberOfLivingRooms *int32
+	UNumberOfBedrooms    *int32
+	UNumberOfBathrooms   *int32
+	UNumberOfToilets     *int32
+	UNumberOfKitchens    *int32
+	UNumberOfBalconies   *int32
+	UAmenities           []int64
+	LMinPrice            *float32
+	LMaxPrice            *float32
+}
@@ -0,0 +1,22 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// CreateListingTranslation is the title and description of a listing in a language other than Vietnamese
+type CreateListingTranslation struct {
+	Language    string `json:"language" validate:"required,oneof=en zh ko ja"`
+	Title       string `json:"title" validate:"required"`
+	Description string `json:"description" validate:"required"`
+}
+
+func (c *CreateListingTranslation) ToUpsertListingTranslationDB(listingId uuid.UUID) database.UpsertListingTranslationParams {
+	return database.UpsertListingTranslationParams{
+		ListingID:   listingId,
+		Language:    c.Language,
+		Title:       c.Title,
+		Description: c.Description,
+	}
+}
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/user2410/rrms-backend/internal/infrastructure/database"
 	"github.com/user2410/rrms-backend/internal/utils/types"
@@ -14,20 +16,27 @@ type UpdateListing struct {
 	LeaseTerm         *int32                `json:"leaseTerm" validate:"omitempty"`
 	PetsAllowed       *bool                 `json:"petsAllowed" validate:"omitempty"`
 	NumberOfResidents *int32                `json:"numberOfResidents" validate:"omitempty"`
+	PostAt            *time.Time            `json:"postAt" validate:"omitempty,gt"`
+	AutoRenew         *bool                 `json:"autoRenew"`
 	Policies          []CreateListingPolicy `json:"policies" validate:"omitempty,dive"`
 	Units             []CreateListingUnit   `json:"units" validate:"omitempty,dive"`
 	Tags              []string              `json:"tags" validate:"omitempty,dive"`
+	// number of days a pending application stays valid without any update
+	ApplicationValidityDays *int32 `json:"applicationValidityDays" validate:"omitempty,gte=1,lte=365"`
 }
 
 func (u *UpdateListing) ToUpdateListingDB(id uuid.UUID) *database.UpdateListingParams {
 	return &database.UpdateListingParams{
-		Title:             types.StrN(u.Title),
-		Description:       types.StrN(u.Description),
-		Price:             types.Float32N(u.Price),
-		SecurityDeposit:   types.Float32N(u.SecurityDeposit),
-		LeaseTerm:         types.Int32N(u.LeaseTerm),
-		PetsAllowed:       types.BoolN(u.PetsAllowed),
-		NumberOfResidents: types.Int32N(u.NumberOfResidents),
-		ID:                id,
+		Title:                   types.StrN(u.Title),
+		Description:             types.StrN(u.Description),
+		Price:                   types.Float32N(u.Price),
+		SecurityDeposit:         types.Float32N(u.SecurityDeposit),
+		LeaseTerm:               types.Int32N(u.LeaseTerm),
+		PetsAllowed:             types.BoolN(u.PetsAllowed),
+		NumberOfResidents:       types.Int32N(u.NumberOfResidents),
+		PostAt:                  types.TimestamptzN(u.PostAt),
+		AutoRenew:               types.BoolN(u.AutoRenew),
+		ApplicationValidityDays: types.Int32N(u.ApplicationValidityDays),
+		ID:                      id,
 	}
 }
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+type CreateListingViewingSlots struct {
+	ListingID uuid.UUID `json:"listingId"`
+	ManagerID uuid.UUID `json:"managerId"`
+	StartAt   time.Time `json:"startAt" validate:"required,gt"`
+	EndAt     time.Time `json:"endAt" validate:"required,gtfield=StartAt"`
+	// number of tenants the slot welcomes, more than one for an open house
+	Capacity  int32 `json:"capacity" validate:"required,gte=1,lte=100"`
+	OpenHouse bool  `json:"openHouse"`
+	// the slot is repeated on the same day and time of this many following weeks
+	RepeatWeeks int32   `json:"repeatWeeks" validate:"omitempty,gte=0,lte=12"`
+	Note        *string `json:"note" validate:"omitempty"`
+}
+
+type BookListingViewing struct {
+	SlotID   int64     `json:"slotId"`
+	TenantID uuid.UUID `json:"tenantId"`
+	Note     *string   `json:"note" validate:"omitempty,max=500"`
+}
+
+type UpdateListingViewingAttendance struct {
+	Status database.LISTINGVIEWINGSTATUS `json:"status" validate:"required,oneof=ATTENDED NO_SHOW"`
+}
+
+type LISTINGVIEWINGEVENT string
+
+const (
+	LISTINGVIEWINGEVENT_BOOKED   LISTINGVIEWINGEVENT = "BOOKED"
+	LISTINGVIEWINGEVENT_CANCELED LISTINGVIEWINGEVENT = "CANCELED"
+	LISTINGVIEWINGEVENT_REMINDER LISTINGVIEWINGEVENT = "REMINDER"
+)
+
+type NotifyListingViewing struct {
+	ViewingID int64               `json:"viewingId"`
+	Event     LISTINGVIEWINGEVENT `json:"event"`
+}
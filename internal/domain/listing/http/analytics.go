@@ -0,0 +1,61 @@
+package http
+
+import (
+	"errors"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	auth_http "github.com/user2410/rrms-backend/internal/domain/auth/http"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/utils"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/token"
+)
+
+// the events recorded in the background at any time, the events beyond are dropped
+const LISTINGANALYTICS_MAXINFLIGHT = 64
+
+// recordListingEvent counts the event in the background, requests of bots are ignored.
+// Analytics never fail nor slow down the request: the event is dropped when too many are being recorded.
+func (a *adapter) recordListingEvent(ctx *fiber.Ctx, event dto.LISTINGEVENT, lids ...uuid.UUID) {
+	userAgent := ctx.Get(fiber.HeaderUserAgent)
+	if len(lids) == 0 || utils.IsBot(userAgent) {
+		return
+	}
+	var userId uuid.UUID
+	if tkPayload, ok := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload); ok {
+		userId = tkPayload.UserID
+	}
+	session := utils.GetAnalyticsSession(userId, ctx.IP(), userAgent)
+
+	select {
+	case a.analyticsSem <- struct{}{}:
+	default:
+		log.Println("dropped listing event", event, ": too many in flight")
+		return
+	}
+	go func() {
+		defer func() { <-a.analyticsSem }()
+		if err := a.lService.RecordListingEvent(event, lids, session); err != nil {
+			log.Println("failed to record listing event", event, ":", err)
+		}
+	}()
+}
+
+func (a *adapter) getListingContact() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		lid := ctx.Locals(ListingIDLocalKey).(uuid.UUID)
+
+		res, err := a.lService.GetListingContact(lid)
+		if err != nil {
+			if errors.Is(err, database.ErrRecordNotFound) {
+				return ctx.SendStatus(fiber.StatusNotFound)
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+		a.recordListingEvent(ctx, dto.LISTINGEVENT_CONTACT, lid)
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
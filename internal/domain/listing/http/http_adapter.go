@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
 	auth_http "github.com/user2410/rrms-backend/internal/domain/auth/http"
+	auth_service "github.com/user2410/rrms-backend/internal/domain/auth/service"
 	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
 	listing_service "github.com/user2410/rrms-backend/internal/domain/listing/service"
 	"github.com/user2410/rrms-backend/internal/domain/listing/utils"
@@ -21,13 +22,15 @@ import (
 )
 
 type Adapter interface {
-	RegisterServer(route *fiber.Router, tokenMaker token.Maker)
+	RegisterServer(route *fiber.Router, tokenMaker token.Maker, authService auth_service.Service)
 }
 
 type adapter struct {
 	pService property_service.Service
 	uService unit_service.Service
 	lService listing_service.Service
+
+	analyticsSem chan struct{}
 }
 
 func NewAdapter(lService listing_service.Service, pService property_service.Service, uService unit_service.Service) Adapter {
@@ -35,17 +38,24 @@ func NewAdapter(lService listing_service.Service, pService property_service.Serv
 		lService: lService,
 		pService: pService,
 		uService: uService,
+
+		analyticsSem: make(chan struct{}, LISTINGANALYTICS_MAXINFLIGHT),
 	}
 }
 
-func (a *adapter) RegisterServer(router *fiber.Router, tokenMaker token.Maker) {
+func (a *adapter) RegisterServer(router *fiber.Router, tokenMaker token.Maker, authService auth_service.Service) {
 	listingRoute := (*router).Group("/listings")
 
 	listingRoute.Get("/search",
 		auth_http.GetAuthorizationMiddleware(tokenMaker),
 		a.searchListings(),
 	)
+	listingRoute.Get("/search/geo-grid", a.getListingGeoGrid())
+	listingRoute.Get("/search/autocomplete", a.autocompleteListings())
+	listingRoute.Get("/search/outbox", auth_http.AuthorizedMiddleware(tokenMaker), auth_http.AdminOnlyRoutes(authService), a.getSearchOutboxStats())
 	listingRoute.Get("/ids", auth_http.GetAuthorizationMiddleware(tokenMaker), a.getListingsByIds())
+	listingRoute.Get("/compare", auth_http.GetAuthorizationMiddleware(tokenMaker), a.compareListings())
+	listingRoute.Get("/price-estimate", a.getRentEstimate())
 	listingRoute.Get("/listing/:id/application-link", a.verifyApplicationLink())
 	listingRoute.Get("/listing/:id",
 		auth_http.GetAuthorizationMiddleware(tokenMaker),
@@ -53,11 +63,61 @@ func (a *adapter) RegisterServer(router *fiber.Router, tokenMaker token.Maker) {
 		CheckListingVisibility(a.lService),
 		a.getListingById(),
 	)
+	listingRoute.Post("/listing/:id/contact",
+		auth_http.GetAuthorizationMiddleware(tokenMaker),
+		GetListingId(),
+		CheckListingVisibility(a.lService),
+		a.getListingContact(),
+	)
+	listingRoute.Get("/listing/:id/price-history",
+		auth_http.GetAuthorizationMiddleware(tokenMaker),
+		GetListingId(),
+		CheckListingVisibility(a.lService),
+		a.getListingPriceHistory(),
+	)
+	listingRoute.Get("/listing/:id/viewing-slots",
+		auth_http.GetAuthorizationMiddleware(tokenMaker),
+		GetListingId(),
+		CheckListingVisibility(a.lService),
+		a.getListingViewingSlots(),
+	)
+	listingRoute.Get("/listing/:id/required-documents",
+		auth_http.GetAuthorizationMiddleware(tokenMaker),
+		GetListingId(),
+		CheckListingVisibility(a.lService),
+		a.getListingRequiredDocuments(),
+	)
+	listingRoute.Get("/listing/:id/questions",
+		auth_http.GetAuthorizationMiddleware(tokenMaker),
+		GetListingId(),
+		CheckListingVisibility(a.lService),
+		a.getListingQuestions(),
+	)
 
 	listingRoute.Use(auth_http.AuthorizedMiddleware(tokenMaker))
 
 	listingRoute.Post("/", a.createListing())
 	listingRoute.Get("/managed-listings", a.getManagedListings())
+	listingRoute.Get("/saved-searches", a.getSavedSearches())
+	listingRoute.Post("/saved-searches", a.createSavedSearch())
+	listingRoute.Patch("/saved-searches/:id", a.updateSavedSearch())
+	listingRoute.Delete("/saved-searches/:id", a.deleteSavedSearch())
+	listingRoute.Get("/favorites", a.getFavoriteListings())
+	listingRoute.Put("/favorites/:id", GetListingId(), a.saveFavoriteListing())
+	listingRoute.Delete("/favorites/:id", GetListingId(), a.deleteFavoriteListing())
+	listingRoute.Get("/favorite-folders", a.getFavoriteFolders())
+	listingRoute.Post("/favorite-folders", a.createFavoriteFolder())
+	listingRoute.Patch("/favorite-folders/:id", a.updateFavoriteFolder())
+	listingRoute.Delete("/favorite-folders/:id", a.deleteFavoriteFolder())
+	listingRoute.Get("/moderations", auth_http.AdminOnlyRoutes(authService), a.getListingModerations())
+	listingRoute.Get("/moderations/:id", auth_http.AdminOnlyRoutes(authService), a.getListingModeration())
+	listingRoute.Patch("/moderations/:id", auth_http.AdminOnlyRoutes(authService), a.reviewListingModeration())
+	listingRoute.Get("/duplicate-clusters", auth_http.AdminOnlyRoutes(authService), a.getListingDuplicateClusters())
+	listingRoute.Get("/duplicate-clusters/:id", auth_http.AdminOnlyRoutes(authService), a.getListingDuplicateCluster())
+	listingRoute.Post("/viewing-slots/:id/book", a.bookListingViewing())
+	listingRoute.Get("/viewings", a.getMyListingViewings())
+	listingRoute.Patch("/viewings/:id/cancel", a.cancelListingViewing())
+	listingRoute.Patch("/viewings/:id/attendance", a.updateListingViewingAttendance())
 
 	listingRoute.Group("/listing/:id").Use(GetListingId())
 	listingRoute.Post("/listing/:id/application-link", CheckListingManageability(a.lService), a.createApplicationLink())
@@ -65,6 +125,19 @@ func (a *adapter) RegisterServer(router *fiber.Router, tokenMaker token.Maker) {
 	listingRoute.Get("/listing/:id/payments", CheckListingManageability(a.lService), a.getListingPayments())
 	listingRoute.Patch("/listing/:id/upgrade", CheckListingManageability(a.lService), a.upgradeListing())
 	listingRoute.Patch("/listing/:id/extend", CheckListingManageability(a.lService), a.extendListing())
+	listingRoute.Get("/listing/:id/moderation", CheckListingManageability(a.lService), a.getLatestListingModeration())
+	listingRoute.Post("/listing/:id/moderation", CheckListingManageability(a.lService), a.resubmitListingForModeration())
+	listingRoute.Get("/listing/:id/duplicates", CheckListingManageability(a.lService), a.getListingDuplicates())
+	listingRoute.Post("/listing/:id/viewing-slots", CheckListingManageability(a.lService), a.createListingViewingSlots())
+	listingRoute.Delete("/listing/:id/viewing-slots/:slotId", CheckListingManageability(a.lService), a.deleteListingViewingSlot())
+	listingRoute.Get("/listing/:id/viewings", CheckListingManageability(a.lService), a.getListingViewings())
+	listingRoute.Get("/listing/:id/translations", CheckListingManageability(a.lService), a.getListingTranslations())
+	listingRoute.Put("/listing/:id/translations/:language", CheckListingManageability(a.lService), a.upsertListingTranslation())
+	listingRoute.Delete("/listing/:id/translations/:language", CheckListingManageability(a.lService), a.deleteListingTranslation())
+	listingRoute.Put("/listing/:id/required-documents", CheckListingManageability(a.lService), a.updateListingRequiredDocuments())
+	listingRoute.Post("/listing/:id/questions", CheckListingManageability(a.lService), a.createListingQuestion())
+	listingRoute.Patch("/listing/:id/questions/:questionId", CheckListingManageability(a.lService), a.updateListingQuestion())
+	listingRoute.Delete("/listing/:id/questions/:questionId", CheckListingManageability(a.lService), a.deleteListingQuestion())
 	listingRoute.Delete("/listing/:id", CheckListingManageability(a.lService), a.deleteListing())
 }
 
@@ -133,6 +206,7 @@ func (a *adapter) searchListings() fiber.Handler {
 			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
 		}
 		// log.Println(payload)
+		payload.Language = utils.ParseAcceptLanguage(ctx.Get(fiber.HeaderAcceptLanguage))
 
 		// make sure properties are public and listings not expired
 
@@ -147,11 +221,20 @@ func (a *adapter) searchListings() fiber.Handler {
 			if err == database.ErrRecordNotFound {
 				return ctx.SendStatus(fiber.StatusNotFound)
 			}
+			if errors.Is(err, utils.ErrInvalidPolygon) {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+			}
 
 			ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
 			return nil
 		}
 
+		lids := make([]uuid.UUID, 0, len(res.Items))
+		for _, item := range res.Items {
+			lids = append(lids, item.LId)
+		}
+		a.recordListingEvent(ctx, dto.LISTINGEVENT_IMPRESSION, lids...)
+
 		return ctx.Status(fiber.StatusOK).JSON(res)
 	}
 }
@@ -193,7 +276,7 @@ func (a *adapter) getListingById() fiber.Handler {
 			ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
 			return nil
 		}
-		res, err := a.lService.GetListingByID(lid)
+		res, err := a.lService.GetListingByID(lid, utils.ParseAcceptLanguage(ctx.Get(fiber.HeaderAcceptLanguage)))
 		if err != nil {
 			if err == database.ErrRecordNotFound {
 				return ctx.SendStatus(fiber.StatusNotFound)
@@ -202,6 +285,63 @@ func (a *adapter) getListingById() fiber.Handler {
 			ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
 			return nil
 		}
+		// views of the listing creator are not counted
+		if tkPayload, ok := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload); !ok || tkPayload.UserID != res.CreatorID {
+			a.recordListingEvent(ctx, dto.LISTINGEVENT_VIEW, lid)
+		}
+		ctx.Set(fiber.HeaderContentLanguage, res.Language)
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func (a *adapter) getListingGeoGrid() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		payload := new(dto.ListingGeoGridQuery)
+		if err := payload.QueryParser(ctx); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, *payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		res, err := a.lService.GetListingGeoGrid(payload)
+		if err != nil {
+			if errors.Is(err, utils.ErrInvalidPolygon) {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func (a *adapter) autocompleteListings() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		payload := new(dto.ListingAutocompleteQuery)
+		if err := ctx.QueryParser(payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, *payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		res, err := a.lService.AutocompleteListings(payload)
+		if err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func (a *adapter) getSearchOutboxStats() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		res, err := a.lService.GetSearchOutboxStats()
+		if err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
 		return ctx.Status(fiber.StatusOK).JSON(res)
 	}
 }
@@ -223,7 +363,7 @@ func (a *adapter) getListingsByIds() fiber.Handler {
 		if ok {
 			tkPayload.UserID = uid
 		}
-		res, err := a.lService.GetListingsByIds(uid, query.IDs, query.Fields)
+		res, err := a.lService.GetListingsByIds(uid, query.IDs, query.Fields, utils.ParseAcceptLanguage(ctx.Get(fiber.HeaderAcceptLanguage)))
 		if err != nil {
 			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
 		}
@@ -246,6 +386,9 @@ func (a *adapter) updateListing() fiber.Handler {
 
 		err := a.lService.UpdateListing(lid, &payload)
 		if err != nil {
+			if errors.Is(err, listing_service.ErrListingAlreadyPosted) {
+				return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{"message": err.Error()})
+			}
 			if txErr, ok := err.(*database.TXError); ok {
 				if txErr.RollbackErr != nil || txErr.CommitErr != nil {
 					return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": txErr.RollbackErr.Error()})
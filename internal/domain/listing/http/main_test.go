@@ -7,8 +7,10 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
+	"github.com/robfig/cron/v3"
 	"github.com/stretchr/testify/require"
 	repos "github.com/user2410/rrms-backend/internal/domain/_repos"
+	auth_service "github.com/user2410/rrms-backend/internal/domain/auth/service"
 	listing_service "github.com/user2410/rrms-backend/internal/domain/listing/service"
 	property_service "github.com/user2410/rrms-backend/internal/domain/property/service"
 	unit_service "github.com/user2410/rrms-backend/internal/domain/unit/service"
@@ -35,7 +37,8 @@ func newTestServer(t *testing.T, ctrl *gomock.Controller) *server {
 
 	uService := unit_service.NewService(domainRepo, s3Client, "")
 	pService := property_service.NewService(domainRepo, s3Client, "", nil, nil, nil)
-	lService := listing_service.NewService(domainRepo, "", nil)
+	lService := listing_service.NewService(domainRepo, "", nil, nil, nil, nil, cron.New(), "", "")
+	authService := auth_service.NewService(domainRepo, tokenMaker, time.Hour, time.Hour)
 
 	// initialize http router
 	httpServer := http.NewServer(
@@ -48,7 +51,7 @@ func newTestServer(t *testing.T, ctrl *gomock.Controller) *server {
 			AllowHeaders: "Origin, Content-Type, Accept, Authorization",
 		},
 	)
-	NewAdapter(lService, pService, uService).RegisterServer(httpServer.GetApiRoute(), tokenMaker)
+	NewAdapter(lService, pService, uService).RegisterServer(httpServer.GetApiRoute(), tokenMaker, authService)
 
 	return &server{
 		tokenMaker: tokenMaker,
@@ -0,0 +1,40 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/utils/validation"
+)
+
+func (a *adapter) getRentEstimate() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		var query dto.GetRentEstimateQuery
+		if err := ctx.QueryParser(&query); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, query); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		res, err := a.lService.GetRentEstimate(&query)
+		if err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func (a *adapter) getListingPriceHistory() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		lid := ctx.Locals(ListingIDLocalKey).(uuid.UUID)
+
+		res, err := a.lService.GetListingPriceHistory(lid)
+		if err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
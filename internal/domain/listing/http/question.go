@@ -0,0 +1,98 @@
+package http
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/validation"
+)
+
+func (a *adapter) getListingQuestions() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		lid := ctx.Locals(ListingIDLocalKey).(uuid.UUID)
+
+		res, err := a.lService.GetListingQuestions(lid)
+		if err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func (a *adapter) createListingQuestion() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		lid := ctx.Locals(ListingIDLocalKey).(uuid.UUID)
+
+		var payload dto.CreateListingQuestion
+		if err := ctx.BodyParser(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+		if err := payload.Validate(); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		res, err := a.lService.CreateListingQuestion(lid, &payload)
+		if err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusCreated).JSON(res)
+	}
+}
+
+func (a *adapter) updateListingQuestion() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		lid := ctx.Locals(ListingIDLocalKey).(uuid.UUID)
+		qid, err := strconv.ParseInt(ctx.Params("questionId"), 10, 64)
+		if err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": "invalid question id"})
+		}
+
+		var payload dto.UpdateListingQuestion
+		if err := ctx.BodyParser(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		if err = a.lService.UpdateListingQuestion(lid, qid, &payload); err != nil {
+			if errors.Is(err, database.ErrRecordNotFound) {
+				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{"message": "question not found"})
+			}
+			if errors.Is(err, dto.ErrQuestionOptionsNotAllowed) {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
+
+func (a *adapter) deleteListingQuestion() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		lid := ctx.Locals(ListingIDLocalKey).(uuid.UUID)
+		qid, err := strconv.ParseInt(ctx.Params("questionId"), 10, 64)
+		if err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": "invalid question id"})
+		}
+
+		if err = a.lService.DeleteListingQuestion(lid, qid); err != nil {
+			if errors.Is(err, database.ErrRecordNotFound) {
+				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{"message": "question not found"})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
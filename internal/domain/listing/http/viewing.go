@@ -0,0 +1,216 @@
+package http
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	auth_http "github.com/user2410/rrms-backend/internal/domain/auth/http"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	listing_service "github.com/user2410/rrms-backend/internal/domain/listing/service"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/token"
+	"github.com/user2410/rrms-backend/internal/utils/validation"
+)
+
+func (a *adapter) createListingViewingSlots() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		lid := ctx.Locals(ListingIDLocalKey).(uuid.UUID)
+		tkPayload := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload)
+
+		var payload dto.CreateListingViewingSlots
+		if err := ctx.BodyParser(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		payload.ListingID = lid
+		payload.ManagerID = tkPayload.UserID
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		res, err := a.lService.CreateListingViewingSlots(&payload)
+		if err != nil {
+			if errors.Is(err, listing_service.ErrViewingSlotTooLong) {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+			}
+			if errors.Is(err, listing_service.ErrOverlappingViewingSlot) {
+				return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{"message": err.Error()})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusCreated).JSON(res)
+	}
+}
+
+func (a *adapter) getListingViewingSlots() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		lid := ctx.Locals(ListingIDLocalKey).(uuid.UUID)
+
+		res, err := a.lService.GetListingViewingSlots(lid)
+		if err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func (a *adapter) deleteListingViewingSlot() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		lid := ctx.Locals(ListingIDLocalKey).(uuid.UUID)
+		slotId, err := strconv.ParseInt(ctx.Params("slotId"), 10, 64)
+		if err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": "invalid viewing slot id"})
+		}
+
+		slot, err := a.lService.GetListingViewingSlot(slotId)
+		if err != nil {
+			if errors.Is(err, database.ErrRecordNotFound) {
+				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{"message": "viewing slot not found"})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+		if slot.ListingID != lid {
+			return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{"message": "viewing slot not found"})
+		}
+
+		if err = a.lService.DeleteListingViewingSlot(slotId); err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+func (a *adapter) getListingViewings() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		lid := ctx.Locals(ListingIDLocalKey).(uuid.UUID)
+
+		res, err := a.lService.GetListingViewingsOfListing(lid)
+		if err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func (a *adapter) bookListingViewing() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		slotId, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+		if err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": "invalid viewing slot id"})
+		}
+		tkPayload := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload)
+
+		var payload dto.BookListingViewing
+		if err := ctx.BodyParser(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		payload.SlotID = slotId
+		payload.TenantID = tkPayload.UserID
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		res, err := a.lService.BookListingViewing(&payload)
+		if err != nil {
+			if errors.Is(err, database.ErrRecordNotFound) {
+				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{"message": "viewing slot not found"})
+			}
+			if errors.Is(err, listing_service.ErrViewingSlotFull) ||
+				errors.Is(err, listing_service.ErrViewingSlotStarted) ||
+				errors.Is(err, listing_service.ErrListingNotActive) {
+				return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{"message": err.Error()})
+			}
+			if database.ErrorCode(err) == database.UniqueViolation {
+				return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{"message": "viewing slot already booked"})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusCreated).JSON(res)
+	}
+}
+
+func (a *adapter) getMyListingViewings() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		tkPayload := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload)
+
+		res, err := a.lService.GetListingViewingsOfTenant(tkPayload.UserID)
+		if err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+// getViewing returns the viewing of the id param if the user is its tenant or, when allowed, its manager
+func (a *adapter) getViewing(ctx *fiber.Ctx, allowTenant bool) (*model.ListingViewingModel, error) {
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return nil, ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": "invalid viewing id"})
+	}
+	tkPayload := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload)
+
+	v, err := a.lService.GetListingViewing(id)
+	if err != nil {
+		if errors.Is(err, database.ErrRecordNotFound) {
+			return nil, ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{"message": "viewing not found"})
+		}
+		return nil, ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+	}
+	isManager := v.Slot != nil && v.Slot.ManagerID == tkPayload.UserID
+	if !isManager && !(allowTenant && v.TenantID == tkPayload.UserID) {
+		return nil, ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{"message": "operation not permitted on this viewing"})
+	}
+	return &v, nil
+}
+
+func (a *adapter) cancelListingViewing() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		v, err := a.getViewing(ctx, true)
+		if v == nil {
+			return err
+		}
+
+		if err = a.lService.CancelListingViewing(v.ID); err != nil {
+			if errors.Is(err, listing_service.ErrViewingSlotStarted) || errors.Is(err, listing_service.ErrViewingNotBooked) {
+				return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{"message": err.Error()})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
+
+func (a *adapter) updateListingViewingAttendance() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		v, err := a.getViewing(ctx, false)
+		if v == nil {
+			return err
+		}
+
+		var payload dto.UpdateListingViewingAttendance
+		if err := ctx.BodyParser(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		if err = a.lService.UpdateListingViewingAttendance(v.ID, &payload); err != nil {
+			if errors.Is(err, listing_service.ErrViewingSlotNotStarted) || errors.Is(err, listing_service.ErrViewingNotBooked) {
+				return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{"message": err.Error()})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
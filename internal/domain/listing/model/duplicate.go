@@ -0,0 +1,82 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// The criteria on which two listings are similar
+const (
+	DUPLICATEREASON_LOCATION    = "LOCATION"
+	DUPLICATEREASON_UNIT        = "UNIT"
+	DUPLICATEREASON_PRICE       = "PRICE"
+	DUPLICATEREASON_DESCRIPTION = "DESCRIPTION"
+	DUPLICATEREASON_IMAGES      = "IMAGES"
+)
+
+// ListingDuplicateFeatures are the attributes of a listing compared to find its duplicates
+type ListingDuplicateFeatures struct {
+	ID           uuid.UUID
+	CreatorID    uuid.UUID
+	PropertyID   uuid.UUID
+	Description  string
+	Price        float32
+	FullAddress  string
+	City         string
+	District     string
+	Lat          *float64
+	Lng          *float64
+	UnitIDs      []uuid.UUID
+	UnitAreas    []float32
+	UnitBedrooms []int32
+	// hashes of the images of the property
+	ImageHashes []uint64
+}
+
+func ToListingDuplicateFeatures(row *database.GetListingsDuplicateFeaturesRow) ListingDuplicateFeatures {
+	return ListingDuplicateFeatures{
+		ID:           row.ID,
+		CreatorID:    row.CreatorID,
+		PropertyID:   row.PropertyID,
+		Description:  row.Description,
+		Price:        row.Price,
+		FullAddress:  row.FullAddress,
+		City:         row.City,
+		District:     row.District,
+		Lat:          types.PNFloat64(row.Lat),
+		Lng:          types.PNFloat64(row.Lng),
+		UnitIDs:      row.UnitIds,
+		UnitAreas:    row.UnitAreas,
+		UnitBedrooms: row.UnitBedrooms,
+	}
+}
+
+type ListingSimilarityModel struct {
+	ListingID        uuid.UUID `json:"listingId"`
+	SimilarListingID uuid.UUID `json:"similarListingId"`
+	Score            float32   `json:"score"`
+	Reasons          []string  `json:"reasons"`
+	CreatedAt        time.Time `json:"createdAt"`
+}
+
+func ToListingSimilarityModel(s *database.ListingSimilarity) ListingSimilarityModel {
+	return ListingSimilarityModel{
+		ListingID:        s.ListingID,
+		SimilarListingID: s.SimilarListingID,
+		Score:            s.Score,
+		Reasons:          s.Reasons,
+		CreatedAt:        s.CreatedAt,
+	}
+}
+
+// ListingDuplicateClusterModel is a group of listings suspected to advertise the same unit
+type ListingDuplicateClusterModel struct {
+	ID           int64                    `json:"id"`
+	CreatedAt    time.Time                `json:"createdAt"`
+	ListingIDs   []uuid.UUID              `json:"listingIds"`
+	Listings     []ListingModel           `json:"listings"`
+	Similarities []ListingSimilarityModel `json:"similarities"`
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type FavoriteFolderModel struct {
+	ID        int64     `json:"id"`
+	UserID    uuid.UUID `json:"userId"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func ToFavoriteFolderModel(f *database.FavoriteFolder) FavoriteFolderModel {
+	return FavoriteFolderModel{
+		ID:        f.ID,
+		UserID:    f.UserID,
+		Name:      f.Name,
+		CreatedAt: f.CreatedAt,
+	}
+}
+
+type FavoriteListingModel struct {
+	UserID    uuid.UUID `json:"userId"`
+	ListingID uuid.UUID `json:"listingId"`
+	FolderID  *int64    `json:"folderId"`
+	Note      *string   `json:"note"`
+	// price of the listing when it was added to the favorites
+	SavedPrice float32   `json:"savedPrice"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+func ToFavoriteListingModel(f *database.FavoriteListing) FavoriteListingModel {
+	return FavoriteListingModel{
+		UserID:     f.UserID,
+		ListingID:  f.ListingID,
+		FolderID:   types.PNInt64(f.FolderID),
+		Note:       types.PNStr(f.Note),
+		SavedPrice: f.SavedPrice,
+		CreatedAt:  f.CreatedAt,
+		UpdatedAt:  f.UpdatedAt,
+	}
+}
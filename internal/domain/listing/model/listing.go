@@ -65,36 +65,47 @@ type ListingModel struct {
 	CreatedAt time.Time            `json:"createdAt"`
 	UpdatedAt time.Time            `json:"updatedAt"`
 	ExpiredAt time.Time            `json:"expiredAt"`
+	PostAt    *time.Time           `json:"postAt"`
+	AutoRenew bool                 `json:"autoRenew"`
 	Policies  []ListingPolicyModel `json:"policies"`
 	Units     []ListingUnitModel   `json:"units"`
 	Tags      []ListingTagModel    `json:"tags"`
+	// language of the title and description, set when the listing is read in the language of a user
+	Language string `json:"language,omitempty"`
+	// number of days a pending application stays valid without any update
+	ApplicationValidityDays int32 `json:"applicationValidityDays"`
 }
 
 func ToListingModel(ldb *database.Listing) *ListingModel {
 	lm := &ListingModel{
-		ID:                ldb.ID,
-		CreatorID:         ldb.CreatorID,
-		PropertyID:        ldb.PropertyID,
-		Title:             ldb.Title,
-		Description:       ldb.Description,
-		FullName:          ldb.FullName,
-		Email:             ldb.Email,
-		Phone:             ldb.Phone,
-		ContactType:       ldb.ContactType,
-		Price:             ldb.Price,
-		PriceNegotiable:   ldb.PriceNegotiable,
-		Priority:          ldb.Priority,
-		Active:            ldb.Active,
-		CreatedAt:         ldb.CreatedAt,
-		UpdatedAt:         ldb.UpdatedAt,
-		ExpiredAt:         ldb.ExpiredAt,
-		SecurityDeposit:   types.PNFloat32(ldb.SecurityDeposit),
-		LeaseTerm:         types.PNInt32(ldb.LeaseTerm),
-		PetsAllowed:       types.PNBool(ldb.PetsAllowed),
-		NumberOfResidents: types.PNInt32(ldb.NumberOfResidents),
-		Policies:          make([]ListingPolicyModel, 0),
-		Units:             make([]ListingUnitModel, 0),
-		Tags:              make([]ListingTagModel, 0),
+		ID:                      ldb.ID,
+		CreatorID:               ldb.CreatorID,
+		PropertyID:              ldb.PropertyID,
+		Title:                   ldb.Title,
+		Description:             ldb.Description,
+		FullName:                ldb.FullName,
+		Email:                   ldb.Email,
+		Phone:                   ldb.Phone,
+		ContactType:             ldb.ContactType,
+		Price:                   ldb.Price,
+		PriceNegotiable:         ldb.PriceNegotiable,
+		Priority:                ldb.Priority,
+		Active:                  ldb.Active,
+		CreatedAt:               ldb.CreatedAt,
+		UpdatedAt:               ldb.UpdatedAt,
+		ExpiredAt:               ldb.ExpiredAt,
+		AutoRenew:               ldb.AutoRenew,
+		ApplicationValidityDays: ldb.ApplicationValidityDays,
+		SecurityDeposit:         types.PNFloat32(ldb.SecurityDeposit),
+		LeaseTerm:               types.PNInt32(ldb.LeaseTerm),
+		PetsAllowed:             types.PNBool(ldb.PetsAllowed),
+		NumberOfResidents:       types.PNInt32(ldb.NumberOfResidents),
+		Policies:                make([]ListingPolicyModel, 0),
+		Units:                   make([]ListingUnitModel, 0),
+		Tags:                    make([]ListingTagModel, 0),
+	}
+	if ldb.PostAt.Valid {
+		lm.PostAt = types.Ptr(ldb.PostAt.Time)
 	}
 
 	return lm
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type MODERATIONFLAG string
+
+const (
+	MODERATIONFLAG_BANNEDWORD  MODERATIONFLAG = "BANNED_WORD"
+	MODERATIONFLAG_PHONENUMBER MODERATIONFLAG = "PHONE_NUMBER"
+	// the price per m² is far from the median of the district, raised only when no unit could be estimated
+	MODERATIONFLAG_PRICEOUTLIER MODERATIONFLAG = "PRICE_OUTLIER"
+	// the price of a unit is far outside the rents of comparable units
+	MODERATIONFLAG_UNITPRICEOUTLIER MODERATIONFLAG = "UNIT_PRICE_OUTLIER"
+	MODERATIONFLAG_DUPLICATEIMAGE   MODERATIONFLAG = "DUPLICATE_IMAGE"
+	MODERATIONFLAG_DUPLICATELISTING MODERATIONFLAG = "DUPLICATE_LISTING"
+)
+
+// ModerationFlag is a finding of the automatic content policy checks
+type ModerationFlag struct {
+	Type MODERATIONFLAG `json:"type"`
+	// what was found, like the banned word or the url of the image
+	Evidence []string `json:"evidence"`
+	Message  string   `json:"message"`
+}
+
+type ListingModerationModel struct {
+	ID        int64                            `json:"id"`
+	ListingID uuid.UUID                        `json:"listingId"`
+	Status    database.LISTINGMODERATIONSTATUS `json:"status"`
+	Flags     []ModerationFlag                 `json:"flags"`
+	// nil while the automatic checks are pending
+	CheckedAt  *time.Time `json:"checkedAt"`
+	Reason     *string    `json:"reason"`
+	ReviewerID *uuid.UUID `json:"reviewerId"`
+	ReviewedAt *time.Time `json:"reviewedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+func ToListingModerationModel(m *database.ListingModeration) (ListingModerationModel, error) {
+	res := ListingModerationModel{
+		ID:        m.ID,
+		ListingID: m.ListingID,
+		Status:    m.Status,
+		Flags:     []ModerationFlag{},
+		Reason:    types.PNStr(m.Reason),
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	if err := json.Unmarshal(m.Flags, &res.Flags); err != nil {
+		return ListingModerationModel{}, err
+	}
+	if m.CheckedAt.Valid {
+		res.CheckedAt = types.Ptr(m.CheckedAt.Time)
+	}
+	if m.ReviewerID != uuid.Nil {
+		res.ReviewerID = types.Ptr(m.ReviewerID)
+	}
+	if m.ReviewedAt.Valid {
+		res.ReviewedAt = types.Ptr(m.ReviewedAt.Time)
+	}
+	return res, nil
+}
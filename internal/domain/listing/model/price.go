@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// ListingPriceHistoryModel is a change of the price of a listing, or of one of its units
+type ListingPriceHistoryModel struct {
+	ID        int64     `json:"id"`
+	ListingID uuid.UUID `json:"listingId"`
+	// nil for the price of the listing
+	UnitID    *uuid.UUID `json:"unitId"`
+	OldPrice  float32    `json:"oldPrice"`
+	NewPrice  float32    `json:"newPrice"`
+	ChangedAt time.Time  `json:"changedAt"`
+}
+
+func ToListingPriceHistoryModel(h *database.ListingPriceHistory) ListingPriceHistoryModel {
+	m := ListingPriceHistoryModel{
+		ID:        h.ID,
+		ListingID: h.ListingID,
+		OldPrice:  h.OldPrice,
+		NewPrice:  h.NewPrice,
+		ChangedAt: h.ChangedAt,
+	}
+	if h.UnitID.Valid {
+		m.UnitID = types.Ptr(uuid.UUID(h.UnitID.Bytes))
+	}
+	return m
+}
+
+// RentEstimateModel sums up the monthly prices of the units comparable to a unit.
+// The suggested price range is [P25, P75].
+type RentEstimateModel struct {
+	// number of comparable active listing units and recent rentals
+	Samples int64 `json:"samples"`
+	// whether the comparables are in the same ward, or only in the same district
+	SameWard bool    `json:"sameWard"`
+	P10      float64 `json:"p10"`
+	P25      float64 `json:"p25"`
+	Median   float64 `json:"median"`
+	P75      float64 `json:"p75"`
+	P90      float64 `json:"p90"`
+}
+
+// ListingUnitPriceEstimate compares the price of a unit of a listing with the estimate of its rent
+type ListingUnitPriceEstimate struct {
+	UnitID   uuid.UUID         `json:"unitId"`
+	Price    int64             `json:"price"`
+	Estimate RentEstimateModel `json:"estimate"`
+	// the price is far outside the suggested range
+	OutOfRange bool `json:"outOfRange"`
+}
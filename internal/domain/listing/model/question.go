@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// ListingQuestionModel is a question the applicants to a listing answer in addition to the application form
+type ListingQuestionModel struct {
+	ID        int64                            `json:"id"`
+	ListingID uuid.UUID                        `json:"listingId"`
+	Question  string                           `json:"question"`
+	Type      database.APPLICATIONQUESTIONTYPE `json:"type"`
+	Required  bool                             `json:"required"`
+	// choices of SINGLE_CHOICE and MULTI_CHOICE questions
+	Options   []string  `json:"options"`
+	Position  int32     `json:"position"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func ToListingQuestionModel(q *database.ListingQuestion) ListingQuestionModel {
+	options := q.Options
+	if options == nil {
+		options = []string{}
+	}
+	return ListingQuestionModel{
+		ID:        q.ID,
+		ListingID: q.ListingID,
+		Question:  q.Question,
+		Type:      q.Type,
+		Required:  q.Required,
+		Options:   options,
+		Position:  q.Position,
+		CreatedAt: q.CreatedAt,
+		UpdatedAt: q.UpdatedAt,
+	}
+}
+
+// IsChoice tells whether the answers to the question are picked among its options
+func (q *ListingQuestionModel) IsChoice() bool {
+	return q.Type == database.APPLICATIONQUESTIONTYPESINGLECHOICE || q.Type == database.APPLICATIONQUESTIONTYPEMULTICHOICE
+}
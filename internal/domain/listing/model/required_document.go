@@ -0,0 +1,25 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// ListingRequiredDocumentModel is a document the applicants to a listing are asked to upload
+type ListingRequiredDocumentModel struct {
+	ListingID uuid.UUID                        `json:"listingId"`
+	Type      database.APPLICATIONDOCUMENTTYPE `json:"type"`
+	// applications cannot be approved until the document is verified, unless a manager overrides
+	Mandatory bool    `json:"mandatory"`
+	Note      *string `json:"note"`
+}
+
+func ToListingRequiredDocumentModel(d *database.ListingRequiredDocument) ListingRequiredDocumentModel {
+	return ListingRequiredDocumentModel{
+		ListingID: d.ListingID,
+		Type:      d.Type,
+		Mandatory: d.Mandatory,
+		Note:      types.PNStr(d.Note),
+	}
+}
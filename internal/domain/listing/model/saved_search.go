@@ -0,0 +1,42 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type SavedSearchModel struct {
+	ID     int64     `json:"id"`
+	UserID uuid.UUID `json:"userId"`
+	Name   string    `json:"name"`
+	// the saved SearchListingCombinationQuery
+	Query         json.RawMessage               `json:"query"`
+	Frequency     database.SAVEDSEARCHFREQUENCY `json:"frequency"`
+	Active        bool                          `json:"active"`
+	LastCheckedAt time.Time                     `json:"lastCheckedAt"`
+	LastSentAt    *time.Time                    `json:"lastSentAt"`
+	CreatedAt     time.Time                     `json:"createdAt"`
+	UpdatedAt     time.Time                     `json:"updatedAt"`
+}
+
+func ToSavedSearchModel(ss *database.SavedSearch) SavedSearchModel {
+	m := SavedSearchModel{
+		ID:            ss.ID,
+		UserID:        ss.UserID,
+		Name:          ss.Name,
+		Query:         ss.Query,
+		Frequency:     ss.Frequency,
+		Active:        ss.Active,
+		LastCheckedAt: ss.LastCheckedAt,
+		CreatedAt:     ss.CreatedAt,
+		UpdatedAt:     ss.UpdatedAt,
+	}
+	if ss.LastSentAt.Valid {
+		m.LastSentAt = types.Ptr(ss.LastSentAt.Time)
+	}
+	return m
+}
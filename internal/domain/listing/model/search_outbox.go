@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type SearchOutboxEventModel struct {
+	ID        int64                       `json:"id"`
+	ListingID uuid.UUID                   `json:"listingId"`
+	Source    database.SEARCHOUTBOXSOURCE `json:"source"`
+	Attempts  int32                       `json:"attempts"`
+	LastError *string                     `json:"lastError"`
+	CreatedAt time.Time                   `json:"createdAt"`
+}
+
+func ToSearchOutboxEventModel(e *database.SearchOutbox) SearchOutboxEventModel {
+	return SearchOutboxEventModel{
+		ID:        e.ID,
+		ListingID: e.ListingID,
+		Source:    e.Source,
+		Attempts:  e.Attempts,
+		LastError: types.PNStr(e.LastError),
+		CreatedAt: e.CreatedAt,
+	}
+}
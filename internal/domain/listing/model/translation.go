@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+type ListingTranslationModel struct {
+	ListingID   uuid.UUID `json:"listingId"`
+	Language    string    `json:"language"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+func ToListingTranslationModel(t *database.ListingTranslation) ListingTranslationModel {
+	return ListingTranslationModel(*t)
+}
+
+// ListingTranslationsModel are the translations of a listing and the supported languages it is not translated into
+type ListingTranslationsModel struct {
+	Translations     []ListingTranslationModel `json:"translations"`
+	MissingLanguages []string                  `json:"missingLanguages"`
+}
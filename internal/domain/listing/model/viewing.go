@@ -0,0 +1,74 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type ListingViewingSlotModel struct {
+	ID        int64     `json:"id"`
+	ListingID uuid.UUID `json:"listingId"`
+	ManagerID uuid.UUID `json:"managerId"`
+	StartAt   time.Time `json:"startAt"`
+	EndAt     time.Time `json:"endAt"`
+	Capacity  int32     `json:"capacity"`
+	// number of bookings not canceled
+	Booked    int32 `json:"booked"`
+	OpenHouse bool  `json:"openHouse"`
+	// shared by the slots of a recurring open house
+	SeriesID   *uuid.UUID `json:"seriesId"`
+	Note       *string    `json:"note"`
+	ReminderID *int64     `json:"reminderId"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+func ToListingViewingSlotModel(s *database.ListingViewingSlot) ListingViewingSlotModel {
+	m := ListingViewingSlotModel{
+		ID:         s.ID,
+		ListingID:  s.ListingID,
+		ManagerID:  s.ManagerID,
+		StartAt:    s.StartAt,
+		EndAt:      s.EndAt,
+		Capacity:   s.Capacity,
+		Booked:     s.Booked,
+		OpenHouse:  s.OpenHouse,
+		Note:       types.PNStr(s.Note),
+		ReminderID: types.PNInt64(s.ReminderID),
+		CreatedAt:  s.CreatedAt,
+	}
+	if s.SeriesID.Valid {
+		m.SeriesID = types.Ptr(uuid.UUID(s.SeriesID.Bytes))
+	}
+	return m
+}
+
+type ListingViewingModel struct {
+	ID         int64                         `json:"id"`
+	SlotID     int64                         `json:"slotId"`
+	TenantID   uuid.UUID                     `json:"tenantId"`
+	Note       *string                       `json:"note"`
+	Status     database.LISTINGVIEWINGSTATUS `json:"status"`
+	RemindedAt *time.Time                    `json:"remindedAt"`
+	CreatedAt  time.Time                     `json:"createdAt"`
+	UpdatedAt  time.Time                     `json:"updatedAt"`
+	Slot       *ListingViewingSlotModel      `json:"slot,omitempty"`
+}
+
+func ToListingViewingModel(v *database.ListingViewing) ListingViewingModel {
+	m := ListingViewingModel{
+		ID:        v.ID,
+		SlotID:    v.SlotID,
+		TenantID:  v.TenantID,
+		Note:      types.PNStr(v.Note),
+		Status:    v.Status,
+		CreatedAt: v.CreatedAt,
+		UpdatedAt: v.UpdatedAt,
+	}
+	if v.RemindedAt.Valid {
+		m.RemindedAt = types.Ptr(v.RemindedAt.Time)
+	}
+	return m
+}
@@ -0,0 +1,122 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// the aggregates of a day are kept long enough to be flushed after the day is over
+const LISTINGANALYTICS_EXPIRATION = 3 * 24 * time.Hour
+
+func listingAnalyticsDay(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
+
+// the counters of a day, a hash field per listing and event
+func listingAnalyticsCountersKey(day string) string {
+	return fmt.Sprintf("listing_analytics:%s", day)
+}
+
+// the sessions an event of a listing was already counted for in a day
+func listingAnalyticsSessionsKey(day string, event dto.LISTINGEVENT, lid uuid.UUID) string {
+	return fmt.Sprintf("listing_analytics:%s:%s:%s", day, event, lid.String())
+}
+
+// recordListingEventScript adds the session to the sessions of each listing and counts the event for the listings it was new to.
+// KEYS: the counters key then a sessions key per listing, ARGV: the session, the expiration in seconds then a counter field per listing
+const recordListingEventScript = `
+for i = 2, #KEYS do
+	if redis.call('SADD', KEYS[i], ARGV[1]) == 1 then
+		redis.call('EXPIRE', KEYS[i], ARGV[2])
+		redis.call('HINCRBY', KEYS[1], ARGV[i + 1], 1)
+	end
+end
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+return 0
+`
+
+// RecordListingEvent counts the event once per session and day for each of the listings, in a single round trip
+func (r *repo) RecordListingEvent(ctx context.Context, event dto.LISTINGEVENT, lids []uuid.UUID, session string, at time.Time) error {
+	if len(lids) == 0 {
+		return nil
+	}
+	day := listingAnalyticsDay(at)
+	keys := make([]string, 0, len(lids)+1)
+	args := make([]interface{}, 0, len(lids)+2)
+	keys = append(keys, listingAnalyticsCountersKey(day))
+	args = append(args, session, int64(LISTINGANALYTICS_EXPIRATION/time.Second))
+	for _, lid := range lids {
+		keys = append(keys, listingAnalyticsSessionsKey(day, event, lid))
+		args = append(args, fmt.Sprintf("%s:%s", lid.String(), event))
+	}
+	return r.redisClient.Eval(ctx, recordListingEventScript, keys, args...).Err()
+}
+
+// GetCachedListingDailyStats returns the counters aggregated in Redis for the day
+func (r *repo) GetCachedListingDailyStats(ctx context.Context, date time.Time) ([]dto.ListingDailyStats, error) {
+	counters, err := r.redisClient.HGetAll(ctx, listingAnalyticsCountersKey(listingAnalyticsDay(date))).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := make(map[uuid.UUID]int)
+	var res []dto.ListingDailyStats
+	for field, value := range counters {
+		lidStr, event, ok := strings.Cut(field, ":")
+		if !ok {
+			continue
+		}
+		lid, err := uuid.Parse(lidStr)
+		if err != nil {
+			continue
+		}
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		i, ok := idx[lid]
+		if !ok {
+			i = len(res)
+			idx[lid] = i
+			res = append(res, dto.ListingDailyStats{ListingID: lid, Date: date})
+		}
+		res[i].Set(dto.LISTINGEVENT(event), count)
+	}
+	return res, nil
+}
+
+// SaveListingDailyStats upserts the counters of the day. Counters only grow during a day,
+// so flushing the same counters again, or older ones, leaves the saved stats unchanged.
+func (r *repo) SaveListingDailyStats(ctx context.Context, date time.Time, stats []dto.ListingDailyStats) error {
+	if len(stats) == 0 {
+		return nil
+	}
+	params := database.UpsertListingDailyStatsParams{
+		Date:             pgtype.Date{Time: date, Valid: true},
+		ListingIds:       make([]uuid.UUID, 0, len(stats)),
+		Impressions:      make([]int64, 0, len(stats)),
+		Views:            make([]int64, 0, len(stats)),
+		Contacts:         make([]int64, 0, len(stats)),
+		ApplicationLinks: make([]int64, 0, len(stats)),
+		Applications:     make([]int64, 0, len(stats)),
+		Viewings:         make([]int64, 0, len(stats)),
+	}
+	for _, s := range stats {
+		params.ListingIds = append(params.ListingIds, s.ListingID)
+		params.Impressions = append(params.Impressions, s.Impressions)
+		params.Views = append(params.Views, s.Views)
+		params.Contacts = append(params.Contacts, s.Contacts)
+		params.ApplicationLinks = append(params.ApplicationLinks, s.ApplicationLinks)
+		params.Applications = append(params.Applications, s.Applications)
+		params.Viewings = append(params.Viewings, s.Viewings)
+	}
+	return r.dao.UpsertListingDailyStats(ctx, params)
+}
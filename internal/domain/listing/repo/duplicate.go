@@ -0,0 +1,192 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// GetListingDuplicateCandidates returns the recent listings of the same property, around the property,
+// or in the same district at a close price, the most recent first
+func (r *repo) GetListingDuplicateCandidates(ctx context.Context, f *model.ListingDuplicateFeatures, priceTolerance float64, limit int32) ([]uuid.UUID, error) {
+	params := database.GetListingDuplicateCandidatesParams{
+		ListingID:  f.ID,
+		PropertyID: f.PropertyID,
+		City:       f.City,
+		District:   f.District,
+		MinPrice:   f.Price * float32(1-priceTolerance),
+		MaxPrice:   f.Price * float32(1+priceTolerance),
+		Limit:      limit,
+	}
+	if f.Lat != nil && f.Lng != nil {
+		params.Lat = pgtype.Float8{Float64: *f.Lat, Valid: true}
+		params.Lng = pgtype.Float8{Float64: *f.Lng, Valid: true}
+	}
+	return r.dao.GetListingDuplicateCandidates(ctx, params)
+}
+
+// GetListingsDuplicateFeatures returns the attributes of the listings compared to find duplicates,
+// with the hashes of the images of their properties already hashed
+func (r *repo) GetListingsDuplicateFeatures(ctx context.Context, ids []uuid.UUID) ([]model.ListingDuplicateFeatures, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	res, err := r.dao.GetListingsDuplicateFeatures(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.ListingDuplicateFeatures, 0, len(res))
+	propertyIds := make([]uuid.UUID, 0, len(res))
+	for i := range res {
+		items = append(items, model.ToListingDuplicateFeatures(&res[i]))
+		propertyIds = append(propertyIds, res[i].PropertyID)
+	}
+
+	hashes, err := r.dao.GetPropertiesMediaHashes(ctx, propertyIds)
+	if err != nil {
+		return nil, err
+	}
+	byProperty := make(map[uuid.UUID][]uint64)
+	for _, h := range hashes {
+		byProperty[h.PropertyID] = append(byProperty[h.PropertyID], uint64(h.Hash))
+	}
+	for i := range items {
+		items[i].ImageHashes = byProperty[items[i].PropertyID]
+	}
+	return items, nil
+}
+
+// SaveListingSimilarities replaces the similarities of the listing with the given ones
+func (r *repo) SaveListingSimilarities(ctx context.Context, listingId uuid.UUID, similarities []model.ListingSimilarityModel) error {
+	return r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		if err := tx.DeleteListingSimilarities(ctx, listingId); err != nil {
+			return err
+		}
+		for _, s := range similarities {
+			// each pair is stored once, in order
+			a, b := s.ListingID, s.SimilarListingID
+			if b.String() < a.String() {
+				a, b = b, a
+			}
+			if err := tx.UpsertListingSimilarity(ctx, database.UpsertListingSimilarityParams{
+				ListingID:        a,
+				SimilarListingID: b,
+				Score:            s.Score,
+				Reasons:          s.Reasons,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetListingSimilarities returns the similarities involving any of the listings
+func (r *repo) GetListingSimilarities(ctx context.Context, ids []uuid.UUID) ([]model.ListingSimilarityModel, error) {
+	res, err := r.dao.GetListingSimilarities(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.ListingSimilarityModel, 0, len(res))
+	for i := range res {
+		items = append(items, model.ToListingSimilarityModel(&res[i]))
+	}
+	return items, nil
+}
+
+// GetListingDuplicates returns the cluster of each of the listings that belongs to one
+func (r *repo) GetListingDuplicates(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]int64, error) {
+	res, err := r.dao.GetListingDuplicates(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	clusters := make(map[uuid.UUID]int64, len(res))
+	for _, d := range res {
+		clusters[d.ListingID] = d.ClusterID
+	}
+	return clusters, nil
+}
+
+// GetListingDuplicateClusterMembers returns the listings of each of the clusters
+func (r *repo) GetListingDuplicateClusterMembers(ctx context.Context, clusterIds []int64) (map[int64][]uuid.UUID, error) {
+	res, err := r.dao.GetListingDuplicateClusterMembers(ctx, clusterIds)
+	if err != nil {
+		return nil, err
+	}
+	members := make(map[int64][]uuid.UUID)
+	for _, d := range res {
+		members[d.ClusterID] = append(members[d.ClusterID], d.ListingID)
+	}
+	return members, nil
+}
+
+// ReplaceListingDuplicateClusters deletes the old clusters and creates a cluster for each group of listings
+func (r *repo) ReplaceListingDuplicateClusters(ctx context.Context, oldIds []int64, clusters [][]uuid.UUID) error {
+	return r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		if len(oldIds) > 0 {
+			if err := tx.DeleteListingDuplicateClusters(ctx, oldIds); err != nil {
+				return err
+			}
+		}
+		for _, lids := range clusters {
+			c, err := tx.CreateListingDuplicateCluster(ctx)
+			if err != nil {
+				return err
+			}
+			if err = tx.CreateListingDuplicates(ctx, database.CreateListingDuplicatesParams{
+				ListingIds: lids,
+				ClusterID:  c.ID,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *repo) GetListingDuplicateCluster(ctx context.Context, id int64) (model.ListingDuplicateClusterModel, error) {
+	c, err := r.dao.GetListingDuplicateCluster(ctx, id)
+	if err != nil {
+		return model.ListingDuplicateClusterModel{}, err
+	}
+	members, err := r.GetListingDuplicateClusterMembers(ctx, []int64{id})
+	if err != nil {
+		return model.ListingDuplicateClusterModel{}, err
+	}
+	return model.ListingDuplicateClusterModel{
+		ID:         c.ID,
+		CreatedAt:  c.CreatedAt,
+		ListingIDs: members[id],
+	}, nil
+}
+
+// GetListingDuplicateClusters returns the clusters, the most recent first
+func (r *repo) GetListingDuplicateClusters(ctx context.Context, limit, offset int32) ([]model.ListingDuplicateClusterModel, error) {
+	res, err := r.dao.GetListingDuplicateClusters(ctx, database.GetListingDuplicateClustersParams{
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(res))
+	for _, c := range res {
+		ids = append(ids, c.ID)
+	}
+	members, err := r.GetListingDuplicateClusterMembers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.ListingDuplicateClusterModel, 0, len(res))
+	for _, c := range res {
+		items = append(items, model.ListingDuplicateClusterModel{
+			ID:         c.ID,
+			CreatedAt:  c.CreatedAt,
+			ListingIDs: members[c.ID],
+		})
+	}
+	return items, nil
+}
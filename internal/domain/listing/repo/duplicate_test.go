@@ -0,0 +1,55 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+)
+
+func TestListingDuplicateClusters(t *testing.T) {
+	ctx := context.Background()
+	a := NewRandomListingDB(t, testAuthRepo, testPropertyRepo, testUnitRepo, testListingRepo)
+	b := NewRandomListingDB(t, testAuthRepo, testPropertyRepo, testUnitRepo, testListingRepo)
+	c := NewRandomListingDB(t, testAuthRepo, testPropertyRepo, testUnitRepo, testListingRepo)
+
+	features, err := testListingRepo.GetListingsDuplicateFeatures(ctx, []uuid.UUID{a.ID})
+	require.NoError(t, err)
+	require.Len(t, features, 1)
+	require.Equal(t, a.PropertyID, features[0].PropertyID)
+	require.Len(t, features[0].UnitIDs, len(a.Units))
+
+	// similarities are replaced, whatever the order of the pair
+	err = testListingRepo.SaveListingSimilarities(ctx, a.ID, []model.ListingSimilarityModel{
+		{ListingID: a.ID, SimilarListingID: b.ID, Score: 0.7, Reasons: []string{model.DUPLICATEREASON_LOCATION}},
+	})
+	require.NoError(t, err)
+	err = testListingRepo.SaveListingSimilarities(ctx, b.ID, []model.ListingSimilarityModel{
+		{ListingID: b.ID, SimilarListingID: a.ID, Score: 0.8, Reasons: []string{model.DUPLICATEREASON_IMAGES}},
+		{ListingID: b.ID, SimilarListingID: c.ID, Score: 0.9, Reasons: []string{model.DUPLICATEREASON_DESCRIPTION}},
+	})
+	require.NoError(t, err)
+	similarities, err := testListingRepo.GetListingSimilarities(ctx, []uuid.UUID{a.ID})
+	require.NoError(t, err)
+	require.Len(t, similarities, 1)
+	require.InDelta(t, 0.8, similarities[0].Score, 1e-6)
+
+	err = testListingRepo.ReplaceListingDuplicateClusters(ctx, nil, [][]uuid.UUID{{a.ID, b.ID, c.ID}})
+	require.NoError(t, err)
+	clusters, err := testListingRepo.GetListingDuplicates(ctx, []uuid.UUID{a.ID, c.ID})
+	require.NoError(t, err)
+	require.Len(t, clusters, 2)
+	require.Equal(t, clusters[a.ID], clusters[c.ID])
+
+	// the cluster is split
+	err = testListingRepo.ReplaceListingDuplicateClusters(ctx, []int64{clusters[a.ID]}, [][]uuid.UUID{{a.ID, b.ID}})
+	require.NoError(t, err)
+	clusters, err = testListingRepo.GetListingDuplicates(ctx, []uuid.UUID{a.ID, c.ID})
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+	cluster, err := testListingRepo.GetListingDuplicateCluster(ctx, clusters[a.ID])
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{a.ID, b.ID}, cluster.ListingIDs)
+}
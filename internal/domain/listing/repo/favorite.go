@@ -0,0 +1,104 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func (r *repo) CreateFavoriteFolder(ctx context.Context, data *dto.CreateFavoriteFolder) (model.FavoriteFolderModel, error) {
+	res, err := r.dao.CreateFavoriteFolder(ctx, database.CreateFavoriteFolderParams{
+		UserID: data.UserID,
+		Name:   data.Name,
+	})
+	if err != nil {
+		return model.FavoriteFolderModel{}, err
+	}
+	return model.ToFavoriteFolderModel(&res), nil
+}
+
+func (r *repo) GetFavoriteFolder(ctx context.Context, id int64) (model.FavoriteFolderModel, error) {
+	res, err := r.dao.GetFavoriteFolder(ctx, id)
+	if err != nil {
+		return model.FavoriteFolderModel{}, err
+	}
+	return model.ToFavoriteFolderModel(&res), nil
+}
+
+func (r *repo) GetFavoriteFoldersOfUser(ctx context.Context, userId uuid.UUID) ([]model.FavoriteFolderModel, error) {
+	res, err := r.dao.GetFavoriteFoldersOfUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.FavoriteFolderModel, 0, len(res))
+	for i := range res {
+		items = append(items, model.ToFavoriteFolderModel(&res[i]))
+	}
+	return items, nil
+}
+
+func (r *repo) UpdateFavoriteFolder(ctx context.Context, id int64, data *dto.UpdateFavoriteFolder) error {
+	return r.dao.UpdateFavoriteFolder(ctx, database.UpdateFavoriteFolderParams{
+		ID:   id,
+		Name: data.Name,
+	})
+}
+
+func (r *repo) DeleteFavoriteFolder(ctx context.Context, id int64) error {
+	return r.dao.DeleteFavoriteFolder(ctx, id)
+}
+
+// SaveFavoriteListing adds the listing to the favorites at the given price, or updates the folder and note of the favorite already saved
+func (r *repo) SaveFavoriteListing(ctx context.Context, data *dto.SaveFavoriteListing, price float32) (model.FavoriteListingModel, error) {
+	res, err := r.dao.UpsertFavoriteListing(ctx, database.UpsertFavoriteListingParams{
+		UserID:     data.UserID,
+		ListingID:  data.ListingID,
+		FolderID:   types.Int64N(data.FolderID),
+		Note:       types.StrN(data.Note),
+		SavedPrice: price,
+	})
+	if err != nil {
+		return model.FavoriteListingModel{}, err
+	}
+	return model.ToFavoriteListingModel(&res), nil
+}
+
+func (r *repo) GetFavoriteListingsOfUser(ctx context.Context, userId uuid.UUID, query *dto.GetFavoriteListingsQuery) ([]dto.FavoriteListing, error) {
+	res, err := r.dao.GetFavoriteListingsOfUser(ctx, database.GetFavoriteListingsOfUserParams{
+		UserID:   userId,
+		FolderID: types.Int64N(query.FolderID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]dto.FavoriteListing, 0, len(res))
+	for _, row := range res {
+		items = append(items, dto.FavoriteListing{
+			FavoriteListingModel: model.ToFavoriteListingModel(&database.FavoriteListing{
+				UserID:     row.UserID,
+				ListingID:  row.ListingID,
+				FolderID:   row.FolderID,
+				Note:       row.Note,
+				SavedPrice: row.SavedPrice,
+				CreatedAt:  row.CreatedAt,
+				UpdatedAt:  row.UpdatedAt,
+			}),
+			Title:     row.Title,
+			Price:     row.CurrentPrice,
+			Active:    row.Active,
+			ExpiredAt: row.ExpiredAt,
+		})
+	}
+	return items, nil
+}
+
+func (r *repo) DeleteFavoriteListing(ctx context.Context, userId, listingId uuid.UUID) error {
+	return r.dao.DeleteFavoriteListing(ctx, database.DeleteFavoriteListingParams{
+		UserID:    userId,
+		ListingID: listingId,
+	})
+}
@@ -0,0 +1,63 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	auth_repo "github.com/user2410/rrms-backend/internal/domain/auth/repo"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func TestFavoriteListings(t *testing.T) {
+	ctx := context.Background()
+	user := auth_repo.NewRandomUserDB(t, testAuthRepo)
+	listing := NewRandomListingDB(t, testAuthRepo, testPropertyRepo, testUnitRepo, testListingRepo)
+
+	folder, err := testListingRepo.CreateFavoriteFolder(ctx, &dto.CreateFavoriteFolder{
+		UserID: user.ID,
+		Name:   "Gần trường",
+	})
+	require.NoError(t, err)
+
+	fav, err := testListingRepo.SaveFavoriteListing(ctx, &dto.SaveFavoriteListing{
+		UserID:    user.ID,
+		ListingID: listing.ID,
+		FolderID:  types.Ptr(folder.ID),
+		Note:      types.Ptr("hỏi chủ nhà về chỗ để xe"),
+	}, listing.Price)
+	require.NoError(t, err)
+	require.Equal(t, listing.Price, fav.SavedPrice)
+
+	// saving again updates the note and keeps the saved price
+	fav, err = testListingRepo.SaveFavoriteListing(ctx, &dto.SaveFavoriteListing{
+		UserID:    user.ID,
+		ListingID: listing.ID,
+		FolderID:  types.Ptr(folder.ID),
+		Note:      types.Ptr("đã xem nhà"),
+	}, listing.Price+1000000)
+	require.NoError(t, err)
+	require.Equal(t, listing.Price, fav.SavedPrice)
+	require.Equal(t, "đã xem nhà", *fav.Note)
+
+	favs, err := testListingRepo.GetFavoriteListingsOfUser(ctx, user.ID, &dto.GetFavoriteListingsQuery{FolderID: types.Ptr(folder.ID)})
+	require.NoError(t, err)
+	require.Len(t, favs, 1)
+	require.Equal(t, listing.ID, favs[0].ListingID)
+	require.Equal(t, listing.Title, favs[0].Title)
+
+	// deleting the folder keeps the favorite
+	err = testListingRepo.DeleteFavoriteFolder(ctx, folder.ID)
+	require.NoError(t, err)
+	favs, err = testListingRepo.GetFavoriteListingsOfUser(ctx, user.ID, &dto.GetFavoriteListingsQuery{})
+	require.NoError(t, err)
+	require.Len(t, favs, 1)
+	require.Nil(t, favs[0].FolderID)
+
+	err = testListingRepo.DeleteFavoriteListing(ctx, user.ID, listing.ID)
+	require.NoError(t, err)
+	favs, err = testListingRepo.GetFavoriteListingsOfUser(ctx, user.ID, &dto.GetFavoriteListingsQuery{})
+	require.NoError(t, err)
+	require.Empty(t, favs)
+}
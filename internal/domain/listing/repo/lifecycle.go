@@ -0,0 +1,32 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// GetListingsToPublish returns the approved listings not public yet whose post time has come,
+// and the expired listings extended since they were deactivated
+func (r *repo) GetListingsToPublish(ctx context.Context, limit int32) ([]uuid.UUID, error) {
+	return r.dao.GetListingsToPublish(ctx, limit)
+}
+
+// GetExpiredActiveListings returns the listings still public past their expiry, the earliest expired first
+func (r *repo) GetExpiredActiveListings(ctx context.Context, limit int32) ([]uuid.UUID, error) {
+	return r.dao.GetExpiredActiveListings(ctx, limit)
+}
+
+// GetListingsToWarnExpiry returns the public listings expiring within the given number of days
+// whose creator has not been warned yet. Listings renewed automatically are left out.
+func (r *repo) GetListingsToWarnExpiry(ctx context.Context, days int32, limit int32) ([]uuid.UUID, error) {
+	return r.dao.GetListingsToWarnExpiry(ctx, database.GetListingsToWarnExpiryParams{
+		Days: days,
+		Lim:  limit,
+	})
+}
+
+func (r *repo) MarkListingExpiryWarned(ctx context.Context, id uuid.UUID) error {
+	return r.dao.MarkListingExpiryWarned(ctx, id)
+}
@@ -0,0 +1,111 @@
+package repo
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	auth_repo "github.com/user2410/rrms-backend/internal/domain/auth/repo"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+const lifecycleTestLimit = 10000
+
+func approveListing(t *testing.T, listingId uuid.UUID) {
+	ctx := context.Background()
+	reviewer := auth_repo.NewRandomUserDB(t, testAuthRepo)
+	m, err := testListingRepo.CreateListingModeration(ctx, listingId)
+	require.NoError(t, err)
+	ok, err := testListingRepo.ReviewListingModeration(ctx, m.ID, &dto.ReviewListingModeration{
+		ReviewerID: reviewer.ID,
+		Status:     database.LISTINGMODERATIONSTATUSAPPROVED,
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestScheduledListingsPublish(t *testing.T) {
+	ctx := context.Background()
+	isToPublish := func(id uuid.UUID) bool {
+		ids, err := testListingRepo.GetListingsToPublish(ctx, lifecycleTestLimit)
+		require.NoError(t, err)
+		return slices.Contains(ids, id)
+	}
+
+	// a listing goes public only once approved
+	listing := NewRandomListingDB(t, testAuthRepo, testPropertyRepo, testUnitRepo, testListingRepo)
+	require.False(t, listing.Active)
+	require.False(t, isToPublish(listing.ID))
+	_, err := testListingRepo.CreateListingModeration(ctx, listing.ID)
+	require.NoError(t, err)
+	require.False(t, isToPublish(listing.ID))
+	approveListing(t, listing.ID)
+	require.True(t, isToPublish(listing.ID))
+
+	require.NoError(t, testListingRepo.UpdateListingStatus(ctx, listing.ID, true))
+	require.False(t, isToPublish(listing.ID))
+
+	// an approved listing scheduled later waits for its post time
+	arg := PrepareRandomListing(t, testAuthRepo, testPropertyRepo, testUnitRepo, uuid.Nil, []uuid.UUID{})
+	arg.PostAt = types.Ptr(time.Now().Add(time.Hour))
+	scheduled, err := testListingRepo.CreateListing(ctx, &arg)
+	require.NoError(t, err)
+	require.WithinDuration(t, arg.PostAt.AddDate(0, 0, arg.PostDuration), scheduled.ExpiredAt, time.Second)
+	approveListing(t, scheduled.ID)
+	require.False(t, isToPublish(scheduled.ID))
+}
+
+func TestListingsExpiry(t *testing.T) {
+	ctx := context.Background()
+	isExpired := func(id uuid.UUID) bool {
+		ids, err := testListingRepo.GetExpiredActiveListings(ctx, lifecycleTestLimit)
+		require.NoError(t, err)
+		return slices.Contains(ids, id)
+	}
+	isToWarn := func(id uuid.UUID) bool {
+		ids, err := testListingRepo.GetListingsToWarnExpiry(ctx, 3, lifecycleTestLimit)
+		require.NoError(t, err)
+		return slices.Contains(ids, id)
+	}
+
+	arg := PrepareRandomListing(t, testAuthRepo, testPropertyRepo, testUnitRepo, uuid.Nil, []uuid.UUID{})
+	arg.PostDuration = 2
+	listing := NewRandomListingDBFromArg(t, testListingRepo, &arg)
+	approveListing(t, listing.ID)
+
+	// only public listings expire
+	require.False(t, isToWarn(listing.ID))
+	require.NoError(t, testListingRepo.UpdateListingStatus(ctx, listing.ID, true))
+	require.True(t, isToWarn(listing.ID))
+	require.False(t, isExpired(listing.ID))
+
+	// the creator is warned once
+	require.NoError(t, testListingRepo.MarkListingExpiryWarned(ctx, listing.ID))
+	require.False(t, isToWarn(listing.ID))
+
+	// a negative extension moves the expiry to the past
+	expiredAt, err := testListingRepo.UpdateListingExpiration(ctx, listing.ID, -3)
+	require.NoError(t, err)
+	require.True(t, expiredAt.Before(time.Now()))
+	require.True(t, isExpired(listing.ID))
+	require.False(t, isToWarn(listing.ID))
+
+	require.NoError(t, testListingRepo.UpdateListingStatus(ctx, listing.ID, false))
+	require.False(t, isExpired(listing.ID))
+	ids, err := testListingRepo.GetListingsToPublish(ctx, lifecycleTestLimit)
+	require.NoError(t, err)
+	require.NotContains(t, ids, listing.ID)
+
+	// an expired listing is extended from now on, then goes public again
+	expiredAt, err = testListingRepo.UpdateListingExpiration(ctx, listing.ID, 30)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().AddDate(0, 0, 30), expiredAt, time.Minute)
+	ids, err = testListingRepo.GetListingsToPublish(ctx, lifecycleTestLimit)
+	require.NoError(t, err)
+	require.Contains(t, ids, listing.ID)
+}
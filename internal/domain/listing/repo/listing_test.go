@@ -1,7 +1,44 @@
 package repo
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/interfaces/rest/requests"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
 
 func TestCreateRepo(t *testing.T) {
 	NewRandomListingDB(t, testAuthRepo, testPropertyRepo, testUnitRepo, testListingRepo)
 }
+
+func TestSearchListingCombinationByIds(t *testing.T) {
+	a := NewRandomListingDB(t, testAuthRepo, testPropertyRepo, testUnitRepo, testListingRepo)
+	b := NewRandomListingDB(t, testAuthRepo, testPropertyRepo, testUnitRepo, testListingRepo)
+
+	// more ids than the bind parameters a statement may hold
+	ids := []string{b.ID.String(), a.ID.String()}
+	for i := 0; i < 70000; i++ {
+		ids = append(ids, uuid.NewString())
+	}
+	res, err := testListingRepo.SearchListingCombination(context.Background(), &dto.SearchListingCombinationQuery{
+		SearchSortPaginationQuery: requests.SearchSortPaginationQuery{
+			Limit:  types.Ptr[int32](10),
+			Offset: types.Ptr[int32](0),
+			SortBy: []string{dto.SORTBY_RELEVANCE},
+			Order:  []string{"asc"},
+		},
+		SearchListingQuery: dto.SearchListingQuery{
+			LIds: ids,
+		},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, res.Count)
+	require.Len(t, res.Items, 2)
+	// the listings keep the order of the ids
+	require.Equal(t, b.ID, res.Items[0].LId)
+	require.Equal(t, a.ID, res.Items[1].LId)
+}
@@ -19,6 +19,8 @@ import (
 	model "github.com/user2410/rrms-backend/internal/domain/listing/model"
 	model0 "github.com/user2410/rrms-backend/internal/domain/payment/model"
 	service "github.com/user2410/rrms-backend/internal/domain/payment/service"
+	model1 "github.com/user2410/rrms-backend/internal/domain/property/model"
+	database "github.com/user2410/rrms-backend/internal/infrastructure/database"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -45,6 +47,37 @@ func (m *MockRepo) EXPECT() *MockRepoMockRecorder {
 	return m.recorder
 }
 
+// BookListingViewing mocks base method.
+func (m *MockRepo) BookListingViewing(arg0 context.Context, arg1 *dto.BookListingViewing) (model.ListingViewingModel, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BookListingViewing", arg0, arg1)
+	ret0, _ := ret[0].(model.ListingViewingModel)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BookListingViewing indicates an expected call of BookListingViewing.
+func (mr *MockRepoMockRecorder) BookListingViewing(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BookListingViewing", reflect.TypeOf((*MockRepo)(nil).BookListingViewing), arg0, arg1)
+}
+
+// CancelListingViewing mocks base method.
+func (m *MockRepo) CancelListingViewing(arg0 context.Context, arg1 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelListingViewing", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelListingViewing indicates an expected call of CancelListingViewing.
+func (mr *MockRepoMockRecorder) CancelListingViewing(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelListingViewing", reflect.TypeOf((*MockRepo)(nil).CancelListingViewing), arg0, arg1)
+}
+
 // CheckListingExpired mocks base method.
 func (m *MockRepo) CheckListingExpired(arg0 context.Context, arg1 uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -105,6 +138,66 @@ func (mr *MockRepoMockRecorder) CheckValidUnitForListing(arg0, arg1, arg2 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckValidUnitForListing", reflect.TypeOf((*MockRepo)(nil).CheckValidUnitForListing), arg0, arg1, arg2)
 }
 
+// ClaimSearchOutboxEvents mocks base method.
+func (m *MockRepo) ClaimSearchOutboxEvents(arg0 context.Context, arg1 int32) ([]model.SearchOutboxEventModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimSearchOutboxEvents", arg0, arg1)
+	ret0, _ := ret[0].([]model.SearchOutboxEventModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimSearchOutboxEvents indicates an expected call of ClaimSearchOutboxEvents.
+func (mr *MockRepoMockRecorder) ClaimSearchOutboxEvents(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimSearchOutboxEvents", reflect.TypeOf((*MockRepo)(nil).ClaimSearchOutboxEvents), arg0, arg1)
+}
+
+// CountListings mocks base method.
+func (m *MockRepo) CountListings(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountListings", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountListings indicates an expected call of CountListings.
+func (mr *MockRepoMockRecorder) CountListings(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountListings", reflect.TypeOf((*MockRepo)(nil).CountListings), arg0)
+}
+
+// CountSearchOutboxEventsSince mocks base method.
+func (m *MockRepo) CountSearchOutboxEventsSince(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSearchOutboxEventsSince", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSearchOutboxEventsSince indicates an expected call of CountSearchOutboxEventsSince.
+func (mr *MockRepoMockRecorder) CountSearchOutboxEventsSince(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSearchOutboxEventsSince", reflect.TypeOf((*MockRepo)(nil).CountSearchOutboxEventsSince), arg0, arg1)
+}
+
+// CreateFavoriteFolder mocks base method.
+func (m *MockRepo) CreateFavoriteFolder(arg0 context.Context, arg1 *dto.CreateFavoriteFolder) (model.FavoriteFolderModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFavoriteFolder", arg0, arg1)
+	ret0, _ := ret[0].(model.FavoriteFolderModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFavoriteFolder indicates an expected call of CreateFavoriteFolder.
+func (mr *MockRepoMockRecorder) CreateFavoriteFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFavoriteFolder", reflect.TypeOf((*MockRepo)(nil).CreateFavoriteFolder), arg0, arg1)
+}
+
 // CreateListing mocks base method.
 func (m *MockRepo) CreateListing(arg0 context.Context, arg1 *dto.CreateListing) (*model.ListingModel, error) {
 	m.ctrl.T.Helper()
@@ -120,6 +213,124 @@ func (mr *MockRepoMockRecorder) CreateListing(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateListing", reflect.TypeOf((*MockRepo)(nil).CreateListing), arg0, arg1)
 }
 
+// CreateListingModeration mocks base method.
+func (m *MockRepo) CreateListingModeration(arg0 context.Context, arg1 uuid.UUID) (model.ListingModerationModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateListingModeration", arg0, arg1)
+	ret0, _ := ret[0].(model.ListingModerationModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateListingModeration indicates an expected call of CreateListingModeration.
+func (mr *MockRepoMockRecorder) CreateListingModeration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateListingModeration", reflect.TypeOf((*MockRepo)(nil).CreateListingModeration), arg0, arg1)
+}
+
+// CreateListingQuestion mocks base method.
+func (m *MockRepo) CreateListingQuestion(arg0 context.Context, arg1 uuid.UUID, arg2 *dto.CreateListingQuestion) (model.ListingQuestionModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateListingQuestion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(model.ListingQuestionModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateListingQuestion indicates an expected call of CreateListingQuestion.
+func (mr *MockRepoMockRecorder) CreateListingQuestion(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateListingQuestion", reflect.TypeOf((*MockRepo)(nil).CreateListingQuestion), arg0, arg1, arg2)
+}
+
+// CreateListingViewingSlots mocks base method.
+func (m *MockRepo) CreateListingViewingSlots(arg0 context.Context, arg1 uuid.UUID, arg2 []database.CreateListingViewingSlotParams) ([]model.ListingViewingSlotModel, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateListingViewingSlots", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.ListingViewingSlotModel)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateListingViewingSlots indicates an expected call of CreateListingViewingSlots.
+func (mr *MockRepoMockRecorder) CreateListingViewingSlots(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateListingViewingSlots", reflect.TypeOf((*MockRepo)(nil).CreateListingViewingSlots), arg0, arg1, arg2)
+}
+
+// CreateSavedSearch mocks base method.
+func (m *MockRepo) CreateSavedSearch(arg0 context.Context, arg1 *dto.CreateSavedSearch) (model.SavedSearchModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSavedSearch", arg0, arg1)
+	ret0, _ := ret[0].(model.SavedSearchModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSavedSearch indicates an expected call of CreateSavedSearch.
+func (mr *MockRepoMockRecorder) CreateSavedSearch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSavedSearch", reflect.TypeOf((*MockRepo)(nil).CreateSavedSearch), arg0, arg1)
+}
+
+// CreateSavedSearchMatches mocks base method.
+func (m *MockRepo) CreateSavedSearchMatches(arg0 context.Context, arg1 *model.SavedSearchModel, arg2 []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSavedSearchMatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSavedSearchMatches indicates an expected call of CreateSavedSearchMatches.
+func (mr *MockRepoMockRecorder) CreateSavedSearchMatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSavedSearchMatches", reflect.TypeOf((*MockRepo)(nil).CreateSavedSearchMatches), arg0, arg1, arg2)
+}
+
+// CreateSearchOutboxEvent mocks base method.
+func (m *MockRepo) CreateSearchOutboxEvent(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSearchOutboxEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSearchOutboxEvent indicates an expected call of CreateSearchOutboxEvent.
+func (mr *MockRepoMockRecorder) CreateSearchOutboxEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSearchOutboxEvent", reflect.TypeOf((*MockRepo)(nil).CreateSearchOutboxEvent), arg0, arg1)
+}
+
+// DeleteFavoriteFolder mocks base method.
+func (m *MockRepo) DeleteFavoriteFolder(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFavoriteFolder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFavoriteFolder indicates an expected call of DeleteFavoriteFolder.
+func (mr *MockRepoMockRecorder) DeleteFavoriteFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavoriteFolder", reflect.TypeOf((*MockRepo)(nil).DeleteFavoriteFolder), arg0, arg1)
+}
+
+// DeleteFavoriteListing mocks base method.
+func (m *MockRepo) DeleteFavoriteListing(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFavoriteListing", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFavoriteListing indicates an expected call of DeleteFavoriteListing.
+func (mr *MockRepoMockRecorder) DeleteFavoriteListing(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavoriteListing", reflect.TypeOf((*MockRepo)(nil).DeleteFavoriteListing), arg0, arg1, arg2)
+}
+
 // DeleteListing mocks base method.
 func (m *MockRepo) DeleteListing(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -134,6 +345,93 @@ func (mr *MockRepoMockRecorder) DeleteListing(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteListing", reflect.TypeOf((*MockRepo)(nil).DeleteListing), arg0, arg1)
 }
 
+// DeleteListingQuestion mocks base method.
+func (m *MockRepo) DeleteListingQuestion(arg0 context.Context, arg1 uuid.UUID, arg2 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteListingQuestion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteListingQuestion indicates an expected call of DeleteListingQuestion.
+func (mr *MockRepoMockRecorder) DeleteListingQuestion(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteListingQuestion", reflect.TypeOf((*MockRepo)(nil).DeleteListingQuestion), arg0, arg1, arg2)
+}
+
+// DeleteListingTranslation mocks base method.
+func (m *MockRepo) DeleteListingTranslation(arg0 context.Context, arg1 uuid.UUID, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteListingTranslation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteListingTranslation indicates an expected call of DeleteListingTranslation.
+func (mr *MockRepoMockRecorder) DeleteListingTranslation(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteListingTranslation", reflect.TypeOf((*MockRepo)(nil).DeleteListingTranslation), arg0, arg1, arg2)
+}
+
+// DeleteListingViewingSlot mocks base method.
+func (m *MockRepo) DeleteListingViewingSlot(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteListingViewingSlot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteListingViewingSlot indicates an expected call of DeleteListingViewingSlot.
+func (mr *MockRepoMockRecorder) DeleteListingViewingSlot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteListingViewingSlot", reflect.TypeOf((*MockRepo)(nil).DeleteListingViewingSlot), arg0, arg1)
+}
+
+// DeleteSavedSearch mocks base method.
+func (m *MockRepo) DeleteSavedSearch(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSavedSearch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSavedSearch indicates an expected call of DeleteSavedSearch.
+func (mr *MockRepoMockRecorder) DeleteSavedSearch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedSearch", reflect.TypeOf((*MockRepo)(nil).DeleteSavedSearch), arg0, arg1)
+}
+
+// FailSearchOutboxEvents mocks base method.
+func (m *MockRepo) FailSearchOutboxEvents(arg0 context.Context, arg1 []int64, arg2 string, arg3 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailSearchOutboxEvents", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailSearchOutboxEvents indicates an expected call of FailSearchOutboxEvents.
+func (mr *MockRepoMockRecorder) FailSearchOutboxEvents(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailSearchOutboxEvents", reflect.TypeOf((*MockRepo)(nil).FailSearchOutboxEvents), arg0, arg1, arg2, arg3)
+}
+
+// FilterExistingListings mocks base method.
+func (m *MockRepo) FilterExistingListings(arg0 context.Context, arg1 []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterExistingListings", arg0, arg1)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterExistingListings indicates an expected call of FilterExistingListings.
+func (mr *MockRepoMockRecorder) FilterExistingListings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterExistingListings", reflect.TypeOf((*MockRepo)(nil).FilterExistingListings), arg0, arg1)
+}
+
 // FilterVisibleListings mocks base method.
 func (m *MockRepo) FilterVisibleListings(arg0 context.Context, arg1 []uuid.UUID, arg2 uuid.UUID) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -149,134 +447,1066 @@ func (mr *MockRepoMockRecorder) FilterVisibleListings(arg0, arg1, arg2 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterVisibleListings", reflect.TypeOf((*MockRepo)(nil).FilterVisibleListings), arg0, arg1, arg2)
 }
 
-// GetListingByID mocks base method.
-func (m *MockRepo) GetListingByID(arg0 context.Context, arg1 uuid.UUID) (*model.ListingModel, error) {
+// GetActiveSavedSearchesAfter mocks base method.
+func (m *MockRepo) GetActiveSavedSearchesAfter(arg0 context.Context, arg1 int64, arg2 int32) ([]model.SavedSearchModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetListingByID", arg0, arg1)
-	ret0, _ := ret[0].(*model.ListingModel)
+	ret := m.ctrl.Call(m, "GetActiveSavedSearchesAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.SavedSearchModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetListingByID indicates an expected call of GetListingByID.
-func (mr *MockRepoMockRecorder) GetListingByID(arg0, arg1 any) *gomock.Call {
+// GetActiveSavedSearchesAfter indicates an expected call of GetActiveSavedSearchesAfter.
+func (mr *MockRepoMockRecorder) GetActiveSavedSearchesAfter(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingByID", reflect.TypeOf((*MockRepo)(nil).GetListingByID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveSavedSearchesAfter", reflect.TypeOf((*MockRepo)(nil).GetActiveSavedSearchesAfter), arg0, arg1, arg2)
 }
 
-// GetListingPayments mocks base method.
-func (m *MockRepo) GetListingPayments(arg0 context.Context, arg1 uuid.UUID) ([]model0.PaymentModel, error) {
+// GetCachedListingDailyStats mocks base method.
+func (m *MockRepo) GetCachedListingDailyStats(arg0 context.Context, arg1 time.Time) ([]dto.ListingDailyStats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetListingPayments", arg0, arg1)
-	ret0, _ := ret[0].([]model0.PaymentModel)
+	ret := m.ctrl.Call(m, "GetCachedListingDailyStats", arg0, arg1)
+	ret0, _ := ret[0].([]dto.ListingDailyStats)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetListingPayments indicates an expected call of GetListingPayments.
-func (mr *MockRepoMockRecorder) GetListingPayments(arg0, arg1 any) *gomock.Call {
+// GetCachedListingDailyStats indicates an expected call of GetCachedListingDailyStats.
+func (mr *MockRepoMockRecorder) GetCachedListingDailyStats(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingPayments", reflect.TypeOf((*MockRepo)(nil).GetListingPayments), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCachedListingDailyStats", reflect.TypeOf((*MockRepo)(nil).GetCachedListingDailyStats), arg0, arg1)
 }
 
-// GetListingPaymentsByType mocks base method.
-func (m *MockRepo) GetListingPaymentsByType(arg0 context.Context, arg1 uuid.UUID, arg2 service.PAYMENTTYPE) ([]model0.PaymentModel, error) {
+// GetDistrictListingPriceStats mocks base method.
+func (m *MockRepo) GetDistrictListingPriceStats(arg0 context.Context, arg1 uuid.UUID, arg2 *model1.PropertyModel) (int64, float64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetListingPaymentsByType", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]model0.PaymentModel)
+	ret := m.ctrl.Call(m, "GetDistrictListingPriceStats", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(float64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDistrictListingPriceStats indicates an expected call of GetDistrictListingPriceStats.
+func (mr *MockRepoMockRecorder) GetDistrictListingPriceStats(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDistrictListingPriceStats", reflect.TypeOf((*MockRepo)(nil).GetDistrictListingPriceStats), arg0, arg1, arg2)
+}
+
+// GetExpiredActiveListings mocks base method.
+func (m *MockRepo) GetExpiredActiveListings(arg0 context.Context, arg1 int32) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredActiveListings", arg0, arg1)
+	ret0, _ := ret[0].([]uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetListingPaymentsByType indicates an expected call of GetListingPaymentsByType.
-func (mr *MockRepoMockRecorder) GetListingPaymentsByType(arg0, arg1, arg2 any) *gomock.Call {
+// GetExpiredActiveListings indicates an expected call of GetExpiredActiveListings.
+func (mr *MockRepoMockRecorder) GetExpiredActiveListings(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingPaymentsByType", reflect.TypeOf((*MockRepo)(nil).GetListingPaymentsByType), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredActiveListings", reflect.TypeOf((*MockRepo)(nil).GetExpiredActiveListings), arg0, arg1)
 }
 
-// GetListingsByIds mocks base method.
-func (m *MockRepo) GetListingsByIds(arg0 context.Context, arg1 []uuid.UUID, arg2 []string) ([]model.ListingModel, error) {
+// GetFavoriteFolder mocks base method.
+func (m *MockRepo) GetFavoriteFolder(arg0 context.Context, arg1 int64) (model.FavoriteFolderModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetListingsByIds", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]model.ListingModel)
+	ret := m.ctrl.Call(m, "GetFavoriteFolder", arg0, arg1)
+	ret0, _ := ret[0].(model.FavoriteFolderModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetListingsByIds indicates an expected call of GetListingsByIds.
-func (mr *MockRepoMockRecorder) GetListingsByIds(arg0, arg1, arg2 any) *gomock.Call {
+// GetFavoriteFolder indicates an expected call of GetFavoriteFolder.
+func (mr *MockRepoMockRecorder) GetFavoriteFolder(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingsByIds", reflect.TypeOf((*MockRepo)(nil).GetListingsByIds), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteFolder", reflect.TypeOf((*MockRepo)(nil).GetFavoriteFolder), arg0, arg1)
 }
 
-// SearchListingCombination mocks base method.
-func (m *MockRepo) SearchListingCombination(arg0 context.Context, arg1 *dto.SearchListingCombinationQuery) (*dto.SearchListingCombinationResponse, error) {
+// GetFavoriteFoldersOfUser mocks base method.
+func (m *MockRepo) GetFavoriteFoldersOfUser(arg0 context.Context, arg1 uuid.UUID) ([]model.FavoriteFolderModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchListingCombination", arg0, arg1)
-	ret0, _ := ret[0].(*dto.SearchListingCombinationResponse)
+	ret := m.ctrl.Call(m, "GetFavoriteFoldersOfUser", arg0, arg1)
+	ret0, _ := ret[0].([]model.FavoriteFolderModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchListingCombination indicates an expected call of SearchListingCombination.
-func (mr *MockRepoMockRecorder) SearchListingCombination(arg0, arg1 any) *gomock.Call {
+// GetFavoriteFoldersOfUser indicates an expected call of GetFavoriteFoldersOfUser.
+func (mr *MockRepoMockRecorder) GetFavoriteFoldersOfUser(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchListingCombination", reflect.TypeOf((*MockRepo)(nil).SearchListingCombination), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteFoldersOfUser", reflect.TypeOf((*MockRepo)(nil).GetFavoriteFoldersOfUser), arg0, arg1)
 }
 
-// UpdateListing mocks base method.
-func (m *MockRepo) UpdateListing(arg0 context.Context, arg1 uuid.UUID, arg2 *dto.UpdateListing) error {
+// GetFavoriteListingsOfUser mocks base method.
+func (m *MockRepo) GetFavoriteListingsOfUser(arg0 context.Context, arg1 uuid.UUID, arg2 *dto.GetFavoriteListingsQuery) ([]dto.FavoriteListing, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateListing", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetFavoriteListingsOfUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]dto.FavoriteListing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateListing indicates an expected call of UpdateListing.
-func (mr *MockRepoMockRecorder) UpdateListing(arg0, arg1, arg2 any) *gomock.Call {
+// GetFavoriteListingsOfUser indicates an expected call of GetFavoriteListingsOfUser.
+func (mr *MockRepoMockRecorder) GetFavoriteListingsOfUser(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListing", reflect.TypeOf((*MockRepo)(nil).UpdateListing), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteListingsOfUser", reflect.TypeOf((*MockRepo)(nil).GetFavoriteListingsOfUser), arg0, arg1, arg2)
 }
 
-// UpdateListingExpiration mocks base method.
-func (m *MockRepo) UpdateListingExpiration(arg0 context.Context, arg1 uuid.UUID, arg2 int64) (time.Time, error) {
+// GetLatestListingModeration mocks base method.
+func (m *MockRepo) GetLatestListingModeration(arg0 context.Context, arg1 uuid.UUID) (model.ListingModerationModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateListingExpiration", arg0, arg1, arg2)
-	ret0, _ := ret[0].(time.Time)
+	ret := m.ctrl.Call(m, "GetLatestListingModeration", arg0, arg1)
+	ret0, _ := ret[0].(model.ListingModerationModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateListingExpiration indicates an expected call of UpdateListingExpiration.
-func (mr *MockRepoMockRecorder) UpdateListingExpiration(arg0, arg1, arg2 any) *gomock.Call {
+// GetLatestListingModeration indicates an expected call of GetLatestListingModeration.
+func (mr *MockRepoMockRecorder) GetLatestListingModeration(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListingExpiration", reflect.TypeOf((*MockRepo)(nil).UpdateListingExpiration), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestListingModeration", reflect.TypeOf((*MockRepo)(nil).GetLatestListingModeration), arg0, arg1)
 }
 
-// UpdateListingPriority mocks base method.
-func (m *MockRepo) UpdateListingPriority(arg0 context.Context, arg1 uuid.UUID, arg2 int) error {
+// GetListingByID mocks base method.
+func (m *MockRepo) GetListingByID(arg0 context.Context, arg1 uuid.UUID) (*model.ListingModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateListingPriority", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetListingByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.ListingModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateListingPriority indicates an expected call of UpdateListingPriority.
-func (mr *MockRepoMockRecorder) UpdateListingPriority(arg0, arg1, arg2 any) *gomock.Call {
+// GetListingByID indicates an expected call of GetListingByID.
+func (mr *MockRepoMockRecorder) GetListingByID(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListingPriority", reflect.TypeOf((*MockRepo)(nil).UpdateListingPriority), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingByID", reflect.TypeOf((*MockRepo)(nil).GetListingByID), arg0, arg1)
 }
 
-// UpdateListingStatus mocks base method.
-func (m *MockRepo) UpdateListingStatus(arg0 context.Context, arg1 uuid.UUID, arg2 bool) error {
+// GetListingDuplicateCandidates mocks base method.
+func (m *MockRepo) GetListingDuplicateCandidates(arg0 context.Context, arg1 *model.ListingDuplicateFeatures, arg2 float64, arg3 int32) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateListingStatus", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetListingDuplicateCandidates", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateListingStatus indicates an expected call of UpdateListingStatus.
-func (mr *MockRepoMockRecorder) UpdateListingStatus(arg0, arg1, arg2 any) *gomock.Call {
+// GetListingDuplicateCandidates indicates an expected call of GetListingDuplicateCandidates.
+func (mr *MockRepoMockRecorder) GetListingDuplicateCandidates(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListingStatus", reflect.TypeOf((*MockRepo)(nil).UpdateListingStatus), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingDuplicateCandidates", reflect.TypeOf((*MockRepo)(nil).GetListingDuplicateCandidates), arg0, arg1, arg2, arg3)
+}
+
+// GetListingDuplicateCluster mocks base method.
+func (m *MockRepo) GetListingDuplicateCluster(arg0 context.Context, arg1 int64) (model.ListingDuplicateClusterModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingDuplicateCluster", arg0, arg1)
+	ret0, _ := ret[0].(model.ListingDuplicateClusterModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingDuplicateCluster indicates an expected call of GetListingDuplicateCluster.
+func (mr *MockRepoMockRecorder) GetListingDuplicateCluster(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingDuplicateCluster", reflect.TypeOf((*MockRepo)(nil).GetListingDuplicateCluster), arg0, arg1)
+}
+
+// GetListingDuplicateClusterMembers mocks base method.
+func (m *MockRepo) GetListingDuplicateClusterMembers(arg0 context.Context, arg1 []int64) (map[int64][]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingDuplicateClusterMembers", arg0, arg1)
+	ret0, _ := ret[0].(map[int64][]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingDuplicateClusterMembers indicates an expected call of GetListingDuplicateClusterMembers.
+func (mr *MockRepoMockRecorder) GetListingDuplicateClusterMembers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingDuplicateClusterMembers", reflect.TypeOf((*MockRepo)(nil).GetListingDuplicateClusterMembers), arg0, arg1)
+}
+
+// GetListingDuplicateClusters mocks base method.
+func (m *MockRepo) GetListingDuplicateClusters(arg0 context.Context, arg1, arg2 int32) ([]model.ListingDuplicateClusterModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingDuplicateClusters", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.ListingDuplicateClusterModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingDuplicateClusters indicates an expected call of GetListingDuplicateClusters.
+func (mr *MockRepoMockRecorder) GetListingDuplicateClusters(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingDuplicateClusters", reflect.TypeOf((*MockRepo)(nil).GetListingDuplicateClusters), arg0, arg1, arg2)
+}
+
+// GetListingDuplicates mocks base method.
+func (m *MockRepo) GetListingDuplicates(arg0 context.Context, arg1 []uuid.UUID) (map[uuid.UUID]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingDuplicates", arg0, arg1)
+	ret0, _ := ret[0].(map[uuid.UUID]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingDuplicates indicates an expected call of GetListingDuplicates.
+func (mr *MockRepoMockRecorder) GetListingDuplicates(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingDuplicates", reflect.TypeOf((*MockRepo)(nil).GetListingDuplicates), arg0, arg1)
+}
+
+// GetListingModeration mocks base method.
+func (m *MockRepo) GetListingModeration(arg0 context.Context, arg1 int64) (model.ListingModerationModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingModeration", arg0, arg1)
+	ret0, _ := ret[0].(model.ListingModerationModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingModeration indicates an expected call of GetListingModeration.
+func (mr *MockRepoMockRecorder) GetListingModeration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingModeration", reflect.TypeOf((*MockRepo)(nil).GetListingModeration), arg0, arg1)
+}
+
+// GetListingModerations mocks base method.
+func (m *MockRepo) GetListingModerations(arg0 context.Context, arg1 database.LISTINGMODERATIONSTATUS, arg2, arg3 int32) ([]model.ListingModerationModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingModerations", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]model.ListingModerationModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingModerations indicates an expected call of GetListingModerations.
+func (mr *MockRepoMockRecorder) GetListingModerations(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingModerations", reflect.TypeOf((*MockRepo)(nil).GetListingModerations), arg0, arg1, arg2, arg3)
+}
+
+// GetListingPayments mocks base method.
+func (m *MockRepo) GetListingPayments(arg0 context.Context, arg1 uuid.UUID) ([]model0.PaymentModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingPayments", arg0, arg1)
+	ret0, _ := ret[0].([]model0.PaymentModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingPayments indicates an expected call of GetListingPayments.
+func (mr *MockRepoMockRecorder) GetListingPayments(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingPayments", reflect.TypeOf((*MockRepo)(nil).GetListingPayments), arg0, arg1)
+}
+
+// GetListingPaymentsByType mocks base method.
+func (m *MockRepo) GetListingPaymentsByType(arg0 context.Context, arg1 uuid.UUID, arg2 service.PAYMENTTYPE) ([]model0.PaymentModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingPaymentsByType", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model0.PaymentModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingPaymentsByType indicates an expected call of GetListingPaymentsByType.
+func (mr *MockRepoMockRecorder) GetListingPaymentsByType(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingPaymentsByType", reflect.TypeOf((*MockRepo)(nil).GetListingPaymentsByType), arg0, arg1, arg2)
+}
+
+// GetListingPriceHistory mocks base method.
+func (m *MockRepo) GetListingPriceHistory(arg0 context.Context, arg1 uuid.UUID) ([]model.ListingPriceHistoryModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingPriceHistory", arg0, arg1)
+	ret0, _ := ret[0].([]model.ListingPriceHistoryModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingPriceHistory indicates an expected call of GetListingPriceHistory.
+func (mr *MockRepoMockRecorder) GetListingPriceHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingPriceHistory", reflect.TypeOf((*MockRepo)(nil).GetListingPriceHistory), arg0, arg1)
+}
+
+// GetListingQuestions mocks base method.
+func (m *MockRepo) GetListingQuestions(arg0 context.Context, arg1 uuid.UUID) ([]model.ListingQuestionModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingQuestions", arg0, arg1)
+	ret0, _ := ret[0].([]model.ListingQuestionModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingQuestions indicates an expected call of GetListingQuestions.
+func (mr *MockRepoMockRecorder) GetListingQuestions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingQuestions", reflect.TypeOf((*MockRepo)(nil).GetListingQuestions), arg0, arg1)
+}
+
+// GetListingRequiredDocuments mocks base method.
+func (m *MockRepo) GetListingRequiredDocuments(arg0 context.Context, arg1 uuid.UUID) ([]model.ListingRequiredDocumentModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingRequiredDocuments", arg0, arg1)
+	ret0, _ := ret[0].([]model.ListingRequiredDocumentModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingRequiredDocuments indicates an expected call of GetListingRequiredDocuments.
+func (mr *MockRepoMockRecorder) GetListingRequiredDocuments(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingRequiredDocuments", reflect.TypeOf((*MockRepo)(nil).GetListingRequiredDocuments), arg0, arg1)
+}
+
+// GetListingRevisionsAfter mocks base method.
+func (m *MockRepo) GetListingRevisionsAfter(arg0 context.Context, arg1 uuid.UUID, arg2 int32) ([]dto.ListingRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingRevisionsAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]dto.ListingRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingRevisionsAfter indicates an expected call of GetListingRevisionsAfter.
+func (mr *MockRepoMockRecorder) GetListingRevisionsAfter(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingRevisionsAfter", reflect.TypeOf((*MockRepo)(nil).GetListingRevisionsAfter), arg0, arg1, arg2)
+}
+
+// GetListingSimilarities mocks base method.
+func (m *MockRepo) GetListingSimilarities(arg0 context.Context, arg1 []uuid.UUID) ([]model.ListingSimilarityModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingSimilarities", arg0, arg1)
+	ret0, _ := ret[0].([]model.ListingSimilarityModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingSimilarities indicates an expected call of GetListingSimilarities.
+func (mr *MockRepoMockRecorder) GetListingSimilarities(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingSimilarities", reflect.TypeOf((*MockRepo)(nil).GetListingSimilarities), arg0, arg1)
+}
+
+// GetListingTranslations mocks base method.
+func (m *MockRepo) GetListingTranslations(arg0 context.Context, arg1 uuid.UUID) ([]model.ListingTranslationModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingTranslations", arg0, arg1)
+	ret0, _ := ret[0].([]model.ListingTranslationModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingTranslations indicates an expected call of GetListingTranslations.
+func (mr *MockRepoMockRecorder) GetListingTranslations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingTranslations", reflect.TypeOf((*MockRepo)(nil).GetListingTranslations), arg0, arg1)
+}
+
+// GetListingViewing mocks base method.
+func (m *MockRepo) GetListingViewing(arg0 context.Context, arg1 int64) (model.ListingViewingModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingViewing", arg0, arg1)
+	ret0, _ := ret[0].(model.ListingViewingModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingViewing indicates an expected call of GetListingViewing.
+func (mr *MockRepoMockRecorder) GetListingViewing(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingViewing", reflect.TypeOf((*MockRepo)(nil).GetListingViewing), arg0, arg1)
+}
+
+// GetListingViewingSlot mocks base method.
+func (m *MockRepo) GetListingViewingSlot(arg0 context.Context, arg1 int64) (model.ListingViewingSlotModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingViewingSlot", arg0, arg1)
+	ret0, _ := ret[0].(model.ListingViewingSlotModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingViewingSlot indicates an expected call of GetListingViewingSlot.
+func (mr *MockRepoMockRecorder) GetListingViewingSlot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingViewingSlot", reflect.TypeOf((*MockRepo)(nil).GetListingViewingSlot), arg0, arg1)
+}
+
+// GetListingViewingSlots mocks base method.
+func (m *MockRepo) GetListingViewingSlots(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) ([]model.ListingViewingSlotModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingViewingSlots", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.ListingViewingSlotModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingViewingSlots indicates an expected call of GetListingViewingSlots.
+func (mr *MockRepoMockRecorder) GetListingViewingSlots(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingViewingSlots", reflect.TypeOf((*MockRepo)(nil).GetListingViewingSlots), arg0, arg1, arg2)
+}
+
+// GetListingViewingsOfListing mocks base method.
+func (m *MockRepo) GetListingViewingsOfListing(arg0 context.Context, arg1 uuid.UUID) ([]model.ListingViewingModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingViewingsOfListing", arg0, arg1)
+	ret0, _ := ret[0].([]model.ListingViewingModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingViewingsOfListing indicates an expected call of GetListingViewingsOfListing.
+func (mr *MockRepoMockRecorder) GetListingViewingsOfListing(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingViewingsOfListing", reflect.TypeOf((*MockRepo)(nil).GetListingViewingsOfListing), arg0, arg1)
+}
+
+// GetListingViewingsOfSlot mocks base method.
+func (m *MockRepo) GetListingViewingsOfSlot(arg0 context.Context, arg1 int64) ([]model.ListingViewingModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingViewingsOfSlot", arg0, arg1)
+	ret0, _ := ret[0].([]model.ListingViewingModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingViewingsOfSlot indicates an expected call of GetListingViewingsOfSlot.
+func (mr *MockRepoMockRecorder) GetListingViewingsOfSlot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingViewingsOfSlot", reflect.TypeOf((*MockRepo)(nil).GetListingViewingsOfSlot), arg0, arg1)
+}
+
+// GetListingViewingsOfTenant mocks base method.
+func (m *MockRepo) GetListingViewingsOfTenant(arg0 context.Context, arg1 uuid.UUID) ([]model.ListingViewingModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingViewingsOfTenant", arg0, arg1)
+	ret0, _ := ret[0].([]model.ListingViewingModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingViewingsOfTenant indicates an expected call of GetListingViewingsOfTenant.
+func (mr *MockRepoMockRecorder) GetListingViewingsOfTenant(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingViewingsOfTenant", reflect.TypeOf((*MockRepo)(nil).GetListingViewingsOfTenant), arg0, arg1)
+}
+
+// GetListingViewingsToRemind mocks base method.
+func (m *MockRepo) GetListingViewingsToRemind(arg0 context.Context, arg1, arg2 int32) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingViewingsToRemind", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingViewingsToRemind indicates an expected call of GetListingViewingsToRemind.
+func (mr *MockRepoMockRecorder) GetListingViewingsToRemind(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingViewingsToRemind", reflect.TypeOf((*MockRepo)(nil).GetListingViewingsToRemind), arg0, arg1, arg2)
+}
+
+// GetListingsAfter mocks base method.
+func (m *MockRepo) GetListingsAfter(arg0 context.Context, arg1 uuid.UUID, arg2 int32) ([]model.ListingModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingsAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.ListingModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingsAfter indicates an expected call of GetListingsAfter.
+func (mr *MockRepoMockRecorder) GetListingsAfter(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingsAfter", reflect.TypeOf((*MockRepo)(nil).GetListingsAfter), arg0, arg1, arg2)
+}
+
+// GetListingsByIds mocks base method.
+func (m *MockRepo) GetListingsByIds(arg0 context.Context, arg1 []uuid.UUID, arg2 []string) ([]model.ListingModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingsByIds", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.ListingModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingsByIds indicates an expected call of GetListingsByIds.
+func (mr *MockRepoMockRecorder) GetListingsByIds(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingsByIds", reflect.TypeOf((*MockRepo)(nil).GetListingsByIds), arg0, arg1, arg2)
+}
+
+// GetListingsDuplicateFeatures mocks base method.
+func (m *MockRepo) GetListingsDuplicateFeatures(arg0 context.Context, arg1 []uuid.UUID) ([]model.ListingDuplicateFeatures, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingsDuplicateFeatures", arg0, arg1)
+	ret0, _ := ret[0].([]model.ListingDuplicateFeatures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingsDuplicateFeatures indicates an expected call of GetListingsDuplicateFeatures.
+func (mr *MockRepoMockRecorder) GetListingsDuplicateFeatures(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingsDuplicateFeatures", reflect.TypeOf((*MockRepo)(nil).GetListingsDuplicateFeatures), arg0, arg1)
+}
+
+// GetListingsToPublish mocks base method.
+func (m *MockRepo) GetListingsToPublish(arg0 context.Context, arg1 int32) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingsToPublish", arg0, arg1)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingsToPublish indicates an expected call of GetListingsToPublish.
+func (mr *MockRepoMockRecorder) GetListingsToPublish(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingsToPublish", reflect.TypeOf((*MockRepo)(nil).GetListingsToPublish), arg0, arg1)
+}
+
+// GetListingsToWarnExpiry mocks base method.
+func (m *MockRepo) GetListingsToWarnExpiry(arg0 context.Context, arg1, arg2 int32) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingsToWarnExpiry", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingsToWarnExpiry indicates an expected call of GetListingsToWarnExpiry.
+func (mr *MockRepoMockRecorder) GetListingsToWarnExpiry(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingsToWarnExpiry", reflect.TypeOf((*MockRepo)(nil).GetListingsToWarnExpiry), arg0, arg1, arg2)
+}
+
+// GetListingsTranslations mocks base method.
+func (m *MockRepo) GetListingsTranslations(arg0 context.Context, arg1 []uuid.UUID, arg2 string) (map[uuid.UUID]model.ListingTranslationModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingsTranslations", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[uuid.UUID]model.ListingTranslationModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingsTranslations indicates an expected call of GetListingsTranslations.
+func (mr *MockRepoMockRecorder) GetListingsTranslations(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingsTranslations", reflect.TypeOf((*MockRepo)(nil).GetListingsTranslations), arg0, arg1, arg2)
+}
+
+// GetModerationBannedWords mocks base method.
+func (m *MockRepo) GetModerationBannedWords(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationBannedWords", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationBannedWords indicates an expected call of GetModerationBannedWords.
+func (mr *MockRepoMockRecorder) GetModerationBannedWords(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationBannedWords", reflect.TypeOf((*MockRepo)(nil).GetModerationBannedWords), arg0)
+}
+
+// GetPendingSavedSearchMatches mocks base method.
+func (m *MockRepo) GetPendingSavedSearchMatches(arg0 context.Context, arg1 database.SAVEDSEARCHFREQUENCY) ([]dto.SavedSearchMatches, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingSavedSearchMatches", arg0, arg1)
+	ret0, _ := ret[0].([]dto.SavedSearchMatches)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingSavedSearchMatches indicates an expected call of GetPendingSavedSearchMatches.
+func (mr *MockRepoMockRecorder) GetPendingSavedSearchMatches(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingSavedSearchMatches", reflect.TypeOf((*MockRepo)(nil).GetPendingSavedSearchMatches), arg0, arg1)
+}
+
+// GetPropertyMediaHashes mocks base method.
+func (m *MockRepo) GetPropertyMediaHashes(arg0 context.Context, arg1 []int64) (map[int64]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPropertyMediaHashes", arg0, arg1)
+	ret0, _ := ret[0].(map[int64]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPropertyMediaHashes indicates an expected call of GetPropertyMediaHashes.
+func (mr *MockRepoMockRecorder) GetPropertyMediaHashes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPropertyMediaHashes", reflect.TypeOf((*MockRepo)(nil).GetPropertyMediaHashes), arg0, arg1)
+}
+
+// GetRentEstimate mocks base method.
+func (m *MockRepo) GetRentEstimate(arg0 context.Context, arg1 *dto.GetRentEstimateQuery, arg2 float64, arg3 int32) (model.RentEstimateModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRentEstimate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(model.RentEstimateModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRentEstimate indicates an expected call of GetRentEstimate.
+func (mr *MockRepoMockRecorder) GetRentEstimate(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRentEstimate", reflect.TypeOf((*MockRepo)(nil).GetRentEstimate), arg0, arg1, arg2, arg3)
+}
+
+// GetSavedSearch mocks base method.
+func (m *MockRepo) GetSavedSearch(arg0 context.Context, arg1 int64) (model.SavedSearchModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavedSearch", arg0, arg1)
+	ret0, _ := ret[0].(model.SavedSearchModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedSearch indicates an expected call of GetSavedSearch.
+func (mr *MockRepoMockRecorder) GetSavedSearch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedSearch", reflect.TypeOf((*MockRepo)(nil).GetSavedSearch), arg0, arg1)
+}
+
+// GetSavedSearchesOfUser mocks base method.
+func (m *MockRepo) GetSavedSearchesOfUser(arg0 context.Context, arg1 uuid.UUID) ([]model.SavedSearchModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavedSearchesOfUser", arg0, arg1)
+	ret0, _ := ret[0].([]model.SavedSearchModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedSearchesOfUser indicates an expected call of GetSavedSearchesOfUser.
+func (mr *MockRepoMockRecorder) GetSavedSearchesOfUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedSearchesOfUser", reflect.TypeOf((*MockRepo)(nil).GetSavedSearchesOfUser), arg0, arg1)
+}
+
+// GetSearchOutboxStats mocks base method.
+func (m *MockRepo) GetSearchOutboxStats(arg0 context.Context) (dto.SearchOutboxStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchOutboxStats", arg0)
+	ret0, _ := ret[0].(dto.SearchOutboxStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchOutboxStats indicates an expected call of GetSearchOutboxStats.
+func (mr *MockRepoMockRecorder) GetSearchOutboxStats(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchOutboxStats", reflect.TypeOf((*MockRepo)(nil).GetSearchOutboxStats), arg0)
+}
+
+// GetSimilarPropertyMedia mocks base method.
+func (m *MockRepo) GetSimilarPropertyMedia(arg0 context.Context, arg1 uuid.UUID, arg2 uint64, arg3 int, arg4 int32) ([]model1.PropertyMediaModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSimilarPropertyMedia", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]model1.PropertyMediaModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSimilarPropertyMedia indicates an expected call of GetSimilarPropertyMedia.
+func (mr *MockRepoMockRecorder) GetSimilarPropertyMedia(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimilarPropertyMedia", reflect.TypeOf((*MockRepo)(nil).GetSimilarPropertyMedia), arg0, arg1, arg2, arg3, arg4)
+}
+
+// MarkListingExpiryWarned mocks base method.
+func (m *MockRepo) MarkListingExpiryWarned(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkListingExpiryWarned", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkListingExpiryWarned indicates an expected call of MarkListingExpiryWarned.
+func (mr *MockRepoMockRecorder) MarkListingExpiryWarned(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkListingExpiryWarned", reflect.TypeOf((*MockRepo)(nil).MarkListingExpiryWarned), arg0, arg1)
+}
+
+// MarkListingViewingReminded mocks base method.
+func (m *MockRepo) MarkListingViewingReminded(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkListingViewingReminded", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkListingViewingReminded indicates an expected call of MarkListingViewingReminded.
+func (mr *MockRepoMockRecorder) MarkListingViewingReminded(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkListingViewingReminded", reflect.TypeOf((*MockRepo)(nil).MarkListingViewingReminded), arg0, arg1)
+}
+
+// MarkSavedSearchMatchesNotified mocks base method.
+func (m *MockRepo) MarkSavedSearchMatchesNotified(arg0 context.Context, arg1 int64, arg2 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSavedSearchMatchesNotified", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSavedSearchMatchesNotified indicates an expected call of MarkSavedSearchMatchesNotified.
+func (mr *MockRepoMockRecorder) MarkSavedSearchMatchesNotified(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSavedSearchMatchesNotified", reflect.TypeOf((*MockRepo)(nil).MarkSavedSearchMatchesNotified), arg0, arg1, arg2)
+}
+
+// MarkSearchOutboxEventsProcessed mocks base method.
+func (m *MockRepo) MarkSearchOutboxEventsProcessed(arg0 context.Context, arg1 []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSearchOutboxEventsProcessed", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSearchOutboxEventsProcessed indicates an expected call of MarkSearchOutboxEventsProcessed.
+func (mr *MockRepoMockRecorder) MarkSearchOutboxEventsProcessed(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSearchOutboxEventsProcessed", reflect.TypeOf((*MockRepo)(nil).MarkSearchOutboxEventsProcessed), arg0, arg1)
+}
+
+// PurgeSearchOutboxEvents mocks base method.
+func (m *MockRepo) PurgeSearchOutboxEvents(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeSearchOutboxEvents", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeSearchOutboxEvents indicates an expected call of PurgeSearchOutboxEvents.
+func (mr *MockRepoMockRecorder) PurgeSearchOutboxEvents(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeSearchOutboxEvents", reflect.TypeOf((*MockRepo)(nil).PurgeSearchOutboxEvents), arg0, arg1)
+}
+
+// RecordListingEvent mocks base method.
+func (m *MockRepo) RecordListingEvent(arg0 context.Context, arg1 dto.LISTINGEVENT, arg2 []uuid.UUID, arg3 string, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordListingEvent", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordListingEvent indicates an expected call of RecordListingEvent.
+func (mr *MockRepoMockRecorder) RecordListingEvent(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordListingEvent", reflect.TypeOf((*MockRepo)(nil).RecordListingEvent), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ReplaceListingDuplicateClusters mocks base method.
+func (m *MockRepo) ReplaceListingDuplicateClusters(arg0 context.Context, arg1 []int64, arg2 [][]uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceListingDuplicateClusters", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceListingDuplicateClusters indicates an expected call of ReplaceListingDuplicateClusters.
+func (mr *MockRepoMockRecorder) ReplaceListingDuplicateClusters(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceListingDuplicateClusters", reflect.TypeOf((*MockRepo)(nil).ReplaceListingDuplicateClusters), arg0, arg1, arg2)
+}
+
+// ReplaceListingRequiredDocuments mocks base method.
+func (m *MockRepo) ReplaceListingRequiredDocuments(arg0 context.Context, arg1 uuid.UUID, arg2 *dto.UpdateListingRequiredDocuments) ([]model.ListingRequiredDocumentModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceListingRequiredDocuments", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.ListingRequiredDocumentModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceListingRequiredDocuments indicates an expected call of ReplaceListingRequiredDocuments.
+func (mr *MockRepoMockRecorder) ReplaceListingRequiredDocuments(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceListingRequiredDocuments", reflect.TypeOf((*MockRepo)(nil).ReplaceListingRequiredDocuments), arg0, arg1, arg2)
+}
+
+// ReplaySearchOutboxEventsSince mocks base method.
+func (m *MockRepo) ReplaySearchOutboxEventsSince(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaySearchOutboxEventsSince", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaySearchOutboxEventsSince indicates an expected call of ReplaySearchOutboxEventsSince.
+func (mr *MockRepoMockRecorder) ReplaySearchOutboxEventsSince(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaySearchOutboxEventsSince", reflect.TypeOf((*MockRepo)(nil).ReplaySearchOutboxEventsSince), arg0, arg1)
+}
+
+// ReviewListingModeration mocks base method.
+func (m *MockRepo) ReviewListingModeration(arg0 context.Context, arg1 int64, arg2 *dto.ReviewListingModeration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewListingModeration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewListingModeration indicates an expected call of ReviewListingModeration.
+func (mr *MockRepoMockRecorder) ReviewListingModeration(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewListingModeration", reflect.TypeOf((*MockRepo)(nil).ReviewListingModeration), arg0, arg1, arg2)
+}
+
+// SaveFavoriteListing mocks base method.
+func (m *MockRepo) SaveFavoriteListing(arg0 context.Context, arg1 *dto.SaveFavoriteListing, arg2 float32) (model.FavoriteListingModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFavoriteListing", arg0, arg1, arg2)
+	ret0, _ := ret[0].(model.FavoriteListingModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveFavoriteListing indicates an expected call of SaveFavoriteListing.
+func (mr *MockRepoMockRecorder) SaveFavoriteListing(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFavoriteListing", reflect.TypeOf((*MockRepo)(nil).SaveFavoriteListing), arg0, arg1, arg2)
+}
+
+// SaveListingDailyStats mocks base method.
+func (m *MockRepo) SaveListingDailyStats(arg0 context.Context, arg1 time.Time, arg2 []dto.ListingDailyStats) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveListingDailyStats", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveListingDailyStats indicates an expected call of SaveListingDailyStats.
+func (mr *MockRepoMockRecorder) SaveListingDailyStats(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveListingDailyStats", reflect.TypeOf((*MockRepo)(nil).SaveListingDailyStats), arg0, arg1, arg2)
+}
+
+// SaveListingSimilarities mocks base method.
+func (m *MockRepo) SaveListingSimilarities(arg0 context.Context, arg1 uuid.UUID, arg2 []model.ListingSimilarityModel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveListingSimilarities", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveListingSimilarities indicates an expected call of SaveListingSimilarities.
+func (mr *MockRepoMockRecorder) SaveListingSimilarities(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveListingSimilarities", reflect.TypeOf((*MockRepo)(nil).SaveListingSimilarities), arg0, arg1, arg2)
+}
+
+// SavePropertyMediaHash mocks base method.
+func (m *MockRepo) SavePropertyMediaHash(arg0 context.Context, arg1 int64, arg2 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePropertyMediaHash", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePropertyMediaHash indicates an expected call of SavePropertyMediaHash.
+func (mr *MockRepoMockRecorder) SavePropertyMediaHash(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePropertyMediaHash", reflect.TypeOf((*MockRepo)(nil).SavePropertyMediaHash), arg0, arg1, arg2)
+}
+
+// SearchListingCombination mocks base method.
+func (m *MockRepo) SearchListingCombination(arg0 context.Context, arg1 *dto.SearchListingCombinationQuery) (*dto.SearchListingCombinationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchListingCombination", arg0, arg1)
+	ret0, _ := ret[0].(*dto.SearchListingCombinationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchListingCombination indicates an expected call of SearchListingCombination.
+func (mr *MockRepoMockRecorder) SearchListingCombination(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchListingCombination", reflect.TypeOf((*MockRepo)(nil).SearchListingCombination), arg0, arg1)
+}
+
+// SetListingViewingSlotReminder mocks base method.
+func (m *MockRepo) SetListingViewingSlotReminder(arg0 context.Context, arg1, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetListingViewingSlotReminder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetListingViewingSlotReminder indicates an expected call of SetListingViewingSlotReminder.
+func (mr *MockRepoMockRecorder) SetListingViewingSlotReminder(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetListingViewingSlotReminder", reflect.TypeOf((*MockRepo)(nil).SetListingViewingSlotReminder), arg0, arg1, arg2)
+}
+
+// UpdateFavoriteFolder mocks base method.
+func (m *MockRepo) UpdateFavoriteFolder(arg0 context.Context, arg1 int64, arg2 *dto.UpdateFavoriteFolder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFavoriteFolder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFavoriteFolder indicates an expected call of UpdateFavoriteFolder.
+func (mr *MockRepoMockRecorder) UpdateFavoriteFolder(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFavoriteFolder", reflect.TypeOf((*MockRepo)(nil).UpdateFavoriteFolder), arg0, arg1, arg2)
+}
+
+// UpdateListing mocks base method.
+func (m *MockRepo) UpdateListing(arg0 context.Context, arg1 uuid.UUID, arg2 *dto.UpdateListing) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListing", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateListing indicates an expected call of UpdateListing.
+func (mr *MockRepoMockRecorder) UpdateListing(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListing", reflect.TypeOf((*MockRepo)(nil).UpdateListing), arg0, arg1, arg2)
+}
+
+// UpdateListingExpiration mocks base method.
+func (m *MockRepo) UpdateListingExpiration(arg0 context.Context, arg1 uuid.UUID, arg2 int64) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListingExpiration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateListingExpiration indicates an expected call of UpdateListingExpiration.
+func (mr *MockRepoMockRecorder) UpdateListingExpiration(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListingExpiration", reflect.TypeOf((*MockRepo)(nil).UpdateListingExpiration), arg0, arg1, arg2)
+}
+
+// UpdateListingModerationFlags mocks base method.
+func (m *MockRepo) UpdateListingModerationFlags(arg0 context.Context, arg1 int64, arg2 []model.ModerationFlag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListingModerationFlags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateListingModerationFlags indicates an expected call of UpdateListingModerationFlags.
+func (mr *MockRepoMockRecorder) UpdateListingModerationFlags(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListingModerationFlags", reflect.TypeOf((*MockRepo)(nil).UpdateListingModerationFlags), arg0, arg1, arg2)
+}
+
+// UpdateListingPriority mocks base method.
+func (m *MockRepo) UpdateListingPriority(arg0 context.Context, arg1 uuid.UUID, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListingPriority", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateListingPriority indicates an expected call of UpdateListingPriority.
+func (mr *MockRepoMockRecorder) UpdateListingPriority(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListingPriority", reflect.TypeOf((*MockRepo)(nil).UpdateListingPriority), arg0, arg1, arg2)
+}
+
+// UpdateListingQuestion mocks base method.
+func (m *MockRepo) UpdateListingQuestion(arg0 context.Context, arg1 uuid.UUID, arg2 int64, arg3 *dto.UpdateListingQuestion) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListingQuestion", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateListingQuestion indicates an expected call of UpdateListingQuestion.
+func (mr *MockRepoMockRecorder) UpdateListingQuestion(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListingQuestion", reflect.TypeOf((*MockRepo)(nil).UpdateListingQuestion), arg0, arg1, arg2, arg3)
+}
+
+// UpdateListingStatus mocks base method.
+func (m *MockRepo) UpdateListingStatus(arg0 context.Context, arg1 uuid.UUID, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListingStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateListingStatus indicates an expected call of UpdateListingStatus.
+func (mr *MockRepoMockRecorder) UpdateListingStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListingStatus", reflect.TypeOf((*MockRepo)(nil).UpdateListingStatus), arg0, arg1, arg2)
+}
+
+// UpdateListingViewingStatus mocks base method.
+func (m *MockRepo) UpdateListingViewingStatus(arg0 context.Context, arg1 int64, arg2, arg3 database.LISTINGVIEWINGSTATUS) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListingViewingStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateListingViewingStatus indicates an expected call of UpdateListingViewingStatus.
+func (mr *MockRepoMockRecorder) UpdateListingViewingStatus(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListingViewingStatus", reflect.TypeOf((*MockRepo)(nil).UpdateListingViewingStatus), arg0, arg1, arg2, arg3)
+}
+
+// UpdateSavedSearch mocks base method.
+func (m *MockRepo) UpdateSavedSearch(arg0 context.Context, arg1 int64, arg2 *dto.UpdateSavedSearch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSavedSearch", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSavedSearch indicates an expected call of UpdateSavedSearch.
+func (mr *MockRepoMockRecorder) UpdateSavedSearch(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSavedSearch", reflect.TypeOf((*MockRepo)(nil).UpdateSavedSearch), arg0, arg1, arg2)
+}
+
+// UpdateSavedSearchLastCheckedAt mocks base method.
+func (m *MockRepo) UpdateSavedSearchLastCheckedAt(arg0 context.Context, arg1 int64, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSavedSearchLastCheckedAt", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSavedSearchLastCheckedAt indicates an expected call of UpdateSavedSearchLastCheckedAt.
+func (mr *MockRepoMockRecorder) UpdateSavedSearchLastCheckedAt(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSavedSearchLastCheckedAt", reflect.TypeOf((*MockRepo)(nil).UpdateSavedSearchLastCheckedAt), arg0, arg1, arg2)
+}
+
+// UpsertListingTranslation mocks base method.
+func (m *MockRepo) UpsertListingTranslation(arg0 context.Context, arg1 uuid.UUID, arg2 *dto.CreateListingTranslation) (model.ListingTranslationModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertListingTranslation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(model.ListingTranslationModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertListingTranslation indicates an expected call of UpsertListingTranslation.
+func (mr *MockRepoMockRecorder) UpsertListingTranslation(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertListingTranslation", reflect.TypeOf((*MockRepo)(nil).UpsertListingTranslation), arg0, arg1, arg2)
 }
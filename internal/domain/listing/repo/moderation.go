@@ -0,0 +1,152 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	property_model "github.com/user2410/rrms-backend/internal/domain/property/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func (r *repo) CreateListingModeration(ctx context.Context, listingId uuid.UUID) (model.ListingModerationModel, error) {
+	res, err := r.dao.CreateListingModeration(ctx, listingId)
+	if err != nil {
+		return model.ListingModerationModel{}, err
+	}
+	return model.ToListingModerationModel(&res)
+}
+
+func (r *repo) GetListingModeration(ctx context.Context, id int64) (model.ListingModerationModel, error) {
+	res, err := r.dao.GetListingModeration(ctx, id)
+	if err != nil {
+		return model.ListingModerationModel{}, err
+	}
+	return model.ToListingModerationModel(&res)
+}
+
+func (r *repo) GetLatestListingModeration(ctx context.Context, listingId uuid.UUID) (model.ListingModerationModel, error) {
+	res, err := r.dao.GetLatestListingModeration(ctx, listingId)
+	if err != nil {
+		return model.ListingModerationModel{}, err
+	}
+	return model.ToListingModerationModel(&res)
+}
+
+// GetListingModerations returns the moderations of the given status, the most flagged first
+func (r *repo) GetListingModerations(ctx context.Context, status database.LISTINGMODERATIONSTATUS, limit, offset int32) ([]model.ListingModerationModel, error) {
+	res, err := r.dao.GetListingModerations(ctx, database.GetListingModerationsParams{
+		Status: status,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.ListingModerationModel, 0, len(res))
+	for i := range res {
+		m, err := model.ToListingModerationModel(&res[i])
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, m)
+	}
+	return items, nil
+}
+
+func (r *repo) UpdateListingModerationFlags(ctx context.Context, id int64, flags []model.ModerationFlag) error {
+	if flags == nil {
+		flags = []model.ModerationFlag{}
+	}
+	data, err := json.Marshal(flags)
+	if err != nil {
+		return err
+	}
+	return r.dao.UpdateListingModerationFlags(ctx, database.UpdateListingModerationFlagsParams{
+		ID:    id,
+		Flags: data,
+	})
+}
+
+// ReviewListingModeration records the decision on a pending moderation, it returns false if the moderation is not pending anymore
+func (r *repo) ReviewListingModeration(ctx context.Context, id int64, data *dto.ReviewListingModeration) (bool, error) {
+	n, err := r.dao.ReviewListingModeration(ctx, database.ReviewListingModerationParams{
+		ID:         id,
+		Status:     data.Status,
+		Reason:     types.StrN(data.Reason),
+		ReviewerID: data.ReviewerID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *repo) GetModerationBannedWords(ctx context.Context) ([]string, error) {
+	return r.dao.GetModerationBannedWords(ctx)
+}
+
+// GetDistrictListingPriceStats returns the number of active listings of the same property type in the district,
+// the given listing left out, and the median of their prices per m²
+func (r *repo) GetDistrictListingPriceStats(ctx context.Context, listingId uuid.UUID, property *property_model.PropertyModel) (int64, float64, error) {
+	res, err := r.dao.GetDistrictListingPriceStats(ctx, database.GetDistrictListingPriceStatsParams{
+		ListingID: listingId,
+		City:      property.City,
+		District:  property.District,
+		Type:      database.PROPERTYTYPE(property.Type),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return res.Samples, res.MedianPricePerArea, nil
+}
+
+// GetPropertyMediaHashes returns the image hashes of the media already hashed, by media id
+func (r *repo) GetPropertyMediaHashes(ctx context.Context, mediaIds []int64) (map[int64]uint64, error) {
+	res, err := r.dao.GetPropertyMediaHashes(ctx, mediaIds)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make(map[int64]uint64, len(res))
+	for _, h := range res {
+		hashes[h.MediaID] = uint64(h.Hash)
+	}
+	return hashes, nil
+}
+
+func (r *repo) SavePropertyMediaHash(ctx context.Context, mediaId int64, hash uint64) error {
+	return r.dao.UpsertPropertyMediaHash(ctx, database.UpsertPropertyMediaHashParams{
+		MediaID: mediaId,
+		Hash:    int64(hash),
+	})
+}
+
+// GetSimilarPropertyMedia returns the images of the other properties whose hash is within maxDistance bits of the given one
+func (r *repo) GetSimilarPropertyMedia(ctx context.Context, propertyId uuid.UUID, hash uint64, maxDistance int, limit int32) ([]property_model.PropertyMediaModel, error) {
+	if limit <= 0 {
+		limit = math.MaxInt32
+	}
+	res, err := r.dao.GetSimilarPropertyMedia(ctx, database.GetSimilarPropertyMediaParams{
+		PropertyID:  propertyId,
+		Hash:        int64(hash),
+		MaxDistance: int32(maxDistance),
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]property_model.PropertyMediaModel, 0, len(res))
+	for _, m := range res {
+		items = append(items, property_model.PropertyMediaModel{
+			ID:         m.ID,
+			PropertyID: m.PropertyID,
+			Url:        m.Url,
+			Type:       database.MEDIATYPEIMAGE,
+		})
+	}
+	return items, nil
+}
@@ -0,0 +1,83 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// GetListingPriceHistory returns the price changes of the listing and of its units, the most recent first
+func (r *repo) GetListingPriceHistory(ctx context.Context, id uuid.UUID) ([]model.ListingPriceHistoryModel, error) {
+	res, err := r.dao.GetListingPriceHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.ListingPriceHistoryModel, 0, len(res))
+	for i := range res {
+		items = append(items, model.ToListingPriceHistoryModel(&res[i]))
+	}
+	return items, nil
+}
+
+// GetRentEstimate sums up the prices of the units comparable to the described one,
+// in the ward if one is given, in the district otherwise
+func (r *repo) GetRentEstimate(ctx context.Context, query *dto.GetRentEstimateQuery, areaTolerance float64, rentedWithinDays int32) (model.RentEstimateModel, error) {
+	res, err := r.dao.GetRentComparablesStats(ctx, database.GetRentComparablesStatsParams{
+		City:              query.City,
+		District:          query.District,
+		Ward:              types.StrN(query.Ward),
+		Type:              database.PROPERTYTYPE(query.PropertyType),
+		MinArea:           query.Area * float32(1-areaTolerance),
+		MaxArea:           query.Area * float32(1+areaTolerance),
+		NumberOfBedrooms:  types.Int32N(query.NumberOfBedrooms),
+		NumberOfBathrooms: types.Int32N(query.NumberOfBathrooms),
+		RentedWithinDays:  rentedWithinDays,
+	})
+	if err != nil {
+		return model.RentEstimateModel{}, err
+	}
+	return model.RentEstimateModel{
+		Samples:  res.Samples,
+		SameWard: query.Ward != nil,
+		P10:      res.P10,
+		P25:      res.P25,
+		Median:   res.Median,
+		P75:      res.P75,
+		P90:      res.P90,
+	}, nil
+}
+
+// recordListingPriceChanges saves the changes between the old prices of the listing and of its units and the new ones.
+// Units added to or removed from the listing have no price change.
+func recordListingPriceChanges(ctx context.Context, tx database.DAO, id uuid.UUID, oldPrice float32, oldUnits []database.ListingUnit, data *dto.UpdateListing) error {
+	if data.Price != nil && *data.Price != oldPrice {
+		if err := tx.CreateListingPriceHistory(ctx, database.CreateListingPriceHistoryParams{
+			ListingID: id,
+			OldPrice:  oldPrice,
+			NewPrice:  *data.Price,
+		}); err != nil {
+			return err
+		}
+	}
+	for _, u := range data.Units {
+		for _, ou := range oldUnits {
+			if ou.UnitID != u.UnitID || ou.Price == u.Price {
+				continue
+			}
+			if err := tx.CreateListingPriceHistory(ctx, database.CreateListingPriceHistoryParams{
+				ListingID: id,
+				UnitID:    pgtype.UUID{Bytes: u.UnitID, Valid: true},
+				OldPrice:  float32(ou.Price),
+				NewPrice:  float32(u.Price),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func TestListingPriceHistory(t *testing.T) {
+	ctx := context.Background()
+	listing := NewRandomListingDB(t, testAuthRepo, testPropertyRepo, testUnitRepo, testListingRepo)
+
+	history, err := testListingRepo.GetListingPriceHistory(ctx, listing.ID)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	// updates leaving the prices unchanged are not recorded
+	err = testListingRepo.UpdateListing(ctx, listing.ID, &dto.UpdateListing{
+		Title: types.Ptr("Căn hộ mới sửa"),
+		Price: types.Ptr(listing.Price),
+	})
+	require.NoError(t, err)
+	history, err = testListingRepo.GetListingPriceHistory(ctx, listing.ID)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	// a new price of the listing
+	newPrice := listing.Price + 500000
+	err = testListingRepo.UpdateListing(ctx, listing.ID, &dto.UpdateListing{Price: &newPrice})
+	require.NoError(t, err)
+	history, err = testListingRepo.GetListingPriceHistory(ctx, listing.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, listing.ID, history[0].ListingID)
+	require.Nil(t, history[0].UnitID)
+	require.Equal(t, listing.Price, history[0].OldPrice)
+	require.Equal(t, newPrice, history[0].NewPrice)
+
+	// a new price of one of the units, the other units keep theirs
+	units := make([]dto.CreateListingUnit, 0, len(listing.Units))
+	for _, lu := range listing.Units {
+		units = append(units, dto.CreateListingUnit{UnitID: lu.UnitID, Price: lu.Price})
+	}
+	changed := listing.Units[0]
+	units[0].Price = changed.Price + 200000
+	err = testListingRepo.UpdateListing(ctx, listing.ID, &dto.UpdateListing{Units: units})
+	require.NoError(t, err)
+	history, err = testListingRepo.GetListingPriceHistory(ctx, listing.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	// the most recent change first
+	require.NotNil(t, history[0].UnitID)
+	require.Equal(t, changed.UnitID, *history[0].UnitID)
+	require.Equal(t, float32(changed.Price), history[0].OldPrice)
+	require.Equal(t, float32(units[0].Price), history[0].NewPrice)
+	require.Nil(t, history[1].UnitID)
+	require.False(t, history[0].ChangedAt.Before(history[1].ChangedAt))
+}
@@ -0,0 +1,43 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+func (r *repo) CreateListingQuestion(ctx context.Context, listingId uuid.UUID, data *dto.CreateListingQuestion) (model.ListingQuestionModel, error) {
+	res, err := r.dao.CreateListingQuestion(ctx, data.ToCreateListingQuestionDB(listingId))
+	if err != nil {
+		return model.ListingQuestionModel{}, err
+	}
+	return model.ToListingQuestionModel(&res), nil
+}
+
+func (r *repo) GetListingQuestions(ctx context.Context, listingId uuid.UUID) ([]model.ListingQuestionModel, error) {
+	res, err := r.dao.GetListingQuestions(ctx, listingId)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.ListingQuestionModel, 0, len(res))
+	for i := range res {
+		items = append(items, model.ToListingQuestionModel(&res[i]))
+	}
+	return items, nil
+}
+
+func (r *repo) UpdateListingQuestion(ctx context.Context, listingId uuid.UUID, id int64, data *dto.UpdateListingQuestion) (bool, error) {
+	n, err := r.dao.UpdateListingQuestion(ctx, data.ToUpdateListingQuestionDB(listingId, id))
+	return n > 0, err
+}
+
+func (r *repo) DeleteListingQuestion(ctx context.Context, listingId uuid.UUID, id int64) (bool, error) {
+	n, err := r.dao.DeleteListingQuestion(ctx, database.DeleteListingQuestionParams{
+		ID:        id,
+		ListingID: listingId,
+	})
+	return n > 0, err
+}
@@ -0,0 +1,63 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+func (r *repo) CountListings(ctx context.Context) (int64, error) {
+	return r.dao.CountListings(ctx)
+}
+
+// GetListingRevisionsAfter pages through the listings ordered by id, starting after the given id
+func (r *repo) GetListingRevisionsAfter(ctx context.Context, after uuid.UUID, limit int32) ([]dto.ListingRevision, error) {
+	res, err := r.dao.GetListingIdsAfter(ctx, database.GetListingIdsAfterParams{
+		ID:    after,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]dto.ListingRevision, 0, len(res))
+	for _, row := range res {
+		revisions = append(revisions, dto.ListingRevision{
+			ID:        row.ID,
+			UpdatedAt: row.UpdatedAt,
+		})
+	}
+	return revisions, nil
+}
+
+// GetListingsAfter pages through the listings ordered by id, bypassing the cache so that a full scan does not fill it
+func (r *repo) GetListingsAfter(ctx context.Context, after uuid.UUID, limit int32) ([]model.ListingModel, error) {
+	revisions, err := r.GetListingRevisionsAfter(ctx, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	listings := make([]model.ListingModel, 0, len(revisions))
+	for _, rev := range revisions {
+		l, err := r.getListingByIDWithoutCache(ctx, rev.ID)
+		if err != nil {
+			return nil, err
+		}
+		listings = append(listings, *l)
+	}
+	return listings, nil
+}
+
+func (r *repo) FilterExistingListings(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	return r.dao.GetExistingListingIds(ctx, ids)
+}
+
+func (r *repo) CountSearchOutboxEventsSince(ctx context.Context, since time.Time) (int64, error) {
+	return r.dao.CountSearchOutboxEventsSince(ctx, since)
+}
+
+func (r *repo) ReplaySearchOutboxEventsSince(ctx context.Context, since time.Time) (int64, error) {
+	return r.dao.ReplaySearchOutboxEventsSince(ctx, since)
+}
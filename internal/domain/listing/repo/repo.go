@@ -15,6 +15,7 @@ import (
 	"github.com/user2410/rrms-backend/internal/domain/listing/repo/sqlbuild"
 	payment_model "github.com/user2410/rrms-backend/internal/domain/payment/model"
 	payment_service "github.com/user2410/rrms-backend/internal/domain/payment/service"
+	property_model "github.com/user2410/rrms-backend/internal/domain/property/model"
 	"github.com/user2410/rrms-backend/internal/infrastructure/database"
 	"github.com/user2410/rrms-backend/internal/infrastructure/redisd"
 )
@@ -38,6 +39,107 @@ type Repo interface {
 	CheckValidUnitForListing(ctx context.Context, lid uuid.UUID, uid uuid.UUID) (bool, error)
 	CheckListingExpired(ctx context.Context, lid uuid.UUID) (bool, error)
 	FilterVisibleListings(ctx context.Context, lids []uuid.UUID, uid uuid.UUID) ([]uuid.UUID, error)
+
+	// Search outbox
+	CreateSearchOutboxEvent(ctx context.Context, lid uuid.UUID) error
+	ClaimSearchOutboxEvents(ctx context.Context, limit int32) ([]model.SearchOutboxEventModel, error)
+	MarkSearchOutboxEventsProcessed(ctx context.Context, ids []int64) error
+	FailSearchOutboxEvents(ctx context.Context, ids []int64, lastError string, maxAttempts int32) error
+	GetSearchOutboxStats(ctx context.Context) (dto.SearchOutboxStats, error)
+	PurgeSearchOutboxEvents(ctx context.Context, before time.Time) (int64, error)
+	CountSearchOutboxEventsSince(ctx context.Context, since time.Time) (int64, error)
+	ReplaySearchOutboxEventsSince(ctx context.Context, since time.Time) (int64, error)
+
+	// Reindexing
+	CountListings(ctx context.Context) (int64, error)
+	GetListingRevisionsAfter(ctx context.Context, after uuid.UUID, limit int32) ([]dto.ListingRevision, error)
+	GetListingsAfter(ctx context.Context, after uuid.UUID, limit int32) ([]model.ListingModel, error)
+	FilterExistingListings(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error)
+
+	// Saved searches
+	CreateSavedSearch(ctx context.Context, data *dto.CreateSavedSearch) (model.SavedSearchModel, error)
+	GetSavedSearch(ctx context.Context, id int64) (model.SavedSearchModel, error)
+	GetSavedSearchesOfUser(ctx context.Context, userId uuid.UUID) ([]model.SavedSearchModel, error)
+	GetActiveSavedSearchesAfter(ctx context.Context, after int64, limit int32) ([]model.SavedSearchModel, error)
+	UpdateSavedSearch(ctx context.Context, id int64, data *dto.UpdateSavedSearch) error
+	UpdateSavedSearchLastCheckedAt(ctx context.Context, id int64, checkedAt time.Time) error
+	DeleteSavedSearch(ctx context.Context, id int64) error
+	CreateSavedSearchMatches(ctx context.Context, ss *model.SavedSearchModel, lids []uuid.UUID) ([]uuid.UUID, error)
+	GetPendingSavedSearchMatches(ctx context.Context, frequency database.SAVEDSEARCHFREQUENCY) ([]dto.SavedSearchMatches, error)
+	MarkSavedSearchMatchesNotified(ctx context.Context, id int64, lids []uuid.UUID) error
+
+	// Favorites
+	CreateFavoriteFolder(ctx context.Context, data *dto.CreateFavoriteFolder) (model.FavoriteFolderModel, error)
+	GetFavoriteFolder(ctx context.Context, id int64) (model.FavoriteFolderModel, error)
+	GetFavoriteFoldersOfUser(ctx context.Context, userId uuid.UUID) ([]model.FavoriteFolderModel, error)
+	UpdateFavoriteFolder(ctx context.Context, id int64, data *dto.UpdateFavoriteFolder) error
+	DeleteFavoriteFolder(ctx context.Context, id int64) error
+	SaveFavoriteListing(ctx context.Context, data *dto.SaveFavoriteListing, price float32) (model.FavoriteListingModel, error)
+	GetFavoriteListingsOfUser(ctx context.Context, userId uuid.UUID, query *dto.GetFavoriteListingsQuery) ([]dto.FavoriteListing, error)
+	DeleteFavoriteListing(ctx context.Context, userId, listingId uuid.UUID) error
+
+	// Analytics
+	RecordListingEvent(ctx context.Context, event dto.LISTINGEVENT, lids []uuid.UUID, session string, at time.Time) error
+	GetCachedListingDailyStats(ctx context.Context, date time.Time) ([]dto.ListingDailyStats, error)
+	SaveListingDailyStats(ctx context.Context, date time.Time, stats []dto.ListingDailyStats) error
+	// Moderation
+	CreateListingModeration(ctx context.Context, listingId uuid.UUID) (model.ListingModerationModel, error)
+	GetListingModeration(ctx context.Context, id int64) (model.ListingModerationModel, error)
+	GetLatestListingModeration(ctx context.Context, listingId uuid.UUID) (model.ListingModerationModel, error)
+	GetListingModerations(ctx context.Context, status database.LISTINGMODERATIONSTATUS, limit, offset int32) ([]model.ListingModerationModel, error)
+	UpdateListingModerationFlags(ctx context.Context, id int64, flags []model.ModerationFlag) error
+	ReviewListingModeration(ctx context.Context, id int64, data *dto.ReviewListingModeration) (bool, error)
+	GetModerationBannedWords(ctx context.Context) ([]string, error)
+	GetDistrictListingPriceStats(ctx context.Context, listingId uuid.UUID, property *property_model.PropertyModel) (int64, float64, error)
+	GetPropertyMediaHashes(ctx context.Context, mediaIds []int64) (map[int64]uint64, error)
+	SavePropertyMediaHash(ctx context.Context, mediaId int64, hash uint64) error
+	GetSimilarPropertyMedia(ctx context.Context, propertyId uuid.UUID, hash uint64, maxDistance int, limit int32) ([]property_model.PropertyMediaModel, error)
+	// Duplicates
+	GetListingDuplicateCandidates(ctx context.Context, f *model.ListingDuplicateFeatures, priceTolerance float64, limit int32) ([]uuid.UUID, error)
+	GetListingsDuplicateFeatures(ctx context.Context, ids []uuid.UUID) ([]model.ListingDuplicateFeatures, error)
+	SaveListingSimilarities(ctx context.Context, listingId uuid.UUID, similarities []model.ListingSimilarityModel) error
+	GetListingSimilarities(ctx context.Context, ids []uuid.UUID) ([]model.ListingSimilarityModel, error)
+	GetListingDuplicates(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]int64, error)
+	GetListingDuplicateClusterMembers(ctx context.Context, clusterIds []int64) (map[int64][]uuid.UUID, error)
+	ReplaceListingDuplicateClusters(ctx context.Context, oldIds []int64, clusters [][]uuid.UUID) error
+	GetListingDuplicateCluster(ctx context.Context, id int64) (model.ListingDuplicateClusterModel, error)
+	GetListingDuplicateClusters(ctx context.Context, limit, offset int32) ([]model.ListingDuplicateClusterModel, error)
+	// Lifecycle
+	GetListingsToPublish(ctx context.Context, limit int32) ([]uuid.UUID, error)
+	GetExpiredActiveListings(ctx context.Context, limit int32) ([]uuid.UUID, error)
+	GetListingsToWarnExpiry(ctx context.Context, days int32, limit int32) ([]uuid.UUID, error)
+	MarkListingExpiryWarned(ctx context.Context, id uuid.UUID) error
+	// Prices
+	GetListingPriceHistory(ctx context.Context, id uuid.UUID) ([]model.ListingPriceHistoryModel, error)
+	GetRentEstimate(ctx context.Context, query *dto.GetRentEstimateQuery, areaTolerance float64, rentedWithinDays int32) (model.RentEstimateModel, error)
+	// Viewings
+	CreateListingViewingSlots(ctx context.Context, managerId uuid.UUID, slots []database.CreateListingViewingSlotParams) ([]model.ListingViewingSlotModel, bool, error)
+	GetListingViewingSlot(ctx context.Context, id int64) (model.ListingViewingSlotModel, error)
+	GetListingViewingSlots(ctx context.Context, listingId uuid.UUID, after time.Time) ([]model.ListingViewingSlotModel, error)
+	SetListingViewingSlotReminder(ctx context.Context, id int64, reminderId int64) error
+	DeleteListingViewingSlot(ctx context.Context, id int64) error
+	BookListingViewing(ctx context.Context, data *dto.BookListingViewing) (model.ListingViewingModel, bool, error)
+	CancelListingViewing(ctx context.Context, id int64) (bool, error)
+	UpdateListingViewingStatus(ctx context.Context, id int64, from, to database.LISTINGVIEWINGSTATUS) (bool, error)
+	GetListingViewing(ctx context.Context, id int64) (model.ListingViewingModel, error)
+	GetListingViewingsOfSlot(ctx context.Context, slotId int64) ([]model.ListingViewingModel, error)
+	GetListingViewingsOfListing(ctx context.Context, listingId uuid.UUID) ([]model.ListingViewingModel, error)
+	GetListingViewingsOfTenant(ctx context.Context, tenantId uuid.UUID) ([]model.ListingViewingModel, error)
+	GetListingViewingsToRemind(ctx context.Context, hours int32, limit int32) ([]int64, error)
+	MarkListingViewingReminded(ctx context.Context, id int64) error
+	// Translations
+	UpsertListingTranslation(ctx context.Context, listingId uuid.UUID, data *dto.CreateListingTranslation) (model.ListingTranslationModel, error)
+	GetListingTranslations(ctx context.Context, listingId uuid.UUID) ([]model.ListingTranslationModel, error)
+	GetListingsTranslations(ctx context.Context, ids []uuid.UUID, language string) (map[uuid.UUID]model.ListingTranslationModel, error)
+	DeleteListingTranslation(ctx context.Context, listingId uuid.UUID, language string) (bool, error)
+	// Required documents
+	GetListingRequiredDocuments(ctx context.Context, listingId uuid.UUID) ([]model.ListingRequiredDocumentModel, error)
+	ReplaceListingRequiredDocuments(ctx context.Context, listingId uuid.UUID, data *dto.UpdateListingRequiredDocuments) ([]model.ListingRequiredDocumentModel, error)
+	// Questions
+	CreateListingQuestion(ctx context.Context, listingId uuid.UUID, data *dto.CreateListingQuestion) (model.ListingQuestionModel, error)
+	GetListingQuestions(ctx context.Context, listingId uuid.UUID) ([]model.ListingQuestionModel, error)
+	UpdateListingQuestion(ctx context.Context, listingId uuid.UUID, id int64, data *dto.UpdateListingQuestion) (bool, error)
+	DeleteListingQuestion(ctx context.Context, listingId uuid.UUID, id int64) (bool, error)
 }
 
 type repo struct {
@@ -54,16 +156,16 @@ func NewRepo(d database.DAO, redisClient redisd.RedisClient) Repo {
 
 func (r *repo) CreateListing(ctx context.Context, data *dto.CreateListing) (*model.ListingModel, error) {
 	var lm *model.ListingModel
-	res, err := r.dao.CreateListing(ctx, *data.ToCreateListingDB())
-	if err != nil {
-		return nil, err
-	}
-	lm = model.ToListingModel(&res)
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		res, err := tx.CreateListing(ctx, *data.ToCreateListingDB())
+		if err != nil {
+			return err
+		}
+		lm = model.ToListingModel(&res)
 
-	err = func() error {
 		for i := 0; i < len(data.Units); i++ {
 			u := &data.Units[i]
-			lu, err := r.dao.CreateListingUnit(ctx, database.CreateListingUnitParams{
+			lu, err := tx.CreateListingUnit(ctx, database.CreateListingUnitParams{
 				ListingID: lm.ID,
 				UnitID:    u.UnitID,
 				Price:     u.Price,
@@ -76,7 +178,7 @@ func (r *repo) CreateListing(ctx context.Context, data *dto.CreateListing) (*mod
 
 		for i := 0; i < len(data.Policies); i++ {
 			p := &data.Policies[i]
-			lp, err := r.dao.CreateListingPolicy(ctx, *p.ToCreateListingPolicyDB(lm.ID))
+			lp, err := tx.CreateListingPolicy(ctx, *p.ToCreateListingPolicyDB(lm.ID))
 			if err != nil {
 				return err
 			}
@@ -84,7 +186,7 @@ func (r *repo) CreateListing(ctx context.Context, data *dto.CreateListing) (*mod
 		}
 
 		for i := 0; i < len(data.Tags); i++ {
-			lt, err := r.dao.CreateListingTag(ctx, database.CreateListingTagParams{
+			lt, err := tx.CreateListingTag(ctx, database.CreateListingTagParams{
 				ListingID: lm.ID,
 				Tag:       data.Tags[i],
 			})
@@ -94,12 +196,17 @@ func (r *repo) CreateListing(ctx context.Context, data *dto.CreateListing) (*mod
 			lm.Tags = append(lm.Tags, model.ListingTagModel(lt))
 		}
 
-		return nil
-	}()
+		for i := 0; i < len(data.Translations); i++ {
+			_, err := tx.UpsertListingTranslation(ctx, data.Translations[i].ToUpsertListingTranslationDB(lm.ID))
+			if err != nil {
+				return err
+			}
+		}
 
-	if err != nil {
-		_ = r.dao.DeleteListing(ctx, lm.ID)
-		return nil, err
+		return enqueueSearchSync(ctx, tx, lm.ID)
+	})
+	if txErr != nil {
+		return nil, txErr
 	}
 
 	// save to cache
@@ -193,6 +300,12 @@ func (r *repo) GetListingsByIds(ctx context.Context, ids []uuid.UUID, fields []s
 			scanningFields = append(scanningFields, &i.CreatedAt)
 		case "updated_at":
 			scanningFields = append(scanningFields, &i.UpdatedAt)
+		case "post_at":
+			scanningFields = append(scanningFields, &i.PostAt)
+		case "auto_renew":
+			scanningFields = append(scanningFields, &i.AutoRenew)
+		case "application_validity_days":
+			scanningFields = append(scanningFields, &i.ApplicationValidityDays)
 		}
 	}
 	for rows.Next() {
@@ -286,6 +399,9 @@ func (r *repo) GetListingByID(ctx context.Context, id uuid.UUID) (*model.Listing
 	}
 
 	res, err := r.getListingByIDWithoutCache(ctx, id)
+	if err != nil {
+		return nil, err
+	}
 
 	// save to cache
 	r.saveListingToCache(ctx, res)
@@ -295,31 +411,47 @@ func (r *repo) GetListingByID(ctx context.Context, id uuid.UUID) (*model.Listing
 
 func (r *repo) UpdateListing(ctx context.Context, id uuid.UUID, data *dto.UpdateListing) error {
 	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
-		err := r.dao.UpdateListing(ctx, *data.ToUpdateListingDB(id))
+		// the old prices are kept in the price history
+		old, err := tx.GetListingByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		var oldUnits []database.ListingUnit
+		if len(data.Units) > 0 {
+			oldUnits, err = tx.GetListingUnits(ctx, id)
+			if err != nil {
+				return err
+			}
+		}
+		if err = recordListingPriceChanges(ctx, tx, id, old.Price, oldUnits, data); err != nil {
+			return err
+		}
+
+		err = tx.UpdateListing(ctx, *data.ToUpdateListingDB(id))
 		if err != nil {
 			return err
 		}
 		if len(data.Policies) > 0 {
-			err = r.dao.DeleteListingPolicies(ctx, id)
+			err = tx.DeleteListingPolicies(ctx, id)
 			if err != nil {
 				return err
 			}
 			for i := 0; i < len(data.Policies); i++ {
 				p := &data.Policies[i]
-				_, err := r.dao.CreateListingPolicy(ctx, *p.ToCreateListingPolicyDB(id))
+				_, err := tx.CreateListingPolicy(ctx, *p.ToCreateListingPolicyDB(id))
 				if err != nil {
 					return err
 				}
 			}
 		}
 		if len(data.Units) > 0 {
-			err = r.dao.DeleteListingUnits(ctx, id)
+			err = tx.DeleteListingUnits(ctx, id)
 			if err != nil {
 				return err
 			}
 			for i := 0; i < len(data.Units); i++ {
 				p := &data.Units[i]
-				_, err := r.dao.CreateListingUnit(ctx, database.CreateListingUnitParams{
+				_, err := tx.CreateListingUnit(ctx, database.CreateListingUnitParams{
 					ListingID: id,
 					UnitID:    p.UnitID,
 					Price:     p.Price,
@@ -330,13 +462,13 @@ func (r *repo) UpdateListing(ctx context.Context, id uuid.UUID, data *dto.Update
 			}
 		}
 		if len(data.Tags) > 0 {
-			err = r.dao.DeleteListingTags(ctx, id)
+			err = tx.DeleteListingTags(ctx, id)
 			if err != nil {
 				return err
 			}
 			for i := 0; i < len(data.Tags); i++ {
 				p := &data.Tags[i]
-				_, err := r.dao.CreateListingTag(ctx, database.CreateListingTagParams{
+				_, err := tx.CreateListingTag(ctx, database.CreateListingTagParams{
 					ListingID: id,
 					Tag:       *p,
 				})
@@ -345,7 +477,7 @@ func (r *repo) UpdateListing(ctx context.Context, id uuid.UUID, data *dto.Update
 				}
 			}
 		}
-		return nil
+		return enqueueSearchSync(ctx, tx, id)
 	})
 	if txErr != nil {
 		return error(txErr)
@@ -362,12 +494,18 @@ func (r *repo) UpdateListing(ctx context.Context, id uuid.UUID, data *dto.Update
 }
 
 func (r *repo) UpdateListingStatus(ctx context.Context, id uuid.UUID, active bool) error {
-	err := r.dao.UpdateListingStatus(ctx, database.UpdateListingStatusParams{
-		ID:     id,
-		Active: active,
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		err := tx.UpdateListingStatus(ctx, database.UpdateListingStatusParams{
+			ID:     id,
+			Active: active,
+		})
+		if err != nil {
+			return err
+		}
+		return enqueueSearchSync(ctx, tx, id)
 	})
-	if err != nil {
-		return err
+	if txErr != nil {
+		return txErr
 	}
 
 	// update cache
@@ -381,12 +519,18 @@ func (r *repo) UpdateListingStatus(ctx context.Context, id uuid.UUID, active boo
 }
 
 func (r *repo) UpdateListingPriority(ctx context.Context, id uuid.UUID, priority int) error {
-	err := r.dao.UpdateListingPriority(ctx, database.UpdateListingPriorityParams{
-		ID:       id,
-		Priority: int32(priority),
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		err := tx.UpdateListingPriority(ctx, database.UpdateListingPriorityParams{
+			ID:       id,
+			Priority: int32(priority),
+		})
+		if err != nil {
+			return err
+		}
+		return enqueueSearchSync(ctx, tx, id)
 	})
-	if err != nil {
-		return err
+	if txErr != nil {
+		return txErr
 	}
 
 	// update cache
@@ -403,19 +547,25 @@ func (r *repo) UpdateListingExpiration(ctx context.Context, id uuid.UUID, durati
 	sb := sqlbuilder.PostgreSQL.NewUpdateBuilder()
 	sb.Update("listings")
 	sb.Set(
-		fmt.Sprintf("expired_at = expired_at + %d * INTERVAL '1 day'", duration),
+		// an expired listing is extended from now on
+		fmt.Sprintf("expired_at = GREATEST(expired_at, NOW()) + %d * INTERVAL '1 day'", duration),
+		// the creator is warned again before the new expiry
+		"expiry_warned_at = NULL",
 		"updated_at = NOW()",
 	)
 	sb.Where(sb.Equal("id", id))
 
 	sql, args := sb.Build()
 	sql += " RETURNING expired_at"
-	row := r.dao.QueryRow(ctx, sql, args...)
 	var res time.Time
-	err := row.Scan(&res)
-
-	if err != nil {
-		return time.Time{}, err
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&res); err != nil {
+			return err
+		}
+		return enqueueSearchSync(ctx, tx, id)
+	})
+	if txErr != nil {
+		return time.Time{}, txErr
 	}
 
 	// update cache
@@ -429,7 +579,30 @@ func (r *repo) UpdateListingExpiration(ctx context.Context, id uuid.UUID, durati
 }
 
 func (r *repo) DeleteListing(ctx context.Context, lid uuid.UUID) error {
-	return r.dao.DeleteListing(ctx, lid)
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		if err := tx.DeleteListing(ctx, lid); err != nil {
+			return err
+		}
+		// the projector removes the document once it no longer finds the listing
+		return enqueueSearchSync(ctx, tx, lid)
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	// evict cache
+	r.redisClient.Del(ctx, fmt.Sprintf("listing:%s", lid.String()))
+
+	return nil
+}
+
+// enqueueSearchSync records in the search outbox that the listing document must be reprojected.
+// It must run on the transaction that modifies the listing.
+func enqueueSearchSync(ctx context.Context, tx database.DAO, lid uuid.UUID) error {
+	return tx.CreateSearchOutboxEvent(ctx, database.CreateSearchOutboxEventParams{
+		ListingID: lid,
+		Source:    database.SEARCHOUTBOXSOURCELISTING,
+	})
 }
 
 func (r *repo) CheckListingOwnership(ctx context.Context, lid uuid.UUID, uid uuid.UUID) (bool, error) {
@@ -508,7 +681,7 @@ func (r *repo) CheckListingVisibility(ctx context.Context, lid, uid uuid.UUID) (
 
 func (r *repo) GetListingPayments(ctx context.Context, id uuid.UUID) ([]payment_model.PaymentModel, error) {
 	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
-	sb.Select("id", "user_id", "order_id", "order_info", "amount", "status", "created_at", "updated_at")
+	sb.Select("id", "user_id", "order_id", "order_info", "amount", "status", "created_at", "updated_at", "transaction_date")
 	sb.From("payments")
 
 	metadata := []string{
@@ -551,6 +724,7 @@ func (r *repo) GetListingPayments(ctx context.Context, id uuid.UUID) ([]payment_
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TransactionDate,
 		); err != nil {
 			return nil, err
 		}
@@ -575,7 +749,7 @@ func (r *repo) GetListingPayments(ctx context.Context, id uuid.UUID) ([]payment_
 
 func (r *repo) GetListingPaymentsByType(ctx context.Context, id uuid.UUID, ptype payment_service.PAYMENTTYPE) ([]payment_model.PaymentModel, error) {
 	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
-	sb.Select("id", "user_id", "order_id", "order_info", "amount", "status", "created_at", "updated_at")
+	sb.Select("id", "user_id", "order_id", "order_info", "amount", "status", "created_at", "updated_at", "transaction_date")
 	sb.From("payments")
 
 	metadata := fmt.Sprintf("%s%s%s", ptype, payment_service.PAYMENTTYPE_DELIMITER, id.String())
@@ -606,6 +780,7 @@ func (r *repo) GetListingPaymentsByType(ctx context.Context, id uuid.UUID, ptype
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TransactionDate,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,41 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+func (r *repo) GetListingRequiredDocuments(ctx context.Context, listingId uuid.UUID) ([]model.ListingRequiredDocumentModel, error) {
+	res, err := r.dao.GetListingRequiredDocuments(ctx, listingId)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.ListingRequiredDocumentModel, 0, len(res))
+	for i := range res {
+		items = append(items, model.ToListingRequiredDocumentModel(&res[i]))
+	}
+	return items, nil
+}
+
+// ReplaceListingRequiredDocuments replaces the document checklist of the listing
+func (r *repo) ReplaceListingRequiredDocuments(ctx context.Context, listingId uuid.UUID, data *dto.UpdateListingRequiredDocuments) ([]model.ListingRequiredDocumentModel, error) {
+	items := make([]model.ListingRequiredDocumentModel, 0, len(data.Documents))
+	err := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		if err := tx.DeleteListingRequiredDocuments(ctx, listingId); err != nil {
+			return err
+		}
+		for i := range data.Documents {
+			d, err := tx.CreateListingRequiredDocument(ctx, data.Documents[i].ToCreateListingRequiredDocumentDB(listingId))
+			if err != nil {
+				return err
+			}
+			items = append(items, model.ToListingRequiredDocumentModel(&d))
+		}
+		return nil
+	})
+	return items, err
+}
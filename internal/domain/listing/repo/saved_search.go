@@ -0,0 +1,143 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func (r *repo) CreateSavedSearch(ctx context.Context, data *dto.CreateSavedSearch) (model.SavedSearchModel, error) {
+	query, err := json.Marshal(data.Query)
+	if err != nil {
+		return model.SavedSearchModel{}, err
+	}
+	res, err := r.dao.CreateSavedSearch(ctx, database.CreateSavedSearchParams{
+		UserID:    data.UserID,
+		Name:      data.Name,
+		Query:     query,
+		Frequency: data.Frequency,
+	})
+	if err != nil {
+		return model.SavedSearchModel{}, err
+	}
+	return model.ToSavedSearchModel(&res), nil
+}
+
+func (r *repo) GetSavedSearch(ctx context.Context, id int64) (model.SavedSearchModel, error) {
+	res, err := r.dao.GetSavedSearch(ctx, id)
+	if err != nil {
+		return model.SavedSearchModel{}, err
+	}
+	return model.ToSavedSearchModel(&res), nil
+}
+
+func toSavedSearchModels(res []database.SavedSearch) []model.SavedSearchModel {
+	items := make([]model.SavedSearchModel, 0, len(res))
+	for i := range res {
+		items = append(items, model.ToSavedSearchModel(&res[i]))
+	}
+	return items
+}
+
+func (r *repo) GetSavedSearchesOfUser(ctx context.Context, userId uuid.UUID) ([]model.SavedSearchModel, error) {
+	res, err := r.dao.GetSavedSearchesOfUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	return toSavedSearchModels(res), nil
+}
+
+func (r *repo) GetActiveSavedSearchesAfter(ctx context.Context, after int64, limit int32) ([]model.SavedSearchModel, error) {
+	res, err := r.dao.GetActiveSavedSearchesAfter(ctx, database.GetActiveSavedSearchesAfterParams{
+		ID:    after,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toSavedSearchModels(res), nil
+}
+
+func (r *repo) UpdateSavedSearch(ctx context.Context, id int64, data *dto.UpdateSavedSearch) error {
+	params := database.UpdateSavedSearchParams{
+		ID:     id,
+		Name:   types.StrN(data.Name),
+		Active: types.BoolN(data.Active),
+	}
+	if data.Frequency != nil {
+		params.Frequency = database.NullSAVEDSEARCHFREQUENCY{
+			SAVEDSEARCHFREQUENCY: *data.Frequency,
+			Valid:                true,
+		}
+	}
+	if data.Query != nil {
+		query, err := json.Marshal(data.Query)
+		if err != nil {
+			return err
+		}
+		params.Query = query
+	}
+	return r.dao.UpdateSavedSearch(ctx, params)
+}
+
+func (r *repo) UpdateSavedSearchLastCheckedAt(ctx context.Context, id int64, checkedAt time.Time) error {
+	return r.dao.UpdateSavedSearchLastCheckedAt(ctx, database.UpdateSavedSearchLastCheckedAtParams{
+		ID:            id,
+		LastCheckedAt: checkedAt,
+	})
+}
+
+func (r *repo) DeleteSavedSearch(ctx context.Context, id int64) error {
+	return r.dao.DeleteSavedSearch(ctx, id)
+}
+
+// CreateSavedSearchMatches records the listings matched by the saved search
+// and returns those that were never matched for its user before
+func (r *repo) CreateSavedSearchMatches(ctx context.Context, ss *model.SavedSearchModel, lids []uuid.UUID) ([]uuid.UUID, error) {
+	if len(lids) == 0 {
+		return nil, nil
+	}
+	return r.dao.CreateSavedSearchMatches(ctx, database.CreateSavedSearchMatchesParams{
+		UserID:        ss.UserID,
+		ListingIds:    lids,
+		SavedSearchID: ss.ID,
+	})
+}
+
+// GetPendingSavedSearchMatches returns the matches not notified yet of the active saved searches of the given frequency, grouped by saved search
+func (r *repo) GetPendingSavedSearchMatches(ctx context.Context, frequency database.SAVEDSEARCHFREQUENCY) ([]dto.SavedSearchMatches, error) {
+	res, err := r.dao.GetPendingSavedSearchMatches(ctx, frequency)
+	if err != nil {
+		return nil, err
+	}
+	var items []dto.SavedSearchMatches
+	for _, row := range res {
+		if len(items) == 0 || items[len(items)-1].SavedSearchID != row.SavedSearchID.Int64 {
+			items = append(items, dto.SavedSearchMatches{SavedSearchID: row.SavedSearchID.Int64})
+		}
+		items[len(items)-1].ListingIDs = append(items[len(items)-1].ListingIDs, row.ListingID)
+	}
+	return items, nil
+}
+
+func (r *repo) MarkSavedSearchMatchesNotified(ctx context.Context, id int64, lids []uuid.UUID) error {
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		if err := tx.MarkSavedSearchMatchesNotified(ctx, database.MarkSavedSearchMatchesNotifiedParams{
+			SavedSearchID: id,
+			ListingIds:    lids,
+		}); err != nil {
+			return err
+		}
+		return tx.UpdateSavedSearchLastSentAt(ctx, id)
+	})
+	if txErr != nil {
+		return txErr
+	}
+	return nil
+}
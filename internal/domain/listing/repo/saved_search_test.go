@@ -0,0 +1,108 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	auth_repo "github.com/user2410/rrms-backend/internal/domain/auth/repo"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func TestSavedSearchMatches(t *testing.T) {
+	ctx := context.Background()
+	user := auth_repo.NewRandomUserDB(t, testAuthRepo)
+	listing := NewRandomListingDB(t, testAuthRepo, testPropertyRepo, testUnitRepo, testListingRepo)
+
+	newSavedSearch := func(name string) int64 {
+		ss, err := testListingRepo.CreateSavedSearch(ctx, &dto.CreateSavedSearch{
+			UserID:    user.ID,
+			Name:      name,
+			Frequency: database.SAVEDSEARCHFREQUENCYDAILY,
+			Query: dto.SearchListingCombinationQuery{
+				SearchListingQuery: dto.SearchListingQuery{LQuery: types.Ptr("chung cư")},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, user.ID, ss.UserID)
+		require.True(t, ss.Active)
+		return ss.ID
+	}
+	first, second := newSavedSearch("first"), newSavedSearch("second")
+	firstSS, err := testListingRepo.GetSavedSearch(ctx, first)
+	require.NoError(t, err)
+	secondSS, err := testListingRepo.GetSavedSearch(ctx, second)
+	require.NoError(t, err)
+
+	created, err := testListingRepo.CreateSavedSearchMatches(ctx, &firstSS, []uuid.UUID{listing.ID})
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{listing.ID}, created)
+
+	// a listing is matched only once for a user, whatever the saved search
+	created, err = testListingRepo.CreateSavedSearchMatches(ctx, &firstSS, []uuid.UUID{listing.ID})
+	require.NoError(t, err)
+	require.Empty(t, created)
+	created, err = testListingRepo.CreateSavedSearchMatches(ctx, &secondSS, []uuid.UUID{listing.ID})
+	require.NoError(t, err)
+	require.Empty(t, created)
+
+	isPending := func() bool {
+		matches, err := testListingRepo.GetPendingSavedSearchMatches(ctx, database.SAVEDSEARCHFREQUENCYDAILY)
+		require.NoError(t, err)
+		return slices.ContainsFunc(matches, func(m dto.SavedSearchMatches) bool {
+			return m.SavedSearchID == first && slices.Contains(m.ListingIDs, listing.ID)
+		})
+	}
+	require.True(t, isPending())
+
+	err = testListingRepo.MarkSavedSearchMatchesNotified(ctx, first, []uuid.UUID{listing.ID})
+	require.NoError(t, err)
+	require.False(t, isPending())
+	firstSS, err = testListingRepo.GetSavedSearch(ctx, first)
+	require.NoError(t, err)
+	require.NotNil(t, firstSS.LastSentAt)
+}
+
+func TestUpdateSavedSearch(t *testing.T) {
+	ctx := context.Background()
+	user := auth_repo.NewRandomUserDB(t, testAuthRepo)
+	ss, err := testListingRepo.CreateSavedSearch(ctx, &dto.CreateSavedSearch{
+		UserID:    user.ID,
+		Name:      "chung cư",
+		Frequency: database.SAVEDSEARCHFREQUENCYDAILY,
+		Query: dto.SearchListingCombinationQuery{
+			SearchListingQuery: dto.SearchListingQuery{LQuery: types.Ptr("chung cư")},
+		},
+	})
+	require.NoError(t, err)
+
+	// the search criteria are kept unless given
+	err = testListingRepo.UpdateSavedSearch(ctx, ss.ID, &dto.UpdateSavedSearch{Name: types.Ptr("nhà riêng")})
+	require.NoError(t, err)
+	updated, err := testListingRepo.GetSavedSearch(ctx, ss.ID)
+	require.NoError(t, err)
+	require.Equal(t, "nhà riêng", updated.Name)
+	require.JSONEq(t, string(ss.Query), string(updated.Query))
+
+	err = testListingRepo.UpdateSavedSearch(ctx, ss.ID, &dto.UpdateSavedSearch{
+		Query: &dto.SearchListingCombinationQuery{
+			SearchListingQuery: dto.SearchListingQuery{
+				LQuery:    types.Ptr("nhà riêng"),
+				LMaxPrice: types.Ptr[float32](10000000),
+			},
+		},
+	})
+	require.NoError(t, err)
+	updated, err = testListingRepo.GetSavedSearch(ctx, ss.ID)
+	require.NoError(t, err)
+	var q dto.SearchListingCombinationQuery
+	require.NoError(t, json.Unmarshal(updated.Query, &q))
+	require.Equal(t, "nhà riêng", *q.LQuery)
+	require.Equal(t, float32(10000000), *q.LMaxPrice)
+	require.Equal(t, "nhà riêng", updated.Name)
+}
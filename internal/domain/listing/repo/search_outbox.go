@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func (r *repo) CreateSearchOutboxEvent(ctx context.Context, lid uuid.UUID) error {
+	return enqueueSearchSync(ctx, r.dao, lid)
+}
+
+func (r *repo) ClaimSearchOutboxEvents(ctx context.Context, limit int32) ([]model.SearchOutboxEventModel, error) {
+	res, err := r.dao.ClaimSearchOutboxEvents(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]model.SearchOutboxEventModel, 0, len(res))
+	for i := range res {
+		events = append(events, model.ToSearchOutboxEventModel(&res[i]))
+	}
+	return events, nil
+}
+
+func (r *repo) MarkSearchOutboxEventsProcessed(ctx context.Context, ids []int64) error {
+	return r.dao.MarkSearchOutboxEventsProcessed(ctx, ids)
+}
+
+func (r *repo) FailSearchOutboxEvents(ctx context.Context, ids []int64, lastError string, maxAttempts int32) error {
+	return r.dao.FailSearchOutboxEvents(ctx, database.FailSearchOutboxEventsParams{
+		LastError:   types.StrN(&lastError),
+		MaxAttempts: maxAttempts,
+		Ids:         ids,
+	})
+}
+
+func (r *repo) GetSearchOutboxStats(ctx context.Context) (dto.SearchOutboxStats, error) {
+	res, err := r.dao.GetSearchOutboxStats(ctx)
+	if err != nil {
+		return dto.SearchOutboxStats{}, err
+	}
+	stats := dto.SearchOutboxStats{
+		Pending:         res.Pending,
+		Dead:            res.Dead,
+		OldestPendingAt: res.OldestPendingAt,
+	}
+	if res.Pending > 0 {
+		stats.LagSeconds = time.Since(res.OldestPendingAt).Seconds()
+	}
+	return stats, nil
+}
+
+func (r *repo) PurgeSearchOutboxEvents(ctx context.Context, before time.Time) (int64, error) {
+	return r.dao.PurgeSearchOutboxEvents(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
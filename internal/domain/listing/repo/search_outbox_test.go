@@ -0,0 +1,49 @@
+package repo
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+)
+
+func claimSearchOutboxEventsOf(t *testing.T, lid uuid.UUID) []model.SearchOutboxEventModel {
+	events, err := testListingRepo.ClaimSearchOutboxEvents(context.Background(), math.MaxInt32)
+	require.NoError(t, err)
+	var res []model.SearchOutboxEventModel
+	for _, e := range events {
+		if e.ListingID == lid {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+func TestSearchOutbox(t *testing.T) {
+	listing := NewRandomListingDB(t, testAuthRepo, testPropertyRepo, testUnitRepo, testListingRepo)
+
+	// creating the listing records an event in the same transaction
+	events := claimSearchOutboxEventsOf(t, listing.ID)
+	require.Len(t, events, 1)
+	// claimed events are leased to the processor
+	require.Empty(t, claimSearchOutboxEventsOf(t, listing.ID))
+
+	err := testListingRepo.MarkSearchOutboxEventsProcessed(context.Background(), []int64{events[0].ID})
+	require.NoError(t, err)
+
+	// a failed event is dead-lettered once it reaches the max attempts
+	statsBefore, err := testListingRepo.GetSearchOutboxStats(context.Background())
+	require.NoError(t, err)
+	err = testListingRepo.CreateSearchOutboxEvent(context.Background(), listing.ID)
+	require.NoError(t, err)
+	events = claimSearchOutboxEventsOf(t, listing.ID)
+	require.Len(t, events, 1)
+	err = testListingRepo.FailSearchOutboxEvents(context.Background(), []int64{events[0].ID}, "index unavailable", 1)
+	require.NoError(t, err)
+	statsAfter, err := testListingRepo.GetSearchOutboxStats(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, statsBefore.Dead+1, statsAfter.Dead)
+}
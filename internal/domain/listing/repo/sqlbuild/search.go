@@ -19,6 +19,11 @@ func SearchListingBuilder(
 	var searchQueries []string // WHERE field (=/ILIKE) ?
 	var args []interface{}
 
+	if len(query.LIds) > 0 {
+		// the ids are sent as a single array, and their positions keep the order given by the searcher
+		searchQuery += " INNER JOIN unnest($?::TEXT[]::UUID[]) WITH ORDINALITY AS lids(id, pos) ON lids.id = listings.id"
+		args = append(args, query.LIds)
+	}
 	if query.LTitle != nil {
 		searchQueries = append(searchQueries, "listings.title ILIKE $?")
 		args = append(args, "%"+(*query.LTitle)+"%")
@@ -91,6 +96,10 @@ func SearchListingBuilder(
 		searchQueries = append(searchQueries, "listings.post_at <= $?")
 		args = append(args, *query.LMaxPostAt)
 	}
+	if query.LMinActivatedAt != nil {
+		searchQueries = append(searchQueries, "listings.activated_at >= $?")
+		args = append(args, *query.LMinActivatedAt)
+	}
 	if query.LMinExpiredAt != nil {
 		searchQueries = append(searchQueries, "listings.expired_at >= $?")
 		args = append(args, *query.LMinExpiredAt)
@@ -99,13 +108,29 @@ func SearchListingBuilder(
 		searchQueries = append(searchQueries, "listings.expired_at <= $?")
 		args = append(args, *query.LMaxExpiredAt)
 	}
+	if query.LAvailableFrom != nil {
+		// at least one of the listed units is free by the given date
+		searchQueries = append(searchQueries, "EXISTS (SELECT 1 FROM listing_units WHERE listing_units.listing_id = listings.id AND unit_available_from(listing_units.unit_id, CURRENT_DATE) <= $?)")
+		args = append(args, *query.LAvailableFrom)
+	}
+	if query.LCollapseDuplicates != nil && *query.LCollapseDuplicates {
+		// another visible listing of the cluster comes first when it has a higher priority, or the same priority and was created earlier
+		searchQueries = append(searchQueries, `NOT EXISTS (
+			SELECT 1 FROM listing_duplicates AS ld
+			INNER JOIN listing_duplicates AS od ON od.cluster_id = ld.cluster_id AND od.listing_id <> ld.listing_id
+			INNER JOIN listings AS ol ON ol.id = od.listing_id
+			INNER JOIN properties AS op ON op.id = ol.property_id
+			WHERE ld.listing_id = listings.id AND ol.active AND op.is_public AND ol.expired_at >= NOW() AND
+				(ol.priority > listings.priority OR (ol.priority = listings.priority AND (ol.created_at, ol.id) < (listings.created_at, listings.id)))
+		)`)
+	}
 	if len(query.LPolicies) > 0 {
 		searchQueries = append(searchQueries, "EXISTS (SELECT 1 FROM listing_policies WHERE listing_id = listings.id AND policy_id IN ($?))")
 		args = append(args, sqlbuilder.List(query.LPolicies))
 	}
 
 	// no field is specified and check for exisence only
-	if len(searchQueries) == 0 && searchFields[0] == "1" {
+	if len(searchQueries) == 0 && len(query.LIds) == 0 && searchFields[0] == "1" {
 		return "", []interface{}{}
 	}
 
@@ -139,7 +164,7 @@ func SearchListingCombinationBuilder(query *dto.SearchListingCombinationQuery) (
 	var queryStr string = sqlListing
 	var argsLs []any = argsListing
 	// NOTE: goofy code, will be refactored later
-	if len(argsListing) > 0 {
+	if strings.Contains(sqlListing, " WHERE ") {
 		if len(sqlProp) > 0 {
 			queryStr += fmt.Sprintf(" AND EXISTS (%v)", sqlProp)
 			argsLs = append(argsLs, argsProp...)
@@ -169,6 +194,11 @@ func SearchListingCombinationBuilder(query *dto.SearchListingCombinationQuery) (
 	sqSql += " ORDER BY "
 	sortOrders := make([]string, 0, len(query.SortBy))
 	for i := 0; i < len(query.SortBy); i++ {
+		if query.SortBy[i] == dto.SORTBY_DISTANCE || query.SortBy[i] == dto.SORTBY_RELEVANCE {
+			// LIds holds the listings ordered by distance or relevance
+			sortOrders = append(sortOrders, fmt.Sprintf("lids.pos %v", query.Order[i]))
+			continue
+		}
 		sortOrders = append(sortOrders, fmt.Sprintf("%v %v", query.SortBy[i], query.Order[i]))
 	}
 	sqSql += strings.Join(sortOrders, ", ")
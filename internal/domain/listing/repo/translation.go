@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// UpsertListingTranslation creates or replaces the translation of the listing into the language
+func (r *repo) UpsertListingTranslation(ctx context.Context, listingId uuid.UUID, data *dto.CreateListingTranslation) (model.ListingTranslationModel, error) {
+	var res model.ListingTranslationModel
+	err := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		t, err := tx.UpsertListingTranslation(ctx, data.ToUpsertListingTranslationDB(listingId))
+		if err != nil {
+			return err
+		}
+		res = model.ToListingTranslationModel(&t)
+		return enqueueSearchSync(ctx, tx, listingId)
+	})
+	return res, err
+}
+
+// GetListingTranslations returns the translations of the listing ordered by language
+func (r *repo) GetListingTranslations(ctx context.Context, listingId uuid.UUID) ([]model.ListingTranslationModel, error) {
+	res, err := r.dao.GetListingTranslations(ctx, listingId)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.ListingTranslationModel, 0, len(res))
+	for i := range res {
+		items = append(items, model.ToListingTranslationModel(&res[i]))
+	}
+	return items, nil
+}
+
+// GetListingsTranslations returns the translation into the language of each of the listings translated into it
+func (r *repo) GetListingsTranslations(ctx context.Context, ids []uuid.UUID, language string) (map[uuid.UUID]model.ListingTranslationModel, error) {
+	res, err := r.dao.GetListingsTranslations(ctx, database.GetListingsTranslationsParams{
+		ListingIds: ids,
+		Language:   language,
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make(map[uuid.UUID]model.ListingTranslationModel, len(res))
+	for i := range res {
+		items[res[i].ListingID] = model.ToListingTranslationModel(&res[i])
+	}
+	return items, nil
+}
+
+// DeleteListingTranslation returns false if the listing is not translated into the language
+func (r *repo) DeleteListingTranslation(ctx context.Context, listingId uuid.UUID, language string) (bool, error) {
+	deleted := false
+	err := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		n, err := tx.DeleteListingTranslation(ctx, database.DeleteListingTranslationParams{
+			ListingID: listingId,
+			Language:  language,
+		})
+		if err != nil || n == 0 {
+			return err
+		}
+		deleted = true
+		return enqueueSearchSync(ctx, tx, listingId)
+	})
+	return deleted, err
+}
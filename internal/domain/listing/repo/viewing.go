@@ -0,0 +1,238 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// CreateListingViewingSlots creates the slots at once, it returns false without creating any
+// if one of them overlaps another slot of the manager, whatever the listing
+func (r *repo) CreateListingViewingSlots(ctx context.Context, managerId uuid.UUID, slots []database.CreateListingViewingSlotParams) ([]model.ListingViewingSlotModel, bool, error) {
+	res := make([]model.ListingViewingSlotModel, 0, len(slots))
+	ok := true
+	err := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		if err := tx.LockViewingManager(ctx, managerId.String()); err != nil {
+			return err
+		}
+		for _, s := range slots {
+			overlapping, err := tx.CheckOverlappingViewingSlot(ctx, database.CheckOverlappingViewingSlotParams{
+				ManagerID: managerId,
+				StartAt:   s.StartAt,
+				EndAt:     s.EndAt,
+			})
+			if err != nil {
+				return err
+			}
+			if overlapping {
+				ok = false
+				return nil
+			}
+			slot, err := tx.CreateListingViewingSlot(ctx, s)
+			if err != nil {
+				return err
+			}
+			res = append(res, model.ToListingViewingSlotModel(&slot))
+		}
+		return nil
+	})
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return res, true, nil
+}
+
+func (r *repo) GetListingViewingSlot(ctx context.Context, id int64) (model.ListingViewingSlotModel, error) {
+	res, err := r.dao.GetListingViewingSlot(ctx, id)
+	if err != nil {
+		return model.ListingViewingSlotModel{}, err
+	}
+	return model.ToListingViewingSlotModel(&res), nil
+}
+
+// GetListingViewingSlots returns the slots of the listing ending after the given time, the earliest first
+func (r *repo) GetListingViewingSlots(ctx context.Context, listingId uuid.UUID, after time.Time) ([]model.ListingViewingSlotModel, error) {
+	res, err := r.dao.GetListingViewingSlots(ctx, database.GetListingViewingSlotsParams{
+		ListingID: listingId,
+		After:     after,
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.ListingViewingSlotModel, 0, len(res))
+	for i := range res {
+		items = append(items, model.ToListingViewingSlotModel(&res[i]))
+	}
+	return items, nil
+}
+
+func (r *repo) SetListingViewingSlotReminder(ctx context.Context, id int64, reminderId int64) error {
+	return r.dao.SetListingViewingSlotReminder(ctx, database.SetListingViewingSlotReminderParams{
+		ID:         id,
+		ReminderID: pgtype.Int8{Int64: reminderId, Valid: true},
+	})
+}
+
+func (r *repo) DeleteListingViewingSlot(ctx context.Context, id int64) error {
+	return r.dao.DeleteListingViewingSlot(ctx, id)
+}
+
+// BookListingViewing books a place in the slot for the tenant, it returns false if the slot is full
+func (r *repo) BookListingViewing(ctx context.Context, data *dto.BookListingViewing) (model.ListingViewingModel, bool, error) {
+	var res model.ListingViewingModel
+	ok := true
+	err := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		slot, err := tx.GetListingViewingSlotForUpdate(ctx, data.SlotID)
+		if err != nil {
+			return err
+		}
+		if slot.Booked >= slot.Capacity {
+			ok = false
+			return nil
+		}
+		v, err := tx.CreateListingViewing(ctx, database.CreateListingViewingParams{
+			SlotID:   data.SlotID,
+			TenantID: data.TenantID,
+			Note:     types.StrN(data.Note),
+		})
+		if err != nil {
+			return err
+		}
+		if err = tx.UpdateListingViewingSlotBooked(ctx, database.UpdateListingViewingSlotBookedParams{
+			ID:    data.SlotID,
+			Delta: 1,
+		}); err != nil {
+			return err
+		}
+		slot.Booked++
+		res = model.ToListingViewingModel(&v)
+		sm := model.ToListingViewingSlotModel(&slot)
+		res.Slot = &sm
+		return nil
+	})
+	if err != nil || !ok {
+		return model.ListingViewingModel{}, false, err
+	}
+	return res, true, nil
+}
+
+// CancelListingViewing cancels the booking and frees its place in the slot, it returns false if the viewing is not booked
+func (r *repo) CancelListingViewing(ctx context.Context, id int64) (bool, error) {
+	ok := false
+	err := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		v, err := tx.GetListingViewing(ctx, id)
+		if err != nil {
+			return err
+		}
+		// the slot is locked first, as when booking it
+		if _, err = tx.GetListingViewingSlotForUpdate(ctx, v.SlotID); err != nil {
+			return err
+		}
+		n, err := tx.UpdateListingViewingStatus(ctx, database.UpdateListingViewingStatusParams{
+			ID:         id,
+			Status:     database.LISTINGVIEWINGSTATUSCANCELED,
+			FromStatus: database.LISTINGVIEWINGSTATUSBOOKED,
+		})
+		if err != nil || n == 0 {
+			return err
+		}
+		ok = true
+		return tx.UpdateListingViewingSlotBooked(ctx, database.UpdateListingViewingSlotBookedParams{
+			ID:    v.SlotID,
+			Delta: -1,
+		})
+	})
+	return ok, err
+}
+
+// UpdateListingViewingStatus changes the status of the viewing, it returns false if the viewing was not in the from status
+func (r *repo) UpdateListingViewingStatus(ctx context.Context, id int64, from, to database.LISTINGVIEWINGSTATUS) (bool, error) {
+	n, err := r.dao.UpdateListingViewingStatus(ctx, database.UpdateListingViewingStatusParams{
+		ID:         id,
+		Status:     to,
+		FromStatus: from,
+	})
+	return n > 0, err
+}
+
+// GetListingViewing returns the viewing with its slot
+func (r *repo) GetListingViewing(ctx context.Context, id int64) (model.ListingViewingModel, error) {
+	v, err := r.dao.GetListingViewing(ctx, id)
+	if err != nil {
+		return model.ListingViewingModel{}, err
+	}
+	res, err := r.fillViewingSlots(ctx, []database.ListingViewing{v})
+	if err != nil {
+		return model.ListingViewingModel{}, err
+	}
+	return res[0], nil
+}
+
+// GetListingViewingsOfSlot returns the bookings of the slot not canceled
+func (r *repo) GetListingViewingsOfSlot(ctx context.Context, slotId int64) ([]model.ListingViewingModel, error) {
+	res, err := r.dao.GetListingViewingsOfSlot(ctx, slotId)
+	if err != nil {
+		return nil, err
+	}
+	return r.fillViewingSlots(ctx, res)
+}
+
+// GetListingViewingsOfListing returns the viewings of the listing with their slots, the latest slots first
+func (r *repo) GetListingViewingsOfListing(ctx context.Context, listingId uuid.UUID) ([]model.ListingViewingModel, error) {
+	res, err := r.dao.GetListingViewingsOfListing(ctx, listingId)
+	if err != nil {
+		return nil, err
+	}
+	return r.fillViewingSlots(ctx, res)
+}
+
+// GetListingViewingsOfTenant returns the viewings booked by the tenant with their slots, the most recent first
+func (r *repo) GetListingViewingsOfTenant(ctx context.Context, tenantId uuid.UUID) ([]model.ListingViewingModel, error) {
+	res, err := r.dao.GetListingViewingsOfTenant(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	return r.fillViewingSlots(ctx, res)
+}
+
+func (r *repo) fillViewingSlots(ctx context.Context, viewings []database.ListingViewing) ([]model.ListingViewingModel, error) {
+	ids := make([]int64, 0, len(viewings))
+	for i := range viewings {
+		ids = append(ids, viewings[i].SlotID)
+	}
+	slots, err := r.dao.GetListingViewingSlotsByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byId := make(map[int64]model.ListingViewingSlotModel, len(slots))
+	for i := range slots {
+		byId[slots[i].ID] = model.ToListingViewingSlotModel(&slots[i])
+	}
+	items := make([]model.ListingViewingModel, 0, len(viewings))
+	for i := range viewings {
+		v := model.ToListingViewingModel(&viewings[i])
+		if s, ok := byId[v.SlotID]; ok {
+			v.Slot = &s
+		}
+		items = append(items, v)
+	}
+	return items, nil
+}
+
+// GetListingViewingsToRemind returns the booked viewings starting within the given hours whose sides were not reminded yet
+func (r *repo) GetListingViewingsToRemind(ctx context.Context, hours int32, limit int32) ([]int64, error) {
+	return r.dao.GetListingViewingsToRemind(ctx, database.GetListingViewingsToRemindParams{
+		Hours: hours,
+		Lim:   limit,
+	})
+}
+
+func (r *repo) MarkListingViewingReminded(ctx context.Context, id int64) error {
+	return r.dao.MarkListingViewingReminded(ctx, id)
+}
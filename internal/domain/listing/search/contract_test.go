@@ -0,0 +1,260 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	auth_repo "github.com/user2410/rrms-backend/internal/domain/auth/repo"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	property_dto "github.com/user2410/rrms-backend/internal/domain/property/dto"
+	property_repo "github.com/user2410/rrms-backend/internal/domain/property/repo"
+	unit_repo "github.com/user2410/rrms-backend/internal/domain/unit/repo"
+	"github.com/user2410/rrms-backend/internal/infrastructure/es"
+	"github.com/user2410/rrms-backend/internal/interfaces/rest/requests"
+	"github.com/user2410/rrms-backend/internal/utils/random"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// searchFixture is a set of listings in Hanoi and Ho Chi Minh City.
+// The marker words make the text queries independent of the other listings of the test database.
+type searchFixture struct {
+	marker  string
+	hanoi   uuid.UUID
+	saigon  uuid.UUID
+	hidden  uuid.UUID
+	hanoiLL [2]float64
+}
+
+func newTestListing(t *testing.T, title, address string, lat, lng float64, active bool) uuid.UUID {
+	ctx := context.Background()
+	user := auth_repo.NewRandomUserDB(t, testDomainRepo.AuthRepo)
+
+	createProperty := property_repo.PrepareRandomProperty(t, nil, user.ID)
+	createProperty.FullAddress = address
+	createProperty.Lat = types.Ptr(lat)
+	createProperty.Lng = types.Ptr(lng)
+	property := property_repo.NewRandomPropertyDBFromArg(t, testDomainRepo.PropertyRepo, &createProperty)
+	err := testDomainRepo.PropertyRepo.UpdateProperty(ctx, &property_dto.UpdateProperty{
+		ID:       property.ID,
+		IsPublic: types.Ptr(true),
+	})
+	require.NoError(t, err)
+
+	createUnit := unit_repo.PrepareRandomUnit(t, nil, nil, property.ID)
+	unit := unit_repo.NewRandomUnitDBFromArg(t, testDomainRepo.UnitRepo, &createUnit)
+
+	listing, err := testDomainRepo.ListingRepo.CreateListing(ctx, &dto.CreateListing{
+		CreatorID:    user.ID,
+		PropertyID:   property.ID,
+		Title:        title,
+		Description:  random.RandomAlphanumericStr(100),
+		FullName:     user.FirstName + " " + user.LastName,
+		Email:        user.Email,
+		Phone:        random.RandomNumericStr(10),
+		ContactType:  "OWNER",
+		Price:        5000000,
+		Priority:     1,
+		PostDuration: 30,
+		Units: []dto.CreateListingUnit{
+			{UnitID: unit.ID, Price: 5000000},
+		},
+	})
+	require.NoError(t, err)
+	err = testDomainRepo.ListingRepo.UpdateListingStatus(ctx, listing.ID, active)
+	require.NoError(t, err)
+	return listing.ID
+}
+
+func newSearchFixture(t *testing.T, searcher Searcher) *searchFixture {
+	f := &searchFixture{
+		marker:  strings.ToLower(random.RandomAlphabetStr(12)),
+		hanoiLL: [2]float64{21.0285, 105.8542},
+	}
+	f.hanoi = newTestListing(t, "Căn hộ chung cư "+f.marker, "12 Phố Huế, Hai Bà Trưng, Hà Nội", f.hanoiLL[0], f.hanoiLL[1], true)
+	f.saigon = newTestListing(t, "Nhà nguyên căn", "45 Nguyễn Huệ "+f.marker+", Quận 1, TP Hồ Chí Minh", 10.7769, 106.7009, true)
+	f.hidden = newTestListing(t, "Căn hộ chung cư "+f.marker, "12 Phố Huế, Hai Bà Trưng, Hà Nội", f.hanoiLL[0], f.hanoiLL[1], false)
+
+	// make the listings searchable
+	ctx := context.Background()
+	for _, id := range []uuid.UUID{f.hanoi, f.saigon, f.hidden} {
+		listing, err := testDomainRepo.ListingRepo.GetListingByID(ctx, id)
+		require.NoError(t, err)
+		doc, err := BuildListingDocument(ctx, testDomainRepo, listing)
+		require.NoError(t, err)
+		require.NoError(t, searcher.IndexListing(ctx, &doc))
+	}
+	if testEsClient != nil {
+		require.NoError(t, es.RefreshIndex(ctx, testEsClient.GetClient(), string(es.LISTINGINDEX)))
+	}
+	return f
+}
+
+// testSearcherContract is the behavior every search backend must provide
+func testSearcherContract(t *testing.T, searcher Searcher) {
+	f := newSearchFixture(t, searcher)
+	ctx := context.Background()
+
+	search := func(q *dto.SearchListingCombinationQuery) []string {
+		ids, err := searcher.SearchListingIds(ctx, q)
+		require.NoError(t, err)
+		return ids
+	}
+
+	t.Run("text query matches unaccented title words and visible listings only", func(t *testing.T) {
+		ids := search(&dto.SearchListingCombinationQuery{
+			SearchListingQuery: dto.SearchListingQuery{LQuery: types.Ptr("chung cu " + f.marker)},
+		})
+		require.Contains(t, ids, f.hanoi.String())
+		require.NotContains(t, ids, f.hidden.String())
+	})
+
+	t.Run("text query matches the address", func(t *testing.T) {
+		ids := search(&dto.SearchListingCombinationQuery{
+			SearchListingQuery: dto.SearchListingQuery{LQuery: types.Ptr(f.marker + " Quan 1")},
+		})
+		require.Contains(t, ids, f.saigon.String())
+	})
+
+	t.Run("text query expands synonyms", func(t *testing.T) {
+		ids := search(&dto.SearchListingCombinationQuery{
+			SearchListingQuery: dto.SearchListingQuery{LQuery: types.Ptr("CC " + f.marker)},
+		})
+		require.Contains(t, ids, f.hanoi.String())
+
+		ids = search(&dto.SearchListingCombinationQuery{
+			SearchListingQuery: dto.SearchListingQuery{LQuery: types.Ptr(f.marker + " Q1")},
+		})
+		require.Contains(t, ids, f.saigon.String())
+	})
+
+	t.Run("text query tolerates typos", func(t *testing.T) {
+		// a character substituted in the middle of the word
+		substituted := []byte(f.marker)
+		substituted[6] = 'a' + (substituted[6]-'a'+1)%26
+		// two adjacent characters swapped
+		swapped := []byte(f.marker)
+		swapped[4], swapped[5] = swapped[5], swapped[4]
+		if swapped[4] == swapped[5] {
+			swapped[5] = 'a' + (swapped[5]-'a'+1)%26
+		}
+
+		for _, typo := range []string{string(substituted), string(swapped)} {
+			require.NotEqual(t, f.marker, typo)
+			ids := search(&dto.SearchListingCombinationQuery{
+				SearchListingQuery: dto.SearchListingQuery{LQuery: types.Ptr(typo)},
+			})
+			require.Contains(t, ids, f.hanoi.String(), typo)
+			require.NotContains(t, ids, f.hidden.String(), typo)
+		}
+	})
+
+	t.Run("autocomplete", func(t *testing.T) {
+		suggestions, err := searcher.AutocompleteListings(ctx, &dto.ListingAutocompleteQuery{
+			Q:     "chung cu " + f.marker[:6],
+			Limit: types.Ptr(20),
+		})
+		require.NoError(t, err)
+		idx := slices.IndexFunc(suggestions, func(s dto.ListingSuggestion) bool { return s.ListingID == f.hanoi })
+		require.GreaterOrEqual(t, idx, 0)
+		require.Equal(t, "Căn hộ chung cư "+f.marker, suggestions[idx].Title)
+		require.False(t, slices.ContainsFunc(suggestions, func(s dto.ListingSuggestion) bool { return s.ListingID == f.hidden }))
+	})
+
+	t.Run("radius", func(t *testing.T) {
+		ids := search(&dto.SearchListingCombinationQuery{
+			SearchListingGeoQuery: dto.SearchListingGeoQuery{
+				GLat: types.Ptr(f.hanoiLL[0]), GLng: types.Ptr(f.hanoiLL[1]), GRadius: types.Ptr(5.0),
+			},
+		})
+		require.Contains(t, ids, f.hanoi.String())
+		require.NotContains(t, ids, f.saigon.String())
+		require.NotContains(t, ids, f.hidden.String())
+	})
+
+	t.Run("bounding box", func(t *testing.T) {
+		ids := search(&dto.SearchListingCombinationQuery{
+			SearchListingGeoQuery: dto.SearchListingGeoQuery{
+				GMinLat: types.Ptr(10.5), GMaxLat: types.Ptr(11.0), GMinLng: types.Ptr(106.5), GMaxLng: types.Ptr(107.0),
+			},
+		})
+		require.Contains(t, ids, f.saigon.String())
+		require.NotContains(t, ids, f.hanoi.String())
+	})
+
+	t.Run("polygon", func(t *testing.T) {
+		ids := search(&dto.SearchListingCombinationQuery{
+			SearchListingGeoQuery: dto.SearchListingGeoQuery{
+				GPolygon: types.Ptr("21.1,105.7;21.1,106.0;20.9,106.0;20.9,105.7"),
+			},
+		})
+		require.Contains(t, ids, f.hanoi.String())
+		require.NotContains(t, ids, f.saigon.String())
+	})
+
+	t.Run("distance sort", func(t *testing.T) {
+		ids := search(&dto.SearchListingCombinationQuery{
+			SearchSortPaginationQuery: requests.SearchSortPaginationQuery{
+				SortBy: []string{dto.SORTBY_DISTANCE},
+				Order:  []string{"asc"},
+			},
+			SearchListingQuery: dto.SearchListingQuery{LQuery: types.Ptr(f.marker)},
+			SearchListingGeoQuery: dto.SearchListingGeoQuery{
+				GLat: types.Ptr(10.7769), GLng: types.Ptr(106.7009),
+			},
+		})
+		saigonIdx, hanoiIdx := slices.Index(ids, f.saigon.String()), slices.Index(ids, f.hanoi.String())
+		require.GreaterOrEqual(t, saigonIdx, 0)
+		require.Greater(t, hanoiIdx, saigonIdx)
+	})
+
+	t.Run("geo grid", func(t *testing.T) {
+		cells, err := searcher.GetListingGeoGrid(ctx, &dto.ListingGeoGridQuery{
+			SearchListingGeoQuery: dto.SearchListingGeoQuery{
+				GMinLat: types.Ptr(20.9), GMaxLat: types.Ptr(21.1), GMinLng: types.Ptr(105.7), GMaxLng: types.Ptr(106.0),
+			},
+			Zoom: 5,
+		})
+		require.NoError(t, err)
+		// Hanoi is in the tile 25/14 at zoom 5
+		idx := slices.IndexFunc(cells, func(c dto.ListingGeoGridCell) bool { return c.Key == "5/25/14" })
+		require.GreaterOrEqual(t, idx, 0)
+		require.GreaterOrEqual(t, cells[idx].Count, int64(1))
+		require.InDelta(t, f.hanoiLL[0], cells[idx].Lat, 1)
+		require.InDelta(t, f.hanoiLL[1], cells[idx].Lng, 1)
+	})
+
+	t.Run("similar listings leave out the listing itself and hidden listings", func(t *testing.T) {
+		docs, err := searcher.SearchSimilarListings(ctx, &dto.SimilarListingsQuery{
+			ExcludeID: f.hanoi,
+			PCity:     []string{"Hà Nội"},
+		}, 100)
+		require.NoError(t, err)
+		for _, doc := range docs {
+			var d struct {
+				ID     string `json:"id"`
+				Active bool   `json:"active"`
+			}
+			require.NoError(t, json.Unmarshal(doc, &d))
+			require.NotEqual(t, f.hanoi.String(), d.ID)
+			require.NotEqual(t, f.hidden.String(), d.ID)
+			require.True(t, d.Active, fmt.Sprintf("listing %s is not active", d.ID))
+		}
+	})
+}
+
+func TestPostgresSearcher(t *testing.T) {
+	testSearcherContract(t, NewPostgresSearcher(testDao, testDomainRepo))
+}
+
+func TestElasticSearcher(t *testing.T) {
+	if testEsClient == nil {
+		t.Skip("elasticsearch is not configured")
+	}
+	testSearcherContract(t, NewElasticSearcher(testEsClient))
+}
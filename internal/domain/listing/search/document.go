@@ -0,0 +1,226 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	repos "github.com/user2410/rrms-backend/internal/domain/_repos"
+	listing_model "github.com/user2410/rrms-backend/internal/domain/listing/model"
+	property_model "github.com/user2410/rrms-backend/internal/domain/property/model"
+	unit_model "github.com/user2410/rrms-backend/internal/domain/unit/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/infrastructure/es"
+)
+
+// AggregatedIndex is the search document of a listing, denormalized with its property and units
+type AggregatedIndex struct {
+	ID                string                   `json:"id"`
+	CreatorID         string                   `json:"creator_id"`
+	Title             string                   `json:"title"`
+	Description       string                   `json:"description"`
+	FullName          string                   `json:"full_name"`
+	Email             string                   `json:"email"`
+	Phone             string                   `json:"phone"`
+	ContactType       string                   `json:"contact_type"`
+	Price             float32                  `json:"price"`
+	PriceNegotiable   bool                     `json:"price_negotiable"`
+	SecurityDeposit   *float32                 `json:"security_deposit"`
+	LeaseTerm         *int32                   `json:"lease_term"`
+	PetsAllowed       *bool                    `json:"pets_allowed"`
+	NumberOfResidents *int32                   `json:"number_of_residents"`
+	Priority          int32                    `json:"priority"`
+	Active            bool                     `json:"active"`
+	CreatedAt         time.Time                `json:"created_at"`
+	UpdatedAt         time.Time                `json:"updated_at"`
+	ExpiredAt         time.Time                `json:"expired_at"`
+	Tags              []map[string]string      `json:"tags"`
+	Policies          []map[string]interface{} `json:"policies"`
+	ListingUnits      []map[string]interface{} `json:"listing_units"`
+	Property          map[string]interface{}   `json:"property"`
+	Location          *es.GeoPoint             `json:"location,omitempty"`
+	// title and description by language, each language is analyzed with its own analyzer
+	Translations map[string]TranslationDocument `json:"translations,omitempty"`
+}
+
+type TranslationDocument struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+func buildAggregatedIndex(listing *listing_model.ListingModel, property *property_model.PropertyModel, propertyVStatus any, units []unit_model.UnitModel, translations []listing_model.ListingTranslationModel) AggregatedIndex {
+	return AggregatedIndex{
+		ID:                listing.ID.String(),
+		CreatorID:         listing.CreatorID.String(),
+		Title:             listing.Title,
+		Description:       listing.Description,
+		FullName:          listing.FullName,
+		Email:             listing.Email,
+		Phone:             listing.Phone,
+		ContactType:       listing.ContactType,
+		Price:             listing.Price,
+		PriceNegotiable:   listing.PriceNegotiable,
+		SecurityDeposit:   listing.SecurityDeposit,
+		LeaseTerm:         listing.LeaseTerm,
+		PetsAllowed:       listing.PetsAllowed,
+		NumberOfResidents: listing.NumberOfResidents,
+		Priority:          listing.Priority,
+		Active:            listing.Active,
+		CreatedAt:         listing.CreatedAt,
+		UpdatedAt:         listing.UpdatedAt,
+		ExpiredAt:         listing.ExpiredAt,
+		Tags:              convertTags(listing.Tags),
+		Policies:          convertPolicies(listing.Policies),
+		ListingUnits:      convertListingUnits(units, listing.Units),
+		Property:          convertProperty(property, propertyVStatus),
+		Location:          es.NewGeoPoint(property.Lat, property.Lng),
+		Translations:      convertTranslations(translations),
+	}
+}
+
+// BuildListingDocument gathers the current state of the listing's property and units from the database into its search document
+func BuildListingDocument(ctx context.Context, domainRepo repos.DomainRepo, listing *listing_model.ListingModel) (AggregatedIndex, error) {
+	property, err := domainRepo.PropertyRepo.GetPropertyById(ctx, listing.PropertyID)
+	if err != nil {
+		return AggregatedIndex{}, err
+	}
+	if property == nil {
+		return AggregatedIndex{}, database.ErrRecordNotFound
+	}
+	var pv any = nil
+	pvs, err := domainRepo.PropertyRepo.GetPropertiesVerificationStatus(ctx, []uuid.UUID{property.ID})
+	if err != nil {
+		return AggregatedIndex{}, err
+	}
+	if len(pvs) > 0 {
+		pv = pvs[0].Status
+	}
+	units := make([]unit_model.UnitModel, 0, len(listing.Units))
+	for _, u := range listing.Units {
+		unit, err := domainRepo.UnitRepo.GetUnitById(ctx, u.UnitID)
+		if err != nil {
+			return AggregatedIndex{}, err
+		}
+		units = append(units, *unit)
+	}
+	translations, err := domainRepo.ListingRepo.GetListingTranslations(ctx, listing.ID)
+	if err != nil {
+		return AggregatedIndex{}, err
+	}
+	return buildAggregatedIndex(listing, property, pv, units, translations), nil
+}
+
+func convertTranslations(translations []listing_model.ListingTranslationModel) map[string]TranslationDocument {
+	if len(translations) == 0 {
+		return nil
+	}
+	result := make(map[string]TranslationDocument, len(translations))
+	for _, t := range translations {
+		result[t.Language] = TranslationDocument{
+			Title:       t.Title,
+			Description: t.Description,
+		}
+	}
+	return result
+}
+
+func convertTags(tags []listing_model.ListingTagModel) []map[string]string {
+	var result []map[string]string
+	for _, tag := range tags {
+		result = append(result, map[string]string{"tag": tag.Tag})
+	}
+	return result
+}
+
+func convertPolicies(policies []listing_model.ListingPolicyModel) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, policy := range policies {
+		result = append(result, map[string]interface{}{
+			"policy_id": policy.PolicyID,
+			"note":      policy.Note,
+		})
+	}
+	return result
+}
+
+func convertListingUnits(units []unit_model.UnitModel, listingUnits []listing_model.ListingUnitModel) []map[string]interface{} {
+	var result []map[string]interface{}
+	unitMap := make(map[uuid.UUID]unit_model.UnitModel)
+	for _, unit := range units {
+		unitMap[unit.ID] = unit
+	}
+	for _, lu := range listingUnits {
+		unit := unitMap[lu.UnitID]
+		unitData := map[string]interface{}{
+			"unit_id":                lu.UnitID.String(),
+			"price":                  lu.Price,
+			"name":                   unit.Name,
+			"area":                   unit.Area,
+			"floor":                  unit.Floor,
+			"number_of_living_rooms": unit.NumberOfLivingRooms,
+			"number_of_bedrooms":     unit.NumberOfBedrooms,
+			"number_of_bathrooms":    unit.NumberOfBathrooms,
+			"number_of_toilets":      unit.NumberOfToilets,
+			"number_of_balconies":    unit.NumberOfBalconies,
+			"number_of_kitchens":     unit.NumberOfKitchens,
+			"type":                   unit.Type,
+			"created_at":             unit.CreatedAt,
+			"updated_at":             unit.UpdatedAt,
+			"amenities":              convertAmenities(unit.Amenities),
+		}
+		result = append(result, unitData)
+	}
+	return result
+}
+
+func convertAmenities(amenities []unit_model.UnitAmenityModel) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, amenity := range amenities {
+		result = append(result, map[string]interface{}{
+			"amenity_id":  amenity.AmenityID,
+			"description": amenity.Description,
+		})
+	}
+	return result
+}
+
+func convertProperty(property *property_model.PropertyModel, pv any) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                  property.ID.String(),
+		"creator_id":          property.CreatorID.String(),
+		"name":                property.Name,
+		"building":            property.Building,
+		"project":             property.Project,
+		"area":                property.Area,
+		"number_of_floors":    property.NumberOfFloors,
+		"year_built":          property.YearBuilt,
+		"orientation":         property.Orientation,
+		"entrance_width":      property.EntranceWidth,
+		"facade":              property.Facade,
+		"full_address":        property.FullAddress,
+		"city":                property.City,
+		"district":            property.District,
+		"ward":                property.Ward,
+		"lat":                 property.Lat,
+		"lng":                 property.Lng,
+		"primary_image":       property.PrimaryImage,
+		"description":         property.Description,
+		"type":                property.Type,
+		"is_public":           property.IsPublic,
+		"verification_status": pv,
+		"created_at":          property.CreatedAt,
+		"updated_at":          property.UpdatedAt,
+		"features":            convertFeatures(property.Features),
+	}
+}
+
+func convertFeatures(features []property_model.PropertyFeatureModel) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, feature := range features {
+		result = append(result, map[string]interface{}{
+			"feature_id":  feature.FeatureID,
+			"description": feature.Description,
+		})
+	}
+	return result
+}
@@ -0,0 +1,674 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/core/closepointintime"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/core/search"
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/functionboostmode"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/functionscoremode"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/sortorder"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/textquerytype"
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	listing_utils "github.com/user2410/rrms-backend/internal/domain/listing/utils"
+	"github.com/user2410/rrms-backend/internal/infrastructure/es"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type elasticSearcher struct {
+	esClient *es.ElasticSearchClient
+}
+
+func NewElasticSearcher(esClient *es.ElasticSearchClient) Searcher {
+	return &elasticSearcher{
+		esClient: esClient,
+	}
+}
+
+// visibleListingQueries restricts a search to the listings shown to the public
+func visibleListingQueries() []estypes.Query {
+	return []estypes.Query{
+		{Term: map[string]estypes.TermQuery{"active": {Value: true}}},
+		{Term: map[string]estypes.TermQuery{"property.is_public": {Value: true}}},
+		{Range: map[string]estypes.RangeQuery{"expired_at": estypes.DateRangeQuery{Gte: types.Ptr("now")}}},
+	}
+}
+
+// textQuery matches the text against the title, description and address of the listing,
+// and against its translation into the language of the user.
+// Exact words and their synonyms score the most, then words typed next to each other like the listing does,
+// typos are tolerated at a lower score.
+func textQuery(text string, language string) estypes.Query {
+	fields := []string{"title^3", "description", "property.full_address^2"}
+	if language != "" && language != listing_utils.LANGUAGE_DEFAULT {
+		fields = append(fields, fmt.Sprintf("translations.%s.title^3", language), fmt.Sprintf("translations.%s.description", language))
+	}
+	return estypes.Query{
+		Bool: &estypes.BoolQuery{
+			Should: []estypes.Query{
+				{
+					MultiMatch: &estypes.MultiMatchQuery{
+						Query:  text,
+						Fields: fields,
+						Type:   &textquerytype.Mostfields,
+						Boost:  types.Ptr[float32](2),
+					},
+				},
+				{
+					MultiMatch: &estypes.MultiMatchQuery{
+						Query:  text,
+						Fields: []string{"title.shingles^3", "property.full_address.shingles^2"},
+						Type:   &textquerytype.Mostfields,
+					},
+				},
+				{
+					MultiMatch: &estypes.MultiMatchQuery{
+						Query:        text,
+						Fields:       fields,
+						Type:         &textquerytype.Mostfields,
+						Fuzziness:    "AUTO",
+						PrefixLength: types.Ptr(1),
+					},
+				},
+			},
+			MinimumShouldMatch: 1,
+		},
+	}
+}
+
+// geoQueries builds the filters on the listing location, every filter given must match
+func geoQueries(q *dto.SearchListingGeoQuery) ([]estypes.Query, error) {
+	queries := []estypes.Query{
+		{Exists: &estypes.ExistsQuery{Field: es.LISTINGLOCATIONFIELD}},
+	}
+	if q.GRadius != nil {
+		queries = append(queries, estypes.Query{
+			GeoDistance: &estypes.GeoDistanceQuery{
+				Distance: fmt.Sprintf("%fkm", *q.GRadius),
+				GeoDistanceQuery: map[string]estypes.GeoLocation{
+					es.LISTINGLOCATIONFIELD: estypes.LatLonGeoLocation{Lat: estypes.Float64(*q.GLat), Lon: estypes.Float64(*q.GLng)},
+				},
+			},
+		})
+	}
+	if q.HasBounds() {
+		queries = append(queries, estypes.Query{
+			GeoBoundingBox: &estypes.GeoBoundingBoxQuery{
+				GeoBoundingBoxQuery: map[string]estypes.GeoBounds{
+					es.LISTINGLOCATIONFIELD: estypes.TopLeftBottomRightGeoBounds{
+						TopLeft:     estypes.LatLonGeoLocation{Lat: estypes.Float64(*q.GMaxLat), Lon: estypes.Float64(*q.GMinLng)},
+						BottomRight: estypes.LatLonGeoLocation{Lat: estypes.Float64(*q.GMinLat), Lon: estypes.Float64(*q.GMaxLng)},
+					},
+				},
+			},
+		})
+	}
+	if q.GPolygon != nil && len(*q.GPolygon) > 0 {
+		points, err := listing_utils.ParseGeoPolygon(*q.GPolygon)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, estypes.Query{
+			GeoShape: &estypes.GeoShapeQuery{
+				GeoShapeQuery: map[string]estypes.GeoShapeFieldQuery{
+					es.LISTINGLOCATIONFIELD: {Shape: listing_utils.GeoJSONPolygon(points)},
+				},
+			},
+		})
+	}
+	return queries, nil
+}
+
+// SearchListingIds pages through all the matching listings with search_after on a point in time,
+// so that searches matching more than SEARCH_PAGESIZE listings are neither truncated nor inconsistent between pages
+func (e *elasticSearcher) SearchListingIds(ctx context.Context, q *dto.SearchListingCombinationQuery) ([]string, error) {
+	filters := visibleListingQueries()
+	if q.HasGeoFilter() || q.SortByDistance() {
+		geoFilters, err := geoQueries(&q.SearchListingGeoQuery)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, geoFilters...)
+	}
+	boolQuery := &estypes.BoolQuery{
+		Filter: filters,
+	}
+	if q.HasTextQuery() {
+		boolQuery.Must = []estypes.Query{textQuery(*q.LQuery, q.Language)}
+	}
+
+	// the id breaks ties, so that search_after never skips nor repeats a listing
+	idSort := estypes.SortOptions{SortOptions: map[string]estypes.FieldSort{"id": {Order: &sortorder.Asc}}}
+	sort := []estypes.SortCombinations{
+		estypes.SortOptions{Score_: &estypes.ScoreSort{Order: &sortorder.Desc}},
+		idSort,
+	}
+	if q.SortByDistance() {
+		sort = []estypes.SortCombinations{
+			estypes.SortOptions{
+				GeoDistance_: &estypes.GeoDistanceSort{
+					GeoDistanceSort: map[string][]estypes.GeoLocation{
+						es.LISTINGLOCATIONFIELD: {estypes.LatLonGeoLocation{Lat: estypes.Float64(*q.GLat), Lon: estypes.Float64(*q.GLng)}},
+					},
+					Order: &sortorder.Asc,
+				},
+			},
+			idSort,
+		}
+	}
+
+	client := e.esClient.GetTypedClient()
+	pit, err := client.OpenPointInTime(string(es.LISTINGINDEX)).KeepAlive(SEARCH_PIT_KEEPALIVE).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pitId := pit.Id
+	defer func() {
+		if _, err := client.ClosePointInTime().Request(&closepointintime.Request{Id: pitId}).Do(context.Background()); err != nil {
+			log.Println("failed to close the point in time of a listing search:", err)
+		}
+	}()
+
+	var (
+		ids         []string
+		searchAfter []estypes.FieldValue
+	)
+	for {
+		res, err := client.Search().
+			Request(&search.Request{
+				Size:        types.Ptr(SEARCH_PAGESIZE),
+				Source_:     false,
+				Query:       &estypes.Query{Bool: boolQuery},
+				Sort:        sort,
+				Pit:         &estypes.PointInTimeReference{Id: pitId, KeepAlive: SEARCH_PIT_KEEPALIVE},
+				SearchAfter: searchAfter,
+			}).
+			Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if res.PitId != nil {
+			pitId = *res.PitId
+		}
+		for _, h := range res.Hits.Hits {
+			if h.Id_ != nil {
+				ids = append(ids, *h.Id_)
+			}
+		}
+		if len(res.Hits.Hits) < SEARCH_PAGESIZE {
+			break
+		}
+		searchAfter = res.Hits.Hits[len(res.Hits.Hits)-1].Sort
+	}
+	if ids == nil {
+		ids = []string{}
+	}
+	return ids, nil
+}
+
+func (e *elasticSearcher) GetListingGeoGrid(ctx context.Context, q *dto.ListingGeoGridQuery) ([]dto.ListingGeoGridCell, error) {
+	filters, err := geoQueries(&q.SearchListingGeoQuery)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.esClient.GetTypedClient().Search().
+		Index(string(es.LISTINGINDEX)).
+		TypedKeys(true).
+		Request(&search.Request{
+			Size: types.Ptr(0),
+			Query: &estypes.Query{
+				Bool: &estypes.BoolQuery{
+					Filter: append(filters, visibleListingQueries()...),
+				},
+			},
+			Aggregations: map[string]estypes.Aggregations{
+				"grid": {
+					GeotileGrid: &estypes.GeoTileGridAggregation{
+						Field:     types.Ptr(es.LISTINGLOCATIONFIELD),
+						Precision: types.Ptr(q.Zoom),
+						Size:      types.Ptr(GEOGRID_MAX_CELLS),
+					},
+					Aggregations: map[string]estypes.Aggregations{
+						"centroid": {
+							GeoCentroid: &estypes.GeoCentroidAggregation{
+								Field: types.Ptr(es.LISTINGLOCATIONFIELD),
+							},
+						},
+					},
+				},
+			},
+		}).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cells := []dto.ListingGeoGridCell{}
+	grid, ok := res.Aggregations["grid"].(*estypes.GeoTileGridAggregate)
+	if !ok {
+		return cells, nil
+	}
+	buckets, ok := grid.Buckets.([]estypes.GeoTileGridBucket)
+	if !ok {
+		return cells, nil
+	}
+	for _, b := range buckets {
+		cell := dto.ListingGeoGridCell{
+			Key:   b.Key,
+			Count: b.DocCount,
+		}
+		if centroid, ok := b.Aggregations["centroid"].(*estypes.GeoCentroidAggregate); ok {
+			cell.Lat, cell.Lng = listing_utils.GeoLocationLatLng(centroid.Location)
+		}
+		cells = append(cells, cell)
+	}
+	return cells, nil
+}
+
+// similarityScoreFunctions weights every criterion of the query matched by a listing
+func similarityScoreFunctions(query *dto.SimilarListingsQuery) []estypes.FunctionScore {
+	scoreFns := []estypes.FunctionScore{
+		{
+			Filter: &estypes.Query{
+				Term: map[string]estypes.TermQuery{
+					"property.verification_status": {
+						Value: "APPROVED",
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](3),
+		},
+		{
+			Filter: &estypes.Query{
+				Term: map[string]estypes.TermQuery{
+					"property.verification_status": {
+						Value: "PENDING",
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](2),
+		},
+		{
+			Filter: &estypes.Query{
+				Term: map[string]estypes.TermQuery{
+					"property.verification_status": {
+						Value: "REJECTED",
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](1),
+		},
+		{
+			Filter: &estypes.Query{
+				Bool: &estypes.BoolQuery{
+					MustNot: []estypes.Query{
+						{
+							Exists: &estypes.ExistsQuery{
+								Field: "property.verification_status",
+							},
+						},
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](0.5),
+		},
+	}
+	if len(query.PCity) > 0 {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Terms: &estypes.TermsQuery{
+					TermsQuery: map[string]estypes.TermsQueryField{
+						"property.city": query.PCity,
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](10),
+		})
+	}
+	if len(query.PDistrict) > 0 {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Terms: &estypes.TermsQuery{
+					TermsQuery: map[string]estypes.TermsQueryField{
+						"property.district": query.PDistrict,
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](15),
+		})
+	}
+	if len(query.PWard) > 0 {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Terms: &estypes.TermsQuery{
+					TermsQuery: map[string]estypes.TermsQueryField{
+						"property.ward": query.PWard,
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](20),
+		})
+	}
+	if query.LMaxPrice != nil {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Range: map[string]estypes.RangeQuery{
+					"price": estypes.NumberRangeQuery{
+						Lte: types.Ptr(estypes.Float64(*query.LMaxPrice)),
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](4.5),
+		})
+	}
+	if query.LMinPrice != nil {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Range: map[string]estypes.RangeQuery{
+					"price": estypes.NumberRangeQuery{
+						Gte: types.Ptr(estypes.Float64(*query.LMinPrice)),
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](4.5),
+		})
+	}
+	if query.PMaxArea != nil {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Range: map[string]estypes.RangeQuery{
+					"property.area": estypes.NumberRangeQuery{
+						Lte: types.Ptr(estypes.Float64(*query.PMaxArea)),
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](4.5),
+		})
+	}
+	if query.PMinArea != nil {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Range: map[string]estypes.RangeQuery{
+					"property.area": estypes.NumberRangeQuery{
+						Gte: types.Ptr(estypes.Float64(*query.PMinArea)),
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](4.5),
+		})
+	}
+	if len(query.PTypes) > 0 {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Terms: &estypes.TermsQuery{
+					TermsQuery: map[string]estypes.TermsQueryField{
+						"property.type": query.PTypes,
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](4.5),
+		})
+	}
+	if query.UNumberOfBedrooms != nil {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Nested: &estypes.NestedQuery{
+					Path: "listing_units",
+					Query: &estypes.Query{
+						Range: map[string]estypes.RangeQuery{
+							"listing_units.number_of_bedrooms": estypes.NumberRangeQuery{
+								Gte: types.Ptr(estypes.Float64(*query.UNumberOfBedrooms)),
+							},
+						},
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](3),
+		})
+	}
+	if query.UNumberOfBathrooms != nil {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Nested: &estypes.NestedQuery{
+					Path: "listing_units",
+					Query: &estypes.Query{
+						Range: map[string]estypes.RangeQuery{
+							"listing_units.number_of_bathrooms": estypes.NumberRangeQuery{
+								Gte: types.Ptr(estypes.Float64(*query.UNumberOfBathrooms)),
+							},
+						},
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](3),
+		})
+	}
+	if query.UNumberOfBalconies != nil {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Nested: &estypes.NestedQuery{
+					Path: "listing_units",
+					Query: &estypes.Query{
+						Range: map[string]estypes.RangeQuery{
+							"listing_units.number_of_balconies": estypes.NumberRangeQuery{
+								Gte: types.Ptr(estypes.Float64(*query.UNumberOfBalconies)),
+							},
+						},
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](3),
+		})
+	}
+	if query.UNumberOfToilets != nil {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Nested: &estypes.NestedQuery{
+					Path: "listing_units",
+					Query: &estypes.Query{
+						Range: map[string]estypes.RangeQuery{
+							"listing_units.number_of_toilets": estypes.NumberRangeQuery{
+								Gte: types.Ptr(estypes.Float64(*query.UNumberOfToilets)),
+							},
+						},
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](3),
+		})
+	}
+	if query.UNumberOfLivingRooms != nil {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Nested: &estypes.NestedQuery{
+					Path: "listing_units",
+					Query: &estypes.Query{
+						Range: map[string]estypes.RangeQuery{
+							"listing_units.number_of_living_rooms": estypes.NumberRangeQuery{
+								Gte: types.Ptr(estypes.Float64(*query.UNumberOfLivingRooms)),
+							},
+						},
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](2),
+		})
+	}
+	if query.UNumberOfKitchens != nil {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Nested: &estypes.NestedQuery{
+					Path: "listing_units",
+					Query: &estypes.Query{
+						Range: map[string]estypes.RangeQuery{
+							"listing_units.number_of_kitchens": estypes.NumberRangeQuery{
+								Gte: types.Ptr(estypes.Float64(*query.UNumberOfKitchens)),
+							},
+						},
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](2),
+		})
+	}
+	if len(query.POrientation) > 0 {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Terms: &estypes.TermsQuery{
+					TermsQuery: map[string]estypes.TermsQueryField{
+						"property.orientation": query.POrientation,
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](3),
+		})
+	}
+	if len(query.PFeatures) > 0 {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Nested: &estypes.NestedQuery{
+					Path: "property.features",
+					Query: &estypes.Query{
+						Terms: &estypes.TermsQuery{
+							TermsQuery: map[string]estypes.TermsQueryField{
+								"property.features.feature_id": query.PFeatures,
+							},
+						},
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](1),
+		})
+	}
+	if len(query.UAmenities) > 0 {
+		scoreFns = append(scoreFns, estypes.FunctionScore{
+			Filter: &estypes.Query{
+				Nested: &estypes.NestedQuery{
+					Path: "listing_units.amenities",
+					Query: &estypes.Query{
+						Terms: &estypes.TermsQuery{
+							TermsQuery: map[string]estypes.TermsQueryField{
+								"listing_units.amenities.amenity_id": query.UAmenities,
+							},
+						},
+					},
+				},
+			},
+			Weight: types.Ptr[estypes.Float64](1),
+		})
+	}
+
+	return scoreFns
+}
+
+func (e *elasticSearcher) SearchSimilarListings(ctx context.Context, query *dto.SimilarListingsQuery, limit int) ([]json.RawMessage, error) {
+	res, err := e.esClient.GetTypedClient().Search().
+		Index(string(es.LISTINGINDEX)).
+		Request(&search.Request{
+			Size: types.Ptr(limit),
+			Query: &estypes.Query{
+				FunctionScore: &estypes.FunctionScoreQuery{
+					Query: &estypes.Query{
+						Bool: &estypes.BoolQuery{
+							Filter: visibleListingQueries(),
+							MustNot: []estypes.Query{
+								{Term: map[string]estypes.TermQuery{"id": {Value: query.ExcludeID.String()}}},
+							},
+						},
+					},
+					Functions: similarityScoreFunctions(query),
+					ScoreMode: &functionscoremode.Sum,
+					BoostMode: &functionboostmode.Replace,
+				},
+			},
+			Sort: []estypes.SortCombinations{
+				estypes.SortOptions{SortOptions: map[string]estypes.FieldSort{"priority": {Order: &sortorder.Desc}}},
+				estypes.SortOptions{Score_: &estypes.ScoreSort{Order: &sortorder.Desc}},
+			},
+		}).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]json.RawMessage, 0, len(res.Hits.Hits))
+	for _, h := range res.Hits.Hits {
+		docs = append(docs, h.Source_)
+	}
+	return docs, nil
+}
+
+func (e *elasticSearcher) AutocompleteListings(ctx context.Context, q *dto.ListingAutocompleteQuery) ([]dto.ListingSuggestion, error) {
+	res, err := e.esClient.GetTypedClient().Search().
+		Index(string(es.LISTINGINDEX)).
+		Request(&search.Request{
+			Size: types.Ptr(q.GetLimit()),
+			Source_: estypes.SourceFilter{
+				Includes: []string{"id", "title", "property.full_address"},
+			},
+			Query: &estypes.Query{
+				Bool: &estypes.BoolQuery{
+					Filter: visibleListingQueries(),
+					Must: []estypes.Query{
+						{
+							MultiMatch: &estypes.MultiMatchQuery{
+								Query:        q.Q,
+								Fields:       []string{"suggest", "suggest._2gram", "suggest._3gram"},
+								Type:         &textquerytype.Boolprefix,
+								Fuzziness:    "AUTO",
+								PrefixLength: types.Ptr(1),
+							},
+						},
+					},
+				},
+			},
+			Sort: []estypes.SortCombinations{
+				estypes.SortOptions{Score_: &estypes.ScoreSort{Order: &sortorder.Desc}},
+				estypes.SortOptions{SortOptions: map[string]estypes.FieldSort{"priority": {Order: &sortorder.Desc}}},
+			},
+		}).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]dto.ListingSuggestion, 0, len(res.Hits.Hits))
+	for _, h := range res.Hits.Hits {
+		var doc struct {
+			ID       uuid.UUID `json:"id"`
+			Title    string    `json:"title"`
+			Property struct {
+				FullAddress string `json:"full_address"`
+			} `json:"property"`
+		}
+		if err := json.Unmarshal(h.Source_, &doc); err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, dto.ListingSuggestion{
+			ListingID:   doc.ID,
+			Title:       doc.Title,
+			FullAddress: doc.Property.FullAddress,
+		})
+	}
+	return suggestions, nil
+}
+
+func (e *elasticSearcher) IndexListing(ctx context.Context, doc *AggregatedIndex) error {
+	_, err := e.esClient.GetTypedClient().Index(string(es.LISTINGINDEX)).Request(doc).Id(doc.ID).Do(ctx)
+	return err
+}
+
+// DeleteListing removes the document of the listing, a missing document is not an error
+func (e *elasticSearcher) DeleteListing(ctx context.Context, id uuid.UUID) error {
+	_, err := e.esClient.GetTypedClient().Delete(string(es.LISTINGINDEX), id.String()).Do(ctx)
+	var esErr *estypes.ElasticsearchError
+	if errors.As(err, &esErr) && esErr.Status == 404 {
+		return nil
+	}
+	return err
+}
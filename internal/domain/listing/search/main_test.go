@@ -0,0 +1,55 @@
+package search
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	repos "github.com/user2410/rrms-backend/internal/domain/_repos"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/infrastructure/es"
+	"github.com/user2410/rrms-backend/internal/infrastructure/redisd"
+	"github.com/user2410/rrms-backend/internal/utils"
+	"github.com/user2410/rrms-backend/internal/utils/config"
+)
+
+var (
+	basePath       = utils.GetBasePath()
+	testDomainRepo repos.DomainRepo
+	testDao        database.DAO
+	testEsClient   *es.ElasticSearchClient
+)
+
+func TestMain(m *testing.M) {
+	conf, err := config.NewTestRepoConfig(basePath)
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	testDao, err = database.NewPostgresDAO(conf.DatabaseURL)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     conf.RedisAddr,
+		Password: conf.RedisPassword,
+		DB:       conf.RedisDB,
+	})
+	testDomainRepo = repos.NewDomainRepo(testDao, redisd.NewRedisClient(rdb))
+
+	if utils.PtrDerefence(conf.ElasticsearchAddresses, "") != "" {
+		testEsClient, err = es.NewElasticSearchClient(es.ElasticSearchClientParams{
+			Addresses:  conf.ElasticsearchAddresses,
+			Username:   conf.ElasticsearchUsername,
+			Password:   conf.ElasticsearchPassword,
+			CACertPath: conf.ElasticsearchCACertPath,
+		})
+		if err != nil {
+			log.Fatalf("failed to connect to elasticsearch: %v", err)
+		}
+	}
+
+	os.Exit(m.Run())
+}
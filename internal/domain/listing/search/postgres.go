@@ -0,0 +1,327 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	repos "github.com/user2410/rrms-backend/internal/domain/_repos"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	listing_utils "github.com/user2410/rrms-backend/internal/domain/listing/utils"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// pgTextVector is the text search document of a listing, it must stay identical to the expression of listings_fulltext_idx
+const pgTextVector = `(setweight(to_tsvector('simple', f_unaccent(listings.title)), 'A') || setweight(to_tsvector('simple', f_unaccent(listings.description)), 'B'))`
+
+// pgTranslationVector is the text search document of a translation of a listing
+const pgTranslationVector = `(setweight(to_tsvector('simple', f_unaccent(listing_translations.title)), 'A') || setweight(to_tsvector('simple', f_unaccent(listing_translations.description)), 'B'))`
+
+// postgresSearcher searches the listing tables directly, for deployments without Elasticsearch.
+// Titles and descriptions are matched with full-text search on their unaccented words,
+// addresses with trigram similarity so that partial and misspelled addresses still match.
+type postgresSearcher struct {
+	dao        database.DAO
+	domainRepo repos.DomainRepo
+}
+
+func NewPostgresSearcher(dao database.DAO, domainRepo repos.DomainRepo) Searcher {
+	return &postgresSearcher{
+		dao:        dao,
+		domainRepo: domainRepo,
+	}
+}
+
+func newListingSelectBuilder() *sqlbuilder.SelectBuilder {
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.From("listings")
+	sb.JoinWithOption(sqlbuilder.InnerJoin, "properties", "properties.id = listings.property_id")
+	// visible listings only
+	sb.Where("listings.active", "properties.is_public", "listings.expired_at >= NOW()")
+	return sb
+}
+
+// pgDistanceExpr is the haversine distance in km between the property and (lat, lng)
+func pgDistanceExpr(sb *sqlbuilder.SelectBuilder, lat, lng float64) string {
+	pLat, pLng := sb.Var(lat), sb.Var(lng)
+	return fmt.Sprintf(
+		"(12742 * asin(sqrt(power(sin(radians(properties.lat - %s) / 2), 2) + cos(radians(%s)) * cos(radians(properties.lat)) * power(sin(radians(properties.lng - %s) / 2), 2))))",
+		pLat, pLat, pLng,
+	)
+}
+
+func pgGeoConditions(sb *sqlbuilder.SelectBuilder, q *dto.SearchListingGeoQuery) error {
+	sb.Where("properties.lat IS NOT NULL", "properties.lng IS NOT NULL")
+	if q.GRadius != nil {
+		sb.Where(fmt.Sprintf("%s <= %s", pgDistanceExpr(sb, *q.GLat, *q.GLng), sb.Var(*q.GRadius)))
+	}
+	if q.HasBounds() {
+		sb.Where(
+			sb.Between("properties.lat", *q.GMinLat, *q.GMaxLat),
+			sb.Between("properties.lng", *q.GMinLng, *q.GMaxLng),
+		)
+	}
+	if q.GPolygon != nil && len(*q.GPolygon) > 0 {
+		points, err := listing_utils.ParseGeoPolygon(*q.GPolygon)
+		if err != nil {
+			return err
+		}
+		vertices := make([]string, 0, len(points))
+		for _, p := range points {
+			vertices = append(vertices, fmt.Sprintf("(%f,%f)", p.Lon, p.Lat))
+		}
+		sb.Where(fmt.Sprintf("%s::POLYGON @> point(properties.lng, properties.lat)", sb.Var("("+strings.Join(vertices, ",")+")")))
+	}
+	return nil
+}
+
+// pgTextQuery returns the tsquery of the words of the text and their synonyms, and the unaccented text for the trigram matches
+func pgTextQuery(sb *sqlbuilder.SelectBuilder, text string, prefix bool) (string, string) {
+	text = strings.TrimSpace(text)
+	return fmt.Sprintf("to_tsquery('simple', f_unaccent(%s))", sb.Var(listing_utils.PgTsQuery(text, prefix))),
+		fmt.Sprintf("f_unaccent(%s)", sb.Var(text))
+}
+
+// pgTextCondition matches the words of the title and description, or tolerates typos with trigram similarity to the title and address
+func pgTextCondition(tsQuery, text string) string {
+	return fmt.Sprintf(
+		"(%s @@ %s OR %s <%% f_unaccent(listings.title) OR %s <%% f_unaccent(properties.full_address))",
+		pgTextVector, tsQuery, text, text,
+	)
+}
+
+func pgTextRank(tsQuery, text string) string {
+	return fmt.Sprintf(
+		"(ts_rank(%s, %s) + word_similarity(%s, f_unaccent(listings.title)) + word_similarity(%s, f_unaccent(properties.full_address)))",
+		pgTextVector, tsQuery, text, text,
+	)
+}
+
+// pgTranslationMatch is the rank of the translation of the listing into the language matching the tsquery, NULL if there is none
+func pgTranslationMatch(sb *sqlbuilder.SelectBuilder, language, tsQuery string) string {
+	return fmt.Sprintf(
+		"(SELECT ts_rank(%s, %s) FROM listing_translations WHERE listing_translations.listing_id = listings.id AND listing_translations.language = %s AND %s @@ %s)",
+		pgTranslationVector, tsQuery, sb.Var(language), pgTranslationVector, tsQuery,
+	)
+}
+
+func (p *postgresSearcher) queryIds(ctx context.Context, sb *sqlbuilder.SelectBuilder) ([]string, error) {
+	sql, args := sb.Build()
+	rows, err := p.dao.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (p *postgresSearcher) SearchListingIds(ctx context.Context, q *dto.SearchListingCombinationQuery) ([]string, error) {
+	sb := newListingSelectBuilder()
+	sb.Select("listings.id::TEXT")
+	if q.HasGeoFilter() || q.SortByDistance() {
+		if err := pgGeoConditions(sb, &q.SearchListingGeoQuery); err != nil {
+			return nil, err
+		}
+	}
+
+	var orderBy []string
+	if q.HasTextQuery() {
+		tsQuery, text := pgTextQuery(sb, *q.LQuery, false)
+		condition, rank := pgTextCondition(tsQuery, text), pgTextRank(tsQuery, text)
+		// the listings are also matched by their translation into the language of the user
+		if q.Language != "" && q.Language != listing_utils.LANGUAGE_DEFAULT {
+			translation := pgTranslationMatch(sb, q.Language, tsQuery)
+			condition = fmt.Sprintf("(%s OR %s IS NOT NULL)", condition, translation)
+			rank = fmt.Sprintf("(%s + COALESCE(%s, 0))", rank, translation)
+		}
+		sb.Where(condition)
+		orderBy = append(orderBy, rank+" DESC")
+	}
+	if q.SortByDistance() {
+		orderBy = []string{pgDistanceExpr(sb, *q.GLat, *q.GLng)}
+	}
+	sb.OrderBy(append(orderBy, "listings.id")...)
+
+	return p.queryIds(ctx, sb)
+}
+
+func (p *postgresSearcher) GetListingGeoGrid(ctx context.Context, q *dto.ListingGeoGridQuery) ([]dto.ListingGeoGridCell, error) {
+	sb := newListingSelectBuilder()
+	// web mercator tile of the property at the zoom level, clamped like the geotile_grid aggregation of Elasticsearch
+	sb.Select(
+		fmt.Sprintf("LEAST(GREATEST(floor((properties.lng + 180) / 360 * power(2, %d)), 0), power(2, %d) - 1)::BIGINT AS x", q.Zoom, q.Zoom),
+		fmt.Sprintf("LEAST(GREATEST(floor((1 - ln(tan(radians(LEAST(GREATEST(properties.lat, -85.05112878), 85.05112878))) + 1 / cos(radians(LEAST(GREATEST(properties.lat, -85.05112878), 85.05112878)))) / pi()) / 2 * power(2, %d)), 0), power(2, %d) - 1)::BIGINT AS y", q.Zoom, q.Zoom),
+		"properties.lat", "properties.lng",
+	)
+	if err := pgGeoConditions(sb, &q.SearchListingGeoQuery); err != nil {
+		return nil, err
+	}
+	tiles, args := sb.Build()
+
+	rows, err := p.dao.Query(ctx, fmt.Sprintf(
+		"SELECT x, y, count(*), avg(lat), avg(lng) FROM (%s) AS tiles GROUP BY x, y ORDER BY count(*) DESC, x, y LIMIT %d",
+		tiles, GEOGRID_MAX_CELLS,
+	), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	cells := []dto.ListingGeoGridCell{}
+	for rows.Next() {
+		var (
+			x, y int64
+			cell dto.ListingGeoGridCell
+		)
+		if err := rows.Scan(&x, &y, &cell.Count, &cell.Lat, &cell.Lng); err != nil {
+			return nil, err
+		}
+		cell.Key = fmt.Sprintf("%d/%d/%d", q.Zoom, x, y)
+		cells = append(cells, cell)
+	}
+	return cells, rows.Err()
+}
+
+// pgSimilarityScore mirrors the score functions of the Elasticsearch searcher
+func pgSimilarityScore(sb *sqlbuilder.SelectBuilder, query *dto.SimilarListingsQuery) string {
+	terms := []string{
+		`(CASE (SELECT status FROM property_verification_requests WHERE property_id = properties.id ORDER BY updated_at DESC LIMIT 1)
+			WHEN 'APPROVED' THEN 3 WHEN 'PENDING' THEN 2 WHEN 'REJECTED' THEN 1 ELSE 0.5 END)`,
+	}
+	addTerm := func(cond string, weight float64) {
+		terms = append(terms, fmt.Sprintf("(CASE WHEN %s THEN %v ELSE 0 END)", cond, weight))
+	}
+	unitCond := func(cond string) string {
+		return fmt.Sprintf("EXISTS (SELECT 1 FROM listing_units JOIN units ON units.id = listing_units.unit_id WHERE listing_units.listing_id = listings.id AND %s)", cond)
+	}
+
+	if len(query.PCity) > 0 {
+		addTerm(sb.In("properties.city", sqlbuilder.Flatten(query.PCity)...), 10)
+	}
+	if len(query.PDistrict) > 0 {
+		addTerm(sb.In("properties.district", sqlbuilder.Flatten(query.PDistrict)...), 15)
+	}
+	if len(query.PWard) > 0 {
+		addTerm(sb.In("properties.ward", sqlbuilder.Flatten(query.PWard)...), 20)
+	}
+	if query.LMaxPrice != nil {
+		addTerm(sb.LessEqualThan("listings.price", *query.LMaxPrice), 4.5)
+	}
+	if query.LMinPrice != nil {
+		addTerm(sb.GreaterEqualThan("listings.price", *query.LMinPrice), 4.5)
+	}
+	if query.PMaxArea != nil {
+		addTerm(sb.LessEqualThan("properties.area", *query.PMaxArea), 4.5)
+	}
+	if query.PMinArea != nil {
+		addTerm(sb.GreaterEqualThan("properties.area", *query.PMinArea), 4.5)
+	}
+	if len(query.PTypes) > 0 {
+		addTerm(sb.In("properties.type::TEXT", sqlbuilder.Flatten(query.PTypes)...), 4.5)
+	}
+	if query.UNumberOfBedrooms != nil {
+		addTerm(unitCond(sb.GreaterEqualThan("units.number_of_bedrooms", *query.UNumberOfBedrooms)), 3)
+	}
+	if query.UNumberOfBathrooms != nil {
+		addTerm(unitCond(sb.GreaterEqualThan("units.number_of_bathrooms", *query.UNumberOfBathrooms)), 3)
+	}
+	if query.UNumberOfBalconies != nil {
+		addTerm(unitCond(sb.GreaterEqualThan("units.number_of_balconies", *query.UNumberOfBalconies)), 3)
+	}
+	if query.UNumberOfToilets != nil {
+		addTerm(unitCond(sb.GreaterEqualThan("units.number_of_toilets", *query.UNumberOfToilets)), 3)
+	}
+	if query.UNumberOfLivingRooms != nil {
+		addTerm(unitCond(sb.GreaterEqualThan("units.number_of_living_rooms", *query.UNumberOfLivingRooms)), 2)
+	}
+	if query.UNumberOfKitchens != nil {
+		addTerm(unitCond(sb.GreaterEqualThan("units.number_of_kitchens", *query.UNumberOfKitchens)), 2)
+	}
+	if len(query.POrientation) > 0 {
+		addTerm(sb.In("properties.orientation", sqlbuilder.Flatten(query.POrientation)...), 3)
+	}
+	if len(query.PFeatures) > 0 {
+		addTerm(fmt.Sprintf("EXISTS (SELECT 1 FROM property_features WHERE property_features.property_id = properties.id AND %s)",
+			sb.In("property_features.feature_id", sqlbuilder.Flatten(query.PFeatures)...)), 1)
+	}
+	if len(query.UAmenities) > 0 {
+		addTerm(fmt.Sprintf("EXISTS (SELECT 1 FROM listing_units JOIN unit_amenities ON unit_amenities.unit_id = listing_units.unit_id WHERE listing_units.listing_id = listings.id AND %s)",
+			sb.In("unit_amenities.amenity_id", sqlbuilder.Flatten(query.UAmenities)...)), 1)
+	}
+	return strings.Join(terms, " + ")
+}
+
+func (p *postgresSearcher) SearchSimilarListings(ctx context.Context, query *dto.SimilarListingsQuery, limit int) ([]json.RawMessage, error) {
+	sb := newListingSelectBuilder()
+	sb.Select("listings.id::TEXT")
+	sb.Where(sb.NotEqual("listings.id", query.ExcludeID))
+	sb.OrderBy("listings.priority DESC", pgSimilarityScore(sb, query)+" DESC", "listings.id")
+	sb.Limit(limit)
+
+	ids, err := p.queryIds(ctx, sb)
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]json.RawMessage, 0, len(ids))
+	for _, id := range ids {
+		listing, err := p.domainRepo.ListingRepo.GetListingByID(ctx, uuid.MustParse(id))
+		if err != nil {
+			return nil, err
+		}
+		doc, err := BuildListingDocument(ctx, p.domainRepo, listing)
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, data)
+	}
+	return docs, nil
+}
+
+func (p *postgresSearcher) AutocompleteListings(ctx context.Context, q *dto.ListingAutocompleteQuery) ([]dto.ListingSuggestion, error) {
+	sb := newListingSelectBuilder()
+	sb.Select("listings.id", "listings.title", "properties.full_address")
+	tsQuery, text := pgTextQuery(sb, q.Q, true)
+	sb.Where(pgTextCondition(tsQuery, text))
+	sb.OrderBy(pgTextRank(tsQuery, text)+" DESC", "listings.priority DESC", "listings.id")
+	sb.Limit(q.GetLimit())
+
+	sql, args := sb.Build()
+	rows, err := p.dao.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	suggestions := []dto.ListingSuggestion{}
+	for rows.Next() {
+		var s dto.ListingSuggestion
+		if err := rows.Scan(&s.ListingID, &s.Title, &s.FullAddress); err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, s)
+	}
+	return suggestions, rows.Err()
+}
+
+// IndexListing is a no-op, the listing tables are searched directly
+func (p *postgresSearcher) IndexListing(ctx context.Context, doc *AggregatedIndex) error {
+	return nil
+}
+
+// DeleteListing is a no-op, the listing tables are searched directly
+func (p *postgresSearcher) DeleteListing(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
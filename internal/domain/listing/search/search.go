@@ -0,0 +1,69 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	repos "github.com/user2410/rrms-backend/internal/domain/_repos"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/infrastructure/es"
+)
+
+const (
+	BACKEND_ELASTICSEARCH = "elasticsearch"
+	BACKEND_POSTGRES      = "postgres"
+)
+
+const (
+	// number of listings fetched per request when paging through the matches of a search,
+	// at most the default max_result_window of Elasticsearch
+	SEARCH_PAGESIZE = 10000
+	// how long the point in time of a search is kept open between two pages
+	SEARCH_PIT_KEEPALIVE = "1m"
+	// maximum number of cells returned for a map view
+	GEOGRID_MAX_CELLS = 2000
+)
+
+// Searcher evaluates the parts of a listing search that plain filters cannot express:
+// full-text matching, geo filters and similarity ranking. Only visible listings are ever returned.
+type Searcher interface {
+	// SearchListingIds returns the ids of all the listings matching the text query and geo filters,
+	// ordered by distance when the query is sorted by distance, otherwise by relevance to the text query
+	SearchListingIds(ctx context.Context, q *dto.SearchListingCombinationQuery) ([]string, error)
+	// GetListingGeoGrid clusters the listings matching the geo filters into the map tiles of the given zoom
+	GetListingGeoGrid(ctx context.Context, q *dto.ListingGeoGridQuery) ([]dto.ListingGeoGridCell, error)
+	// SearchSimilarListings returns the search documents of the listings most similar to the query,
+	// by priority first then by similarity
+	SearchSimilarListings(ctx context.Context, q *dto.SimilarListingsQuery, limit int) ([]json.RawMessage, error)
+	// AutocompleteListings suggests the listings whose title or address match the text typed so far
+	AutocompleteListings(ctx context.Context, q *dto.ListingAutocompleteQuery) ([]dto.ListingSuggestion, error)
+
+	// IndexListing and DeleteListing keep the backend in sync with the database
+	IndexListing(ctx context.Context, doc *AggregatedIndex) error
+	DeleteListing(ctx context.Context, id uuid.UUID) error
+}
+
+// NewSearcher returns the searcher of the given backend.
+// When no backend is configured, Elasticsearch is used if a client is available.
+func NewSearcher(backend string, dao database.DAO, domainRepo repos.DomainRepo, esClient *es.ElasticSearchClient) (Searcher, error) {
+	if backend == "" {
+		backend = BACKEND_POSTGRES
+		if esClient != nil {
+			backend = BACKEND_ELASTICSEARCH
+		}
+	}
+	switch backend {
+	case BACKEND_ELASTICSEARCH:
+		if esClient == nil {
+			return nil, fmt.Errorf("search backend %s requires an Elasticsearch client", backend)
+		}
+		return NewElasticSearcher(esClient), nil
+	case BACKEND_POSTGRES:
+		return NewPostgresSearcher(dao, domainRepo), nil
+	default:
+		return nil, fmt.Errorf("unknown search backend %s", backend)
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/robfig/cron/v3"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
+)
+
+// the impressions of a search are counted for the first listings only
+const LISTINGANALYTICS_MAXLISTINGS = 100
+
+// setupAnalyticsCronjob schedules the flush of the listing analytics to Postgres
+func (s *service) setupAnalyticsCronjob(c *cron.Cron) error {
+	return s.scheduleTask(c, "@every 10m", asynctask.LISTING_ANALYTICS_FLUSH, asynq.Unique(time.Minute), asynq.MaxRetry(3))
+}
+
+// RecordListingEvent counts the event for each of the listings, once per session and day
+func (s *service) RecordListingEvent(event dto.LISTINGEVENT, lids []uuid.UUID, session string) error {
+	if len(lids) > LISTINGANALYTICS_MAXLISTINGS {
+		lids = lids[:LISTINGANALYTICS_MAXLISTINGS]
+	}
+	return s.domainRepo.ListingRepo.RecordListingEvent(context.Background(), event, lids, session, time.Now())
+}
+
+// FlushListingAnalytics saves the counters aggregated in Redis for today and yesterday,
+// yesterday's counters may have grown since the last flush before midnight
+func (s *service) FlushListingAnalytics() error {
+	now := time.Now()
+	var errs []error
+	for _, day := range []time.Time{now.AddDate(0, 0, -1), now} {
+		date := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+		stats, err := s.domainRepo.ListingRepo.GetCachedListingDailyStats(context.Background(), day)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err = s.domainRepo.ListingRepo.SaveListingDailyStats(context.Background(), date, stats); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *service) GetListingContact(id uuid.UUID) (dto.ListingContact, error) {
+	listing, err := s.domainRepo.ListingRepo.GetListingByID(context.Background(), id)
+	if err != nil {
+		return dto.ListingContact{}, err
+	}
+	return dto.ListingContact{
+		FullName:    listing.FullName,
+		Email:       listing.Email,
+		Phone:       listing.Phone,
+		ContactType: listing.ContactType,
+	}, nil
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	listing_utils "github.com/user2410/rrms-backend/internal/domain/listing/utils"
+	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+const (
+	// maximum number of listings compared with a listing
+	DUPLICATE_MAX_CANDIDATES = 200
+	DUPLICATE_DEFAULT_LIMIT  = 20
+)
+
+// fields of the listings shown in a duplicate cluster
+var duplicateListingFields = []string{"creator_id", "title", "price", "priority", "active", "created_at", "expired_at"}
+
+// DetectListingDuplicates compares the listing with the recent listings around it, saves the ones suspected to advertise
+// the same unit and regroups the clusters they belong to. It returns the cluster of the listing, nil if it has no duplicate.
+func (s *service) DetectListingDuplicates(id uuid.UUID) (*model.ListingDuplicateClusterModel, error) {
+	fs, err := s.domainRepo.ListingRepo.GetListingsDuplicateFeatures(context.Background(), []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(fs) == 0 {
+		return nil, database.ErrRecordNotFound
+	}
+	f := fs[0]
+
+	// the images of the listing may not be hashed yet
+	property, err := s.domainRepo.PropertyRepo.GetPropertyById(context.Background(), f.PropertyID)
+	if err != nil {
+		return nil, err
+	}
+	hashes, err := s.hashPropertyImages(property)
+	if err != nil {
+		return nil, err
+	}
+	f.ImageHashes = make([]uint64, 0, len(hashes))
+	for _, h := range hashes {
+		f.ImageHashes = append(f.ImageHashes, h)
+	}
+
+	// the candidates are a bit wider than a similar price, the other criteria can make up for it
+	candidateIds, err := s.domainRepo.ListingRepo.GetListingDuplicateCandidates(context.Background(), &f, 2*listing_utils.DUPLICATE_PRICE_TOLERANCE, DUPLICATE_MAX_CANDIDATES)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := s.domainRepo.ListingRepo.GetListingsDuplicateFeatures(context.Background(), candidateIds)
+	if err != nil {
+		return nil, err
+	}
+	var similarities []model.ListingSimilarityModel
+	for i := range candidates {
+		score, reasons := listing_utils.ListingSimilarity(&f, &candidates[i])
+		if score < listing_utils.DUPLICATE_MIN_SCORE {
+			continue
+		}
+		similarities = append(similarities, model.ListingSimilarityModel{
+			ListingID:        id,
+			SimilarListingID: candidates[i].ID,
+			Score:            float32(score),
+			Reasons:          reasons,
+		})
+	}
+	if err = s.domainRepo.ListingRepo.SaveListingSimilarities(context.Background(), id, similarities); err != nil {
+		return nil, err
+	}
+	if err = s.regroupListingDuplicates(id, similarities); err != nil {
+		return nil, err
+	}
+
+	return s.getListingDuplicateClusterOfListing(id)
+}
+
+// regroupListingDuplicates rebuilds the clusters of the listing and of its similar listings from their similarities,
+// so that clusters are merged when the listing connects them and split when the listing no longer does
+func (s *service) regroupListingDuplicates(id uuid.UUID, similarities []model.ListingSimilarityModel) error {
+	lids := []uuid.UUID{id}
+	for _, sim := range similarities {
+		lids = append(lids, sim.SimilarListingID)
+	}
+	clusters, err := s.domainRepo.ListingRepo.GetListingDuplicates(context.Background(), lids)
+	if err != nil {
+		return err
+	}
+	var oldIds []int64
+	for _, cid := range clusters {
+		if !slices.Contains(oldIds, cid) {
+			oldIds = append(oldIds, cid)
+		}
+	}
+	if len(oldIds) == 0 && len(similarities) == 0 {
+		return nil
+	}
+
+	// every listing similar to a member of a cluster is a member too, so the similarities
+	// of these listings do not lead outside of them
+	members, err := s.domainRepo.ListingRepo.GetListingDuplicateClusterMembers(context.Background(), oldIds)
+	if err != nil {
+		return err
+	}
+	for _, ms := range members {
+		for _, lid := range ms {
+			if !slices.Contains(lids, lid) {
+				lids = append(lids, lid)
+			}
+		}
+	}
+	edges, err := s.domainRepo.ListingRepo.GetListingSimilarities(context.Background(), lids)
+	if err != nil {
+		return err
+	}
+	pairs := make([][2]uuid.UUID, 0, len(edges))
+	for _, e := range edges {
+		pairs = append(pairs, [2]uuid.UUID{e.ListingID, e.SimilarListingID})
+	}
+	return s.domainRepo.ListingRepo.ReplaceListingDuplicateClusters(context.Background(), oldIds, listing_utils.ClusterListings(pairs))
+}
+
+func (s *service) getListingDuplicateClusterOfListing(id uuid.UUID) (*model.ListingDuplicateClusterModel, error) {
+	clusters, err := s.domainRepo.ListingRepo.GetListingDuplicates(context.Background(), []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+	cid, ok := clusters[id]
+	if !ok {
+		return nil, nil
+	}
+	c, err := s.GetListingDuplicateCluster(cid)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// fillListingDuplicateCluster adds the listings of the cluster and the similarities between them
+func (s *service) fillListingDuplicateCluster(c *model.ListingDuplicateClusterModel) error {
+	var err error
+	c.Listings, err = s.domainRepo.ListingRepo.GetListingsByIds(context.Background(), c.ListingIDs, duplicateListingFields)
+	if err != nil {
+		return err
+	}
+	similarities, err := s.domainRepo.ListingRepo.GetListingSimilarities(context.Background(), c.ListingIDs)
+	if err != nil {
+		return err
+	}
+	c.Similarities = make([]model.ListingSimilarityModel, 0, len(similarities))
+	for _, sim := range similarities {
+		if slices.Contains(c.ListingIDs, sim.ListingID) && slices.Contains(c.ListingIDs, sim.SimilarListingID) {
+			c.Similarities = append(c.Similarities, sim)
+		}
+	}
+	return nil
+}
+
+func (s *service) GetListingDuplicateCluster(id int64) (model.ListingDuplicateClusterModel, error) {
+	c, err := s.domainRepo.ListingRepo.GetListingDuplicateCluster(context.Background(), id)
+	if err != nil {
+		return model.ListingDuplicateClusterModel{}, err
+	}
+	if err = s.fillListingDuplicateCluster(&c); err != nil {
+		return model.ListingDuplicateClusterModel{}, err
+	}
+	return c, nil
+}
+
+func (s *service) GetListingDuplicateClusters(query *dto.GetListingDuplicateClustersQuery) ([]model.ListingDuplicateClusterModel, error) {
+	limit := types.Ptr[int32](DUPLICATE_DEFAULT_LIMIT)
+	if query.Limit != nil {
+		limit = query.Limit
+	}
+	offset := types.Ptr[int32](0)
+	if query.Offset != nil {
+		offset = query.Offset
+	}
+	res, err := s.domainRepo.ListingRepo.GetListingDuplicateClusters(context.Background(), *limit, *offset)
+	if err != nil {
+		return nil, err
+	}
+	for i := range res {
+		if err = s.fillListingDuplicateCluster(&res[i]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// GetListingDuplicatesForManager returns the cluster of the listing as its manager sees it
+func (s *service) GetListingDuplicatesForManager(id uuid.UUID, userId uuid.UUID) (*model.ListingDuplicateClusterModel, error) {
+	c, err := s.getListingDuplicateClusterOfListing(id)
+	if err != nil || c == nil {
+		return c, err
+	}
+	return c, s.hideInvisibleDuplicates(c, userId)
+}
+
+// hideInvisibleDuplicates leaves out the details of the listings of the cluster the user cannot see, only their ids are kept
+func (s *service) hideInvisibleDuplicates(c *model.ListingDuplicateClusterModel, userId uuid.UUID) error {
+	listings := make([]model.ListingModel, 0, len(c.Listings))
+	for _, l := range c.Listings {
+		visible, err := s.domainRepo.ListingRepo.CheckListingVisibility(context.Background(), l.ID, userId)
+		if err != nil {
+			return err
+		}
+		if visible {
+			listings = append(listings, l)
+		}
+	}
+	c.Listings = listings
+	return nil
+}
+
+// detectListingDuplicatesLater schedules the duplicate detection of a listing whose content changed
+func (s *service) detectListingDuplicatesLater(id uuid.UUID) error {
+	return s.asynctaskDistributor.DistributeTaskJSON(context.Background(), asynctask.LISTING_DUPLICATE_DETECT, dto.DetectListingDuplicates{
+		ListingID: id,
+	})
+}
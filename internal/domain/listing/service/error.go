@@ -5,4 +5,8 @@ import "errors"
 var (
 	ErrUpgradeListingInvalidPriority = errors.New("invalid priority")
 	ErrUnpaidPayment                 = errors.New("unpaid payment")
+	ErrReindexCountMismatch          = errors.New("document count of the new index does not match the database")
+	ErrSavedSearchLimitReached       = errors.New("maximum number of saved searches reached")
+	ErrInvalidFavoriteFolder         = errors.New("invalid favorite folder")
+	ErrListingAlreadyPosted          = errors.New("listing already posted")
 )
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+	"github.com/user2410/rrms-backend/pkg/ds/set"
+)
+
+func (s *service) CreateFavoriteFolder(data *dto.CreateFavoriteFolder) (model.FavoriteFolderModel, error) {
+	return s.domainRepo.ListingRepo.CreateFavoriteFolder(context.Background(), data)
+}
+
+func (s *service) GetFavoriteFoldersOfUser(userId uuid.UUID) ([]model.FavoriteFolderModel, error) {
+	return s.domainRepo.ListingRepo.GetFavoriteFoldersOfUser(context.Background(), userId)
+}
+
+func (s *service) getFavoriteFolderOfUser(userId uuid.UUID, id int64) (model.FavoriteFolderModel, error) {
+	f, err := s.domainRepo.ListingRepo.GetFavoriteFolder(context.Background(), id)
+	if err != nil {
+		return model.FavoriteFolderModel{}, err
+	}
+	if f.UserID != userId {
+		return model.FavoriteFolderModel{}, database.ErrRecordNotFound
+	}
+	return f, nil
+}
+
+func (s *service) UpdateFavoriteFolder(userId uuid.UUID, id int64, data *dto.UpdateFavoriteFolder) error {
+	if _, err := s.getFavoriteFolderOfUser(userId, id); err != nil {
+		return err
+	}
+	return s.domainRepo.ListingRepo.UpdateFavoriteFolder(context.Background(), id, data)
+}
+
+func (s *service) DeleteFavoriteFolder(userId uuid.UUID, id int64) error {
+	if _, err := s.getFavoriteFolderOfUser(userId, id); err != nil {
+		return err
+	}
+	return s.domainRepo.ListingRepo.DeleteFavoriteFolder(context.Background(), id)
+}
+
+func (s *service) SaveFavoriteListing(data *dto.SaveFavoriteListing) (model.FavoriteListingModel, error) {
+	visible, err := s.domainRepo.ListingRepo.CheckListingVisibility(context.Background(), data.ListingID, data.UserID)
+	if err != nil {
+		return model.FavoriteListingModel{}, err
+	}
+	if !visible {
+		return model.FavoriteListingModel{}, database.ErrRecordNotFound
+	}
+	if data.FolderID != nil {
+		if _, err = s.getFavoriteFolderOfUser(data.UserID, *data.FolderID); err != nil {
+			if err == database.ErrRecordNotFound {
+				return model.FavoriteListingModel{}, ErrInvalidFavoriteFolder
+			}
+			return model.FavoriteListingModel{}, err
+		}
+	}
+
+	listing, err := s.domainRepo.ListingRepo.GetListingByID(context.Background(), data.ListingID)
+	if err != nil {
+		return model.FavoriteListingModel{}, err
+	}
+	return s.domainRepo.ListingRepo.SaveFavoriteListing(context.Background(), data, listing.Price)
+}
+
+func (s *service) GetFavoriteListingsOfUser(userId uuid.UUID, query *dto.GetFavoriteListingsQuery) ([]dto.FavoriteListing, error) {
+	res, err := s.domainRepo.ListingRepo.GetFavoriteListingsOfUser(context.Background(), userId, query)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for i := range res {
+		res[i].Expired = res[i].ExpiredAt.Before(now)
+		res[i].Unavailable = !res[i].Active || res[i].Expired
+		res[i].PriceChanged = res[i].Price != res[i].SavedPrice
+	}
+	return res, nil
+}
+
+func (s *service) DeleteFavoriteListing(userId, listingId uuid.UUID) error {
+	return s.domainRepo.ListingRepo.DeleteFavoriteListing(context.Background(), userId, listingId)
+}
+
+// CompareListings returns the listings side by side, in the given order
+func (s *service) CompareListings(userId uuid.UUID, ids []uuid.UUID) (*dto.ListingComparison, error) {
+	res := &dto.ListingComparison{
+		Listings:   make([]dto.ComparedListing, 0, len(ids)),
+		AmenityIDs: make([]int64, 0),
+		PolicyIDs:  make([]int64, 0),
+	}
+	amenitySet, policySet := set.NewSet[int64](), set.NewSet[int64]()
+	for _, id := range ids {
+		visible, err := s.domainRepo.ListingRepo.CheckListingVisibility(context.Background(), id, userId)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			return nil, database.ErrRecordNotFound
+		}
+		cl, err := s.compareListing(id)
+		if err != nil {
+			return nil, err
+		}
+		amenitySet.AddAll(cl.AmenityIDs...)
+		for _, p := range cl.Policies {
+			policySet.Add(p.PolicyID)
+		}
+		res.Listings = append(res.Listings, cl)
+	}
+
+	res.AmenityIDs = append(res.AmenityIDs, amenitySet.ToSlice()...)
+	slices.Sort(res.AmenityIDs)
+	res.PolicyIDs = append(res.PolicyIDs, policySet.ToSlice()...)
+	slices.Sort(res.PolicyIDs)
+	return res, nil
+}
+
+func (s *service) compareListing(id uuid.UUID) (dto.ComparedListing, error) {
+	ctx := context.Background()
+	listing, err := s.domainRepo.ListingRepo.GetListingByID(ctx, id)
+	if err != nil {
+		return dto.ComparedListing{}, err
+	}
+	property, err := s.domainRepo.PropertyRepo.GetPropertyById(ctx, listing.PropertyID)
+	if err != nil {
+		return dto.ComparedListing{}, err
+	}
+	if property == nil {
+		return dto.ComparedListing{}, database.ErrRecordNotFound
+	}
+
+	cl := dto.ComparedListing{
+		ID:                listing.ID,
+		Title:             listing.Title,
+		FullAddress:       property.FullAddress,
+		City:              property.City,
+		District:          property.District,
+		PropertyType:      string(property.Type),
+		Price:             listing.Price,
+		PriceNegotiable:   listing.PriceNegotiable,
+		SecurityDeposit:   listing.SecurityDeposit,
+		LeaseTerm:         listing.LeaseTerm,
+		PetsAllowed:       listing.PetsAllowed,
+		NumberOfResidents: listing.NumberOfResidents,
+		Units:             make([]dto.ComparedListingUnit, 0, len(listing.Units)),
+		AmenityIDs:        make([]int64, 0),
+		Policies:          listing.Policies,
+	}
+	amenitySet := set.NewSet[int64]()
+	for _, lu := range listing.Units {
+		unit, err := s.domainRepo.UnitRepo.GetUnitById(ctx, lu.UnitID)
+		if err != nil {
+			return dto.ComparedListing{}, err
+		}
+		cu := dto.ComparedListingUnit{
+			UnitID:            unit.ID,
+			Name:              unit.Name,
+			Type:              string(unit.Type),
+			Price:             lu.Price,
+			Area:              unit.Area,
+			NumberOfBedrooms:  unit.NumberOfBedrooms,
+			NumberOfBathrooms: unit.NumberOfBathrooms,
+		}
+		if unit.Area > 0 {
+			cu.PricePerM2 = types.Ptr(float32(lu.Price) / unit.Area)
+		}
+		cl.Units = append(cl.Units, cu)
+		cl.Area += unit.Area
+		for _, a := range unit.Amenities {
+			amenitySet.Add(a.AmenityID)
+		}
+	}
+	if cl.Area > 0 {
+		cl.PricePerM2 = types.Ptr(cl.Price / cl.Area)
+	}
+	cl.AmenityIDs = append(cl.AmenityIDs, amenitySet.ToSlice()...)
+	slices.Sort(cl.AmenityIDs)
+	return cl, nil
+}
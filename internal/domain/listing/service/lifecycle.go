@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/robfig/cron/v3"
+	application_dto "github.com/user2410/rrms-backend/internal/domain/application/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	listing_utils "github.com/user2410/rrms-backend/internal/domain/listing/utils"
+	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
+	payment_model "github.com/user2410/rrms-backend/internal/domain/payment/model"
+	payment_service "github.com/user2410/rrms-backend/internal/domain/payment/service"
+	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+const (
+	LIFECYCLE_BATCHSIZE = 100
+	// creators are warned this many days before their listings expire
+	EXPIRY_WARNING_DAYS = 3
+	// listings are extended by this many days from the expiry warnings and by the automatic renewals
+	RENEWAL_DURATION = 30
+)
+
+var (
+	ErrNoRenewHook = errors.New("no renew hook set")
+	// ErrRenewalUnconfirmed is returned when the saved card may have been charged without the extension being recorded,
+	// the listing is neither charged again nor sent another payment link until the payment is settled
+	ErrRenewalUnconfirmed = errors.New("renewal payment not confirmed")
+)
+
+// setupLifecycleCronjob schedules the publication and expiry of listings every minute and the expiry warnings every hour
+func (s *service) setupLifecycleCronjob(c *cron.Cron) error {
+	if err := s.scheduleTask(c, "@every 1m", asynctask.LISTING_LIFECYCLE_PROCESS, asynq.Unique(time.Minute), asynq.MaxRetry(3)); err != nil {
+		return err
+	}
+	return s.scheduleTask(c, "@hourly", asynctask.LISTING_EXPIRY_WARN, asynq.Unique(time.Minute), asynq.MaxRetry(3))
+}
+
+// RenewHook extends the listing on behalf of its creator, charging the card they saved.
+// The payment service depends on the listing service, so it registers its hook once both are created.
+type RenewHook func(listingId uuid.UUID, userId uuid.UUID, duration int) error
+
+func (s *service) SetRenewHook(hook RenewHook) {
+	s.renewHook = hook
+}
+
+// PublishScheduledListings makes public the approved listings whose post time has come,
+// as well as the expired listings extended since they were deactivated
+func (s *service) PublishScheduledListings() error {
+	ids, err := s.domainRepo.ListingRepo.GetListingsToPublish(context.Background(), LIFECYCLE_BATCHSIZE)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, id := range ids {
+		if err := s.activateListing(id); err != nil {
+			errs = append(errs, fmt.Errorf("listing %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ExpireListings renews the expired listings set to auto-renew and deactivates the others,
+// their search documents are reprojected through the search outbox.
+// The creator of a listing whose renewal failed is sent a link to extend it.
+func (s *service) ExpireListings() error {
+	ids, err := s.domainRepo.ListingRepo.GetExpiredActiveListings(context.Background(), LIFECYCLE_BATCHSIZE)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, id := range ids {
+		if err := s.expireListing(id); err != nil {
+			errs = append(errs, fmt.Errorf("listing %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *service) expireListing(id uuid.UUID) error {
+	listing, err := s.domainRepo.ListingRepo.GetListingByID(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if listing.AutoRenew {
+		err = s.renewListing(listing)
+		if err == nil {
+			return nil
+		}
+		log.Println("failed to renew listing", id, err)
+		if errors.Is(err, ErrRenewalUnconfirmed) {
+			return err
+		}
+	}
+
+	if err = s.domainRepo.ListingRepo.UpdateListingStatus(context.Background(), id, false); err != nil {
+		return err
+	}
+	if err = s.withdrawListingApplications(id); err != nil {
+		return err
+	}
+	if listing.AutoRenew {
+		return s.notifyListingExpiry(listing, true)
+	}
+	return nil
+}
+
+// withdrawListingApplications withdraws the open applications to a listing no longer active and notifies their applicants.
+// The approved applications are left untouched, their applicants are already being offered a rental.
+func (s *service) withdrawListingApplications(id uuid.UUID) error {
+	ctx := context.Background()
+	ids, err := s.domainRepo.ApplicationRepo.WithdrawApplicationsOfListing(ctx, id)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+	as, err := s.domainRepo.ApplicationRepo.GetApplicationsByIds(ctx, ids, []string{"creator_id", "property_id", "unit_id", "full_name", "email", "status"})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for i := range as {
+		err = s.asynctaskDistributor.DistributeTaskJSON(ctx, asynctask.APPLICATION_UPDATE, application_dto.NotificationOnUpdateApplication{
+			Application: &as[i],
+			Status:      database.APPLICATIONSTATUSWITHDRAWN,
+			Automatic:   true,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("application %d: %w", as[i].ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *service) renewListing(listing *model.ListingModel) error {
+	if s.renewHook == nil {
+		return ErrNoRenewHook
+	}
+	return s.renewHook(listing.ID, listing.CreatorID, RENEWAL_DURATION)
+}
+
+// WarnListingsExpiry sends the creators of the listings expiring within EXPIRY_WARNING_DAYS days a link to extend them.
+// Each listing is warned once until it is extended.
+func (s *service) WarnListingsExpiry() error {
+	ids, err := s.domainRepo.ListingRepo.GetListingsToWarnExpiry(context.Background(), EXPIRY_WARNING_DAYS, LIFECYCLE_BATCHSIZE)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, id := range ids {
+		listing, err := s.domainRepo.ListingRepo.GetListingByID(context.Background(), id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("listing %s: %w", id, err))
+			continue
+		}
+		if err = s.notifyListingExpiry(listing, false); err != nil {
+			errs = append(errs, fmt.Errorf("listing %s: %w", id, err))
+			continue
+		}
+		if err = s.domainRepo.ListingRepo.MarkListingExpiryWarned(context.Background(), id); err != nil {
+			errs = append(errs, fmt.Errorf("listing %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// getExtendPayment returns the unpaid extension payment of the listing, creating one for RENEWAL_DURATION days if there is none
+func (s *service) getExtendPayment(listing *model.ListingModel) (*payment_model.PaymentModel, error) {
+	payments, err := s.domainRepo.ListingRepo.GetListingPaymentsByType(context.Background(), listing.ID, payment_service.PAYMENTTYPE_EXTENDLISTING)
+	if err != nil {
+		return nil, err
+	}
+	payment, unconfirmed := listing_utils.PendingExtendPayment(payments)
+	if unconfirmed {
+		return nil, ErrRenewalUnconfirmed
+	}
+	if payment != nil {
+		return payment, nil
+	}
+	return s.ExtendListing(listing.CreatorID, listing.ID, RENEWAL_DURATION)
+}
+
+// notifyListingExpiry sends the creator of the listing a link to pay for its extension,
+// before the listing expires or once it has expired
+func (s *service) notifyListingExpiry(listing *model.ListingModel, expired bool) error {
+	payment, err := s.getExtendPayment(listing)
+	if err != nil {
+		return err
+	}
+	creator, err := s.domainRepo.AuthRepo.GetUserById(context.Background(), listing.CreatorID)
+	if err != nil {
+		return err
+	}
+	tz, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	if err != nil {
+		return err
+	}
+
+	data := struct {
+		FESite      string
+		Listing     *model.ListingModel
+		ExpiredAt   string
+		Expired     bool
+		Payment     *payment_model.PaymentModel
+		PaymentLink string
+	}{
+		FESite:      s.feSite,
+		Listing:     listing,
+		ExpiredAt:   listing.ExpiredAt.In(tz).Format("15:04 02/01/2006"),
+		Expired:     expired,
+		Payment:     payment,
+		PaymentLink: fmt.Sprintf("%s/manage/payments/payment/%d", s.feSite, payment.ID),
+	}
+	return s.sendNotification("listing_expiry", data, creator.ID, creator.Email, map[string]interface{}{
+		"notificationType": misc_service.NOTIFICATIONTYPE_LISTINGEXPIRY,
+		"listingId":        listing.ID.String(),
+		"paymentId":        payment.ID,
+		"expired":          expired,
+	})
+}
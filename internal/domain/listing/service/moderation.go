@@ -0,0 +1,434 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	auth_model "github.com/user2410/rrms-backend/internal/domain/auth/model"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	listing_utils "github.com/user2410/rrms-backend/internal/domain/listing/utils"
+	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
+	property_dto "github.com/user2410/rrms-backend/internal/domain/property/dto"
+	property_model "github.com/user2410/rrms-backend/internal/domain/property/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+const (
+	MODERATION_DEFAULT_LIMIT = 20
+	// images are downloaded to be hashed, bigger ones are skipped
+	MODERATION_IMAGE_MAX_SIZE = 20 << 20
+	MODERATION_IMAGE_TIMEOUT  = 30 * time.Second
+	// maximum number of similar images reported for each image of the listing
+	MODERATION_MAX_SIMILAR_IMAGES = 5
+)
+
+var (
+	ErrModerationAlreadyReviewed = errors.New("listing moderation already reviewed")
+	ErrListingNotResubmittable   = errors.New("no changes were requested on this listing")
+	ErrNoRefundHook              = errors.New("no refund hook set")
+)
+
+// RefundHook refunds the payment of a listing.
+// The payment service depends on the listing service, so it registers its hook once both are created.
+type RefundHook func(listingId uuid.UUID, reason string) error
+
+func (s *service) SetRefundHook(hook RefundHook) {
+	s.refundHook = hook
+}
+
+// submitListingForModeration puts the listing in the moderation queue and schedules the automatic checks.
+// A listing already waiting in the queue or approved is left as it is.
+func (s *service) submitListingForModeration(id uuid.UUID) error {
+	latest, err := s.domainRepo.ListingRepo.GetLatestListingModeration(context.Background(), id)
+	if err != nil && !errors.Is(err, database.ErrRecordNotFound) {
+		return err
+	}
+	if err == nil && (latest.Status == database.LISTINGMODERATIONSTATUSPENDING || latest.Status == database.LISTINGMODERATIONSTATUSAPPROVED) {
+		return nil
+	}
+
+	m, err := s.domainRepo.ListingRepo.CreateListingModeration(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	return s.asynctaskDistributor.DistributeTaskJSON(context.Background(), asynctask.LISTING_MODERATION_CHECK, dto.CheckListingModeration{
+		ModerationID: m.ID,
+	})
+}
+
+// activateListing makes the listing and its property public
+func (s *service) activateListing(id uuid.UUID) error {
+	var propertyID uuid.UUID
+	{
+		ls, err := s.domainRepo.ListingRepo.GetListingsByIds(context.Background(), []uuid.UUID{id}, []string{"property_id"})
+		if err != nil {
+			return err
+		}
+		if len(ls) == 0 {
+			return database.ErrRecordNotFound
+		}
+		propertyID = ls[0].PropertyID
+	}
+
+	err := s.domainRepo.ListingRepo.UpdateListingStatus(context.Background(), id, true)
+	if err != nil {
+		return err
+	}
+
+	return s.domainRepo.PropertyRepo.UpdateProperty(context.Background(), &property_dto.UpdateProperty{
+		ID:       propertyID,
+		IsPublic: types.Ptr(true),
+	})
+}
+
+// CheckListingModeration runs the automatic content policy checks of the moderation and saves their findings
+func (s *service) CheckListingModeration(id int64) error {
+	m, err := s.domainRepo.ListingRepo.GetListingModeration(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	listing, err := s.domainRepo.ListingRepo.GetListingByID(context.Background(), m.ListingID)
+	if err != nil {
+		return err
+	}
+	property, err := s.domainRepo.PropertyRepo.GetPropertyById(context.Background(), listing.PropertyID)
+	if err != nil {
+		return err
+	}
+
+	var flags []model.ModerationFlag
+
+	words, err := s.domainRepo.ListingRepo.GetModerationBannedWords(context.Background())
+	if err != nil {
+		return err
+	}
+	if found := listing_utils.FindBannedWords(listing.Title+"\n"+listing.Description, words); len(found) > 0 {
+		flags = append(flags, model.ModerationFlag{
+			Type:     model.MODERATIONFLAG_BANNEDWORD,
+			Evidence: found,
+			Message:  "the title or the description contains banned words",
+		})
+	}
+
+	// contacts are to be reached through the contact info of the listing
+	if found := listing_utils.FindPhoneNumbers(listing.Description); len(found) > 0 {
+		flags = append(flags, model.ModerationFlag{
+			Type:     model.MODERATIONFLAG_PHONENUMBER,
+			Evidence: found,
+			Message:  "the description contains phone numbers",
+		})
+	}
+
+	estimates, err := s.estimateListingPrices(listing, property)
+	if err != nil {
+		return err
+	}
+	var outOfRange []string
+	for _, e := range estimates {
+		if e.OutOfRange {
+			outOfRange = append(outOfRange, fmt.Sprintf("unit %s: %d VND, %d comparable units between %.0f and %.0f VND", e.UnitID, e.Price, e.Estimate.Samples, e.Estimate.P25, e.Estimate.P75))
+		}
+	}
+	if len(outOfRange) > 0 {
+		flags = append(flags, model.ModerationFlag{
+			Type:     model.MODERATIONFLAG_UNITPRICEOUTLIER,
+			Evidence: outOfRange,
+			Message:  "units are priced far from the rents of comparable units",
+		})
+	}
+
+	// the district median is coarser than the estimates of the units, it is only a fallback
+	// for the listings whose units have too few comparable units to be estimated
+	if property.Area > 0 && !listing_utils.HasRentEstimate(estimates) {
+		samples, median, err := s.domainRepo.ListingRepo.GetDistrictListingPriceStats(context.Background(), listing.ID, property)
+		if err != nil {
+			return err
+		}
+		pricePerArea := float64(listing.Price) / float64(property.Area)
+		if listing_utils.IsPriceOutlier(pricePerArea, median, samples) {
+			flags = append(flags, model.ModerationFlag{
+				Type: model.MODERATIONFLAG_PRICEOUTLIER,
+				Evidence: []string{
+					fmt.Sprintf("%.0f VND/m2", pricePerArea),
+					fmt.Sprintf("median of %d listings in %s, %s: %.0f VND/m2", samples, property.District, property.City, median),
+				},
+				Message: "the price is far from the prices of the district",
+			})
+		}
+	}
+
+	duplicates, err := s.findDuplicateImages(property)
+	if err != nil {
+		return err
+	}
+	if len(duplicates) > 0 {
+		flags = append(flags, model.ModerationFlag{
+			Type:     model.MODERATIONFLAG_DUPLICATEIMAGE,
+			Evidence: duplicates,
+			Message:  "images are used by other properties",
+		})
+	}
+
+	cluster, err := s.getListingDuplicateClusterOfListing(listing.ID)
+	if err != nil {
+		return err
+	}
+	if cluster != nil {
+		var others []string
+		for _, lid := range cluster.ListingIDs {
+			if lid != listing.ID {
+				others = append(others, lid.String())
+			}
+		}
+		flags = append(flags, model.ModerationFlag{
+			Type:     model.MODERATIONFLAG_DUPLICATELISTING,
+			Evidence: others,
+			Message:  "the listing looks like a duplicate of other listings",
+		})
+	}
+
+	return s.domainRepo.ListingRepo.UpdateListingModerationFlags(context.Background(), id, flags)
+}
+
+// hashPropertyImages returns the hashes of the images of the property by media id, computing the missing ones.
+// Images that cannot be downloaded or decoded are left out.
+func (s *service) hashPropertyImages(property *property_model.PropertyModel) (map[int64]uint64, error) {
+	var ids []int64
+	for _, m := range property.Media {
+		if m.Type == database.MEDIATYPEIMAGE {
+			ids = append(ids, m.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	hashes, err := s.domainRepo.ListingRepo.GetPropertyMediaHashes(context.Background(), ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range property.Media {
+		if _, ok := hashes[m.ID]; ok || m.Type != database.MEDIATYPEIMAGE {
+			continue
+		}
+		if !listing_utils.IsBucketObjectUrl(m.Url, s.imageBucketName) {
+			log.Println("skipped hashing image outside of the media bucket", m.Url)
+			continue
+		}
+		h, err := hashImage(m.Url)
+		if err != nil {
+			log.Println("failed to hash image", m.Url, err)
+			continue
+		}
+		if err = s.domainRepo.ListingRepo.SavePropertyMediaHash(context.Background(), m.ID, h); err != nil {
+			return nil, err
+		}
+		hashes[m.ID] = h
+	}
+	return hashes, nil
+}
+
+// hashImage downloads the image from the media bucket and hashes it. Redirects are not followed,
+// they could lead the server to hosts other than the bucket.
+func hashImage(url string) (uint64, error) {
+	client := http.Client{
+		Timeout: MODERATION_IMAGE_TIMEOUT,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	res, err := client.Get(url)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	img, _, err := image.Decode(io.LimitReader(res.Body, MODERATION_IMAGE_MAX_SIZE))
+	if err != nil {
+		return 0, err
+	}
+	return listing_utils.DHash(img), nil
+}
+
+// findDuplicateImages returns the pairs of images of the property also found in other properties
+func (s *service) findDuplicateImages(property *property_model.PropertyModel) ([]string, error) {
+	hashes, err := s.hashPropertyImages(property)
+	if err != nil {
+		return nil, err
+	}
+	var res []string
+	for _, m := range property.Media {
+		h, ok := hashes[m.ID]
+		if !ok {
+			continue
+		}
+		similar, err := s.domainRepo.ListingRepo.GetSimilarPropertyMedia(context.Background(), property.ID, h, listing_utils.MODERATION_IMAGE_MAX_DISTANCE, MODERATION_MAX_SIMILAR_IMAGES)
+		if err != nil {
+			return nil, err
+		}
+		for _, sm := range similar {
+			res = append(res, fmt.Sprintf("%s ~ %s", m.Url, sm.Url))
+		}
+	}
+	return res, nil
+}
+
+func (s *service) GetListingModerations(query *dto.GetListingModerationsQuery) ([]model.ListingModerationModel, error) {
+	status := query.Status
+	if status == "" {
+		status = database.LISTINGMODERATIONSTATUSPENDING
+	}
+	limit := types.Ptr[int32](MODERATION_DEFAULT_LIMIT)
+	if query.Limit != nil {
+		limit = query.Limit
+	}
+	offset := types.Ptr[int32](0)
+	if query.Offset != nil {
+		offset = query.Offset
+	}
+	return s.domainRepo.ListingRepo.GetListingModerations(context.Background(), status, *limit, *offset)
+}
+
+func (s *service) GetListingModeration(id int64) (model.ListingModerationModel, error) {
+	return s.domainRepo.ListingRepo.GetListingModeration(context.Background(), id)
+}
+
+func (s *service) GetLatestListingModeration(listingId uuid.UUID) (model.ListingModerationModel, error) {
+	return s.domainRepo.ListingRepo.GetLatestListingModeration(context.Background(), listingId)
+}
+
+// ReviewListingModeration records the decision of a moderator.
+// An approved listing goes live, unless it is scheduled later, a rejected one is refunded. The creator is notified in any case.
+func (s *service) ReviewListingModeration(id int64, data *dto.ReviewListingModeration) error {
+	m, err := s.domainRepo.ListingRepo.GetListingModeration(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if m.Status != database.LISTINGMODERATIONSTATUSPENDING {
+		return ErrModerationAlreadyReviewed
+	}
+	reviewed, err := s.domainRepo.ListingRepo.ReviewListingModeration(context.Background(), id, data)
+	if err != nil {
+		return err
+	}
+	if !reviewed {
+		return ErrModerationAlreadyReviewed
+	}
+
+	switch data.Status {
+	case database.LISTINGMODERATIONSTATUSAPPROVED:
+		ls, err := s.domainRepo.ListingRepo.GetListingsByIds(context.Background(), []uuid.UUID{m.ListingID}, []string{"post_at"})
+		if err != nil {
+			return err
+		}
+		if len(ls) == 0 {
+			return database.ErrRecordNotFound
+		}
+		// a scheduled listing is published by the lifecycle job when its post time comes
+		if ls[0].PostAt == nil || !ls[0].PostAt.After(time.Now()) {
+			if err = s.activateListing(m.ListingID); err != nil {
+				return err
+			}
+		}
+	case database.LISTINGMODERATIONSTATUSREJECTED:
+		err = s.asynctaskDistributor.DistributeTaskJSON(context.Background(), asynctask.LISTING_MODERATION_REFUND, dto.RefundListing{
+			ListingID: m.ListingID,
+			Reason:    *data.Reason,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.asynctaskDistributor.DistributeTaskJSON(context.Background(), asynctask.LISTING_MODERATION_NOTIFY, dto.NotifyListingModeration{
+		ModerationID: id,
+	})
+}
+
+// ResubmitListingForModeration puts back in the queue a listing its creator changed as requested
+func (s *service) ResubmitListingForModeration(listingId uuid.UUID) error {
+	latest, err := s.domainRepo.ListingRepo.GetLatestListingModeration(context.Background(), listingId)
+	if err != nil {
+		if errors.Is(err, database.ErrRecordNotFound) {
+			return ErrListingNotResubmittable
+		}
+		return err
+	}
+	if latest.Status != database.LISTINGMODERATIONSTATUSCHANGESREQUESTED {
+		return ErrListingNotResubmittable
+	}
+	return s.submitListingForModeration(listingId)
+}
+
+// RefundListing refunds the payment of a rejected listing through the refund hook
+func (s *service) RefundListing(data *dto.RefundListing) error {
+	if s.refundHook == nil {
+		return ErrNoRefundHook
+	}
+	return s.refundHook(data.ListingID, data.Reason)
+}
+
+// NotifyListingModeration sends the decision of the moderator and its reason to the creator of the listing
+func (s *service) NotifyListingModeration(id int64) error {
+	m, err := s.domainRepo.ListingRepo.GetListingModeration(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	ls, err := s.domainRepo.ListingRepo.GetListingsByIds(context.Background(), []uuid.UUID{m.ListingID}, []string{"title", "creator_id", "post_at"})
+	if err != nil {
+		return err
+	}
+	if len(ls) == 0 {
+		return database.ErrRecordNotFound
+	}
+	listing := &ls[0]
+	us, err := s.domainRepo.AuthRepo.GetUsersByIds(context.Background(), []uuid.UUID{listing.CreatorID}, []string{"email"})
+	if err != nil {
+		return err
+	}
+	if len(us) == 0 {
+		return database.ErrRecordNotFound
+	}
+	creator := &us[0]
+	tz, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	if err != nil {
+		return err
+	}
+
+	data := struct {
+		FESite     string
+		Listing    *model.ListingModel
+		Creator    *auth_model.UserModel
+		Moderation *model.ListingModerationModel
+		// time the approved listing goes live, empty if it is already public
+		PostAt string
+	}{
+		FESite:     s.feSite,
+		Listing:    listing,
+		Creator:    creator,
+		Moderation: &m,
+	}
+	if listing.PostAt != nil && listing.PostAt.After(time.Now()) {
+		data.PostAt = listing.PostAt.In(tz).Format("15:04 02/01/2006")
+	}
+	return s.sendNotification("listing_moderation", data, creator.ID, creator.Email, map[string]interface{}{
+		"notificationType": misc_service.NOTIFICATIONTYPE_LISTINGMODERATION,
+		"listingId":        m.ListingID.String(),
+		"moderationId":     m.ID,
+		"status":           m.Status,
+	})
+}
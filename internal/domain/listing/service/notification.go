@@ -0,0 +1,68 @@
+package service
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	misc_dto "github.com/user2410/rrms-backend/internal/domain/misc/dto"
+	html_util "github.com/user2410/rrms-backend/internal/utils/template/html"
+	text_util "github.com/user2410/rrms-backend/internal/utils/template/text"
+)
+
+// sendNotification renders the title, the email and the push notification of the templates of the given name,
+// then emails the user and pushes the notification to their devices.
+// Once the email is sent a failed push notification is only logged, retrying would send the email again.
+func (s *service) sendNotification(name string, data any, userId uuid.UUID, email string, payload map[string]interface{}) error {
+	title, err := text_util.RenderText(data, fmt.Sprintf("%s/title/%s.txt", basePath, name), nil)
+	if err != nil {
+		return err
+	}
+	emailContent, err := html_util.RenderHtml(data, fmt.Sprintf("%s/email/%s.gohtml", basePath, name), nil)
+	if err != nil {
+		return err
+	}
+	pushContent, err := text_util.RenderText(data, fmt.Sprintf("%s/push/%s.txt", basePath, name), nil)
+	if err != nil {
+		return err
+	}
+
+	cn := misc_dto.CreateNotification{
+		Title:   string(title),
+		Content: string(emailContent),
+		Data:    payload,
+		Targets: []misc_dto.CreateNotificationTarget{
+			{
+				UserId: userId,
+				Emails: []string{email},
+			},
+		},
+	}
+	if err = s.mService.SendNotification(&cn); err != nil {
+		return err
+	}
+
+	devices, err := s.mService.GetNotificationDevice(userId, uuid.Nil, "", "")
+	if err != nil {
+		log.Println("failed to get notification devices:", err)
+		return nil
+	}
+	if len(devices) == 0 {
+		return nil
+	}
+	tokens := make([]string, 0, len(devices))
+	for _, d := range devices {
+		tokens = append(tokens, d.Token)
+	}
+	cn.Content = string(pushContent)
+	cn.Targets = []misc_dto.CreateNotificationTarget{
+		{
+			UserId: userId,
+			Tokens: tokens,
+		},
+	}
+	if err = s.mService.SendNotification(&cn); err != nil {
+		log.Printf("failed to send %s push notification: %v", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	listing_utils "github.com/user2410/rrms-backend/internal/domain/listing/utils"
+	property_model "github.com/user2410/rrms-backend/internal/domain/property/model"
+)
+
+func (s *service) GetListingPriceHistory(id uuid.UUID) ([]model.ListingPriceHistoryModel, error) {
+	return s.domainRepo.ListingRepo.GetListingPriceHistory(context.Background(), id)
+}
+
+// GetRentEstimate estimates the rent of the described unit from the comparable units of its ward,
+// or of its district when the ward has too few of them
+func (s *service) GetRentEstimate(query *dto.GetRentEstimateQuery) (model.RentEstimateModel, error) {
+	if query.Ward != nil && *query.Ward != "" {
+		e, err := s.domainRepo.ListingRepo.GetRentEstimate(context.Background(), query, listing_utils.RENT_ESTIMATE_AREA_TOLERANCE, listing_utils.RENT_ESTIMATE_RENTED_WITHIN_DAYS)
+		if err != nil || e.Samples >= listing_utils.RENT_ESTIMATE_MIN_SAMPLES {
+			return e, err
+		}
+	}
+	q := *query
+	q.Ward = nil
+	return s.domainRepo.ListingRepo.GetRentEstimate(context.Background(), &q, listing_utils.RENT_ESTIMATE_AREA_TOLERANCE, listing_utils.RENT_ESTIMATE_RENTED_WITHIN_DAYS)
+}
+
+// estimateListingPrices estimates the rent of each unit of the listing and compares it with the price of the unit
+func (s *service) estimateListingPrices(listing *model.ListingModel, property *property_model.PropertyModel) ([]model.ListingUnitPriceEstimate, error) {
+	uids := make([]uuid.UUID, 0, len(listing.Units))
+	for _, lu := range listing.Units {
+		uids = append(uids, lu.UnitID)
+	}
+	units, err := s.domainRepo.UnitRepo.GetUnitsByIds(context.Background(), uids, []string{"area", "number_of_bedrooms", "number_of_bathrooms"})
+	if err != nil {
+		return nil, err
+	}
+
+	estimates := make([]model.ListingUnitPriceEstimate, 0, len(units))
+	for _, lu := range listing.Units {
+		for _, u := range units {
+			if u.ID != lu.UnitID || u.Area <= 0 {
+				continue
+			}
+			e, err := s.GetRentEstimate(&dto.GetRentEstimateQuery{
+				City:              property.City,
+				District:          property.District,
+				Ward:              property.Ward,
+				PropertyType:      string(property.Type),
+				Area:              u.Area,
+				NumberOfBedrooms:  u.NumberOfBedrooms,
+				NumberOfBathrooms: u.NumberOfBathrooms,
+			})
+			if err != nil {
+				return nil, err
+			}
+			estimates = append(estimates, model.ListingUnitPriceEstimate{
+				UnitID:     lu.UnitID,
+				Price:      lu.Price,
+				Estimate:   e,
+				OutOfRange: listing_utils.IsOutsideRentEstimate(float64(lu.Price), &e),
+			})
+		}
+	}
+	return estimates, nil
+}
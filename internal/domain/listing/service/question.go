@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+func (s *service) CreateListingQuestion(listingId uuid.UUID, data *dto.CreateListingQuestion) (model.ListingQuestionModel, error) {
+	return s.domainRepo.ListingRepo.CreateListingQuestion(context.Background(), listingId, data)
+}
+
+func (s *service) GetListingQuestions(listingId uuid.UUID) ([]model.ListingQuestionModel, error) {
+	return s.domainRepo.ListingRepo.GetListingQuestions(context.Background(), listingId)
+}
+
+// UpdateListingQuestion updates a question of the listing.
+// The answers already given keep the question as it was asked.
+func (s *service) UpdateListingQuestion(listingId uuid.UUID, id int64, data *dto.UpdateListingQuestion) error {
+	if data.Options != nil {
+		qs, err := s.domainRepo.ListingRepo.GetListingQuestions(context.Background(), listingId)
+		if err != nil {
+			return err
+		}
+		idx := slices.IndexFunc(qs, func(q model.ListingQuestionModel) bool { return q.ID == id })
+		if idx < 0 {
+			return database.ErrRecordNotFound
+		}
+		if !qs[idx].IsChoice() {
+			return dto.ErrQuestionOptionsNotAllowed
+		}
+	}
+	updated, err := s.domainRepo.ListingRepo.UpdateListingQuestion(context.Background(), listingId, id, data)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return database.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (s *service) DeleteListingQuestion(listingId uuid.UUID, id int64) error {
+	deleted, err := s.domainRepo.ListingRepo.DeleteListingQuestion(context.Background(), listingId, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return database.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/esutil"
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/domain/listing/search"
+	"github.com/user2410/rrms-backend/internal/infrastructure/es"
+	"github.com/user2410/rrms-backend/pkg/ds/set"
+)
+
+const (
+	REINDEX_BATCHSIZE = 500
+	DIFF_BATCHSIZE    = 1000
+)
+
+// ReindexListings builds a new versioned listings index from Postgres and points the listings alias to it.
+// The state is passed to checkpoint after every step, so a reindex interrupted at any point
+// can be resumed by calling ReindexListings again with the last checkpointed state.
+// Writes made while the new index is being built go to the old index through the search outbox,
+// they are replayed into the new index once the alias has been swapped.
+func (s *service) ReindexListings(state *dto.ReindexListingsState, checkpoint func(*dto.ReindexListingsState) error) error {
+	ctx := context.Background()
+	client := s.esClient.GetClient()
+
+	if state.Completed {
+		return nil
+	}
+
+	if state.Index == "" {
+		state.StartedAt = time.Now()
+		state.Index = es.VersionedIndexName(es.LISTINGINDEX, state.StartedAt)
+		if err := es.CreateVersionedIndex(ctx, client, es.LISTINGINDEX, state.Index); err != nil {
+			return err
+		}
+		if err := checkpoint(state); err != nil {
+			return err
+		}
+		log.Println("created index", state.Index)
+	}
+
+	if !state.Swapped {
+		for {
+			listings, err := s.domainRepo.ListingRepo.GetListingsAfter(ctx, state.LastID, REINDEX_BATCHSIZE)
+			if err != nil {
+				return err
+			}
+			if len(listings) == 0 {
+				break
+			}
+			if err = s.bulkIndexListings(ctx, state.Index, listings); err != nil {
+				return err
+			}
+			state.LastID = listings[len(listings)-1].ID
+			state.Indexed += int64(len(listings))
+			if err = checkpoint(state); err != nil {
+				return err
+			}
+			log.Printf("%d listings indexed into %s\n", state.Indexed, state.Index)
+		}
+
+		if err := s.validateReindex(ctx, state); err != nil {
+			return err
+		}
+
+		oldIndices, err := es.SwapAlias(ctx, client, string(es.LISTINGINDEX), state.Index)
+		if err != nil {
+			return err
+		}
+		state.Swapped = true
+		if err = checkpoint(state); err != nil {
+			return err
+		}
+		log.Printf("alias %s swapped from %v to %s\n", es.LISTINGINDEX, oldIndices, state.Index)
+	}
+
+	n, err := s.domainRepo.ListingRepo.ReplaySearchOutboxEventsSince(ctx, state.StartedAt)
+	if err != nil {
+		return err
+	}
+	log.Printf("%d outbox events since %s requeued for projection\n", n, state.StartedAt.Format(time.RFC3339))
+
+	state.Completed = true
+	return checkpoint(state)
+}
+
+func (s *service) bulkIndexListings(ctx context.Context, index string, listings []model.ListingModel) error {
+	bi, err := esutil.NewBulkIndexer(esutil.BulkIndexerConfig{
+		Index:  index,
+		Client: s.esClient.GetClient(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := range listings {
+		l := &listings[i]
+		doc, err := search.BuildListingDocument(ctx, s.domainRepo, l)
+		if err != nil {
+			return fmt.Errorf("failed to build the search document of listing %s: %w", l.ID, err)
+		}
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		err = bi.Add(ctx, esutil.BulkIndexerItem{
+			Action:     "index",
+			DocumentID: l.ID.String(),
+			Body:       bytes.NewReader(data),
+			OnFailure: func(ctx context.Context, item esutil.BulkIndexerItem, res esutil.BulkIndexerResponseItem, err error) {
+				if err != nil {
+					log.Println("failed to index listing", item.DocumentID, err)
+				} else {
+					log.Println("failed to index listing", item.DocumentID, res.Error.Type, res.Error.Reason)
+				}
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = bi.Close(ctx); err != nil {
+		return err
+	}
+	if stats := bi.Stats(); stats.NumFailed > 0 {
+		return fmt.Errorf("%d listings failed to index into %s", stats.NumFailed, index)
+	}
+	return nil
+}
+
+// validateReindex compares the document count of the new index with the number of listings.
+// Listings created or deleted during the reindex are not in the new index yet, so the counts may differ
+// by at most the number of outbox events since the reindex started.
+func (s *service) validateReindex(ctx context.Context, state *dto.ReindexListingsState) error {
+	client := s.esClient.GetClient()
+	if err := es.RefreshIndex(ctx, client, state.Index); err != nil {
+		return err
+	}
+	indexCount, err := es.CountDocuments(ctx, client, state.Index)
+	if err != nil {
+		return err
+	}
+	dbCount, err := s.domainRepo.ListingRepo.CountListings(ctx)
+	if err != nil {
+		return err
+	}
+	drift, err := s.domainRepo.ListingRepo.CountSearchOutboxEventsSince(ctx, state.StartedAt)
+	if err != nil {
+		return err
+	}
+
+	diff := dbCount - indexCount
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > drift {
+		return fmt.Errorf("%w: %d documents in %s, %d listings in the database, %d changes since %s",
+			ErrReindexCountMismatch, indexCount, state.Index, dbCount, drift, state.StartedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// DiffListingsIndex compares the listings in the database with the documents of the given index,
+// or of the listings alias if index is empty, without modifying either
+func (s *service) DiffListingsIndex(index string) (*dto.ListingsIndexDiff, error) {
+	ctx := context.Background()
+	client := s.esClient.GetClient()
+	if index == "" {
+		index = string(es.LISTINGINDEX)
+	}
+
+	var (
+		res = &dto.ListingsIndexDiff{Index: index}
+		err error
+	)
+	if res.DBCount, err = s.domainRepo.ListingRepo.CountListings(ctx); err != nil {
+		return nil, err
+	}
+	if res.IndexCount, err = es.CountDocuments(ctx, client, index); err != nil {
+		return nil, err
+	}
+
+	// listings missing from the index or indexed at an older revision
+	var after uuid.UUID
+	for {
+		revisions, err := s.domainRepo.ListingRepo.GetListingRevisionsAfter(ctx, after, DIFF_BATCHSIZE)
+		if err != nil {
+			return nil, err
+		}
+		if len(revisions) == 0 {
+			break
+		}
+		ids := make([]string, 0, len(revisions))
+		for _, r := range revisions {
+			ids = append(ids, r.ID.String())
+		}
+		indexed, err := es.MgetField(ctx, client, index, ids, "updated_at")
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range revisions {
+			raw, ok := indexed[r.ID.String()]
+			if !ok {
+				res.Missing = append(res.Missing, r.ID)
+				continue
+			}
+			var updatedAt time.Time
+			if err := json.Unmarshal(raw, &updatedAt); err != nil || !updatedAt.Equal(r.UpdatedAt) {
+				res.Outdated = append(res.Outdated, r.ID)
+			}
+		}
+		after = revisions[len(revisions)-1].ID
+	}
+
+	// documents whose listing no longer exists
+	lastDoc := ""
+	for {
+		docIds, err := es.SearchIdsAfter(ctx, client, index, "id", lastDoc, DIFF_BATCHSIZE)
+		if err != nil {
+			return nil, err
+		}
+		if len(docIds) == 0 {
+			break
+		}
+		lids := make([]uuid.UUID, 0, len(docIds))
+		for _, id := range docIds {
+			if lid, err := uuid.Parse(id); err == nil {
+				lids = append(lids, lid)
+			}
+		}
+		existing, err := s.domainRepo.ListingRepo.FilterExistingListings(ctx, lids)
+		if err != nil {
+			return nil, err
+		}
+		existingSet := set.NewSet[string]()
+		for _, lid := range existing {
+			existingSet.Add(lid.String())
+		}
+		for _, id := range docIds {
+			if !existingSet.Contains(id) {
+				res.Orphaned = append(res.Orphaned, id)
+			}
+		}
+		lastDoc = docIds[len(docIds)-1]
+	}
+
+	return res, nil
+}
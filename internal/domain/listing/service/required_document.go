@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+)
+
+func (s *service) GetListingRequiredDocuments(listingId uuid.UUID) ([]model.ListingRequiredDocumentModel, error) {
+	return s.domainRepo.ListingRepo.GetListingRequiredDocuments(context.Background(), listingId)
+}
+
+func (s *service) UpdateListingRequiredDocuments(listingId uuid.UUID, data *dto.UpdateListingRequiredDocuments) ([]model.ListingRequiredDocumentModel, error) {
+	return s.domainRepo.ListingRepo.ReplaceListingRequiredDocuments(context.Background(), listingId, data)
+}
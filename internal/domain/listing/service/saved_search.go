@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/robfig/cron/v3"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
+	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+const (
+	basePath = "internal/domain/listing/service/templates"
+
+	SAVEDSEARCH_MAX_PER_USER = 20
+	// number of saved searches loaded at once by the matcher
+	SAVEDSEARCH_BATCHSIZE = 200
+	// number of new listings matched by a saved search per page of search results
+	SAVEDSEARCH_MATCHES_PAGESIZE = 50
+	// each check looks back this far before the previous one, so that listings activated
+	// by a transaction committed during the previous check are not missed
+	SAVEDSEARCH_CHECK_OVERLAP = time.Minute
+	SAVEDSEARCH_MATCH_TIMEOUT = 10 * time.Minute
+)
+
+// setupSavedSearchCronjob schedules the matching of saved searches every few minutes and the daily and weekly digests
+func (s *service) setupSavedSearchCronjob(c *cron.Cron) error {
+	err := s.scheduleTask(c, "@every 5m", asynctask.LISTING_SAVED_SEARCH_MATCH,
+		asynq.Unique(SAVEDSEARCH_MATCH_TIMEOUT), asynq.Timeout(SAVEDSEARCH_MATCH_TIMEOUT), asynq.MaxRetry(3))
+	if err != nil {
+		return err
+	}
+
+	for spec, frequency := range map[string]database.SAVEDSEARCHFREQUENCY{
+		"0 8 * * *": database.SAVEDSEARCHFREQUENCYDAILY,
+		"0 8 * * 1": database.SAVEDSEARCHFREQUENCYWEEKLY,
+	} {
+		frequency := frequency
+		entryID, err := c.AddFunc(spec, func() {
+			err := s.asynctaskDistributor.DistributeTaskJSON(context.Background(), asynctask.LISTING_SAVED_SEARCH_ALERT,
+				dto.SendSavedSearchAlerts{Frequency: frequency},
+				asynq.Unique(time.Hour), asynq.MaxRetry(3))
+			if err != nil && !errors.Is(err, asynq.ErrDuplicateTask) {
+				log.Println("failed to enqueue saved search alerts:", err)
+			}
+		})
+		if err != nil {
+			return err
+		}
+		s.cronEntries = append(s.cronEntries, entryID)
+	}
+	return nil
+}
+
+func (s *service) CreateSavedSearch(data *dto.CreateSavedSearch) (model.SavedSearchModel, error) {
+	ss, err := s.domainRepo.ListingRepo.GetSavedSearchesOfUser(context.Background(), data.UserID)
+	if err != nil {
+		return model.SavedSearchModel{}, err
+	}
+	if len(ss) >= SAVEDSEARCH_MAX_PER_USER {
+		return model.SavedSearchModel{}, ErrSavedSearchLimitReached
+	}
+	// pagination is chosen by the matcher
+	data.Query.Limit = nil
+	data.Query.Offset = nil
+	return s.domainRepo.ListingRepo.CreateSavedSearch(context.Background(), data)
+}
+
+func (s *service) GetSavedSearchesOfUser(userId uuid.UUID) ([]model.SavedSearchModel, error) {
+	return s.domainRepo.ListingRepo.GetSavedSearchesOfUser(context.Background(), userId)
+}
+
+func (s *service) getSavedSearchOfUser(userId uuid.UUID, id int64) (model.SavedSearchModel, error) {
+	ss, err := s.domainRepo.ListingRepo.GetSavedSearch(context.Background(), id)
+	if err != nil {
+		return model.SavedSearchModel{}, err
+	}
+	if ss.UserID != userId {
+		return model.SavedSearchModel{}, database.ErrRecordNotFound
+	}
+	return ss, nil
+}
+
+func (s *service) UpdateSavedSearch(userId uuid.UUID, id int64, data *dto.UpdateSavedSearch) error {
+	if _, err := s.getSavedSearchOfUser(userId, id); err != nil {
+		return err
+	}
+	return s.domainRepo.ListingRepo.UpdateSavedSearch(context.Background(), id, data)
+}
+
+func (s *service) DeleteSavedSearch(userId uuid.UUID, id int64) error {
+	if _, err := s.getSavedSearchOfUser(userId, id); err != nil {
+		return err
+	}
+	return s.domainRepo.ListingRepo.DeleteSavedSearch(context.Background(), id)
+}
+
+// MatchSavedSearches runs every active saved search against the listings activated since its last check,
+// then sends the new matches of the instant saved searches
+func (s *service) MatchSavedSearches() error {
+	var (
+		after int64
+		errs  []error
+	)
+	for {
+		batch, err := s.domainRepo.ListingRepo.GetActiveSavedSearchesAfter(context.Background(), after, SAVEDSEARCH_BATCHSIZE)
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			break
+		}
+		for i := range batch {
+			if err := s.matchSavedSearch(&batch[i]); err != nil {
+				errs = append(errs, fmt.Errorf("saved search %d: %w", batch[i].ID, err))
+			}
+		}
+		after = batch[len(batch)-1].ID
+	}
+
+	if err := s.SendSavedSearchAlerts(database.SAVEDSEARCHFREQUENCYINSTANT); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (s *service) matchSavedSearch(ss *model.SavedSearchModel) error {
+	checkedAt := time.Now()
+
+	var q dto.SearchListingCombinationQuery
+	if err := json.Unmarshal(ss.Query, &q); err != nil {
+		return err
+	}
+	q.LMinActivatedAt = types.Ptr(ss.LastCheckedAt.Add(-SAVEDSEARCH_CHECK_OVERLAP))
+	q.Limit = types.Ptr[int32](SAVEDSEARCH_MATCHES_PAGESIZE)
+	// the check is only recorded once all the new listings are matched, a failed page is retried on the next check
+	for offset := int32(0); ; offset += SAVEDSEARCH_MATCHES_PAGESIZE {
+		// the search fills the query, each page starts from the saved one
+		page := q
+		page.Offset = types.Ptr(offset)
+		res, err := s.SearchListingCombination(&page, ss.UserID)
+		if err != nil {
+			return err
+		}
+
+		lids := make([]uuid.UUID, 0, len(res.Items))
+		for _, item := range res.Items {
+			lids = append(lids, item.LId)
+		}
+		// listings already matched for the user, by this search or another one, are left out
+		if _, err = s.domainRepo.ListingRepo.CreateSavedSearchMatches(context.Background(), ss, lids); err != nil {
+			return err
+		}
+		if len(res.Items) < SAVEDSEARCH_MATCHES_PAGESIZE || uint32(offset)+SAVEDSEARCH_MATCHES_PAGESIZE >= res.Count {
+			break
+		}
+	}
+	return s.domainRepo.ListingRepo.UpdateSavedSearchLastCheckedAt(context.Background(), ss.ID, checkedAt)
+}
+
+// SendSavedSearchAlerts sends the matches not notified yet of the saved searches of the given frequency
+func (s *service) SendSavedSearchAlerts(frequency database.SAVEDSEARCHFREQUENCY) error {
+	matches, err := s.domainRepo.ListingRepo.GetPendingSavedSearchMatches(context.Background(), frequency)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, m := range matches {
+		ss, err := s.domainRepo.ListingRepo.GetSavedSearch(context.Background(), m.SavedSearchID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("saved search %d: %w", m.SavedSearchID, err))
+			continue
+		}
+		if err = s.notifySavedSearchMatches(&ss, m.ListingIDs); err != nil {
+			errs = append(errs, fmt.Errorf("saved search %d: %w", m.SavedSearchID, err))
+			continue
+		}
+		if err = s.domainRepo.ListingRepo.MarkSavedSearchMatchesNotified(context.Background(), ss.ID, m.ListingIDs); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *service) notifySavedSearchMatches(ss *model.SavedSearchModel, lids []uuid.UUID) error {
+	// listings hidden since they were matched are not sent
+	visibleIds := make([]uuid.UUID, 0, len(lids))
+	for _, lid := range lids {
+		visible, err := s.domainRepo.ListingRepo.CheckListingVisibility(context.Background(), lid, ss.UserID)
+		if err != nil {
+			return err
+		}
+		if visible {
+			visibleIds = append(visibleIds, lid)
+		}
+	}
+	if len(visibleIds) == 0 {
+		return nil
+	}
+	listings, err := s.domainRepo.ListingRepo.GetListingsByIds(context.Background(), visibleIds, []string{"title", "price"})
+	if err != nil {
+		return err
+	}
+	user, err := s.domainRepo.AuthRepo.GetUserById(context.Background(), ss.UserID)
+	if err != nil {
+		return err
+	}
+
+	data := struct {
+		FESite      string
+		SavedSearch *model.SavedSearchModel
+		Listings    []model.ListingModel
+	}{
+		FESite:      s.feSite,
+		SavedSearch: ss,
+		Listings:    listings,
+	}
+	return s.sendNotification("notify_savedsearch", data, ss.UserID, user.Email, map[string]interface{}{
+		"notificationType": misc_service.NOTIFICATIONTYPE_SAVEDSEARCHMATCH,
+		"savedSearchId":    ss.ID,
+		"listingIds":       visibleIds,
+	})
+}
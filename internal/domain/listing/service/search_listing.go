@@ -12,13 +12,43 @@ import (
 
 func (s *service) SearchListingCombination(q *dto.SearchListingCombinationQuery, userId uuid.UUID) (*dto.SearchListingCombinationResponse, error) {
 	if len(q.SortBy) == 0 {
-		q.SortBy = append(q.SortBy, "listings.created_at", "listings.priority")
-		q.Order = append(q.Order, "desc", "desc")
+		if q.HasTextQuery() {
+			q.SortBy = append(q.SortBy, dto.SORTBY_RELEVANCE)
+			q.Order = append(q.Order, "asc")
+		} else {
+			q.SortBy = append(q.SortBy, "listings.created_at", "listings.priority")
+			q.Order = append(q.Order, "desc", "desc")
+		}
 	}
 	q.Limit = types.Ptr(utils.PtrDerefence(q.Limit, 1000))
 	q.Offset = types.Ptr(utils.PtrDerefence(q.Offset, 0))
 	q.LActive = types.Ptr(true)
 	q.PIsPublic = types.Ptr(true)
 	q.LMinExpiredAt = types.Ptr(time.Now())
+	q.LCollapseDuplicates = types.Ptr(true)
+	if q.HasTextQuery() || q.HasGeoFilter() || q.SortByDistance() {
+		ids, err := s.searcher.SearchListingIds(context.Background(), q)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return &dto.SearchListingCombinationResponse{
+				Limit:  *q.Limit,
+				Offset: *q.Offset,
+				SortBy: q.SortBy,
+				Order:  q.Order,
+				Items:  []dto.SearchListingCombinationItem{},
+			}, nil
+		}
+		q.LIds = ids
+	}
 	return s.domainRepo.ListingRepo.SearchListingCombination(context.Background(), q)
 }
+
+func (s *service) GetListingGeoGrid(q *dto.ListingGeoGridQuery) ([]dto.ListingGeoGridCell, error) {
+	return s.searcher.GetListingGeoGrid(context.Background(), q)
+}
+
+func (s *service) AutocompleteListings(q *dto.ListingAutocompleteQuery) ([]dto.ListingSuggestion, error) {
+	return s.searcher.AutocompleteListings(context.Background(), q)
+}
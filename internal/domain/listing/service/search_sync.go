@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/robfig/cron/v3"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/search"
+	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+const (
+	SEARCHOUTBOX_BATCHSIZE   = 100
+	SEARCHOUTBOX_MAXBATCHES  = 50
+	SEARCHOUTBOX_MAXATTEMPTS = 10
+	SEARCHOUTBOX_RETENTION   = 7 * 24 * time.Hour
+)
+
+// setupSearchSyncCronjob relays the search outbox to the async task processor every few seconds
+// and purges the projected events once a day
+func (s *service) setupSearchSyncCronjob(c *cron.Cron) error {
+	if err := s.scheduleTask(c, "@every 5s", asynctask.LISTING_SEARCH_OUTBOX_PROCESS, asynq.Unique(time.Minute), asynq.MaxRetry(3)); err != nil {
+		return err
+	}
+
+	entryID, err := c.AddFunc("30 3 * * *", func() {
+		if _, err := s.domainRepo.ListingRepo.PurgeSearchOutboxEvents(context.Background(), time.Now().Add(-SEARCHOUTBOX_RETENTION)); err != nil {
+			log.Println("failed to purge search outbox:", err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	s.cronEntries = append(s.cronEntries, entryID)
+	return nil
+}
+
+// ProcessSearchOutbox projects the pending outbox events into the listings index.
+// Each listing is rebuilt from Postgres, so events can be replayed in any order.
+// Failed events are retried with exponential backoff and dead-lettered after SEARCHOUTBOX_MAXATTEMPTS.
+func (s *service) ProcessSearchOutbox() error {
+	ctx := context.Background()
+	for i := 0; i < SEARCHOUTBOX_MAXBATCHES; i++ {
+		events, err := s.domainRepo.ListingRepo.ClaimSearchOutboxEvents(ctx, SEARCHOUTBOX_BATCHSIZE)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		// coalesce the events of the same listing
+		eventIds := make(map[uuid.UUID][]int64)
+		for _, e := range events {
+			eventIds[e.ListingID] = append(eventIds[e.ListingID], e.ID)
+		}
+
+		var processed []int64
+		for lid, ids := range eventIds {
+			if err := s.projectListing(ctx, lid); err != nil {
+				log.Println("failed to project listing", lid, "into the search index:", err)
+				if err := s.domainRepo.ListingRepo.FailSearchOutboxEvents(ctx, ids, err.Error(), SEARCHOUTBOX_MAXATTEMPTS); err != nil {
+					return err
+				}
+				continue
+			}
+			processed = append(processed, ids...)
+		}
+		if len(processed) > 0 {
+			if err := s.domainRepo.ListingRepo.MarkSearchOutboxEventsProcessed(ctx, processed); err != nil {
+				return err
+			}
+		}
+	}
+
+	stats, err := s.domainRepo.ListingRepo.GetSearchOutboxStats(ctx)
+	if err != nil {
+		return err
+	}
+	if stats.Pending > 0 || stats.Dead > 0 {
+		log.Printf("search outbox: %d pending, %d dead, lag %.0fs\n", stats.Pending, stats.Dead, stats.LagSeconds)
+	}
+	return nil
+}
+
+func (s *service) GetSearchOutboxStats() (dto.SearchOutboxStats, error) {
+	return s.domainRepo.ListingRepo.GetSearchOutboxStats(context.Background())
+}
+
+// projectListing replaces the search document of the listing, or removes it if the listing no longer exists
+func (s *service) projectListing(ctx context.Context, id uuid.UUID) error {
+	listing, err := s.domainRepo.ListingRepo.GetListingByID(ctx, id)
+	if errors.Is(err, database.ErrRecordNotFound) {
+		return s.searcher.DeleteListing(ctx, id)
+	}
+	if err != nil {
+		return err
+	}
+
+	doc, err := search.BuildListingDocument(ctx, s.domainRepo, listing)
+	if err != nil {
+		return err
+	}
+	return s.searcher.IndexListing(ctx, &doc)
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"math"
 	"net/url"
 
+	"github.com/hibiken/asynq"
+	"github.com/robfig/cron/v3"
 	repos "github.com/user2410/rrms-backend/internal/domain/_repos"
+	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
 	"github.com/user2410/rrms-backend/internal/infrastructure/database"
 	"github.com/user2410/rrms-backend/internal/infrastructure/es"
 	"github.com/user2410/rrms-backend/pkg/ds/set"
@@ -15,7 +19,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
 	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/domain/listing/search"
 	listing_utils "github.com/user2410/rrms-backend/internal/domain/listing/utils"
+	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
 	payment_dto "github.com/user2410/rrms-backend/internal/domain/payment/dto"
 	payment_model "github.com/user2410/rrms-backend/internal/domain/payment/model"
 	payment_service "github.com/user2410/rrms-backend/internal/domain/payment/service"
@@ -28,8 +34,10 @@ import (
 type Service interface {
 	CreateListing(data *dto.CreateListing) (*dto.CreateListingResponse, error)
 	SearchListingCombination(data *dto.SearchListingCombinationQuery, userId uuid.UUID) (*dto.SearchListingCombinationResponse, error)
-	GetListingByID(id uuid.UUID) (*model.ListingModel, error)
-	GetListingsByIds(uid uuid.UUID, ids []uuid.UUID, fields []string) ([]model.ListingModel, error)
+	GetListingGeoGrid(q *dto.ListingGeoGridQuery) ([]dto.ListingGeoGridCell, error)
+	AutocompleteListings(q *dto.ListingAutocompleteQuery) ([]dto.ListingSuggestion, error)
+	GetListingByID(id uuid.UUID, language string) (*model.ListingModel, error)
+	GetListingsByIds(uid uuid.UUID, ids []uuid.UUID, fields []string, language string) ([]model.ListingModel, error)
 	GetListingsOfUser(userId uuid.UUID, query *dto.GetListingsQuery) (int, []model.ListingModel, error)
 	GetListingPayments(id uuid.UUID) ([]payment_model.PaymentModel, error)
 
@@ -45,40 +53,184 @@ type Service interface {
 	UpdateListingExpiration(id uuid.UUID, duration int64) error
 	UpdateListingPriority(id uuid.UUID, priority int) error
 	ExtendListing(userId uuid.UUID, lid uuid.UUID, duration int) (*payment_model.PaymentModel, error)
+
+	ProcessSearchOutbox() error
+	GetSearchOutboxStats() (dto.SearchOutboxStats, error)
+	ReindexListings(state *dto.ReindexListingsState, checkpoint func(*dto.ReindexListingsState) error) error
+	DiffListingsIndex(index string) (*dto.ListingsIndexDiff, error)
+
+	CreateSavedSearch(data *dto.CreateSavedSearch) (model.SavedSearchModel, error)
+	GetSavedSearchesOfUser(userId uuid.UUID) ([]model.SavedSearchModel, error)
+	UpdateSavedSearch(userId uuid.UUID, id int64, data *dto.UpdateSavedSearch) error
+	DeleteSavedSearch(userId uuid.UUID, id int64) error
+	MatchSavedSearches() error
+	SendSavedSearchAlerts(frequency database.SAVEDSEARCHFREQUENCY) error
+
+	CreateFavoriteFolder(data *dto.CreateFavoriteFolder) (model.FavoriteFolderModel, error)
+	GetFavoriteFoldersOfUser(userId uuid.UUID) ([]model.FavoriteFolderModel, error)
+	UpdateFavoriteFolder(userId uuid.UUID, id int64, data *dto.UpdateFavoriteFolder) error
+	DeleteFavoriteFolder(userId uuid.UUID, id int64) error
+	SaveFavoriteListing(data *dto.SaveFavoriteListing) (model.FavoriteListingModel, error)
+	GetFavoriteListingsOfUser(userId uuid.UUID, query *dto.GetFavoriteListingsQuery) ([]dto.FavoriteListing, error)
+	DeleteFavoriteListing(userId, listingId uuid.UUID) error
+	CompareListings(userId uuid.UUID, ids []uuid.UUID) (*dto.ListingComparison, error)
+
+	RecordListingEvent(event dto.LISTINGEVENT, lids []uuid.UUID, session string) error
+	FlushListingAnalytics() error
+	GetListingContact(id uuid.UUID) (dto.ListingContact, error)
+
+	SetRefundHook(hook RefundHook)
+	SetRenewHook(hook RenewHook)
+	CheckListingModeration(id int64) error
+	GetListingModerations(query *dto.GetListingModerationsQuery) ([]model.ListingModerationModel, error)
+	GetListingModeration(id int64) (model.ListingModerationModel, error)
+	GetLatestListingModeration(listingId uuid.UUID) (model.ListingModerationModel, error)
+	ReviewListingModeration(id int64, data *dto.ReviewListingModeration) error
+	ResubmitListingForModeration(listingId uuid.UUID) error
+	RefundListing(data *dto.RefundListing) error
+	NotifyListingModeration(id int64) error
+
+	DetectListingDuplicates(id uuid.UUID) (*model.ListingDuplicateClusterModel, error)
+	GetListingDuplicateCluster(id int64) (model.ListingDuplicateClusterModel, error)
+	GetListingDuplicateClusters(query *dto.GetListingDuplicateClustersQuery) ([]model.ListingDuplicateClusterModel, error)
+	GetListingDuplicatesForManager(id uuid.UUID, userId uuid.UUID) (*model.ListingDuplicateClusterModel, error)
+
+	PublishScheduledListings() error
+	ExpireListings() error
+	WarnListingsExpiry() error
+
+	GetListingPriceHistory(id uuid.UUID) ([]model.ListingPriceHistoryModel, error)
+	GetRentEstimate(query *dto.GetRentEstimateQuery) (model.RentEstimateModel, error)
+
+	GetListingTranslations(listingId uuid.UUID) (model.ListingTranslationsModel, error)
+	UpsertListingTranslation(listingId uuid.UUID, data *dto.CreateListingTranslation) (model.ListingTranslationModel, error)
+	DeleteListingTranslation(listingId uuid.UUID, language string) error
+
+	GetListingRequiredDocuments(listingId uuid.UUID) ([]model.ListingRequiredDocumentModel, error)
+	UpdateListingRequiredDocuments(listingId uuid.UUID, data *dto.UpdateListingRequiredDocuments) ([]model.ListingRequiredDocumentModel, error)
+
+	CreateListingQuestion(listingId uuid.UUID, data *dto.CreateListingQuestion) (model.ListingQuestionModel, error)
+	GetListingQuestions(listingId uuid.UUID) ([]model.ListingQuestionModel, error)
+	UpdateListingQuestion(listingId uuid.UUID, id int64, data *dto.UpdateListingQuestion) error
+	DeleteListingQuestion(listingId uuid.UUID, id int64) error
+
+	CreateListingViewingSlots(data *dto.CreateListingViewingSlots) ([]model.ListingViewingSlotModel, error)
+	GetListingViewingSlots(listingId uuid.UUID) ([]model.ListingViewingSlotModel, error)
+	GetListingViewingSlot(id int64) (model.ListingViewingSlotModel, error)
+	DeleteListingViewingSlot(id int64) error
+	BookListingViewing(data *dto.BookListingViewing) (model.ListingViewingModel, error)
+	GetListingViewing(id int64) (model.ListingViewingModel, error)
+	GetListingViewingsOfListing(listingId uuid.UUID) ([]model.ListingViewingModel, error)
+	GetListingViewingsOfTenant(tenantId uuid.UUID) ([]model.ListingViewingModel, error)
+	CancelListingViewing(id int64) error
+	UpdateListingViewingAttendance(id int64, data *dto.UpdateListingViewingAttendance) error
+	RemindListingViewings() error
+	NotifyListingViewing(id int64, event dto.LISTINGVIEWINGEVENT) error
 }
 
 type service struct {
 	hashSecret string
 	domainRepo repos.DomainRepo
 
-	esClient *es.ElasticSearchClient
+	esClient             *es.ElasticSearchClient
+	searcher             search.Searcher
+	mService             misc_service.Service
+	asynctaskDistributor asynctask.Distributor
+	cronEntries          []cron.EntryID
+	feSite               string
+	imageBucketName      string
+	refundHook           RefundHook
+	renewHook            RenewHook
 }
 
 func NewService(
 	domainRepo repos.DomainRepo,
 	hashSecret string,
 	esClient *es.ElasticSearchClient,
+	searcher search.Searcher,
+	mService misc_service.Service,
+	asynctaskDistributor asynctask.Distributor,
+	c *cron.Cron,
+	feSite string,
+	imageBucketName string,
 ) Service {
-	return &service{
-		hashSecret: hashSecret,
-		domainRepo: domainRepo,
-		esClient:   esClient,
+	res := &service{
+		hashSecret:           hashSecret,
+		domainRepo:           domainRepo,
+		esClient:             esClient,
+		searcher:             searcher,
+		mService:             mService,
+		asynctaskDistributor: asynctaskDistributor,
+		cronEntries:          make([]cron.EntryID, 0),
+		feSite:               feSite,
+		imageBucketName:      imageBucketName,
+	}
+	res.setupCronjob(c)
+	return res
+}
+
+// setupCronjob registers the scheduled jobs of each feature of listings
+func (s *service) setupCronjob(c *cron.Cron) ([]cron.EntryID, error) {
+	for _, setup := range []func(c *cron.Cron) error{
+		s.setupSearchSyncCronjob,
+		s.setupSavedSearchCronjob,
+		s.setupAnalyticsCronjob,
+		s.setupLifecycleCronjob,
+		s.setupViewingCronjob,
+	} {
+		if err := setup(c); err != nil {
+			return nil, err
+		}
+	}
+	return s.cronEntries, nil
+}
+
+// scheduleTask enqueues the task on the schedule. The task is expected to be unique,
+// so that at most one is queued at any time across all server instances.
+func (s *service) scheduleTask(c *cron.Cron, spec string, task string, opts ...asynq.Option) error {
+	entryID, err := c.AddFunc(spec, func() {
+		err := s.asynctaskDistributor.DistributeTask(context.Background(), task, nil, opts...)
+		if err != nil && !errors.Is(err, asynq.ErrDuplicateTask) {
+			log.Println("failed to enqueue", task, ":", err)
+		}
+	})
+	if err != nil {
+		return err
 	}
+	s.cronEntries = append(s.cronEntries, entryID)
+	return nil
 }
 
-func (s *service) GetListingByID(id uuid.UUID) (*model.ListingModel, error) {
-	return s.domainRepo.ListingRepo.GetListingByID(context.Background(), id)
+// GetListingByID returns the listing with its title and description in the language, in Vietnamese if it is not translated into it
+func (s *service) GetListingByID(id uuid.UUID, language string) (*model.ListingModel, error) {
+	res, err := s.domainRepo.ListingRepo.GetListingByID(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+	ls := []model.ListingModel{*res}
+	if err = s.translateListings(ls, language); err != nil {
+		return nil, err
+	}
+	return &ls[0], nil
 }
 
-func (s *service) GetListingsByIds(uid uuid.UUID, ids []uuid.UUID, fields []string) ([]model.ListingModel, error) {
+func (s *service) GetListingsByIds(uid uuid.UUID, ids []uuid.UUID, fields []string, language string) ([]model.ListingModel, error) {
 	visibleIDS, err := s.FilterVisibleListings(ids, uid)
 	if err != nil {
 		return nil, err
 	}
-	return s.domainRepo.ListingRepo.GetListingsByIds(context.Background(), visibleIDS, fields)
+	res, err := s.domainRepo.ListingRepo.GetListingsByIds(context.Background(), visibleIDS, fields)
+	if err != nil {
+		return nil, err
+	}
+	return res, s.translateListings(res, language)
 }
 
 func (s *service) DeleteListing(id uuid.UUID) error {
+	// the applications are deleted along with the listing, notify their applicants beforehand
+	if err := s.withdrawListingApplications(id); err != nil {
+		return err
+	}
 	return s.domainRepo.ListingRepo.DeleteListing(context.Background(), id)
 }
 
@@ -159,6 +311,11 @@ func (s *service) CreateApplicationLink(data *dto.CreateApplicationLink) (string
 	urlValues.Add("phone", data.Phone)
 	urlValues.Add("k", key)
 
+	// a link is counted once per invited applicant and day
+	if err = s.RecordListingEvent(dto.LISTINGEVENT_APPLICATIONLINK, []uuid.UUID{data.ListingId}, data.Email); err != nil {
+		log.Println("failed to record listing event", dto.LISTINGEVENT_APPLICATIONLINK, ":", err)
+	}
+
 	return urlValues.Encode(), nil
 }
 
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	listing_utils "github.com/user2410/rrms-backend/internal/domain/listing/utils"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// GetListingTranslations returns the translations of the listing and the languages its manager has yet to translate it into
+func (s *service) GetListingTranslations(listingId uuid.UUID) (model.ListingTranslationsModel, error) {
+	ts, err := s.domainRepo.ListingRepo.GetListingTranslations(context.Background(), listingId)
+	if err != nil {
+		return model.ListingTranslationsModel{}, err
+	}
+	languages := make([]string, 0, len(ts))
+	for _, t := range ts {
+		languages = append(languages, t.Language)
+	}
+	return model.ListingTranslationsModel{
+		Translations:     ts,
+		MissingLanguages: listing_utils.MissingLanguages(languages),
+	}, nil
+}
+
+// UpsertListingTranslation creates or replaces the translation of the listing into a language, the listing is reindexed
+func (s *service) UpsertListingTranslation(listingId uuid.UUID, data *dto.CreateListingTranslation) (model.ListingTranslationModel, error) {
+	return s.domainRepo.ListingRepo.UpsertListingTranslation(context.Background(), listingId, data)
+}
+
+func (s *service) DeleteListingTranslation(listingId uuid.UUID, language string) error {
+	deleted, err := s.domainRepo.ListingRepo.DeleteListingTranslation(context.Background(), listingId, language)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return database.ErrRecordNotFound
+	}
+	return nil
+}
+
+// translateListings replaces the title and description of the listings translated into the language.
+// Only the fields read are replaced, the listings not translated keep them in Vietnamese.
+func (s *service) translateListings(listings []model.ListingModel, language string) error {
+	for i := range listings {
+		listings[i].Language = listing_utils.LANGUAGE_DEFAULT
+	}
+	if language == "" || language == listing_utils.LANGUAGE_DEFAULT || len(listings) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(listings))
+	for i := range listings {
+		ids = append(ids, listings[i].ID)
+	}
+	ts, err := s.domainRepo.ListingRepo.GetListingsTranslations(context.Background(), ids, language)
+	if err != nil {
+		return err
+	}
+	for i := range listings {
+		t, ok := ts[listings[i].ID]
+		if !ok {
+			continue
+		}
+		if listings[i].Title != "" {
+			listings[i].Title = t.Title
+		}
+		if listings[i].Description != "" {
+			listings[i].Description = t.Description
+		}
+		listings[i].Language = language
+	}
+	return nil
+}
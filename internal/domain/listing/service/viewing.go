@@ -0,0 +1,350 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/robfig/cron/v3"
+	auth_model "github.com/user2410/rrms-backend/internal/domain/auth/model"
+	"github.com/user2410/rrms-backend/internal/domain/listing/dto"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	listing_utils "github.com/user2410/rrms-backend/internal/domain/listing/utils"
+	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
+	reminder_dto "github.com/user2410/rrms-backend/internal/domain/reminder/dto"
+	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+const (
+	// maximum duration of a viewing slot
+	VIEWING_SLOT_MAXDURATION = 12 * time.Hour
+	// both sides are reminded of a booked viewing this many hours before it starts
+	VIEWING_REMINDER_HOURS     = 24
+	VIEWING_REMINDER_BATCHSIZE = 100
+)
+
+var (
+	ErrViewingSlotTooLong     = errors.New("viewing slot too long")
+	ErrOverlappingViewingSlot = errors.New("viewing slot overlaps another slot of the manager")
+	ErrViewingSlotFull        = errors.New("viewing slot full")
+	ErrViewingSlotStarted     = errors.New("viewing slot already started")
+	ErrViewingSlotNotStarted  = errors.New("viewing slot not started yet")
+	ErrListingNotActive       = errors.New("listing not active")
+	ErrViewingNotBooked       = errors.New("viewing not booked")
+)
+
+// setupViewingCronjob schedules the reminders of upcoming viewings
+func (s *service) setupViewingCronjob(c *cron.Cron) error {
+	return s.scheduleTask(c, "@every 15m", asynctask.LISTING_VIEWING_REMIND, asynq.Unique(time.Minute), asynq.MaxRetry(3))
+}
+
+// CreateListingViewingSlots publishes the slot and, for a recurring open house, its weekly repetitions.
+// None is created if one of them overlaps another slot of the manager, on any listing.
+func (s *service) CreateListingViewingSlots(data *dto.CreateListingViewingSlots) ([]model.ListingViewingSlotModel, error) {
+	if data.EndAt.Sub(data.StartAt) > VIEWING_SLOT_MAXDURATION {
+		return nil, ErrViewingSlotTooLong
+	}
+	var seriesID uuid.UUID
+	if data.RepeatWeeks > 0 {
+		seriesID = uuid.New()
+	}
+	occurrences := listing_utils.ViewingSlotOccurrences(data.StartAt, data.EndAt, int(data.RepeatWeeks))
+	slots := make([]database.CreateListingViewingSlotParams, 0, len(occurrences))
+	for _, o := range occurrences {
+		slots = append(slots, database.CreateListingViewingSlotParams{
+			ListingID: data.ListingID,
+			ManagerID: data.ManagerID,
+			StartAt:   o[0],
+			EndAt:     o[1],
+			Capacity:  data.Capacity,
+			OpenHouse: data.OpenHouse,
+			SeriesID:  types.UUIDN(seriesID),
+			Note:      types.StrN(data.Note),
+		})
+	}
+	res, ok, err := s.domainRepo.ListingRepo.CreateListingViewingSlots(context.Background(), data.ManagerID, slots)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrOverlappingViewingSlot
+	}
+	return res, nil
+}
+
+// GetListingViewingSlots returns the slots of the listing not ended yet
+func (s *service) GetListingViewingSlots(listingId uuid.UUID) ([]model.ListingViewingSlotModel, error) {
+	return s.domainRepo.ListingRepo.GetListingViewingSlots(context.Background(), listingId, time.Now())
+}
+
+func (s *service) GetListingViewingSlot(id int64) (model.ListingViewingSlotModel, error) {
+	return s.domainRepo.ListingRepo.GetListingViewingSlot(context.Background(), id)
+}
+
+// DeleteListingViewingSlot deletes the slot with its bookings and the reminder of the manager,
+// the tenants who booked a slot not started yet are told it is canceled
+func (s *service) DeleteListingViewingSlot(id int64) error {
+	slot, err := s.domainRepo.ListingRepo.GetListingViewingSlot(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if slot.StartAt.After(time.Now()) {
+		viewings, err := s.domainRepo.ListingRepo.GetListingViewingsOfSlot(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		for i := range viewings {
+			if err = s.notifyListingViewing(&viewings[i], dto.LISTINGVIEWINGEVENT_CANCELED, false); err != nil {
+				log.Println("failed to notify the canceled viewing", viewings[i].ID, err)
+			}
+		}
+	}
+	if slot.ReminderID != nil {
+		if err = s.domainRepo.ReminderRepo.DeleteReminder(context.Background(), *slot.ReminderID); err != nil {
+			return err
+		}
+	}
+	return s.domainRepo.ListingRepo.DeleteListingViewingSlot(context.Background(), id)
+}
+
+// BookListingViewing books a place in a slot of an active listing not started yet.
+// The manager gets a reminder of the slot on its first booking.
+func (s *service) BookListingViewing(data *dto.BookListingViewing) (model.ListingViewingModel, error) {
+	slot, err := s.domainRepo.ListingRepo.GetListingViewingSlot(context.Background(), data.SlotID)
+	if err != nil {
+		return model.ListingViewingModel{}, err
+	}
+	if !slot.StartAt.After(time.Now()) {
+		return model.ListingViewingModel{}, ErrViewingSlotStarted
+	}
+	listing, err := s.domainRepo.ListingRepo.GetListingByID(context.Background(), slot.ListingID)
+	if err != nil {
+		return model.ListingViewingModel{}, err
+	}
+	if !listing.Active {
+		return model.ListingViewingModel{}, ErrListingNotActive
+	}
+
+	res, ok, err := s.domainRepo.ListingRepo.BookListingViewing(context.Background(), data)
+	if err != nil {
+		return model.ListingViewingModel{}, err
+	}
+	if !ok {
+		return model.ListingViewingModel{}, ErrViewingSlotFull
+	}
+
+	if res.Slot.ReminderID == nil {
+		if err = s.createViewingSlotReminder(listing, res.Slot); err != nil {
+			log.Println("failed to create the reminder of viewing slot", slot.ID, err)
+		}
+	}
+	if err = s.notifyListingViewingLater(res.ID, dto.LISTINGVIEWINGEVENT_BOOKED); err != nil {
+		log.Println("failed to schedule the notification of viewing", res.ID, err)
+	}
+	return res, nil
+}
+
+func (s *service) createViewingSlotReminder(listing *model.ListingModel, slot *model.ListingViewingSlotModel) error {
+	property, err := s.domainRepo.PropertyRepo.GetPropertyById(context.Background(), listing.PropertyID)
+	if err != nil {
+		return err
+	}
+	title := fmt.Sprintf("Lịch xem nhà: %s", listing.Title)
+	if slot.OpenHouse {
+		title = fmt.Sprintf("Mở cửa xem nhà: %s", listing.Title)
+	}
+	note := fmt.Sprintf("%s/manage/listings/listing/%s", s.feSite, listing.ID)
+	reminder, err := s.domainRepo.ReminderRepo.CreateReminder(context.Background(), &reminder_dto.CreateReminder{
+		CreatorID: slot.ManagerID,
+		Title:     title,
+		StartAt:   slot.StartAt,
+		EndAt:     slot.EndAt,
+		Note:      &note,
+		Location:  property.FullAddress,
+	})
+	if err != nil {
+		return err
+	}
+	return s.domainRepo.ListingRepo.SetListingViewingSlotReminder(context.Background(), slot.ID, reminder.ID)
+}
+
+func (s *service) GetListingViewing(id int64) (model.ListingViewingModel, error) {
+	return s.domainRepo.ListingRepo.GetListingViewing(context.Background(), id)
+}
+
+func (s *service) GetListingViewingsOfListing(listingId uuid.UUID) ([]model.ListingViewingModel, error) {
+	return s.domainRepo.ListingRepo.GetListingViewingsOfListing(context.Background(), listingId)
+}
+
+func (s *service) GetListingViewingsOfTenant(tenantId uuid.UUID) ([]model.ListingViewingModel, error) {
+	return s.domainRepo.ListingRepo.GetListingViewingsOfTenant(context.Background(), tenantId)
+}
+
+// CancelListingViewing cancels a booked viewing not started yet, on behalf of the tenant or the manager
+func (s *service) CancelListingViewing(id int64) error {
+	v, err := s.domainRepo.ListingRepo.GetListingViewing(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if v.Slot != nil && !v.Slot.StartAt.After(time.Now()) {
+		return ErrViewingSlotStarted
+	}
+	ok, err := s.domainRepo.ListingRepo.CancelListingViewing(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrViewingNotBooked
+	}
+	if err = s.notifyListingViewingLater(id, dto.LISTINGVIEWINGEVENT_CANCELED); err != nil {
+		log.Println("failed to schedule the notification of viewing", id, err)
+	}
+	return nil
+}
+
+// UpdateListingViewingAttendance records whether the tenant came to a viewing once its slot started,
+// attended viewings are counted in the funnel of the listing
+func (s *service) UpdateListingViewingAttendance(id int64, data *dto.UpdateListingViewingAttendance) error {
+	v, err := s.domainRepo.ListingRepo.GetListingViewing(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if v.Slot == nil {
+		return database.ErrRecordNotFound
+	}
+	if v.Slot.StartAt.After(time.Now()) {
+		return ErrViewingSlotNotStarted
+	}
+	ok, err := s.domainRepo.ListingRepo.UpdateListingViewingStatus(context.Background(), id, database.LISTINGVIEWINGSTATUSBOOKED, data.Status)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrViewingNotBooked
+	}
+	if data.Status == database.LISTINGVIEWINGSTATUSATTENDED {
+		return s.RecordListingEvent(dto.LISTINGEVENT_VIEWING, []uuid.UUID{v.Slot.ListingID}, v.TenantID.String())
+	}
+	return nil
+}
+
+// RemindListingViewings reminds both sides of the viewings starting within VIEWING_REMINDER_HOURS hours.
+// Each viewing is reminded once.
+func (s *service) RemindListingViewings() error {
+	ids, err := s.domainRepo.ListingRepo.GetListingViewingsToRemind(context.Background(), VIEWING_REMINDER_HOURS, VIEWING_REMINDER_BATCHSIZE)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, id := range ids {
+		if err = s.NotifyListingViewing(id, dto.LISTINGVIEWINGEVENT_REMINDER); err != nil {
+			errs = append(errs, fmt.Errorf("viewing %d: %w", id, err))
+			continue
+		}
+		if err = s.domainRepo.ListingRepo.MarkListingViewingReminded(context.Background(), id); err != nil {
+			errs = append(errs, fmt.Errorf("viewing %d: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *service) notifyListingViewingLater(id int64, event dto.LISTINGVIEWINGEVENT) error {
+	return s.asynctaskDistributor.DistributeTaskJSON(context.Background(), asynctask.LISTING_VIEWING_NOTIFY, dto.NotifyListingViewing{
+		ViewingID: id,
+		Event:     event,
+	})
+}
+
+// NotifyListingViewing tells the tenant and the manager about the booking, the cancellation or the coming of the viewing
+func (s *service) NotifyListingViewing(id int64, event dto.LISTINGVIEWINGEVENT) error {
+	v, err := s.domainRepo.ListingRepo.GetListingViewing(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	return s.notifyListingViewing(&v, event, true)
+}
+
+// notifyListingViewing sends the notification of the event to the tenant, and to the manager if toManager is set
+func (s *service) notifyListingViewing(v *model.ListingViewingModel, event dto.LISTINGVIEWINGEVENT, toManager bool) error {
+	if v.Slot == nil {
+		return database.ErrRecordNotFound
+	}
+	ls, err := s.domainRepo.ListingRepo.GetListingsByIds(context.Background(), []uuid.UUID{v.Slot.ListingID}, []string{"title", "property_id"})
+	if err != nil {
+		return err
+	}
+	if len(ls) == 0 {
+		return database.ErrRecordNotFound
+	}
+	listing := &ls[0]
+	property, err := s.domainRepo.PropertyRepo.GetPropertyById(context.Background(), listing.PropertyID)
+	if err != nil {
+		return err
+	}
+	us, err := s.domainRepo.AuthRepo.GetUsersByIds(context.Background(), []uuid.UUID{v.TenantID, v.Slot.ManagerID}, []string{"email", "first_name", "last_name", "phone"})
+	if err != nil {
+		return err
+	}
+	var tenant, manager *auth_model.UserModel
+	for i := range us {
+		switch us[i].ID {
+		case v.TenantID:
+			tenant = &us[i]
+		case v.Slot.ManagerID:
+			manager = &us[i]
+		}
+	}
+	if tenant == nil || manager == nil {
+		return database.ErrRecordNotFound
+	}
+	tz, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	if err != nil {
+		return err
+	}
+
+	recipients := []*auth_model.UserModel{tenant}
+	if toManager {
+		recipients = append(recipients, manager)
+	}
+	var errs []error
+	for _, recipient := range recipients {
+		data := struct {
+			FESite     string
+			Event      dto.LISTINGVIEWINGEVENT
+			ForManager bool
+			Listing    *model.ListingModel
+			Address    string
+			Viewing    *model.ListingViewingModel
+			Tenant     *auth_model.UserModel
+			Manager    *auth_model.UserModel
+			StartAt    string
+			EndAt      string
+		}{
+			FESite:     s.feSite,
+			Event:      event,
+			ForManager: recipient.ID == manager.ID,
+			Listing:    listing,
+			Address:    property.FullAddress,
+			Viewing:    v,
+			Tenant:     tenant,
+			Manager:    manager,
+			StartAt:    v.Slot.StartAt.In(tz).Format("15:04 02/01/2006"),
+			EndAt:      v.Slot.EndAt.In(tz).Format("15:04 02/01/2006"),
+		}
+		if err = s.sendNotification("listing_viewing", data, recipient.ID, recipient.Email, map[string]interface{}{
+			"notificationType": misc_service.NOTIFICATIONTYPE_LISTINGVIEWING,
+			"listingId":        listing.ID.String(),
+			"viewingId":        v.ID,
+			"event":            event,
+		}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
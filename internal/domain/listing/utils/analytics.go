@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+var botUserAgentRegex = regexp.MustCompile(`(?i)bot|crawl|spider|slurp|facebookexternalhit|headless|lighthouse|curl|wget|python-requests|go-http-client|okhttp|axios|postman`)
+
+// IsBot reports whether the user agent is a crawler or a script, whose requests are left out of the listing analytics
+func IsBot(userAgent string) bool {
+	return userAgent == "" || botUserAgentRegex.MatchString(userAgent)
+}
+
+// GetAnalyticsSession identifies the visitor an event is deduplicated for: the user when authenticated,
+// otherwise the IP address and user agent pair
+func GetAnalyticsSession(userId uuid.UUID, ip, userAgent string) string {
+	if userId != uuid.Nil {
+		return userId.String()
+	}
+	sum := sha256.Sum256([]byte(ip + "|" + userAgent))
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsBot(t *testing.T) {
+	require.True(t, IsBot(""))
+	require.True(t, IsBot("Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"))
+	require.True(t, IsBot("facebookexternalhit/1.1"))
+	require.True(t, IsBot("curl/8.4.0"))
+	require.True(t, IsBot("Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) HeadlessChrome/120.0.0.0 Safari/537.36"))
+	require.False(t, IsBot("Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1"))
+}
+
+func TestGetAnalyticsSession(t *testing.T) {
+	uid := uuid.New()
+	require.Equal(t, uid.String(), GetAnalyticsSession(uid, "1.2.3.4", "Mozilla/5.0"))
+
+	s := GetAnalyticsSession(uuid.Nil, "1.2.3.4", "Mozilla/5.0")
+	require.Equal(t, s, GetAnalyticsSession(uuid.Nil, "1.2.3.4", "Mozilla/5.0"))
+	require.NotEqual(t, s, GetAnalyticsSession(uuid.Nil, "1.2.3.5", "Mozilla/5.0"))
+	require.NotEqual(t, s, GetAnalyticsSession(uuid.Nil, "1.2.3.4", "Mozilla/5.1"))
+}
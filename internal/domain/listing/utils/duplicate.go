@@ -0,0 +1,230 @@
+package utils
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+)
+
+const (
+	// listings at least this similar are suspected duplicates
+	DUPLICATE_MIN_SCORE = 0.6
+	// properties closer than this, in km, are at the same location
+	DUPLICATE_MAX_DISTANCE = 0.05
+	// relative difference of area and price below which units and prices are the same
+	DUPLICATE_AREA_TOLERANCE  = 0.05
+	DUPLICATE_PRICE_TOLERANCE = 0.1
+	// number of words of the shingles of the descriptions
+	DUPLICATE_SHINGLE_SIZE = 3
+)
+
+// weights of the criteria in the similarity score, they sum up to 1
+var duplicateWeights = map[string]float64{
+	model.DUPLICATEREASON_LOCATION:    0.25,
+	model.DUPLICATEREASON_UNIT:        0.15,
+	model.DUPLICATEREASON_PRICE:       0.1,
+	model.DUPLICATEREASON_DESCRIPTION: 0.25,
+	model.DUPLICATEREASON_IMAGES:      0.25,
+}
+
+// ListingSimilarity scores how likely two listings advertise the same unit, between 0 and 1,
+// and returns the criteria on which they are similar
+func ListingSimilarity(a, b *model.ListingDuplicateFeatures) (float64, []string) {
+	// the units of a property are listed separately on purpose, only the listings sharing a unit are duplicates
+	if a.PropertyID == b.PropertyID && !shareUnit(a, b) {
+		return 0, nil
+	}
+	similarities := map[string]float64{
+		model.DUPLICATEREASON_LOCATION:    locationSimilarity(a, b),
+		model.DUPLICATEREASON_UNIT:        unitSimilarity(a, b),
+		model.DUPLICATEREASON_PRICE:       priceSimilarity(a.Price, b.Price),
+		model.DUPLICATEREASON_DESCRIPTION: Jaccard(Shingles(a.Description, DUPLICATE_SHINGLE_SIZE), Shingles(b.Description, DUPLICATE_SHINGLE_SIZE)),
+		model.DUPLICATEREASON_IMAGES:      imageSimilarity(a.ImageHashes, b.ImageHashes),
+	}
+	var (
+		score   float64
+		reasons []string
+	)
+	for _, reason := range []string{
+		model.DUPLICATEREASON_LOCATION,
+		model.DUPLICATEREASON_UNIT,
+		model.DUPLICATEREASON_PRICE,
+		model.DUPLICATEREASON_DESCRIPTION,
+		model.DUPLICATEREASON_IMAGES,
+	} {
+		score += duplicateWeights[reason] * similarities[reason]
+		if similarities[reason] >= 0.5 {
+			reasons = append(reasons, reason)
+		}
+	}
+	return score, reasons
+}
+
+// distanceKm is the haversine distance between two points
+func distanceKm(lat1, lng1, lat2, lng2 float64) float64 {
+	const earthRadius = 6371
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLng := (lng2 - lng1) * math.Pi / 180
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+// locationSimilarity compares the addresses of the properties, and their coordinates when both are known
+func locationSimilarity(a, b *model.ListingDuplicateFeatures) float64 {
+	if a.PropertyID == b.PropertyID || NormalizeText(a.FullAddress) == NormalizeText(b.FullAddress) {
+		return 1
+	}
+	if a.Lat != nil && a.Lng != nil && b.Lat != nil && b.Lng != nil {
+		d := distanceKm(*a.Lat, *a.Lng, *b.Lat, *b.Lng)
+		switch {
+		case d <= DUPLICATE_MAX_DISTANCE:
+			return 1
+		case d <= 4*DUPLICATE_MAX_DISTANCE:
+			return 0.5
+		}
+	}
+	return 0
+}
+
+func shareUnit(a, b *model.ListingDuplicateFeatures) bool {
+	for _, ua := range a.UnitIDs {
+		if slices.Contains(b.UnitIDs, ua) {
+			return true
+		}
+	}
+	return false
+}
+
+// unitSimilarity is 1 when the listings share a unit or have a unit of the same area and number of bedrooms,
+// 0.5 when only the area is the same
+func unitSimilarity(a, b *model.ListingDuplicateFeatures) float64 {
+	if shareUnit(a, b) {
+		return 1
+	}
+	var res float64
+	for i, areaA := range a.UnitAreas {
+		for j, areaB := range b.UnitAreas {
+			if !withinTolerance(float64(areaA), float64(areaB), DUPLICATE_AREA_TOLERANCE) {
+				continue
+			}
+			if i < len(a.UnitBedrooms) && j < len(b.UnitBedrooms) && a.UnitBedrooms[i] == b.UnitBedrooms[j] {
+				return 1
+			}
+			res = 0.5
+		}
+	}
+	return res
+}
+
+func priceSimilarity(a, b float32) float64 {
+	switch {
+	case withinTolerance(float64(a), float64(b), DUPLICATE_PRICE_TOLERANCE):
+		return 1
+	case withinTolerance(float64(a), float64(b), 2*DUPLICATE_PRICE_TOLERANCE):
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+func withinTolerance(a, b, tolerance float64) bool {
+	if a <= 0 || b <= 0 {
+		return false
+	}
+	return math.Abs(a-b) <= tolerance*math.Max(a, b)
+}
+
+// imageSimilarity is the share of the images of the listing with fewer images that are found in the other listing
+func imageSimilarity(a, b []uint64) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	var matches int
+	for _, ha := range a {
+		for _, hb := range b {
+			if HammingDistance(ha, hb) <= MODERATION_IMAGE_MAX_DISTANCE {
+				matches++
+				break
+			}
+		}
+	}
+	return float64(matches) / float64(len(a))
+}
+
+// Shingles returns the sequences of size consecutive words of the normalized text.
+// A text shorter than size words is a single shingle.
+func Shingles(text string, size int) map[string]struct{} {
+	words := strings.Fields(NormalizeText(text))
+	res := make(map[string]struct{})
+	if len(words) == 0 {
+		return res
+	}
+	if len(words) < size {
+		res[strings.Join(words, " ")] = struct{}{}
+		return res
+	}
+	for i := 0; i+size <= len(words); i++ {
+		res[strings.Join(words[i:i+size], " ")] = struct{}{}
+	}
+	return res
+}
+
+// Jaccard is the size of the intersection of the sets over the size of their union
+func Jaccard(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	var inter int
+	for s := range a {
+		if _, ok := b[s]; ok {
+			inter++
+		}
+	}
+	return float64(inter) / float64(len(a)+len(b)-inter)
+}
+
+// ClusterListings groups the listings connected by the given pairs into clusters,
+// each cluster and the list of clusters sorted for a stable output
+func ClusterListings(pairs [][2]uuid.UUID) [][]uuid.UUID {
+	parent := make(map[uuid.UUID]uuid.UUID)
+	var find func(id uuid.UUID) uuid.UUID
+	find = func(id uuid.UUID) uuid.UUID {
+		p, ok := parent[id]
+		if !ok {
+			parent[id] = id
+			return id
+		}
+		if p == id {
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+	for _, pair := range pairs {
+		ra, rb := find(pair[0]), find(pair[1])
+		if ra != rb {
+			parent[ra] = rb
+		}
+	}
+
+	groups := make(map[uuid.UUID][]uuid.UUID)
+	for id := range parent {
+		root := find(id)
+		groups[root] = append(groups[root], id)
+	}
+	res := make([][]uuid.UUID, 0, len(groups))
+	for _, group := range groups {
+		sort.Slice(group, func(i, j int) bool { return group[i].String() < group[j].String() })
+		res = append(res, group)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i][0].String() < res[j][0].String() })
+	return res
+}
@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func TestShinglesJaccard(t *testing.T) {
+	a := Shingles("Cho thuê căn hộ 2 phòng ngủ, full nội thất", 3)
+	b := Shingles("CHO THUÊ căn hộ 2 phòng ngủ - full nội thất!!!", 3)
+	require.Len(t, a, 8)
+	require.Equal(t, 1.0, Jaccard(a, b))
+	require.Zero(t, Jaccard(a, Shingles("Nhà nguyên căn mặt phố", 3)))
+	require.Zero(t, Jaccard(a, Shingles("", 3)))
+	require.Equal(t, map[string]struct{}{"phong tro": {}}, Shingles("Phòng trọ", 3))
+}
+
+func TestListingSimilarity(t *testing.T) {
+	unitId := uuid.New()
+	original := model.ListingDuplicateFeatures{
+		ID:           uuid.New(),
+		PropertyID:   uuid.New(),
+		Description:  "Cho thuê căn hộ 2 phòng ngủ tại chung cư Times City, full nội thất, view hồ",
+		Price:        12000000,
+		FullAddress:  "458 Minh Khai, Hai Bà Trưng, Hà Nội",
+		Lat:          types.Ptr(20.9955),
+		Lng:          types.Ptr(105.8683),
+		UnitIDs:      []uuid.UUID{unitId},
+		UnitAreas:    []float32{75},
+		UnitBedrooms: []int32{2},
+		ImageHashes:  []uint64{0xF0F0F0F0F0F0F0F0, 0x0123456789ABCDEF},
+	}
+
+	// the same unit posted again by an agent, with a new property, a new title and slightly edited images
+	repost := model.ListingDuplicateFeatures{
+		ID:           uuid.New(),
+		PropertyID:   uuid.New(),
+		Description:  "CHO THUÊ căn hộ 2 phòng ngủ tại chung cư Times City - full nội thất, view hồ. Liên hệ ngay",
+		Price:        11500000,
+		FullAddress:  "458 Minh Khai, Q. Hai Bà Trưng, Hà Nội",
+		Lat:          types.Ptr(20.9957),
+		Lng:          types.Ptr(105.8684),
+		UnitIDs:      []uuid.UUID{uuid.New()},
+		UnitAreas:    []float32{76},
+		UnitBedrooms: []int32{2},
+		ImageHashes:  []uint64{0xF0F0F0F0F0F0F0F1, 0x0123456789ABCDEF, 0xFFFF},
+	}
+	score, reasons := ListingSimilarity(&original, &repost)
+	require.GreaterOrEqual(t, score, DUPLICATE_MIN_SCORE)
+	require.Equal(t, []string{
+		model.DUPLICATEREASON_LOCATION,
+		model.DUPLICATEREASON_UNIT,
+		model.DUPLICATEREASON_PRICE,
+		model.DUPLICATEREASON_DESCRIPTION,
+		model.DUPLICATEREASON_IMAGES,
+	}, reasons)
+
+	// another unit of the same building
+	neighbor := repost
+	neighbor.Description = "Căn hộ 3 phòng ngủ rộng rãi, ban công hướng nam"
+	neighbor.Price = 18000000
+	neighbor.UnitAreas = []float32{110}
+	neighbor.UnitBedrooms = []int32{3}
+	neighbor.ImageHashes = []uint64{0xAAAAAAAAAAAAAAAA}
+	score, reasons = ListingSimilarity(&original, &neighbor)
+	require.Less(t, score, DUPLICATE_MIN_SCORE)
+	require.Equal(t, []string{model.DUPLICATEREASON_LOCATION}, reasons)
+
+	// the units of a property are listed separately
+	sibling := original
+	sibling.ID = uuid.New()
+	sibling.UnitIDs = []uuid.UUID{uuid.New()}
+	score, reasons = ListingSimilarity(&original, &sibling)
+	require.Zero(t, score)
+	require.Empty(t, reasons)
+}
+
+func TestClusterListings(t *testing.T) {
+	ids := make([]uuid.UUID, 5)
+	for i := range ids {
+		ids[i] = uuid.New()
+	}
+	clusters := ClusterListings([][2]uuid.UUID{
+		{ids[0], ids[1]},
+		{ids[2], ids[1]},
+		{ids[3], ids[4]},
+	})
+	require.Len(t, clusters, 2)
+	require.ElementsMatch(t, [][]uuid.UUID{
+		sortedIds(ids[0], ids[1], ids[2]),
+		sortedIds(ids[3], ids[4]),
+	}, clusters)
+	require.Empty(t, ClusterListings(nil))
+}
+
+func sortedIds(ids ...uuid.UUID) []uuid.UUID {
+	for i := range ids {
+		for j := i + 1; j < len(ids); j++ {
+			if ids[j].String() < ids[i].String() {
+				ids[i], ids[j] = ids[j], ids[i]
+			}
+		}
+	}
+	return ids
+}
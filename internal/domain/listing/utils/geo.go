@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/user2410/rrms-backend/internal/infrastructure/es"
+)
+
+var ErrInvalidPolygon = errors.New("polygon must have at least 3 vertices formatted as lat,lng;lat,lng;...")
+
+// ParseGeoPolygon parses the vertices of a polygon drawn on a map, given as "lat,lng;lat,lng;..."
+func ParseGeoPolygon(s string) ([]es.GeoPoint, error) {
+	var points []es.GeoPoint
+	for _, v := range strings.Split(strings.TrimSpace(s), ";") {
+		if v = strings.TrimSpace(v); len(v) == 0 {
+			continue
+		}
+		latLng := strings.Split(v, ",")
+		if len(latLng) != 2 {
+			return nil, ErrInvalidPolygon
+		}
+		lat, err := strconv.ParseFloat(strings.TrimSpace(latLng[0]), 64)
+		if err != nil || lat < -90 || lat > 90 {
+			return nil, ErrInvalidPolygon
+		}
+		lng, err := strconv.ParseFloat(strings.TrimSpace(latLng[1]), 64)
+		if err != nil || lng < -180 || lng > 180 {
+			return nil, ErrInvalidPolygon
+		}
+		points = append(points, es.GeoPoint{Lat: lat, Lon: lng})
+	}
+	// a closing vertex repeating the first one is optional
+	if len(points) > 1 && points[0] == points[len(points)-1] {
+		points = points[:len(points)-1]
+	}
+	if len(points) < 3 {
+		return nil, ErrInvalidPolygon
+	}
+	return points, nil
+}
+
+// GeoJSONPolygon returns the GeoJSON polygon of the vertices, with its ring closed and coordinates in [lng, lat] order
+func GeoJSONPolygon(points []es.GeoPoint) json.RawMessage {
+	ring := make([][2]float64, 0, len(points)+1)
+	for _, p := range points {
+		ring = append(ring, [2]float64{p.Lon, p.Lat})
+	}
+	if len(points) > 0 {
+		ring = append(ring, [2]float64{points[0].Lon, points[0].Lat})
+	}
+	data, _ := json.Marshal(map[string]any{
+		"type":        "Polygon",
+		"coordinates": [][][2]float64{ring},
+	})
+	return data
+}
+
+// GeoLocationLatLng reads the coordinates of a geo_point decoded from a search response in its object form
+func GeoLocationLatLng(loc any) (float64, float64) {
+	m, ok := loc.(map[string]any)
+	if !ok {
+		return 0, 0
+	}
+	lat, _ := m["lat"].(float64)
+	lng, _ := m["lon"].(float64)
+	return lat, lng
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/infrastructure/es"
+)
+
+func TestParseGeoPolygon(t *testing.T) {
+	points, err := ParseGeoPolygon("21.03,105.78; 21.03,105.85;20.98,105.85;21.03,105.78")
+	require.NoError(t, err)
+	require.Equal(t, []es.GeoPoint{
+		{Lat: 21.03, Lon: 105.78},
+		{Lat: 21.03, Lon: 105.85},
+		{Lat: 20.98, Lon: 105.85},
+	}, points)
+
+	for _, s := range []string{"", "21.03,105.78;21.03,105.85", "21.03;105.78;20.98", "91,105.78;21.03,105.85;20.98,105.85", "a,b;c,d;e,f"} {
+		_, err = ParseGeoPolygon(s)
+		require.ErrorIs(t, err, ErrInvalidPolygon, s)
+	}
+}
+
+func TestGeoJSONPolygon(t *testing.T) {
+	shape := GeoJSONPolygon([]es.GeoPoint{{Lat: 1, Lon: 2}, {Lat: 3, Lon: 4}, {Lat: 5, Lon: 6}})
+	require.JSONEq(t, `{"type":"Polygon","coordinates":[[[2,1],[4,3],[6,5],[2,1]]]}`, string(shape))
+}
+
+func TestGeoLocationLatLng(t *testing.T) {
+	lat, lng := GeoLocationLatLng(map[string]any{"lat": 21.03, "lon": 105.85})
+	require.Equal(t, 21.03, lat)
+	require.Equal(t, 105.85, lng)
+
+	lat, lng = GeoLocationLatLng("21.03,105.85")
+	require.Zero(t, lat)
+	require.Zero(t, lng)
+}
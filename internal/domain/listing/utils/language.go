@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LANGUAGE_DEFAULT is the language of the title and description of the listings table
+const LANGUAGE_DEFAULT = "vi"
+
+// LANGUAGES are the languages a listing can be written in, the default language first
+var LANGUAGES = []string{LANGUAGE_DEFAULT, "en", "zh", "ko", "ja"}
+
+// ParseAcceptLanguage returns the supported language preferred in the Accept-Language header,
+// the default language if the header names none of them
+func ParseAcceptLanguage(header string) string {
+	type tag struct {
+		lang string
+		q    float64
+	}
+	var tags []tag
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		// en-US is matched as en
+		lang := strings.ToLower(strings.SplitN(strings.TrimSpace(fields[0]), "-", 2)[0])
+		if lang == "" {
+			continue
+		}
+		q := 1.0
+		for _, f := range fields[1:] {
+			if v, ok := strings.CutPrefix(strings.TrimSpace(f), "q="); ok {
+				if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		if q > 0 {
+			tags = append(tags, tag{lang, q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+	for _, t := range tags {
+		if slices.Contains(LANGUAGES, t.lang) {
+			return t.lang
+		}
+	}
+	return LANGUAGE_DEFAULT
+}
+
+// MissingLanguages returns the supported languages a listing is not translated into
+func MissingLanguages(translated []string) []string {
+	res := []string{}
+	for _, l := range LANGUAGES[1:] {
+		if !slices.Contains(translated, l) {
+			res = append(res, l)
+		}
+	}
+	return res
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	require.Equal(t, LANGUAGE_DEFAULT, ParseAcceptLanguage(""))
+	require.Equal(t, LANGUAGE_DEFAULT, ParseAcceptLanguage("fr-FR, de;q=0.8"))
+	require.Equal(t, "en", ParseAcceptLanguage("en-US,en;q=0.9,vi;q=0.8"))
+	require.Equal(t, "vi", ParseAcceptLanguage("en;q=0.5, vi-VN"))
+	// unsupported languages are skipped for the next preferred one
+	require.Equal(t, "ko", ParseAcceptLanguage("fr;q=0.9, ko;q=0.7, en;q=0.3"))
+	// q=0 means not acceptable
+	require.Equal(t, LANGUAGE_DEFAULT, ParseAcceptLanguage("en;q=0"))
+	require.Equal(t, "ja", ParseAcceptLanguage("*, JA"))
+}
+
+func TestMissingLanguages(t *testing.T) {
+	require.Equal(t, []string{"en", "zh", "ko", "ja"}, MissingLanguages(nil))
+	require.Equal(t, []string{"zh", "ja"}, MissingLanguages([]string{"en", "ko"}))
+	require.Empty(t, MissingLanguages(LANGUAGES[1:]))
+}
@@ -0,0 +1,141 @@
+package utils
+
+import (
+	"image"
+	"math/bits"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// the district median is only meaningful with enough comparable listings
+	MODERATION_PRICE_MIN_SAMPLES = 5
+	// a price per m² this many times above or below the district median is an outlier
+	MODERATION_PRICE_OUTLIER_RATIO = 3.0
+	// maximum hamming distance between the hashes of two images considered the same
+	MODERATION_IMAGE_MAX_DISTANCE = 6
+)
+
+// phoneNumberRegexp matches Vietnamese phone numbers, including the ones written with spaces, dots or dashes between the digits
+var phoneNumberRegexp = regexp.MustCompile(`(?:\+84|\b84|\b0)[\s.\-]?(?:\d[\s.\-]?){8,9}\d\b`)
+
+// NormalizeText lowercases the text, strips its diacritics and collapses everything but letters and numbers into single spaces
+func NormalizeText(s string) string {
+	var sb strings.Builder
+	space := true
+	for _, r := range norm.NFD.String(strings.ToLower(s)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case r == 'đ':
+			sb.WriteRune('d')
+			space = false
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			sb.WriteRune(r)
+			space = false
+		case !space:
+			sb.WriteRune(' ')
+			space = true
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// FindBannedWords returns the banned words or phrases found in the text as whole words,
+// so that "cá độ" and "ca do" are both found but not "cado"
+func FindBannedWords(text string, words []string) []string {
+	normalized := " " + NormalizeText(text) + " "
+	var res []string
+	for _, w := range words {
+		nw := NormalizeText(w)
+		if nw != "" && strings.Contains(normalized, " "+nw+" ") {
+			res = append(res, w)
+		}
+	}
+	return res
+}
+
+func FindPhoneNumbers(text string) []string {
+	return phoneNumberRegexp.FindAllString(text, -1)
+}
+
+// IsPriceOutlier tells whether the price per m² of a listing is far from the median of its district
+func IsPriceOutlier(pricePerArea, median float64, samples int64) bool {
+	if samples < MODERATION_PRICE_MIN_SAMPLES || median <= 0 || pricePerArea <= 0 {
+		return false
+	}
+	ratio := pricePerArea / median
+	return ratio > MODERATION_PRICE_OUTLIER_RATIO || ratio < 1/MODERATION_PRICE_OUTLIER_RATIO
+}
+
+// IsBucketObjectUrl reports whether the url points to an object of the S3 bucket, in the virtual-hosted style
+// S3 uses for the uploaded media: https://<bucket>.s3.amazonaws.com/<key> or https://<bucket>.s3.<region>.amazonaws.com/<key>
+func IsBucketObjectUrl(rawUrl string, bucket string) bool {
+	if bucket == "" {
+		return false
+	}
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Scheme != "https" || u.User != nil || u.Port() != "" || len(u.Path) <= 1 {
+		return false
+	}
+	rest, ok := strings.CutPrefix(u.Hostname(), bucket+".s3.")
+	if !ok {
+		return false
+	}
+	if rest == "amazonaws.com" {
+		return true
+	}
+	region, ok := strings.CutSuffix(rest, ".amazonaws.com")
+	return ok && region != "" && !strings.Contains(region, ".")
+}
+
+// DHash computes the 64-bit difference hash of the image: the image is shrunk to 9x8 gray cells
+// and each bit tells whether a cell is brighter than its right neighbor.
+// Resized, recompressed or slightly edited copies of an image have close hashes.
+func DHash(img image.Image) uint64 {
+	const w, h = 9, 8
+	b := img.Bounds()
+	if b.Dx() == 0 || b.Dy() == 0 {
+		return 0
+	}
+	var cells [h][w]float64
+	for y := 0; y < h; y++ {
+		y0, y1 := b.Min.Y+y*b.Dy()/h, b.Min.Y+(y+1)*b.Dy()/h
+		if y1 == y0 {
+			y1++
+		}
+		for x := 0; x < w; x++ {
+			x0, x1 := b.Min.X+x*b.Dx()/w, b.Min.X+(x+1)*b.Dx()/w
+			if x1 == x0 {
+				x1++
+			}
+			var sum float64
+			for py := y0; py < y1 && py < b.Max.Y; py++ {
+				for px := x0; px < x1 && px < b.Max.X; px++ {
+					r, g, bl, _ := img.At(px, py).RGBA()
+					sum += 0.299*float64(r) + 0.587*float64(g) + 0.114*float64(bl)
+				}
+			}
+			cells[y][x] = sum / float64((y1-y0)*(x1-x0))
+		}
+	}
+
+	var hash uint64
+	for y := 0; y < h; y++ {
+		for x := 0; x < w-1; x++ {
+			hash <<= 1
+			if cells[y][x] > cells[y][x+1] {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
+
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeText(t *testing.T) {
+	require.Equal(t, "can ho dep quan 1", NormalizeText("  Căn hộ ĐẸP, quận 1!"))
+}
+
+func TestFindBannedWords(t *testing.T) {
+	words := []string{"cá độ", "lừa đảo", "chuyển khoản trước"}
+	require.Equal(t, []string{"cá độ", "chuyển khoản trước"}, FindBannedWords("Nhận CA DO bóng đá. Vui lòng chuyển-khoản trước khi xem nhà", words))
+	require.Empty(t, FindBannedWords("Căn hộ cao độ 30m, cado", words))
+}
+
+func TestFindPhoneNumbers(t *testing.T) {
+	require.Equal(t,
+		[]string{"0912345678", "+84 912 345 678", "0912.345.678", "024 3825 1234"},
+		FindPhoneNumbers("Gọi 0912345678 hoặc +84 912 345 678, zalo 0912.345.678, bàn 024 3825 1234"),
+	)
+	require.Empty(t, FindPhoneNumbers("Diện tích 45m2, giá 5000000 đồng, ngày 01.01.2024"))
+}
+
+func TestIsPriceOutlier(t *testing.T) {
+	require.False(t, IsPriceOutlier(100000, 100000, 10))
+	require.True(t, IsPriceOutlier(400000, 100000, 10))
+	require.True(t, IsPriceOutlier(20000, 100000, 10))
+	// not enough listings in the district to compare with
+	require.False(t, IsPriceOutlier(400000, 100000, MODERATION_PRICE_MIN_SAMPLES-1))
+}
+
+// waveImage draws the same picture whatever its size
+func waveImage(w, h int, shift float64) image.Image {
+	img := image.NewGray(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			v := 100 + 80*math.Sin(3*math.Pi*float64(x)/float64(w))*math.Cos(2*math.Pi*float64(y)/float64(h)) + shift
+			img.SetGray(x, y, color.Gray{Y: uint8(v)})
+		}
+	}
+	return img
+}
+
+func TestIsBucketObjectUrl(t *testing.T) {
+	require.True(t, IsBucketObjectUrl("https://rrms-image.s3.amazonaws.com/property/a.jpg", "rrms-image"))
+	require.True(t, IsBucketObjectUrl("https://rrms-image.s3.ap-southeast-1.amazonaws.com/property/a.jpg", "rrms-image"))
+
+	for _, u := range []string{
+		"http://rrms-image.s3.amazonaws.com/property/a.jpg",
+		"https://rrms-image.s3.amazonaws.com/",
+		"https://other.s3.amazonaws.com/property/a.jpg",
+		"https://rrms-image.s3.amazonaws.com.evil.com/property/a.jpg",
+		"https://rrms-image.s3.evil.com/property/a.jpg",
+		"https://rrms-image.s3.a.b.amazonaws.com/property/a.jpg",
+		"https://rrms-image.s3.amazonaws.com:8080/property/a.jpg",
+		"https://user@rrms-image.s3.amazonaws.com/property/a.jpg",
+		"https://169.254.169.254/latest/meta-data",
+		"not a url",
+	} {
+		require.False(t, IsBucketObjectUrl(u, "rrms-image"), u)
+	}
+	require.False(t, IsBucketObjectUrl("https://.s3.amazonaws.com/property/a.jpg", ""))
+}
+
+func TestDHash(t *testing.T) {
+	a := DHash(waveImage(640, 480, 0))
+	// the same picture, smaller and brighter
+	b := DHash(waveImage(320, 240, 20))
+	require.LessOrEqual(t, HammingDistance(a, b), MODERATION_IMAGE_MAX_DISTANCE)
+
+	// a different picture
+	img := image.NewGray(image.Rect(0, 0, 640, 480))
+	for y := 0; y < 480; y++ {
+		for x := 0; x < 640; x++ {
+			img.SetGray(x, y, color.Gray{Y: uint8(255 - (x*x+y)%256)})
+		}
+	}
+	require.Greater(t, HammingDistance(a, DHash(img)), MODERATION_IMAGE_MAX_DISTANCE)
+}
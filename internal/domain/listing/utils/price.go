@@ -0,0 +1,32 @@
+package utils
+
+import "github.com/user2410/rrms-backend/internal/domain/listing/model"
+
+const (
+	// an estimate is only meaningful with enough comparable units
+	RENT_ESTIMATE_MIN_SAMPLES = 5
+	// comparable units have an area within this fraction of the area of the unit
+	RENT_ESTIMATE_AREA_TOLERANCE = 0.2
+	// rentals started within this many days are comparable
+	RENT_ESTIMATE_RENTED_WITHIN_DAYS = 180
+	// a price this many times above the 75th percentile, or below the 25th, is far outside the range
+	RENT_ESTIMATE_OUTLIER_RATIO = 1.5
+)
+
+// IsOutsideRentEstimate tells whether the price is far outside the suggested range of the estimate
+func IsOutsideRentEstimate(price float64, e *model.RentEstimateModel) bool {
+	if e.Samples < RENT_ESTIMATE_MIN_SAMPLES || price <= 0 || e.P25 <= 0 {
+		return false
+	}
+	return price > e.P75*RENT_ESTIMATE_OUTLIER_RATIO || price < e.P25/RENT_ESTIMATE_OUTLIER_RATIO
+}
+
+// HasRentEstimate tells whether the rent of any of the units could be estimated from enough comparable units
+func HasRentEstimate(estimates []model.ListingUnitPriceEstimate) bool {
+	for i := range estimates {
+		if estimates[i].Estimate.Samples >= RENT_ESTIMATE_MIN_SAMPLES {
+			return true
+		}
+	}
+	return false
+}
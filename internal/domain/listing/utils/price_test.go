@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/listing/model"
+)
+
+func TestIsOutsideRentEstimate(t *testing.T) {
+	e := model.RentEstimateModel{
+		Samples: 20,
+		P10:     3000000,
+		P25:     4000000,
+		Median:  5000000,
+		P75:     6000000,
+		P90:     7000000,
+	}
+	require.False(t, IsOutsideRentEstimate(5000000, &e))
+	// outside the range but not far from it
+	require.False(t, IsOutsideRentEstimate(8000000, &e))
+	require.False(t, IsOutsideRentEstimate(3000000, &e))
+	require.True(t, IsOutsideRentEstimate(9500000, &e))
+	require.True(t, IsOutsideRentEstimate(2000000, &e))
+
+	// not enough comparable units
+	e.Samples = RENT_ESTIMATE_MIN_SAMPLES - 1
+	require.False(t, IsOutsideRentEstimate(9500000, &e))
+}
+
+func TestHasRentEstimate(t *testing.T) {
+	require.False(t, HasRentEstimate(nil))
+	estimates := []model.ListingUnitPriceEstimate{
+		{Estimate: model.RentEstimateModel{Samples: RENT_ESTIMATE_MIN_SAMPLES - 1}},
+	}
+	require.False(t, HasRentEstimate(estimates))
+	estimates = append(estimates, model.ListingUnitPriceEstimate{Estimate: model.RentEstimateModel{Samples: RENT_ESTIMATE_MIN_SAMPLES}})
+	require.True(t, HasRentEstimate(estimates))
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	payment_model "github.com/user2410/rrms-backend/internal/domain/payment/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// PendingExtendPayment picks among the extension payments of a listing the one to charge or to send a link for.
+// Only a pending payment never sent to VNPay is picked: a failed payment is never retried,
+// and a pending payment with an order id is being charged or its charge could not be confirmed.
+// unconfirmed is true in the latter case, the listing must not be charged again until the payment is settled.
+func PendingExtendPayment(payments []payment_model.PaymentModel) (payment *payment_model.PaymentModel, unconfirmed bool) {
+	for i := range payments {
+		if payments[i].Status != database.PAYMENTSTATUSPENDING {
+			continue
+		}
+		if payments[i].OrderID != "" {
+			return nil, true
+		}
+		if payment == nil {
+			payment = &payments[i]
+		}
+	}
+	return payment, false
+}
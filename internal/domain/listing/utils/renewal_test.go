@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	payment_model "github.com/user2410/rrms-backend/internal/domain/payment/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+func TestPendingExtendPayment(t *testing.T) {
+	failed := payment_model.PaymentModel{ID: 1, OrderID: "01100000", Status: database.PAYMENTSTATUSFAILED}
+	paid := payment_model.PaymentModel{ID: 2, OrderID: "02100000", Status: database.PAYMENTSTATUSSUCCESS}
+	pending := payment_model.PaymentModel{ID: 3, Status: database.PAYMENTSTATUSPENDING}
+	charging := payment_model.PaymentModel{ID: 4, OrderID: "04100000", Status: database.PAYMENTSTATUSPENDING}
+
+	p, unconfirmed := PendingExtendPayment(nil)
+	require.Nil(t, p)
+	require.False(t, unconfirmed)
+
+	// failed and paid payments are never reused
+	p, unconfirmed = PendingExtendPayment([]payment_model.PaymentModel{failed, paid})
+	require.Nil(t, p)
+	require.False(t, unconfirmed)
+
+	p, unconfirmed = PendingExtendPayment([]payment_model.PaymentModel{failed, pending, paid})
+	require.NotNil(t, p)
+	require.Equal(t, pending.ID, p.ID)
+	require.False(t, unconfirmed)
+
+	// a charge sent to VNPay blocks any other payment of the extension
+	p, unconfirmed = PendingExtendPayment([]payment_model.PaymentModel{pending, charging})
+	require.Nil(t, p)
+	require.True(t, unconfirmed)
+}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/user2410/rrms-backend/internal/utils"
+)
+
+// synonymGroups maps every single-word term of the synonym rules of the listings index, like "cc" or "q1",
+// to all the terms of its rule. The Postgres searcher uses it to expand queries the way the vi_synonyms filter does.
+var synonymGroups = map[string][]string{}
+
+func init() {
+	// the synonyms are defined once, in the mapping of the listings index
+	basepath := utils.GetBasePath()
+	data, err := os.ReadFile(fmt.Sprintf("%s/internal/infrastructure/es/mappings/listings.json", basepath))
+	if err != nil {
+		panic(err)
+	}
+
+	var mapping struct {
+		Settings struct {
+			Analysis struct {
+				Filter struct {
+					Synonyms struct {
+						Synonyms []string `json:"synonyms"`
+					} `json:"vi_synonyms"`
+				} `json:"filter"`
+			} `json:"analysis"`
+		} `json:"settings"`
+	}
+	if err = json.Unmarshal(data, &mapping); err != nil {
+		panic(err)
+	}
+
+	for _, rule := range mapping.Settings.Analysis.Filter.Synonyms.Synonyms {
+		// only equivalent synonyms are expanded, explicit mappings "a => b" are left to Elasticsearch
+		if strings.Contains(rule, "=>") {
+			continue
+		}
+		var terms []string
+		for _, term := range strings.Split(rule, ",") {
+			if term = strings.ToLower(strings.TrimSpace(term)); term != "" {
+				terms = append(terms, term)
+			}
+		}
+		for _, term := range terms {
+			if !strings.Contains(term, " ") {
+				synonymGroups[term] = terms
+			}
+		}
+	}
+}
+
+func splitWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// PgTsQuery builds the to_tsquery input matching all the words of the text, each word or any of its synonyms.
+// With prefix, the last word also matches the words it is a prefix of, for queries typed so far.
+func PgTsQuery(text string, prefix bool) string {
+	words := splitWords(text)
+	terms := make([]string, 0, len(words))
+	for i, word := range words {
+		term := word
+		if prefix && i == len(words)-1 {
+			term += ":*"
+		}
+		if group, ok := synonymGroups[word]; ok {
+			alternatives := []string{term}
+			for _, synonym := range group {
+				if synonym != word {
+					alternatives = append(alternatives, strings.Join(strings.Fields(synonym), " <-> "))
+				}
+			}
+			term = "(" + strings.Join(alternatives, " | ") + ")"
+		}
+		terms = append(terms, term)
+	}
+	return strings.Join(terms, " & ")
+}
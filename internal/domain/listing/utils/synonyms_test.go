@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgTsQuery(t *testing.T) {
+	require.Contains(t, synonymGroups, "cc")
+	require.Contains(t, synonymGroups, "q1")
+
+	require.Equal(t, "căn & hộ", PgTsQuery("Căn-hộ!", false))
+	require.Equal(t, "(cc | chung <-> cư) & (q1 | quận <-> 1)", PgTsQuery("CC, Q1", false))
+	require.Equal(t, "chung & cu:*", PgTsQuery("chung cu", true))
+	require.Equal(t, "", PgTsQuery(" ,. ", false))
+}
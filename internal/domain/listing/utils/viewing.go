@@ -0,0 +1,13 @@
+package utils
+
+import "time"
+
+// ViewingSlotOccurrences returns the start and end of the slot and of its repetitions on the same day and time
+// of the following weeks, in the location of start so that a change of daylight saving time keeps the local time
+func ViewingSlotOccurrences(start, end time.Time, repeatWeeks int) [][2]time.Time {
+	res := make([][2]time.Time, 0, repeatWeeks+1)
+	for w := 0; w <= repeatWeeks; w++ {
+		res = append(res, [2]time.Time{start.AddDate(0, 0, 7*w), end.AddDate(0, 0, 7*w)})
+	}
+	return res
+}
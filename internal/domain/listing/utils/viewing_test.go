@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewingSlotOccurrences(t *testing.T) {
+	start := time.Date(2024, 3, 28, 18, 0, 0, 0, time.UTC)
+	end := start.Add(90 * time.Minute)
+
+	res := ViewingSlotOccurrences(start, end, 0)
+	require.Len(t, res, 1)
+	require.Equal(t, [2]time.Time{start, end}, res[0])
+
+	res = ViewingSlotOccurrences(start, end, 3)
+	require.Len(t, res, 4)
+	require.Equal(t, time.Date(2024, 4, 4, 18, 0, 0, 0, time.UTC), res[1][0])
+	require.Equal(t, time.Date(2024, 4, 18, 19, 30, 0, 0, time.UTC), res[3][1])
+}
+
+func TestViewingSlotOccurrencesKeepLocalTime(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+	// daylight saving time starts on 31/03/2024 in Paris
+	start := time.Date(2024, 3, 28, 18, 0, 0, 0, loc)
+	res := ViewingSlotOccurrences(start, start.Add(time.Hour), 1)
+	require.Equal(t, 18, res[1][0].Hour())
+	require.Equal(t, time.Hour, res[1][1].Sub(res[1][0]))
+}
@@ -97,8 +97,10 @@ func (s *service) GetNotificationsOfUser(userId uuid.UUID, query dto.GetNotifica
 type NOTIFICATIONTYPE = string
 
 const (
-	NOTIFICATIONTYPE_CREATEAPPLICATION NOTIFICATIONTYPE = "CREATE_APPLICATION"
-	NOTIFICATIONTYPE_UPDATEAPPLICATION NOTIFICATIONTYPE = "UPDATE_APPLICATION"
+	NOTIFICATIONTYPE_CREATEAPPLICATION    NOTIFICATIONTYPE = "CREATE_APPLICATION"
+	NOTIFICATIONTYPE_UPDATEAPPLICATION    NOTIFICATIONTYPE = "UPDATE_APPLICATION"
+	NOTIFICATIONTYPE_APPLICATIONDOCUMENTS NOTIFICATIONTYPE = "APPLICATION_DOCUMENTS"
+	NOTIFICATIONTYPE_APPLICATIONREFERENCE NOTIFICATIONTYPE = "APPLICATION_REFERENCE"
 
 	NOTIFICATIONTYPE_CREATEPRERENTAL NOTIFICATIONTYPE = "CREATE_PRERENTAL"
 	NOTIFICATIONTYPE_UPDATEPRERENTAL NOTIFICATIONTYPE = "UPDATE_PRERENTAL"
@@ -109,12 +111,22 @@ const (
 	NOTIFICATIONTYPE_CREATECONTRACT NOTIFICATIONTYPE = "CREATE_CONTRACT"
 	NOTIFICATIONTYPE_UPDATECONTRACT NOTIFICATIONTYPE = "UPDATE_CONTRACT"
 
+	NOTIFICATIONTYPE_RENTALEXPIRY NOTIFICATIONTYPE = "RENTAL_EXPIRY"
+
 	NOTIFICATIONTYPE_CREATERENTALCOMPLAINT       NOTIFICATIONTYPE = "CREATE_RENTALCOMPLAINT"
 	NOTIFICATIONTYPE_UPDATERENTALCOMPLAINTSTATUS NOTIFICATIONTYPE = "UPDATE_RENTALCOMPLAINTSTATUS"
 	NOTIFICATIONTYPE_CREATERENTALCOMPLAINTREPLY  NOTIFICATIONTYPE = "CREATE_RENTALCOMPLAINTREPLY"
 
 	NOTIFICATIONTYPE_CREATEPROPERTYVERIFICATIONSTATUS NOTIFICATIONTYPE = "CREATE_PROPERTYVERIFICATIONSTATUS"
 	NOTIFICATIONTYPE_UPDATEPROPERTYVERIFICATIONSTATUS NOTIFICATIONTYPE = "UPDATE_PROPERTYVERIFICATIONSTATUS"
+
+	NOTIFICATIONTYPE_SCHEDULEDREPORT NOTIFICATIONTYPE = "SCHEDULED_REPORT"
+
+	NOTIFICATIONTYPE_SAVEDSEARCHMATCH NOTIFICATIONTYPE = "SAVED_SEARCH_MATCH"
+
+	NOTIFICATIONTYPE_LISTINGMODERATION NOTIFICATIONTYPE = "LISTING_MODERATION"
+	NOTIFICATIONTYPE_LISTINGEXPIRY     NOTIFICATIONTYPE = "LISTING_EXPIRY"
+	NOTIFICATIONTYPE_LISTINGVIEWING    NOTIFICATIONTYPE = "LISTING_VIEWING"
 )
 
 func (s *service) SendNotification(payload *dto.CreateNotification) error {
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/user2410/rrms-backend/internal/infrastructure/database"
 )
@@ -32,4 +34,20 @@ type UpdatePayment struct {
 	OrderInfo *string                 `json:"orderInfo" validate:"omitempty"`
 	Amount    *float32                `json:"amount" validate:"omitempty,gte=0"`
 	Status    *database.PAYMENTSTATUS `json:"status" validate:"omitempty"`
+	// creation date of the VNPay transaction, set along with the order id
+	TransactionDate *time.Time `json:"transactionDate" validate:"omitempty"`
+}
+
+type CreatePaymentRefund struct {
+	PaymentID int64   `json:"paymentId" validate:"required"`
+	Amount    float32 `json:"amount" validate:"required,gt=0"`
+	Reason    string  `json:"reason" validate:"required"`
+	CreatedBy string  `json:"createdBy" validate:"required"`
+}
+
+type SavePaymentToken struct {
+	UserID     uuid.UUID `json:"userId" validate:"required"`
+	Token      string    `json:"token" validate:"required"`
+	CardNumber *string   `json:"cardNumber" validate:"omitempty"`
+	BankCode   *string   `json:"bankCode" validate:"omitempty"`
 }
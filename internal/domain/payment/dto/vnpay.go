@@ -32,3 +32,14 @@ type VNPRefund struct {
 	TransType string `json:"transType" validate:"required"`
 	User      string `json:"user" validate:"required"`
 }
+
+type VNPCreateTokenUrl struct {
+	Language  *string `json:"language" validate:"omitempty"`
+	ReturnUrl string  `json:"returnUrl" validate:"required"`
+	CancelUrl *string `json:"cancelUrl" validate:"omitempty"`
+}
+
+type VNPTokenReturnQuery struct {
+	VnpAppUserId  string `query:"vnp_app_user_id" validate:"required"`
+	VnpSecureHash string `query:"vnp_secure_hash" validate:"required"`
+}
@@ -27,6 +27,8 @@ func (a *adapter) RegisterServer(route *fiber.Router, tokenMaker token.Maker) {
 	// paymentRoute.Use(auth_http.AuthorizedMiddleware(tokenMaker))
 	paymentRoute.Get("/my-payments", auth_http.AuthorizedMiddleware(tokenMaker), a.getMyPayments())
 	paymentRoute.Get("/payment/:id", auth_http.AuthorizedMiddleware(tokenMaker), a.getPaymentById())
+	paymentRoute.Get("/my-token", auth_http.AuthorizedMiddleware(tokenMaker), a.getMyPaymentToken())
+	paymentRoute.Delete("/my-token", auth_http.AuthorizedMiddleware(tokenMaker), a.deleteMyPaymentToken())
 
 	_, ok := a.paymentService.(*vnpay.VnPayService)
 	if ok {
@@ -36,6 +38,8 @@ func (a *adapter) RegisterServer(route *fiber.Router, tokenMaker token.Maker) {
 		vnpayRoute.Get("/vnpay_ipn", a.vnpIpn())
 		vnpayRoute.Post("/querydr", a.vnpQuerydr())
 		vnpayRoute.Post("/refund", a.vnpRefund())
+		vnpayRoute.Post("/create_token_url", auth_http.AuthorizedMiddleware(tokenMaker), a.vnpCreateTokenUrl())
+		vnpayRoute.Get("/token_return", a.vnpTokenReturn())
 	}
 
 }
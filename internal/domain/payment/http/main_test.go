@@ -9,6 +9,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
+	"github.com/robfig/cron/v3"
 	"github.com/spf13/viper"
 	repos "github.com/user2410/rrms-backend/internal/domain/_repos"
 	listing_service "github.com/user2410/rrms-backend/internal/domain/listing/service"
@@ -25,6 +26,7 @@ type ServerConfig struct {
 	VnpUrl        string `mapstructure:"VNP_URL" validate:"required"`
 	VnpApi        string `mapstructure:"VNP_API" validate:"required"`
 	VnpReturnUrl  string `mapstructure:"VNP_RETURNURL" validate:"required"`
+	VnpTokenUrl   string `mapstructure:"VNP_TOKENURL" validate:"omitempty"`
 }
 
 var (
@@ -39,8 +41,8 @@ type server struct {
 func newTestServer(t *testing.T, ctrl *gomock.Controller) *server {
 
 	domainRepo := repos.NewDomainRepoFromMockCtrl(ctrl)
-	listingService := listing_service.NewService(domainRepo, "", nil)
-	vnpService := vnpay.NewVnpayService(domainRepo, listingService, conf.VnpTmnCode, conf.VnpHashSecret, conf.VnpUrl, conf.VnpApi)
+	listingService := listing_service.NewService(domainRepo, "", nil, nil, nil, nil, cron.New(), "", "")
+	vnpService := vnpay.NewVnpayService(domainRepo, listingService, conf.VnpTmnCode, conf.VnpHashSecret, conf.VnpUrl, conf.VnpApi, conf.VnpTokenUrl)
 
 	httpServer := http.NewServer(
 		fiber.Config{
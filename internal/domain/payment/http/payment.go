@@ -69,3 +69,32 @@ func (a *adapter) getPaymentById() fiber.Handler {
 		return c.Status(fiber.StatusOK).JSON(payment)
 	}
 }
+
+// getMyPaymentToken returns the card saved for the automatic renewals of the listings of the user
+func (a *adapter) getMyPaymentToken() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		tkPayload := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload)
+
+		res, err := a.paymentService.GetPaymentToken(tkPayload.UserID)
+		if err != nil {
+			if errors.Is(err, database.ErrRecordNotFound) {
+				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{"message": "No saved card"})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func (a *adapter) deleteMyPaymentToken() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		tkPayload := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload)
+
+		if err := a.paymentService.DeletePaymentToken(tkPayload.UserID); err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+}
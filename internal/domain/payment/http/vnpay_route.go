@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log"
 	"maps"
 	"strconv"
 
@@ -166,7 +167,73 @@ func (a *adapter) vnpRefund() fiber.Handler {
 		if err != nil {
 			return ctx.SendStatus(fiber.StatusInternalServerError)
 		}
+		if res.StatusCode == fiber.StatusOK {
+			if err := paymentService.SaveRefund(payload, body); err != nil {
+				log.Println("failed to save refund of order", payload.OrderId, err)
+			}
+		}
 
 		return ctx.Status(res.StatusCode).Type(res.Header.Get("Content-Type")).Send(body)
 	}
 }
+
+// Tạo URL lưu thẻ
+// Người dùng nhập thẻ tại Cổng thanh toán VNPAY, thẻ được dùng để gia hạn tự động tin đăng
+func (a *adapter) vnpCreateTokenUrl() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		paymentService, ok := a.paymentService.(*vnpay.VnPayService)
+		if !ok {
+			return ctx.Status(fiber.StatusMethodNotAllowed).JSON(fiber.Map{"message": "Method not allowed"})
+		}
+
+		payload := new(dto.VNPCreateTokenUrl)
+		if err := ctx.BodyParser(payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		tkPayload := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload)
+		url, err := paymentService.CreateTokenUrl(ctx.IP(), tkPayload.UserID, payload)
+		if err != nil {
+			if errors.Is(err, vnpay.ErrTokenUnavailable) {
+				return ctx.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"message": err.Error()})
+			}
+			return ctx.SendStatus(fiber.StatusInternalServerError)
+		}
+
+		return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{"url": url})
+	}
+}
+
+// URL VNPAY gọi về sau khi lưu thẻ
+func (a *adapter) vnpTokenReturn() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		paymentService, ok := a.paymentService.(*vnpay.VnPayService)
+		if !ok {
+			return ctx.Status(fiber.StatusMethodNotAllowed).SendString("Method not allowed")
+		}
+
+		payload := new(dto.VNPTokenReturnQuery)
+		if err := ctx.QueryParser(payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).SendString(validation.GetValidationError(errs))
+		}
+
+		_, err := paymentService.TokenReturn(maps.Clone(ctx.Queries()))
+		if err != nil {
+			if errors.Is(err, vnpay.ErrInvalidHash) {
+				return ctx.Status(fiber.StatusBadGateway).SendString(fmt.Sprintf("Lưu thẻ thất bại: mã lỗi 97, %s", err.Error()))
+			}
+			if errors.Is(err, vnpay.ErrBadStatusPayment) {
+				return ctx.Status(fiber.StatusBadRequest).SendString(fmt.Sprintf("Lưu thẻ thất bại: %s", err.Error()))
+			}
+			return ctx.SendStatus(fiber.StatusInternalServerError)
+		}
+
+		return ctx.Status(fiber.StatusOK).SendString("Lưu thẻ thành công, hãy đóng tab này để tiếp tục")
+	}
+}
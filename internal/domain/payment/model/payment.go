@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
 )
 
 type PaymentItemModel struct {
@@ -24,12 +25,14 @@ type PaymentModel struct {
 	Status    database.PAYMENTSTATUS `json:"status"`
 	CreatedAt time.Time              `json:"createdAt"`
 	UpdatedAt time.Time              `json:"updatedAt"`
+	// creation date of the VNPay transaction, required to refund it
+	TransactionDate *time.Time `json:"transactionDate"`
 
 	Items []PaymentItemModel `json:"items"`
 }
 
 func ToPaymentModel(p *database.Payment) *PaymentModel {
-	return &PaymentModel{
+	res := &PaymentModel{
 		ID:        p.ID,
 		UserID:    p.UserID,
 		OrderID:   p.OrderID,
@@ -40,4 +43,36 @@ func ToPaymentModel(p *database.Payment) *PaymentModel {
 		UpdatedAt: p.UpdatedAt,
 		Items:     []PaymentItemModel{},
 	}
+	if p.TransactionDate.Valid {
+		res.TransactionDate = &p.TransactionDate.Time
+	}
+	return res
+}
+
+type PaymentRefundModel struct {
+	ID        int64     `json:"id"`
+	PaymentID int64     `json:"paymentId"`
+	Amount    float32   `json:"amount"`
+	Reason    string    `json:"reason"`
+	CreatedBy string    `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// PaymentTokenModel is the card of a user saved by the payment gateway, the token itself is never sent to the user
+type PaymentTokenModel struct {
+	UserID     uuid.UUID `json:"userId"`
+	Token      string    `json:"-"`
+	CardNumber *string   `json:"cardNumber"`
+	BankCode   *string   `json:"bankCode"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func ToPaymentTokenModel(t *database.PaymentToken) *PaymentTokenModel {
+	return &PaymentTokenModel{
+		UserID:     t.UserID,
+		Token:      t.Token,
+		CardNumber: types.PNStr(t.CardNumber),
+		BankCode:   types.PNStr(t.BankCode),
+		CreatedAt:  t.CreatedAt,
+	}
 }
@@ -72,6 +72,35 @@ func (mr *MockRepoMockRecorder) CreatePayment(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockRepo)(nil).CreatePayment), arg0, arg1)
 }
 
+// CreatePaymentRefund mocks base method.
+func (m *MockRepo) CreatePaymentRefund(arg0 context.Context, arg1 *dto.CreatePaymentRefund) (*model.PaymentRefundModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRefund", arg0, arg1)
+	ret0, _ := ret[0].(*model.PaymentRefundModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRefund indicates an expected call of CreatePaymentRefund.
+func (mr *MockRepoMockRecorder) CreatePaymentRefund(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRefund", reflect.TypeOf((*MockRepo)(nil).CreatePaymentRefund), arg0, arg1)
+}
+
+// DeletePaymentToken mocks base method.
+func (m *MockRepo) DeletePaymentToken(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePaymentToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePaymentToken indicates an expected call of DeletePaymentToken.
+func (mr *MockRepoMockRecorder) DeletePaymentToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePaymentToken", reflect.TypeOf((*MockRepo)(nil).DeletePaymentToken), arg0, arg1)
+}
+
 // GetPaymentById mocks base method.
 func (m *MockRepo) GetPaymentById(arg0 context.Context, arg1 int64) (*model.PaymentModel, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +116,51 @@ func (mr *MockRepoMockRecorder) GetPaymentById(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentById", reflect.TypeOf((*MockRepo)(nil).GetPaymentById), arg0, arg1)
 }
 
+// GetPaymentByOrderId mocks base method.
+func (m *MockRepo) GetPaymentByOrderId(arg0 context.Context, arg1 string) (*model.PaymentModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentByOrderId", arg0, arg1)
+	ret0, _ := ret[0].(*model.PaymentModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentByOrderId indicates an expected call of GetPaymentByOrderId.
+func (mr *MockRepoMockRecorder) GetPaymentByOrderId(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentByOrderId", reflect.TypeOf((*MockRepo)(nil).GetPaymentByOrderId), arg0, arg1)
+}
+
+// GetPaymentRefunds mocks base method.
+func (m *MockRepo) GetPaymentRefunds(arg0 context.Context, arg1 int64) ([]model.PaymentRefundModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentRefunds", arg0, arg1)
+	ret0, _ := ret[0].([]model.PaymentRefundModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentRefunds indicates an expected call of GetPaymentRefunds.
+func (mr *MockRepoMockRecorder) GetPaymentRefunds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentRefunds", reflect.TypeOf((*MockRepo)(nil).GetPaymentRefunds), arg0, arg1)
+}
+
+// GetPaymentToken mocks base method.
+func (m *MockRepo) GetPaymentToken(arg0 context.Context, arg1 uuid.UUID) (*model.PaymentTokenModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentToken", arg0, arg1)
+	ret0, _ := ret[0].(*model.PaymentTokenModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentToken indicates an expected call of GetPaymentToken.
+func (mr *MockRepoMockRecorder) GetPaymentToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentToken", reflect.TypeOf((*MockRepo)(nil).GetPaymentToken), arg0, arg1)
+}
+
 // GetPaymentsOfUser mocks base method.
 func (m *MockRepo) GetPaymentsOfUser(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int32) ([]model.PaymentModel, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +176,21 @@ func (mr *MockRepoMockRecorder) GetPaymentsOfUser(arg0, arg1, arg2, arg3 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentsOfUser", reflect.TypeOf((*MockRepo)(nil).GetPaymentsOfUser), arg0, arg1, arg2, arg3)
 }
 
+// SavePaymentToken mocks base method.
+func (m *MockRepo) SavePaymentToken(arg0 context.Context, arg1 *dto.SavePaymentToken) (*model.PaymentTokenModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePaymentToken", arg0, arg1)
+	ret0, _ := ret[0].(*model.PaymentTokenModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SavePaymentToken indicates an expected call of SavePaymentToken.
+func (mr *MockRepoMockRecorder) SavePaymentToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePaymentToken", reflect.TypeOf((*MockRepo)(nil).SavePaymentToken), arg0, arg1)
+}
+
 // UpdatePayment mocks base method.
 func (m *MockRepo) UpdatePayment(arg0 context.Context, arg1 *dto.UpdatePayment) error {
 	m.ctrl.T.Helper()
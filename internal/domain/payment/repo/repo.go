@@ -16,6 +16,12 @@ type Repo interface {
 	GetPaymentById(ctx context.Context, id int64) (*model.PaymentModel, error)
 	UpdatePayment(ctx context.Context, data *dto.UpdatePayment) error
 	CheckPaymentAccessible(ctx context.Context, userId uuid.UUID, id int64) (bool, error)
+	GetPaymentByOrderId(ctx context.Context, orderId string) (*model.PaymentModel, error)
+	CreatePaymentRefund(ctx context.Context, data *dto.CreatePaymentRefund) (*model.PaymentRefundModel, error)
+	GetPaymentRefunds(ctx context.Context, paymentId int64) ([]model.PaymentRefundModel, error)
+	SavePaymentToken(ctx context.Context, data *dto.SavePaymentToken) (*model.PaymentTokenModel, error)
+	GetPaymentToken(ctx context.Context, userId uuid.UUID) (*model.PaymentTokenModel, error)
+	DeletePaymentToken(ctx context.Context, userId uuid.UUID) error
 }
 
 type repo struct {
@@ -104,10 +110,11 @@ func (r *repo) GetPaymentById(ctx context.Context, id int64) (*model.PaymentMode
 
 func (r *repo) UpdatePayment(ctx context.Context, data *dto.UpdatePayment) error {
 	params := database.UpdatePaymentParams{
-		ID:        data.ID,
-		OrderID:   types.StrN(data.OrderId),
-		OrderInfo: types.StrN(data.OrderInfo),
-		Amount:    types.Float32N(data.Amount),
+		ID:              data.ID,
+		OrderID:         types.StrN(data.OrderId),
+		OrderInfo:       types.StrN(data.OrderInfo),
+		Amount:          types.Float32N(data.Amount),
+		TransactionDate: types.TimestamptzN(data.TransactionDate),
 	}
 	if data.Status != nil {
 		params.Status = database.NullPAYMENTSTATUS{
@@ -124,3 +131,63 @@ func (r *repo) CheckPaymentAccessible(ctx context.Context, userId uuid.UUID, id
 		ID:     id,
 	})
 }
+
+// GetPaymentByOrderId returns the latest successful payment of the VNPay transaction reference
+func (r *repo) GetPaymentByOrderId(ctx context.Context, orderId string) (*model.PaymentModel, error) {
+	p, err := r.dao.GetPaymentByOrderId(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	return model.ToPaymentModel(&p), nil
+}
+
+func (r *repo) CreatePaymentRefund(ctx context.Context, data *dto.CreatePaymentRefund) (*model.PaymentRefundModel, error) {
+	res, err := r.dao.CreatePaymentRefund(ctx, database.CreatePaymentRefundParams{
+		PaymentID: data.PaymentID,
+		Amount:    data.Amount,
+		Reason:    data.Reason,
+		CreatedBy: data.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return (*model.PaymentRefundModel)(&res), nil
+}
+
+func (r *repo) GetPaymentRefunds(ctx context.Context, paymentId int64) ([]model.PaymentRefundModel, error) {
+	res, err := r.dao.GetPaymentRefunds(ctx, paymentId)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.PaymentRefundModel, 0, len(res))
+	for i := range res {
+		items = append(items, model.PaymentRefundModel(res[i]))
+	}
+	return items, nil
+}
+
+// SavePaymentToken saves the card of the user, replacing the one saved before
+func (r *repo) SavePaymentToken(ctx context.Context, data *dto.SavePaymentToken) (*model.PaymentTokenModel, error) {
+	res, err := r.dao.UpsertPaymentToken(ctx, database.UpsertPaymentTokenParams{
+		UserID:     data.UserID,
+		Token:      data.Token,
+		CardNumber: types.StrN(data.CardNumber),
+		BankCode:   types.StrN(data.BankCode),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return model.ToPaymentTokenModel(&res), nil
+}
+
+func (r *repo) GetPaymentToken(ctx context.Context, userId uuid.UUID) (*model.PaymentTokenModel, error) {
+	res, err := r.dao.GetPaymentToken(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	return model.ToPaymentTokenModel(&res), nil
+}
+
+func (r *repo) DeletePaymentToken(ctx context.Context, userId uuid.UUID) error {
+	return r.dao.DeletePaymentToken(ctx, userId)
+}
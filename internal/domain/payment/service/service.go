@@ -30,6 +30,10 @@ type Service interface {
 	GetPaymentById(userId uuid.UUID, id int64) (*model.PaymentModel, error)
 	GetPaymentsOfUser(userId uuid.UUID, query *dto.GetPaymentsOfUserQuery) ([]model.PaymentModel, error)
 	HandleReturn(data *dto.UpdatePayment, paymentInfo string) error
+	RefundListing(listingId uuid.UUID, reason string) error
+	RenewListing(listingId uuid.UUID, userId uuid.UUID, duration int) error
+	GetPaymentToken(userId uuid.UUID) (*model.PaymentTokenModel, error)
+	DeletePaymentToken(userId uuid.UUID) error
 }
 
 type PaymentService struct {
@@ -71,3 +75,11 @@ func (s *PaymentService) GetPaymentsOfUser(userId uuid.UUID, query *dto.GetPayme
 	}
 	return s.repo.GetPaymentsOfUser(context.Background(), userId, limit, offset)
 }
+
+func (s *PaymentService) GetPaymentToken(userId uuid.UUID) (*model.PaymentTokenModel, error) {
+	return s.repo.GetPaymentToken(context.Background(), userId)
+}
+
+func (s *PaymentService) DeletePaymentToken(userId uuid.UUID) error {
+	return s.repo.DeletePaymentToken(context.Background(), userId)
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/hmac"
 	"crypto/sha512"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -131,6 +132,11 @@ func (s *VnPayService) Return(query map[string]string) error {
 			),
 		)),
 	}
+	if date, err := getTransactionDate(query["vnp_TxnRef"], query["vnp_PayDate"]); err == nil {
+		paymentUpdatePayload.TransactionDate = &date
+	} else {
+		log.Println("failed to get the transaction date of payment", paymentId, err)
+	}
 
 	err := s.HandleReturn(&paymentUpdatePayload, orderInfo[end+1:])
 	if err != nil {
@@ -140,6 +146,30 @@ func (s *VnPayService) Return(query map[string]string) error {
 	return s.domainRepo.PaymentRepo.UpdatePayment(context.Background(), &paymentUpdatePayload)
 }
 
+// getTransactionDate returns the creation date of the transaction, the date VNPay requires to refund it.
+// The transaction reference holds the day and time of the creation date (DDHHmmss), the payment date
+// returned by VNPay gives its month and year.
+func getTransactionDate(txnRef string, payDate string) (time.Time, error) {
+	tz, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	if err != nil {
+		return time.Time{}, err
+	}
+	paidAt, err := time.ParseInLocation("20060102150405", payDate, tz)
+	if err != nil {
+		return time.Time{}, err
+	}
+	ref, err := time.ParseInLocation("02150405", txnRef, tz)
+	if err != nil {
+		return time.Time{}, err
+	}
+	res := time.Date(paidAt.Year(), paidAt.Month(), ref.Day(), ref.Hour(), ref.Minute(), ref.Second(), 0, tz)
+	if res.After(paidAt) {
+		// created in the previous month
+		res = time.Date(paidAt.Year(), paidAt.Month()-1, ref.Day(), ref.Hour(), ref.Minute(), ref.Second(), 0, tz)
+	}
+	return res, nil
+}
+
 type IpnReturn struct {
 	RspCode string `json:"RspCode"`
 	Message string `json:"Message"`
@@ -300,3 +330,34 @@ func (s *VnPayService) Refund(ipAddr string, d *dto.VNPRefund) (*http.Response,
 		"vnp_SecureHash":      vnpSecureHash,
 	})
 }
+
+// SaveRefund records the refund once VNPay has accepted it (vnp_ResponseCode "00"), so that it shows up in the accounting exports
+func (s *VnPayService) SaveRefund(d *dto.VNPRefund, body []byte) error {
+	_, err := s.saveRefund(d, body, "Hoan tien GD ma:"+d.OrderId)
+	return err
+}
+
+// saveRefund records the refund if VNPay has accepted it, it tells whether the refund was accepted
+func (s *VnPayService) saveRefund(d *dto.VNPRefund, body []byte, reason string) (bool, error) {
+	var res struct {
+		ResponseCode string `json:"vnp_ResponseCode"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return false, err
+	}
+	if res.ResponseCode != "00" {
+		return false, nil
+	}
+
+	payment, err := s.domainRepo.PaymentRepo.GetPaymentByOrderId(context.Background(), d.OrderId)
+	if err != nil {
+		return false, err
+	}
+	_, err = s.domainRepo.PaymentRepo.CreatePaymentRefund(context.Background(), &dto.CreatePaymentRefund{
+		PaymentID: payment.ID,
+		Amount:    float32(d.Amount),
+		Reason:    reason,
+		CreatedBy: d.User,
+	})
+	return true, err
+}
@@ -0,0 +1,85 @@
+package vnpay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/payment/dto"
+	"github.com/user2410/rrms-backend/internal/domain/payment/service"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+const (
+	// refunds of rejected listings are requested by the server itself
+	REFUND_IPADDR = "127.0.0.1"
+	REFUND_USER   = "system"
+	// full refund
+	REFUND_TRANSTYPE_FULL = "02"
+)
+
+var ErrRefundDeclined = errors.New("refund declined by VNPay")
+
+// RefundListing refunds in full the successful creation payment of the listing, if it is not refunded yet
+func (s *VnPayService) RefundListing(listingId uuid.UUID, reason string) error {
+	payments, err := s.domainRepo.ListingRepo.GetListingPaymentsByType(context.Background(), listingId, service.PAYMENTTYPE_CREATELISTING)
+	if err != nil {
+		return err
+	}
+
+	tz, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	if err != nil {
+		return err
+	}
+	for _, p := range payments {
+		if p.Status != database.PAYMENTSTATUSSUCCESS {
+			continue
+		}
+		refunds, err := s.domainRepo.PaymentRepo.GetPaymentRefunds(context.Background(), p.ID)
+		if err != nil {
+			return err
+		}
+		if len(refunds) > 0 {
+			continue
+		}
+
+		transDate := p.UpdatedAt
+		if p.TransactionDate != nil {
+			transDate = *p.TransactionDate
+		} else {
+			log.Println("no transaction date recorded for payment", p.ID, "falling back to its last update")
+		}
+		d := dto.VNPRefund{
+			OrderId:   p.OrderID,
+			TransDate: transDate.In(tz).Format("20060102150405"),
+			Amount:    int64(p.Amount),
+			TransType: REFUND_TRANSTYPE_FULL,
+			User:      REFUND_USER,
+		}
+		res, err := s.Refund(REFUND_IPADDR, &d)
+		if err != nil {
+			return err
+		}
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return err
+		}
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("%w: status code %d", ErrRefundDeclined, res.StatusCode)
+		}
+		accepted, err := s.saveRefund(&d, body, reason)
+		if err != nil {
+			return err
+		}
+		if !accepted {
+			return fmt.Errorf("%w: %s", ErrRefundDeclined, string(body))
+		}
+	}
+	return nil
+}
@@ -14,11 +14,13 @@ type VnPayService struct {
 	vnpHashSecret string
 	vnpUrl        string
 	vnpApi        string
+	// base URL of the token API, the cards cannot be saved without it
+	vnpTokenUrl string
 }
 
 func NewVnpayService(
 	domainRepo repos.DomainRepo, lService listing_service.Service,
-	vnpTmnCode string, vnpHashSecret string, vnpUrl string, vnpApi string,
+	vnpTmnCode string, vnpHashSecret string, vnpUrl string, vnpApi string, vnpTokenUrl string,
 ) service.Service {
 	return &VnPayService{
 		PaymentService: service.NewPaymentService(domainRepo.PaymentRepo),
@@ -28,5 +30,6 @@ func NewVnpayService(
 		vnpHashSecret:  vnpHashSecret,
 		vnpUrl:         vnpUrl,
 		vnpApi:         vnpApi,
+		vnpTokenUrl:    vnpTokenUrl,
 	}
 }
@@ -0,0 +1,243 @@
+package vnpay
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	listing_service "github.com/user2410/rrms-backend/internal/domain/listing/service"
+	listing_utils "github.com/user2410/rrms-backend/internal/domain/listing/utils"
+	"github.com/user2410/rrms-backend/internal/domain/payment/dto"
+	"github.com/user2410/rrms-backend/internal/domain/payment/model"
+	"github.com/user2410/rrms-backend/internal/domain/payment/service"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// schema: https://sandbox.vnpayment.vn/apis/docs/thanh-toan-token/
+
+const (
+	// domestic ATM cards
+	TOKEN_CARDTYPE_DOMESTIC = "01"
+	// renewals are charged by the server itself
+	RENEWAL_IPADDR = "127.0.0.1"
+)
+
+var (
+	ErrTokenUnavailable = errors.New("card tokenization is not configured")
+	ErrNoPaymentToken   = errors.New("no saved card")
+	ErrRenewalDeclined  = errors.New("renewal declined by VNPay")
+)
+
+// CreateTokenUrl returns the URL of the VNPay page where the user saves a card for the automatic renewals of their listings
+func (s *VnPayService) CreateTokenUrl(ipAddr string, userId uuid.UUID, data *dto.VNPCreateTokenUrl) (string, error) {
+	if s.vnpTokenUrl == "" {
+		return "", ErrTokenUnavailable
+	}
+	tz, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	if err != nil {
+		return "", err
+	}
+	date := time.Now()
+
+	if data.Language == nil || *data.Language == "" {
+		data.Language = types.Ptr("vn")
+	}
+	cancelUrl := data.ReturnUrl
+	if data.CancelUrl != nil && *data.CancelUrl != "" {
+		cancelUrl = *data.CancelUrl
+	}
+
+	vnpParams := map[string]string{
+		"vnp_version":     "2.1.0",
+		"vnp_command":     "token_create",
+		"vnp_tmn_code":    s.vnpTmnCode,
+		"vnp_app_user_id": userId.String(),
+		"vnp_card_type":   TOKEN_CARDTYPE_DOMESTIC,
+		"vnp_txn_ref":     date.In(tz).Format("02150405"), // DDHHmmss
+		"vnp_txn_desc":    "Luu the thanh toan gia han tin dang",
+		"vnp_locale":      *data.Language,
+		"vnp_return_url":  data.ReturnUrl,
+		"vnp_cancel_url":  cancelUrl,
+		"vnp_ip_addr":     ipAddr,
+		"vnp_create_date": date.In(tz).Format("20060102150405"), // YYYYMMDDHHMMSS
+	}
+	vnpParams = sortObject(vnpParams)
+
+	h := hmac.New(sha512.New, []byte(s.vnpHashSecret))
+	h.Write([]byte(stringify(vnpParams)))
+	vnpParams["vnp_secure_hash"] = hex.EncodeToString(h.Sum(nil))
+
+	return s.vnpTokenUrl + "/token/create?" + stringify(vnpParams), nil
+}
+
+// TokenReturn saves the card VNPay returns after the user has entered it
+func (s *VnPayService) TokenReturn(query map[string]string) (*model.PaymentTokenModel, error) {
+	secureHash := query["vnp_secure_hash"]
+	delete(query, "vnp_secure_hash")
+
+	h := hmac.New(sha512.New, []byte(s.vnpHashSecret))
+	h.Write([]byte(stringify(sortObject(query))))
+	if secureHash != hex.EncodeToString(h.Sum(nil)) {
+		return nil, ErrInvalidHash
+	}
+	if query["vnp_response_code"] != "00" {
+		return nil, fmt.Errorf("%w: response code %s", ErrBadStatusPayment, query["vnp_response_code"])
+	}
+
+	userId, err := uuid.Parse(query["vnp_app_user_id"])
+	if err != nil {
+		return nil, err
+	}
+	data := dto.SavePaymentToken{
+		UserID: userId,
+		Token:  query["vnp_token"],
+	}
+	if v := query["vnp_card_number"]; v != "" {
+		data.CardNumber = &v
+	}
+	if v := query["vnp_bank_code"]; v != "" {
+		data.BankCode = &v
+	}
+	return s.domainRepo.PaymentRepo.SavePaymentToken(context.Background(), &data)
+}
+
+// RenewListing extends the listing on behalf of its creator, charging the card they saved.
+// A pending extension payment of the listing is charged instead of a new one.
+// The order id is saved before the card is charged, so that a charge whose outcome is unknown is never sent twice.
+func (s *VnPayService) RenewListing(listingId uuid.UUID, userId uuid.UUID, duration int) error {
+	if s.vnpTokenUrl == "" {
+		return ErrTokenUnavailable
+	}
+	token, err := s.domainRepo.PaymentRepo.GetPaymentToken(context.Background(), userId)
+	if err != nil {
+		if errors.Is(err, database.ErrRecordNotFound) {
+			return ErrNoPaymentToken
+		}
+		return err
+	}
+
+	payments, err := s.domainRepo.ListingRepo.GetListingPaymentsByType(context.Background(), listingId, service.PAYMENTTYPE_EXTENDLISTING)
+	if err != nil {
+		return err
+	}
+	payment, unconfirmed := listing_utils.PendingExtendPayment(payments)
+	if unconfirmed {
+		return listing_service.ErrRenewalUnconfirmed
+	}
+	if payment == nil {
+		payment, err = s.lService.ExtendListing(userId, listingId, duration)
+		if err != nil {
+			return err
+		}
+	}
+
+	tz, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	if err != nil {
+		return err
+	}
+	date := time.Now().In(tz)
+	orderId := date.Format("02150405") // DDHHmmss
+	err = s.domainRepo.PaymentRepo.UpdatePayment(context.Background(), &dto.UpdatePayment{
+		ID:              payment.ID,
+		OrderId:         &orderId,
+		TransactionDate: &date,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.chargeToken(payment, token, orderId, date)
+	if errors.Is(err, ErrRenewalDeclined) {
+		// nothing was charged, the creator is sent a link to a new payment
+		if uErr := s.domainRepo.PaymentRepo.UpdatePayment(context.Background(), &dto.UpdatePayment{
+			ID:     payment.ID,
+			Status: types.Ptr(database.PAYMENTSTATUSFAILED),
+		}); uErr != nil {
+			return errors.Join(err, uErr)
+		}
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("%w: payment %d: %w", listing_service.ErrRenewalUnconfirmed, payment.ID, err)
+	}
+
+	paymentUpdatePayload := dto.UpdatePayment{
+		ID:      payment.ID,
+		OrderId: &orderId,
+		Status:  types.Ptr(database.PAYMENTSTATUSSUCCESS),
+	}
+	if err = s.HandleReturn(&paymentUpdatePayload, payment.OrderInfo); err != nil {
+		return fmt.Errorf("%w: payment %d charged: %w", listing_service.ErrRenewalUnconfirmed, payment.ID, err)
+	}
+	if err = s.domainRepo.PaymentRepo.UpdatePayment(context.Background(), &paymentUpdatePayload); err != nil {
+		return fmt.Errorf("%w: payment %d charged: %w", listing_service.ErrRenewalUnconfirmed, payment.ID, err)
+	}
+	return nil
+}
+
+// chargeToken pays the payment with the saved card under the given transaction reference.
+// ErrRenewalDeclined is returned only when VNPay refused the charge, any other error leaves its outcome unknown.
+func (s *VnPayService) chargeToken(payment *model.PaymentModel, token *model.PaymentTokenModel, vnpTxnRef string, date time.Time) error {
+	vnpRequestId := date.Format("150405") // HHmmss
+	vnpVersion := "2.1.0"
+	vnpCommand := "token_pay"
+	vnpAmount := strconv.FormatInt(int64(payment.Amount*100), 10)
+	vnpTxnDesc := fmt.Sprintf("[%d]%s", payment.ID, payment.OrderInfo)
+	vnpCreateDate := date.Format("20060102150405") // YYYYMMDDHHMMSS
+
+	data := strings.Join([]string{
+		vnpRequestId, vnpVersion, vnpCommand, s.vnpTmnCode, token.UserID.String(), token.Token,
+		vnpTxnRef, vnpAmount, vnpTxnDesc, vnpCreateDate, RENEWAL_IPADDR,
+	}, "|")
+	h := hmac.New(sha512.New, []byte(s.vnpHashSecret))
+	h.Write([]byte(data))
+
+	res, err := sendHttpRequest(s.vnpTokenUrl+"/token/pay", http.MethodPost, map[string]string{
+		"vnp_request_id":  vnpRequestId,
+		"vnp_version":     vnpVersion,
+		"vnp_command":     vnpCommand,
+		"vnp_tmn_code":    s.vnpTmnCode,
+		"vnp_app_user_id": token.UserID.String(),
+		"vnp_token":       token.Token,
+		"vnp_txn_ref":     vnpTxnRef,
+		"vnp_amount":      vnpAmount,
+		"vnp_curr_code":   "VND",
+		"vnp_txn_desc":    vnpTxnDesc,
+		"vnp_create_date": vnpCreateDate,
+		"vnp_ip_addr":     RENEWAL_IPADDR,
+		"vnp_locale":      "vn",
+		"vnp_secure_hash": hex.EncodeToString(h.Sum(nil)),
+	})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	var resBody struct {
+		ResponseCode string `json:"vnp_response_code"`
+	}
+	if err = json.Unmarshal(body, &resBody); err != nil {
+		return err
+	}
+	if resBody.ResponseCode != "00" {
+		return fmt.Errorf("%w: %s", ErrRenewalDeclined, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type CreatePropertyExpense struct {
+	PropertyID uuid.UUID `json:"propertyId" validate:"required,uuid4"`
+	CreatorID  uuid.UUID `json:"creatorId" validate:"required,uuid4"`
+	Category   string    `json:"category" validate:"required,max=64"`
+	Amount     float32   `json:"amount" validate:"gte=0"`
+	IncurredAt time.Time `json:"incurredAt" validate:"required"`
+	Note       *string   `json:"note" validate:"omitempty"`
+}
+
+func (c *CreatePropertyExpense) ToCreatePropertyExpenseDB() database.CreatePropertyExpenseParams {
+	return database.CreatePropertyExpenseParams{
+		PropertyID: c.PropertyID,
+		CreatorID:  c.CreatorID,
+		Category:   c.Category,
+		Amount:     c.Amount,
+		IncurredAt: pgtype.Date{Time: c.IncurredAt, Valid: true},
+		Note:       types.StrN(c.Note),
+	}
+}
+
+type GetPropertyExpensesQuery struct {
+	From time.Time `query:"from" validate:"required"`
+	To   time.Time `query:"to" validate:"required,gtfield=From"`
+}
@@ -0,0 +1,94 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/property/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type CreatePropertyService struct {
+	PropertyID       uuid.UUID                        `json:"propertyId" validate:"required,uuid4"`
+	Type             database.PROPERTYSERVICETYPE     `json:"type" validate:"required,oneof=INTERNET PARKING CLEANING TRASH OTHER"`
+	Name             string                           `json:"name" validate:"required"`
+	SetupBy          string                           `json:"setupBy" validate:"required,oneof=LANDLORD TENANT"`
+	Provider         *string                          `json:"provider" validate:"omitempty"`
+	DefaultPrice     *float32                         `json:"defaultPrice" validate:"omitempty,gte=0"`
+	BillingFrequency database.SERVICEBILLINGFREQUENCY `json:"billingFrequency" validate:"omitempty,oneof=MONTHLY QUARTERLY YEARLY"`
+}
+
+func (c *CreatePropertyService) ToCreatePropertyServiceDB() database.CreatePropertyServiceParams {
+	p := database.CreatePropertyServiceParams{
+		PropertyID:       c.PropertyID,
+		Type:             c.Type,
+		Name:             c.Name,
+		SetupBy:          c.SetupBy,
+		Provider:         types.StrN(c.Provider),
+		DefaultPrice:     types.Float32N(c.DefaultPrice),
+		BillingFrequency: c.BillingFrequency,
+	}
+	if p.BillingFrequency == "" {
+		p.BillingFrequency = database.SERVICEBILLINGFREQUENCYMONTHLY
+	}
+	return p
+}
+
+type UpdatePropertyService struct {
+	ID               int64                            `json:"id"`
+	Type             database.PROPERTYSERVICETYPE     `json:"type" validate:"omitempty,oneof=INTERNET PARKING CLEANING TRASH OTHER"`
+	Name             *string                          `json:"name" validate:"omitempty"`
+	SetupBy          *string                          `json:"setupBy" validate:"omitempty,oneof=LANDLORD TENANT"`
+	Provider         *string                          `json:"provider" validate:"omitempty"`
+	DefaultPrice     *float32                         `json:"defaultPrice" validate:"omitempty,gte=0"`
+	BillingFrequency database.SERVICEBILLINGFREQUENCY `json:"billingFrequency" validate:"omitempty,oneof=MONTHLY QUARTERLY YEARLY"`
+}
+
+func (u *UpdatePropertyService) ToUpdatePropertyServiceDB() database.UpdatePropertyServiceParams {
+	return database.UpdatePropertyServiceParams{
+		ID: u.ID,
+		Type: database.NullPROPERTYSERVICETYPE{
+			PROPERTYSERVICETYPE: u.Type,
+			Valid:               u.Type != "",
+		},
+		Name:         types.StrN(u.Name),
+		SetupBy:      types.StrN(u.SetupBy),
+		Provider:     types.StrN(u.Provider),
+		DefaultPrice: types.Float32N(u.DefaultPrice),
+		BillingFrequency: database.NullSERVICEBILLINGFREQUENCY{
+			SERVICEBILLINGFREQUENCY: u.BillingFrequency,
+			Valid:                   u.BillingFrequency != "",
+		},
+	}
+}
+
+// CreatePropertyServicePriceChange records a change of the price or of the billing cycle of a catalog service
+type CreatePropertyServicePriceChange struct {
+	ServiceID           int64
+	CreatorID           uuid.UUID
+	OldPrice            *float32
+	NewPrice            float32
+	OldBillingFrequency database.SERVICEBILLINGFREQUENCY
+	NewBillingFrequency database.SERVICEBILLINGFREQUENCY
+}
+
+func (c *CreatePropertyServicePriceChange) ToCreatePropertyServicePriceChangeDB() database.CreatePropertyServicePriceChangeParams {
+	return database.CreatePropertyServicePriceChangeParams{
+		ServiceID:           c.ServiceID,
+		CreatorID:           c.CreatorID,
+		OldPrice:            types.Float32N(c.OldPrice),
+		NewPrice:            c.NewPrice,
+		OldBillingFrequency: c.OldBillingFrequency,
+		NewBillingFrequency: c.NewBillingFrequency,
+	}
+}
+
+// UpdatePropertyServiceResponse carries the price change recorded when the catalog price of a service
+// with subscribed rentals, or its billing cycle, changes. The change is only propagated to the rentals once a manager applies it.
+type UpdatePropertyServiceResponse struct {
+	PriceChange *model.PropertyServicePriceChange `json:"priceChange"`
+	Subscribers int                               `json:"subscribers"`
+}
+
+type UpdatePropertyServicePriceChangeStatus struct {
+	Status database.SERVICEPRICECHANGESTATUS `json:"status" validate:"required,oneof=APPLIED DISMISSED"`
+}
@@ -9,12 +9,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
 	application_dto "github.com/user2410/rrms-backend/internal/domain/application/dto"
+	application_utils "github.com/user2410/rrms-backend/internal/domain/application/utils"
 	auth_http "github.com/user2410/rrms-backend/internal/domain/auth/http"
 	auth_service "github.com/user2410/rrms-backend/internal/domain/auth/service"
 	listing_dto "github.com/user2410/rrms-backend/internal/domain/listing/dto"
 	"github.com/user2410/rrms-backend/internal/domain/property/dto"
 	property_service "github.com/user2410/rrms-backend/internal/domain/property/service"
 	rental_dto "github.com/user2410/rrms-backend/internal/domain/rental/dto"
+	unit_dto "github.com/user2410/rrms-backend/internal/domain/unit/dto"
 	"github.com/user2410/rrms-backend/internal/infrastructure/database"
 	"github.com/user2410/rrms-backend/internal/interfaces/rest/responses"
 	"github.com/user2410/rrms-backend/internal/utils/token"
@@ -79,10 +81,22 @@ func (a *adapter) RegisterServer(router *fiber.Router, tokenMaker token.Maker, a
 		CheckPropertyManageability(a.service),
 		a.getApplicationsOfProperty(),
 	)
+	propertyRoute.Get("/property/:id/screening-config",
+		CheckPropertyManageability(a.service),
+		a.getScreeningConfig(),
+	)
+	propertyRoute.Put("/property/:id/screening-config",
+		CheckPropertyManageability(a.service),
+		a.updateScreeningConfig(),
+	)
 	propertyRoute.Get("/property/:id/rentals",
 		CheckPropertyManageability(a.service),
 		a.getRentalsOfProperty(),
 	)
+	propertyRoute.Get("/property/:id/calendar",
+		CheckPropertyManageability(a.service),
+		a.getPropertyCalendar(),
+	)
 	propertyRoute.Patch("/property/:id/_pre",
 		CheckPropertyManageability(a.service),
 		a.preUpdateProperty(),
@@ -102,6 +116,20 @@ func (a *adapter) RegisterServer(router *fiber.Router, tokenMaker token.Maker, a
 	propertyVerificationRoute.Get("/", a.getVerificationRequestsOfProperty())
 	propertyVerificationRoute.Get("/verification/:vid", a.getVerificationRequest())
 
+	propertyServiceRoute := propertyRoute.Group("/property/:id/services").Use(CheckPropertyManageability(a.service))
+	propertyServiceRoute.Post("/", a.createPropertyService())
+	propertyServiceRoute.Get("/", a.getPropertyServices())
+	propertyServiceRoute.Get("/service/:sid", a.getPropertyService())
+	propertyServiceRoute.Patch("/service/:sid", a.updatePropertyService())
+	propertyServiceRoute.Delete("/service/:sid", a.deletePropertyService())
+	propertyServiceRoute.Get("/service/:sid/price-changes", a.getPropertyServicePriceChanges())
+	propertyServiceRoute.Patch("/service/:sid/price-changes/:cid", a.updatePropertyServicePriceChangeStatus())
+
+	propertyExpenseRoute := propertyRoute.Group("/property/:id/expenses").Use(CheckPropertyManageability(a.service))
+	propertyExpenseRoute.Post("/", a.createPropertyExpense())
+	propertyExpenseRoute.Get("/", a.getPropertyExpenses())
+	propertyExpenseRoute.Delete("/expense/:eid", a.deletePropertyExpense())
+
 	propertyRoute.Get("/verifications", auth_http.AuthorizedMiddleware(tokenMaker), auth_http.AdminOnlyRoutes(authService), a.getVerificationRequests())
 	propertyRoute.Patch("/verifications/:vid", auth_http.AuthorizedMiddleware(tokenMaker), auth_http.AdminOnlyRoutes(authService), a.updateVerificationRequestStatus())
 
@@ -230,6 +258,11 @@ func (a *adapter) getApplicationsOfProperty() fiber.Handler {
 		if errs := validation.ValidateStruct(validator, *query); len(errs) > 0 {
 			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
 		}
+		filters, err := application_utils.ParseApplicationAnswerFilters(query.Answers)
+		if err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		query.AnswerFilters = filters
 
 		res, err := a.service.GetApplicationsOfProperty(puid, query)
 		if err != nil {
@@ -244,6 +277,43 @@ func (a *adapter) getApplicationsOfProperty() fiber.Handler {
 	}
 }
 
+func (a *adapter) getScreeningConfig() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		puid := ctx.Locals(PropertyIDLocalKey).(uuid.UUID)
+
+		res, err := a.service.GetScreeningConfig(puid)
+		if err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func (a *adapter) updateScreeningConfig() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		puid := ctx.Locals(PropertyIDLocalKey).(uuid.UUID)
+
+		var payload application_dto.UpdateScreeningConfig
+		if err := ctx.BodyParser(&payload); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, payload); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+		if !payload.HasWeight() {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": "at least one factor must have a weight"})
+		}
+
+		res, err := a.service.UpdateScreeningConfig(puid, &payload)
+		if err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
 func (a *adapter) getRentalsOfProperty() fiber.Handler {
 	return func(ctx *fiber.Ctx) error {
 		puid := ctx.Locals(PropertyIDLocalKey).(uuid.UUID)
@@ -271,6 +341,27 @@ func (a *adapter) getRentalsOfProperty() fiber.Handler {
 	}
 }
 
+func (a *adapter) getPropertyCalendar() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		puid := ctx.Locals(PropertyIDLocalKey).(uuid.UUID)
+
+		var query unit_dto.GetUnitTimelineQuery
+		if err := ctx.QueryParser(&query); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, query); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		res, err := a.service.GetPropertyCalendar(puid, query.From, query.To)
+		if err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
 func (a *adapter) getPropertiesByIds() fiber.Handler {
 	return func(ctx *fiber.Ctx) error {
 		query := new(dto.GetPropertiesByIdsQuery)
@@ -369,6 +460,9 @@ func (a *adapter) updateProperty() fiber.Handler {
 			if dbErr, ok := err.(*pgconn.PgError); ok {
 				return responses.DBErrorResponse(ctx, dbErr)
 			}
+			if dbErr, ok := err.(*database.TXError); ok {
+				return responses.DBTXErrorResponse(ctx, dbErr)
+			}
 
 			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
 		}
@@ -386,6 +480,9 @@ func (a *adapter) deleteProperty() fiber.Handler {
 			if dbErr, ok := err.(*pgconn.PgError); ok {
 				return responses.DBErrorResponse(ctx, dbErr)
 			}
+			if dbErr, ok := err.(*database.TXError); ok {
+				return responses.DBTXErrorResponse(ctx, dbErr)
+			}
 
 			ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
 		}
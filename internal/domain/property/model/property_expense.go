@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// PropertyExpenseModel is an expense paid on behalf of the owner (repair, maintenance, tax...), deducted in owner statements
+type PropertyExpenseModel struct {
+	ID         int64     `json:"id"`
+	PropertyID uuid.UUID `json:"propertyId"`
+	CreatorID  uuid.UUID `json:"creatorId"`
+	Category   string    `json:"category"`
+	Amount     float32   `json:"amount"`
+	IncurredAt time.Time `json:"incurredAt"`
+	Note       *string   `json:"note"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func ToPropertyExpenseModel(pe *database.PropertyExpense) PropertyExpenseModel {
+	return PropertyExpenseModel{
+		ID:         pe.ID,
+		PropertyID: pe.PropertyID,
+		CreatorID:  pe.CreatorID,
+		Category:   pe.Category,
+		Amount:     pe.Amount,
+		IncurredAt: pe.IncurredAt.Time,
+		Note:       types.PNStr(pe.Note),
+		CreatedAt:  pe.CreatedAt,
+	}
+}
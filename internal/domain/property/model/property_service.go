@@ -0,0 +1,84 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// PropertyServiceModel is an item of the service catalog of a property (internet, parking, cleaning, trash...)
+// that rentals of the property can subscribe to.
+type PropertyServiceModel struct {
+	ID               int64                            `json:"id"`
+	PropertyID       uuid.UUID                        `json:"propertyId"`
+	Type             database.PROPERTYSERVICETYPE     `json:"type"`
+	Name             string                           `json:"name"`
+	SetupBy          string                           `json:"setupBy"`
+	Provider         *string                          `json:"provider"`
+	DefaultPrice     *float32                         `json:"defaultPrice"`
+	BillingFrequency database.SERVICEBILLINGFREQUENCY `json:"billingFrequency"`
+	CreatedAt        time.Time                        `json:"createdAt"`
+	UpdatedAt        time.Time                        `json:"updatedAt"`
+}
+
+func ToPropertyServiceModel(ps *database.PropertyService) PropertyServiceModel {
+	return PropertyServiceModel{
+		ID:               ps.ID,
+		PropertyID:       ps.PropertyID,
+		Type:             ps.Type,
+		Name:             ps.Name,
+		SetupBy:          ps.SetupBy,
+		Provider:         types.PNStr(ps.Provider),
+		DefaultPrice:     types.PNFloat32(ps.DefaultPrice),
+		BillingFrequency: ps.BillingFrequency,
+		CreatedAt:        ps.CreatedAt,
+		UpdatedAt:        ps.UpdatedAt,
+	}
+}
+
+// GetBillingMonths returns the number of months of a billing cycle
+func GetBillingMonths(f database.SERVICEBILLINGFREQUENCY) int32 {
+	switch f {
+	case database.SERVICEBILLINGFREQUENCYQUARTERLY:
+		return 3
+	case database.SERVICEBILLINGFREQUENCYYEARLY:
+		return 12
+	default:
+		return 1
+	}
+}
+
+// GetMonthlyPrice converts a price of one billing cycle to the monthly price used by rental service payments
+func GetMonthlyPrice(price float32, f database.SERVICEBILLINGFREQUENCY) float32 {
+	return price / float32(GetBillingMonths(f))
+}
+
+type PropertyServicePriceChange struct {
+	ID                  int64                             `json:"id"`
+	ServiceID           int64                             `json:"serviceId"`
+	CreatorID           uuid.UUID                         `json:"creatorId"`
+	OldPrice            *float32                          `json:"oldPrice"`
+	NewPrice            float32                           `json:"newPrice"`
+	OldBillingFrequency database.SERVICEBILLINGFREQUENCY  `json:"oldBillingFrequency"`
+	NewBillingFrequency database.SERVICEBILLINGFREQUENCY  `json:"newBillingFrequency"`
+	Status              database.SERVICEPRICECHANGESTATUS `json:"status"`
+	CreatedAt           time.Time                         `json:"createdAt"`
+	UpdatedAt           time.Time                         `json:"updatedAt"`
+}
+
+func ToPropertyServicePriceChange(pc *database.PropertyServicePriceChange) PropertyServicePriceChange {
+	return PropertyServicePriceChange{
+		ID:                  pc.ID,
+		ServiceID:           pc.ServiceID,
+		CreatorID:           pc.CreatorID,
+		OldPrice:            types.PNFloat32(pc.OldPrice),
+		NewPrice:            pc.NewPrice,
+		OldBillingFrequency: pc.OldBillingFrequency,
+		NewBillingFrequency: pc.NewBillingFrequency,
+		Status:              pc.Status,
+		CreatedAt:           pc.CreatedAt,
+		UpdatedAt:           pc.UpdatedAt,
+	}
+}
@@ -17,6 +17,7 @@ var (
 	basePath         = utils.GetBasePath()
 	testAuthRepo     auth_repo.Repo
 	testPropertyRepo Repo
+	testDao          database.DAO
 )
 
 func TestMain(m *testing.M) {
@@ -38,6 +39,7 @@ func TestMain(m *testing.M) {
 	})
 	redisClient := redisd.NewRedisClient(rdb)
 
+	testDao = dao
 	testPropertyRepo = NewRepo(dao, redisClient)
 	testAuthRepo = auth_repo.NewRepo(dao)
 
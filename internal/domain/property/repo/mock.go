@@ -12,6 +12,7 @@ package repo
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	dto "github.com/user2410/rrms-backend/internal/domain/application/dto"
@@ -45,6 +46,21 @@ func (m *MockRepo) EXPECT() *MockRepoMockRecorder {
 	return m.recorder
 }
 
+// ApplyPropertyServicePriceChange mocks base method.
+func (m *MockRepo) ApplyPropertyServicePriceChange(arg0 context.Context, arg1, arg2 int64, arg3 float32) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyPropertyServicePriceChange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyPropertyServicePriceChange indicates an expected call of ApplyPropertyServicePriceChange.
+func (mr *MockRepoMockRecorder) ApplyPropertyServicePriceChange(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyPropertyServicePriceChange", reflect.TypeOf((*MockRepo)(nil).ApplyPropertyServicePriceChange), arg0, arg1, arg2, arg3)
+}
+
 // CreateProperty mocks base method.
 func (m *MockRepo) CreateProperty(arg0 context.Context, arg1 *dto1.CreateProperty) (*model.PropertyModel, error) {
 	m.ctrl.T.Helper()
@@ -60,6 +76,21 @@ func (mr *MockRepoMockRecorder) CreateProperty(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProperty", reflect.TypeOf((*MockRepo)(nil).CreateProperty), arg0, arg1)
 }
 
+// CreatePropertyExpense mocks base method.
+func (m *MockRepo) CreatePropertyExpense(arg0 context.Context, arg1 *dto1.CreatePropertyExpense) (model.PropertyExpenseModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePropertyExpense", arg0, arg1)
+	ret0, _ := ret[0].(model.PropertyExpenseModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePropertyExpense indicates an expected call of CreatePropertyExpense.
+func (mr *MockRepoMockRecorder) CreatePropertyExpense(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePropertyExpense", reflect.TypeOf((*MockRepo)(nil).CreatePropertyExpense), arg0, arg1)
+}
+
 // CreatePropertyManagerRequest mocks base method.
 func (m *MockRepo) CreatePropertyManagerRequest(arg0 context.Context, arg1 *dto1.CreatePropertyManagerRequest) (model.NewPropertyManagerRequest, error) {
 	m.ctrl.T.Helper()
@@ -75,6 +106,36 @@ func (mr *MockRepoMockRecorder) CreatePropertyManagerRequest(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePropertyManagerRequest", reflect.TypeOf((*MockRepo)(nil).CreatePropertyManagerRequest), arg0, arg1)
 }
 
+// CreatePropertyService mocks base method.
+func (m *MockRepo) CreatePropertyService(arg0 context.Context, arg1 *dto1.CreatePropertyService) (model.PropertyServiceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePropertyService", arg0, arg1)
+	ret0, _ := ret[0].(model.PropertyServiceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePropertyService indicates an expected call of CreatePropertyService.
+func (mr *MockRepoMockRecorder) CreatePropertyService(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePropertyService", reflect.TypeOf((*MockRepo)(nil).CreatePropertyService), arg0, arg1)
+}
+
+// CreatePropertyServicePriceChange mocks base method.
+func (m *MockRepo) CreatePropertyServicePriceChange(arg0 context.Context, arg1 *dto1.CreatePropertyServicePriceChange) (model.PropertyServicePriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePropertyServicePriceChange", arg0, arg1)
+	ret0, _ := ret[0].(model.PropertyServicePriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePropertyServicePriceChange indicates an expected call of CreatePropertyServicePriceChange.
+func (mr *MockRepoMockRecorder) CreatePropertyServicePriceChange(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePropertyServicePriceChange", reflect.TypeOf((*MockRepo)(nil).CreatePropertyServicePriceChange), arg0, arg1)
+}
+
 // CreatePropertyVerificationRequest mocks base method.
 func (m *MockRepo) CreatePropertyVerificationRequest(arg0 context.Context, arg1 *dto1.CreatePropertyVerificationRequest) (model.PropertyVerificationRequest, error) {
 	m.ctrl.T.Helper()
@@ -104,6 +165,48 @@ func (mr *MockRepoMockRecorder) DeleteProperty(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProperty", reflect.TypeOf((*MockRepo)(nil).DeleteProperty), arg0, arg1)
 }
 
+// DeletePropertyExpense mocks base method.
+func (m *MockRepo) DeletePropertyExpense(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePropertyExpense", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePropertyExpense indicates an expected call of DeletePropertyExpense.
+func (mr *MockRepoMockRecorder) DeletePropertyExpense(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePropertyExpense", reflect.TypeOf((*MockRepo)(nil).DeletePropertyExpense), arg0, arg1)
+}
+
+// DeletePropertyService mocks base method.
+func (m *MockRepo) DeletePropertyService(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePropertyService", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePropertyService indicates an expected call of DeletePropertyService.
+func (mr *MockRepoMockRecorder) DeletePropertyService(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePropertyService", reflect.TypeOf((*MockRepo)(nil).DeletePropertyService), arg0, arg1)
+}
+
+// DismissPropertyServicePriceChange mocks base method.
+func (m *MockRepo) DismissPropertyServicePriceChange(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DismissPropertyServicePriceChange", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DismissPropertyServicePriceChange indicates an expected call of DismissPropertyServicePriceChange.
+func (mr *MockRepoMockRecorder) DismissPropertyServicePriceChange(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DismissPropertyServicePriceChange", reflect.TypeOf((*MockRepo)(nil).DismissPropertyServicePriceChange), arg0, arg1)
+}
+
 // FilterVisibleProperties mocks base method.
 func (m *MockRepo) FilterVisibleProperties(arg0 context.Context, arg1 []uuid.UUID, arg2 uuid.UUID) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -239,6 +342,36 @@ func (mr *MockRepoMockRecorder) GetPropertyById(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPropertyById", reflect.TypeOf((*MockRepo)(nil).GetPropertyById), arg0, arg1)
 }
 
+// GetPropertyExpense mocks base method.
+func (m *MockRepo) GetPropertyExpense(arg0 context.Context, arg1 int64) (model.PropertyExpenseModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPropertyExpense", arg0, arg1)
+	ret0, _ := ret[0].(model.PropertyExpenseModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPropertyExpense indicates an expected call of GetPropertyExpense.
+func (mr *MockRepoMockRecorder) GetPropertyExpense(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPropertyExpense", reflect.TypeOf((*MockRepo)(nil).GetPropertyExpense), arg0, arg1)
+}
+
+// GetPropertyExpenses mocks base method.
+func (m *MockRepo) GetPropertyExpenses(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 time.Time) ([]model.PropertyExpenseModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPropertyExpenses", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]model.PropertyExpenseModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPropertyExpenses indicates an expected call of GetPropertyExpenses.
+func (mr *MockRepoMockRecorder) GetPropertyExpenses(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPropertyExpenses", reflect.TypeOf((*MockRepo)(nil).GetPropertyExpenses), arg0, arg1, arg2, arg3)
+}
+
 // GetPropertyManagers mocks base method.
 func (m *MockRepo) GetPropertyManagers(arg0 context.Context, arg1 uuid.UUID) ([]model.PropertyManagerModel, error) {
 	m.ctrl.T.Helper()
@@ -254,6 +387,81 @@ func (mr *MockRepoMockRecorder) GetPropertyManagers(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPropertyManagers", reflect.TypeOf((*MockRepo)(nil).GetPropertyManagers), arg0, arg1)
 }
 
+// GetPropertyService mocks base method.
+func (m *MockRepo) GetPropertyService(arg0 context.Context, arg1 int64) (model.PropertyServiceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPropertyService", arg0, arg1)
+	ret0, _ := ret[0].(model.PropertyServiceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPropertyService indicates an expected call of GetPropertyService.
+func (mr *MockRepoMockRecorder) GetPropertyService(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPropertyService", reflect.TypeOf((*MockRepo)(nil).GetPropertyService), arg0, arg1)
+}
+
+// GetPropertyServicePriceChange mocks base method.
+func (m *MockRepo) GetPropertyServicePriceChange(arg0 context.Context, arg1 int64) (model.PropertyServicePriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPropertyServicePriceChange", arg0, arg1)
+	ret0, _ := ret[0].(model.PropertyServicePriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPropertyServicePriceChange indicates an expected call of GetPropertyServicePriceChange.
+func (mr *MockRepoMockRecorder) GetPropertyServicePriceChange(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPropertyServicePriceChange", reflect.TypeOf((*MockRepo)(nil).GetPropertyServicePriceChange), arg0, arg1)
+}
+
+// GetPropertyServicePriceChanges mocks base method.
+func (m *MockRepo) GetPropertyServicePriceChanges(arg0 context.Context, arg1 int64) ([]model.PropertyServicePriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPropertyServicePriceChanges", arg0, arg1)
+	ret0, _ := ret[0].([]model.PropertyServicePriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPropertyServicePriceChanges indicates an expected call of GetPropertyServicePriceChanges.
+func (mr *MockRepoMockRecorder) GetPropertyServicePriceChanges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPropertyServicePriceChanges", reflect.TypeOf((*MockRepo)(nil).GetPropertyServicePriceChanges), arg0, arg1)
+}
+
+// GetPropertyServiceSubscribers mocks base method.
+func (m *MockRepo) GetPropertyServiceSubscribers(arg0 context.Context, arg1 int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPropertyServiceSubscribers", arg0, arg1)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPropertyServiceSubscribers indicates an expected call of GetPropertyServiceSubscribers.
+func (mr *MockRepoMockRecorder) GetPropertyServiceSubscribers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPropertyServiceSubscribers", reflect.TypeOf((*MockRepo)(nil).GetPropertyServiceSubscribers), arg0, arg1)
+}
+
+// GetPropertyServices mocks base method.
+func (m *MockRepo) GetPropertyServices(arg0 context.Context, arg1 uuid.UUID) ([]model.PropertyServiceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPropertyServices", arg0, arg1)
+	ret0, _ := ret[0].([]model.PropertyServiceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPropertyServices indicates an expected call of GetPropertyServices.
+func (mr *MockRepoMockRecorder) GetPropertyServices(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPropertyServices", reflect.TypeOf((*MockRepo)(nil).GetPropertyServices), arg0, arg1)
+}
+
 // GetPropertyVerificationRequest mocks base method.
 func (m *MockRepo) GetPropertyVerificationRequest(arg0 context.Context, arg1 int64) (model.PropertyVerificationRequest, error) {
 	m.ctrl.T.Helper()
@@ -372,6 +580,20 @@ func (mr *MockRepoMockRecorder) UpdatePropertyManagerRequest(arg0, arg1, arg2, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePropertyManagerRequest", reflect.TypeOf((*MockRepo)(nil).UpdatePropertyManagerRequest), arg0, arg1, arg2, arg3)
 }
 
+// UpdatePropertyService mocks base method.
+func (m *MockRepo) UpdatePropertyService(arg0 context.Context, arg1 *dto1.UpdatePropertyService) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePropertyService", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePropertyService indicates an expected call of UpdatePropertyService.
+func (mr *MockRepoMockRecorder) UpdatePropertyService(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePropertyService", reflect.TypeOf((*MockRepo)(nil).UpdatePropertyService), arg0, arg1)
+}
+
 // UpdatePropertyVerificationRequestStatus mocks base method.
 func (m *MockRepo) UpdatePropertyVerificationRequestStatus(arg0 context.Context, arg1 int64, arg2 *dto1.UpdatePropertyVerificationRequestStatus) error {
 	m.ctrl.T.Helper()
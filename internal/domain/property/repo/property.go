@@ -348,6 +348,27 @@ func (r *repo) GetListingsOfProperty(ctx context.Context, id uuid.UUID, query *l
 	return r.dao.GetListingsOfProperty(ctx, params)
 }
 
+// answerFilterExpr matches the values of an answer against the filter.
+// Ranges only match answers of their type, so the casts never apply to other values.
+func answerFilterExpr(sb *sqlbuilder.SelectBuilder, f *application_dto.ApplicationAnswerFilter) string {
+	switch f.Op {
+	case application_dto.ANSWERFILTER_CONTAINS:
+		return fmt.Sprintf("EXISTS (SELECT 1 FROM unnest(application_answers.values) v WHERE v ILIKE %s)", sb.Var("%"+f.Value+"%"))
+	case application_dto.ANSWERFILTER_GTE, application_dto.ANSWERFILTER_LTE:
+		cmp, cast := ">=", "NUMERIC"
+		if f.Op == application_dto.ANSWERFILTER_LTE {
+			cmp = "<="
+		}
+		if f.Type == database.APPLICATIONQUESTIONTYPEDATE {
+			cast = "DATE"
+		}
+		return fmt.Sprintf("CASE WHEN application_answers.type = %s THEN application_answers.values[1]::%s %s %s::%s ELSE FALSE END",
+			sb.Var(string(f.Type)), cast, cmp, sb.Var(f.Value), cast)
+	default:
+		return fmt.Sprintf("%s = ANY(application_answers.values)", sb.Var(f.Value))
+	}
+}
+
 func (r *repo) GetApplicationsOfProperty(ctx context.Context, id uuid.UUID, query *application_dto.GetApplicationsOfPropertyQuery) ([]int64, error) {
 	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
 	sb.Select("id")
@@ -362,6 +383,12 @@ func (r *repo) GetApplicationsOfProperty(ctx context.Context, id uuid.UUID, quer
 		}
 		andExprs = append(andExprs, sb.In("listing_id::text", sqlbuilder.List(idsStr)))
 	}
+	for _, f := range query.AnswerFilters {
+		andExprs = append(andExprs, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM application_answers WHERE application_answers.application_id = applications.id AND application_answers.question_id = %s AND %s)",
+			sb.Var(f.QuestionID), answerFilterExpr(sb, &f),
+		))
+	}
 	sb.Where(andExprs...)
 	if query.Limit != nil {
 		sb.Limit(int(*query.Limit))
@@ -495,71 +522,83 @@ func (r *repo) IsPropertyVisible(ctx context.Context, uid, pid uuid.UUID) (bool,
 }
 
 func (r *repo) UpdateProperty(ctx context.Context, data *property_dto.UpdateProperty) error {
-	// update property media
-	if data.Media != nil {
-		// delete all old media records
-		sb := sqlbuilder.PostgreSQL.NewDeleteBuilder()
-		sb.DeleteFrom("property_media")
-		sb.Where(sb.Equal("property_id::text", data.ID.String()))
-		sql, args := sb.Build()
-		_, err := r.dao.Exec(ctx, sql, args...)
-		if err != nil {
-			return err
-		}
-		// then insert new media records
-		media := []database.PropertyMedium{}
-		for _, m := range data.Media {
-			newMedia, err := r.dao.CreatePropertyMedia(ctx, database.CreatePropertyMediaParams{
-				PropertyID:  data.ID,
-				Url:         m.Url,
-				Type:        m.Type,
-				Description: types.StrN(m.Description),
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		// update property media
+		if data.Media != nil {
+			// delete all old media records
+			sb := sqlbuilder.PostgreSQL.NewDeleteBuilder()
+			sb.DeleteFrom("property_media")
+			sb.Where(sb.Equal("property_id::text", data.ID.String()))
+			sql, args := sb.Build()
+			_, err := tx.Exec(ctx, sql, args...)
+			if err != nil {
+				return err
+			}
+			// then insert new media records
+			media := []database.PropertyMedium{}
+			for _, m := range data.Media {
+				newMedia, err := tx.CreatePropertyMedia(ctx, database.CreatePropertyMediaParams{
+					PropertyID:  data.ID,
+					Url:         m.Url,
+					Type:        m.Type,
+					Description: types.StrN(m.Description),
+				})
+				if err != nil {
+					return err
+				}
+				media = append(media, newMedia)
+			}
+			err = tx.UpdateProperty(ctx, database.UpdatePropertyParams{
+				ID:           data.ID,
+				PrimaryImage: pgtype.Int8{Valid: true, Int64: media[*data.PrimaryImage].ID}, // data.PrimaryImage must exist
 			})
 			if err != nil {
 				return err
 			}
-			media = append(media, newMedia)
+			data.PrimaryImage = nil
 		}
-		err = r.dao.UpdateProperty(ctx, database.UpdatePropertyParams{
-			ID:           data.ID,
-			PrimaryImage: pgtype.Int8{Valid: true, Int64: media[*data.PrimaryImage].ID}, // data.PrimaryImage must exist
-		})
-		if err != nil {
-			return err
+
+		// update property features
+		if data.Features != nil {
+			// delete all old features records
+			sb := sqlbuilder.PostgreSQL.NewDeleteBuilder()
+			sb.DeleteFrom("property_features")
+			sb.Where(sb.Equal("property_id::text", data.ID.String()))
+			sql, args := sb.Build()
+			_, err := tx.Exec(ctx, sql, args...)
+			if err != nil {
+				return err
+			}
+			// then insert new features records
+			for _, f := range data.Features {
+				_, err := tx.CreatePropertyFeature(ctx, database.CreatePropertyFeatureParams{
+					PropertyID:  data.ID,
+					FeatureID:   f.FeatureID,
+					Description: types.StrN(f.Description),
+				})
+				if err != nil {
+					return err
+				}
+			}
 		}
-		data.PrimaryImage = nil
-	}
 
-	// update property features
-	if data.Features != nil {
-		// delete all old features records
-		sb := sqlbuilder.PostgreSQL.NewDeleteBuilder()
-		sb.DeleteFrom("property_features")
-		sb.Where(sb.Equal("property_id::text", data.ID.String()))
-		sql, args := sb.Build()
-		_, err := r.dao.Exec(ctx, sql, args...)
+		err := tx.UpdateProperty(ctx, data.ToUpdatePropertyDB())
 		if err != nil {
 			return err
 		}
-		// then insert new features records
-		for _, f := range data.Features {
-			_, err := r.dao.CreatePropertyFeature(ctx, database.CreatePropertyFeatureParams{
-				PropertyID:  data.ID,
-				FeatureID:   f.FeatureID,
-				Description: types.StrN(f.Description),
-			})
-			if err != nil {
-				return nil
-			}
-		}
-	}
 
-	err := r.dao.UpdateProperty(ctx, data.ToUpdatePropertyDB())
-	if err != nil {
-		return err
+		// the listings of the property embed it in their search documents
+		return tx.CreatePropertySearchOutboxEvents(ctx, database.CreatePropertySearchOutboxEventsParams{
+			Source:     database.SEARCHOUTBOXSOURCEPROPERTY,
+			PropertyID: data.ID,
+		})
+	})
+	if txErr != nil {
+		return txErr
 	}
 
 	// update cache
+	r.redisClient.Del(ctx, fmt.Sprintf("property:%s", data.ID.String()))
 	property, err := r.GetPropertyById(ctx, data.ID)
 	if err == nil {
 		r.savePropertyToCache(ctx, property)
@@ -569,7 +608,25 @@ func (r *repo) UpdateProperty(ctx context.Context, data *property_dto.UpdateProp
 }
 
 func (r *repo) DeleteProperty(ctx context.Context, id uuid.UUID) error {
-	return r.dao.DeleteProperty(ctx, id)
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		// record the events before the listings are deleted along with the property
+		err := tx.CreatePropertySearchOutboxEvents(ctx, database.CreatePropertySearchOutboxEventsParams{
+			Source:     database.SEARCHOUTBOXSOURCEPROPERTY,
+			PropertyID: id,
+		})
+		if err != nil {
+			return err
+		}
+		return tx.DeleteProperty(ctx, id)
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	// evict cache
+	r.redisClient.Del(ctx, fmt.Sprintf("property:%s", id.String()))
+
+	return nil
 }
 
 func (r *repo) FilterVisibleProperties(ctx context.Context, pids []uuid.UUID, uid uuid.UUID) ([]uuid.UUID, error) {
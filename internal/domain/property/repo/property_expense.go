@@ -0,0 +1,49 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	property_dto "github.com/user2410/rrms-backend/internal/domain/property/dto"
+	property_model "github.com/user2410/rrms-backend/internal/domain/property/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+func (r *repo) CreatePropertyExpense(ctx context.Context, data *property_dto.CreatePropertyExpense) (property_model.PropertyExpenseModel, error) {
+	res, err := r.dao.CreatePropertyExpense(ctx, data.ToCreatePropertyExpenseDB())
+	if err != nil {
+		return property_model.PropertyExpenseModel{}, err
+	}
+	return property_model.ToPropertyExpenseModel(&res), nil
+}
+
+func (r *repo) GetPropertyExpense(ctx context.Context, id int64) (property_model.PropertyExpenseModel, error) {
+	res, err := r.dao.GetPropertyExpense(ctx, id)
+	if err != nil {
+		return property_model.PropertyExpenseModel{}, err
+	}
+	return property_model.ToPropertyExpenseModel(&res), nil
+}
+
+// GetPropertyExpenses returns expenses incurred in [from, to)
+func (r *repo) GetPropertyExpenses(ctx context.Context, pid uuid.UUID, from, to time.Time) ([]property_model.PropertyExpenseModel, error) {
+	res, err := r.dao.GetPropertyExpenses(ctx, database.GetPropertyExpensesParams{
+		PropertyID: pid,
+		StartDate:  pgtype.Date{Time: from, Valid: true},
+		EndDate:    pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]property_model.PropertyExpenseModel, 0, len(res))
+	for i := range res {
+		items = append(items, property_model.ToPropertyExpenseModel(&res[i]))
+	}
+	return items, nil
+}
+
+func (r *repo) DeletePropertyExpense(ctx context.Context, id int64) error {
+	return r.dao.DeletePropertyExpense(ctx, id)
+}
@@ -0,0 +1,138 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	property_dto "github.com/user2410/rrms-backend/internal/domain/property/dto"
+	property_model "github.com/user2410/rrms-backend/internal/domain/property/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func (r *repo) CreatePropertyService(ctx context.Context, data *property_dto.CreatePropertyService) (property_model.PropertyServiceModel, error) {
+	res, err := r.dao.CreatePropertyService(ctx, data.ToCreatePropertyServiceDB())
+	if err != nil {
+		return property_model.PropertyServiceModel{}, err
+	}
+	return property_model.ToPropertyServiceModel(&res), nil
+}
+
+func (r *repo) GetPropertyService(ctx context.Context, id int64) (property_model.PropertyServiceModel, error) {
+	res, err := r.dao.GetPropertyService(ctx, id)
+	if err != nil {
+		return property_model.PropertyServiceModel{}, err
+	}
+	return property_model.ToPropertyServiceModel(&res), nil
+}
+
+func (r *repo) GetPropertyServices(ctx context.Context, pid uuid.UUID) ([]property_model.PropertyServiceModel, error) {
+	res, err := r.dao.GetPropertyServices(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]property_model.PropertyServiceModel, 0, len(res))
+	for i := range res {
+		items = append(items, property_model.ToPropertyServiceModel(&res[i]))
+	}
+	return items, nil
+}
+
+func (r *repo) UpdatePropertyService(ctx context.Context, data *property_dto.UpdatePropertyService) error {
+	return r.dao.UpdatePropertyService(ctx, data.ToUpdatePropertyServiceDB())
+}
+
+func (r *repo) DeletePropertyService(ctx context.Context, id int64) error {
+	return r.dao.DeletePropertyService(ctx, id)
+}
+
+func (r *repo) GetPropertyServiceSubscribers(ctx context.Context, id int64) ([]int64, error) {
+	res, err := r.dao.GetPropertyServiceSubscribers(ctx, pgtype.Int8{Int64: id, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	rentalIds := make([]int64, 0, len(res))
+	for _, item := range res {
+		rentalIds = append(rentalIds, item.RentalID)
+	}
+	return rentalIds, nil
+}
+
+func (r *repo) CreatePropertyServicePriceChange(ctx context.Context, data *property_dto.CreatePropertyServicePriceChange) (property_model.PropertyServicePriceChange, error) {
+	var res database.PropertyServicePriceChange
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		// only the latest price change of a service can be applied
+		err := tx.DismissPendingPropertyServicePriceChanges(ctx, data.ServiceID)
+		if err != nil {
+			return err
+		}
+		res, err = tx.CreatePropertyServicePriceChange(ctx, data.ToCreatePropertyServicePriceChangeDB())
+		return err
+	})
+	if txErr != nil {
+		return property_model.PropertyServicePriceChange{}, txErr
+	}
+	return property_model.ToPropertyServicePriceChange(&res), nil
+}
+
+func (r *repo) GetPropertyServicePriceChange(ctx context.Context, id int64) (property_model.PropertyServicePriceChange, error) {
+	res, err := r.dao.GetPropertyServicePriceChange(ctx, id)
+	if err != nil {
+		return property_model.PropertyServicePriceChange{}, err
+	}
+	return property_model.ToPropertyServicePriceChange(&res), nil
+}
+
+func (r *repo) GetPropertyServicePriceChanges(ctx context.Context, serviceId int64) ([]property_model.PropertyServicePriceChange, error) {
+	res, err := r.dao.GetPropertyServicePriceChanges(ctx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]property_model.PropertyServicePriceChange, 0, len(res))
+	for i := range res {
+		items = append(items, property_model.ToPropertyServicePriceChange(&res[i]))
+	}
+	return items, nil
+}
+
+func (r *repo) DismissPropertyServicePriceChange(ctx context.Context, id int64) error {
+	return r.dao.UpdatePropertyServicePriceChangeStatus(ctx, database.UpdatePropertyServicePriceChangeStatusParams{
+		ID:     id,
+		Status: database.SERVICEPRICECHANGESTATUSDISMISSED,
+	})
+}
+
+// ApplyPropertyServicePriceChange sets the monthly price of the rental services subscribed to the catalog service
+// and re-prices their planned payments. Already issued payments are left untouched.
+func (r *repo) ApplyPropertyServicePriceChange(ctx context.Context, id, serviceId int64, monthlyPrice float32) ([]int64, error) {
+	var rentalIds []int64
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		rss, err := tx.UpdateSubscribedRentalServicesPrice(ctx, database.UpdateSubscribedRentalServicesPriceParams{
+			Price:     types.Float32N(&monthlyPrice),
+			ServiceID: pgtype.Int8{Int64: serviceId, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		for _, rs := range rss {
+			err = tx.UpdatePlannedRentalServicePayments(ctx, database.UpdatePlannedRentalServicePaymentsParams{
+				Price:           monthlyPrice,
+				RentalID:        rs.RentalID,
+				RentalServiceID: rs.ID,
+			})
+			if err != nil {
+				return err
+			}
+			rentalIds = append(rentalIds, rs.RentalID)
+		}
+		return tx.UpdatePropertyServicePriceChangeStatus(ctx, database.UpdatePropertyServicePriceChangeStatusParams{
+			ID:     id,
+			Status: database.SERVICEPRICECHANGESTATUSAPPLIED,
+		})
+	})
+	if txErr != nil {
+		return nil, txErr
+	}
+	return rentalIds, nil
+}
@@ -0,0 +1,152 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/property/dto"
+	"github.com/user2410/rrms-backend/internal/domain/property/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/random"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func TestPropertyServicePriceChanges(t *testing.T) {
+	ctx := context.Background()
+	p := NewRandomPropertyDB(t, testPropertyRepo, testAuthRepo)
+
+	ps, err := testPropertyRepo.CreatePropertyService(ctx, &dto.CreatePropertyService{
+		PropertyID:   p.ID,
+		Type:         database.PROPERTYSERVICETYPEINTERNET,
+		Name:         "Internet",
+		SetupBy:      "LANDLORD",
+		DefaultPrice: types.Ptr[float32](300000),
+	})
+	require.NoError(t, err)
+	require.Equal(t, database.SERVICEBILLINGFREQUENCYMONTHLY, ps.BillingFrequency)
+
+	first, err := testPropertyRepo.CreatePropertyServicePriceChange(ctx, &dto.CreatePropertyServicePriceChange{
+		ServiceID:           ps.ID,
+		CreatorID:           p.CreatorID,
+		OldPrice:            ps.DefaultPrice,
+		NewPrice:            350000,
+		OldBillingFrequency: database.SERVICEBILLINGFREQUENCYMONTHLY,
+		NewBillingFrequency: database.SERVICEBILLINGFREQUENCYMONTHLY,
+	})
+	require.NoError(t, err)
+	require.Equal(t, database.SERVICEPRICECHANGESTATUSPENDING, first.Status)
+	require.Equal(t, float32(300000), *first.OldPrice)
+	require.Equal(t, float32(350000), first.NewPrice)
+
+	// a change of the billing cycle alone is recorded as well
+	second, err := testPropertyRepo.CreatePropertyServicePriceChange(ctx, &dto.CreatePropertyServicePriceChange{
+		ServiceID:           ps.ID,
+		CreatorID:           p.CreatorID,
+		OldPrice:            ps.DefaultPrice,
+		NewPrice:            300000,
+		OldBillingFrequency: database.SERVICEBILLINGFREQUENCYMONTHLY,
+		NewBillingFrequency: database.SERVICEBILLINGFREQUENCYQUARTERLY,
+	})
+	require.NoError(t, err)
+	require.Equal(t, database.SERVICEBILLINGFREQUENCYMONTHLY, second.OldBillingFrequency)
+	require.Equal(t, database.SERVICEBILLINGFREQUENCYQUARTERLY, second.NewBillingFrequency)
+
+	// only the latest price change stays pending
+	first, err = testPropertyRepo.GetPropertyServicePriceChange(ctx, first.ID)
+	require.NoError(t, err)
+	require.Equal(t, database.SERVICEPRICECHANGESTATUSDISMISSED, first.Status)
+
+	pcs, err := testPropertyRepo.GetPropertyServicePriceChanges(ctx, ps.ID)
+	require.NoError(t, err)
+	require.Len(t, pcs, 2)
+	require.Equal(t, second.ID, pcs[0].ID)
+	require.Equal(t, database.SERVICEPRICECHANGESTATUSPENDING, pcs[0].Status)
+
+	// no rental subscribes to the service yet
+	rentalIds, err := testPropertyRepo.ApplyPropertyServicePriceChange(ctx, second.ID, ps.ID, 100000)
+	require.NoError(t, err)
+	require.Empty(t, rentalIds)
+	second, err = testPropertyRepo.GetPropertyServicePriceChange(ctx, second.ID)
+	require.NoError(t, err)
+	require.Equal(t, database.SERVICEPRICECHANGESTATUSAPPLIED, second.Status)
+}
+
+// newTestRental inserts an in-progress rental of a new unit of the property,
+// the property repo has no rental helpers
+func newTestRental(t *testing.T, p *model.PropertyModel) int64 {
+	ctx := context.Background()
+	var unitId uuid.UUID
+	err := testDao.QueryRow(ctx, `INSERT INTO "units" ("property_id", "type") VALUES ($1, 'ROOM') RETURNING "id"`, p.ID).Scan(&unitId)
+	require.NoError(t, err)
+
+	var rentalId int64
+	err = testDao.QueryRow(ctx, `INSERT INTO "rentals" (
+		"creator_id", "property_id", "unit_id", "profile_image", "tenant_type", "tenant_name", "tenant_phone", "tenant_email",
+		"start_date", "movein_date", "rental_period", "rental_price", "rental_payment_basis", "rental_intention",
+		"electricity_setup_by", "water_setup_by"
+	) VALUES ($1, $2, $3, '', 'INDIVIDUAL', 'Nguyễn Văn An', '0912345678', 'an@example.com',
+		CURRENT_DATE, CURRENT_DATE, 12, 5000000, 1, 'RESIDENCE', 'LANDLORD', 'LANDLORD') RETURNING "id"`,
+		p.CreatorID, p.ID, unitId).Scan(&rentalId)
+	require.NoError(t, err)
+	return rentalId
+}
+
+func TestApplyPropertyServicePriceChangePayments(t *testing.T) {
+	ctx := context.Background()
+	p := NewRandomPropertyDB(t, testPropertyRepo, testAuthRepo)
+	ps, err := testPropertyRepo.CreatePropertyService(ctx, &dto.CreatePropertyService{
+		PropertyID:   p.ID,
+		Type:         database.PROPERTYSERVICETYPEINTERNET,
+		Name:         "Internet",
+		SetupBy:      "LANDLORD",
+		DefaultPrice: types.Ptr[float32](300000),
+	})
+	require.NoError(t, err)
+	rentalId := newTestRental(t, p)
+
+	// the subscribed service and a service whose id starts with the id of the subscribed one, like services 1 and 10
+	subscribed := random.RandomInt64(1e12, 1e13)
+	other := subscribed * 10
+	for _, rs := range []struct {
+		id        int64
+		catalogId *int64
+	}{{subscribed, &ps.ID}, {other, nil}} {
+		_, err = testDao.Exec(ctx, `INSERT INTO "rental_services" ("id", "rental_id", "name", "setup_by", "price", "catalog_service_id") VALUES ($1, $2, 'Internet', 'LANDLORD', 300000, $3)`,
+			rs.id, rentalId, rs.catalogId)
+		require.NoError(t, err)
+	}
+	paymentCode := func(serviceId int64) string {
+		return fmt.Sprintf("%d_SERVICE_%d_%s", rentalId, serviceId, random.RandomNumericStr(12))
+	}
+	codes := map[int64]string{subscribed: paymentCode(subscribed), other: paymentCode(other)}
+	for _, code := range codes {
+		_, err = testDao.Exec(ctx, `INSERT INTO "rental_payments" ("code", "rental_id", "start_date", "end_date", "status", "amount") VALUES ($1, $2, CURRENT_DATE, CURRENT_DATE + 30, 'PLAN', 300000)`,
+			code, rentalId)
+		require.NoError(t, err)
+	}
+	getAmount := func(code string) float32 {
+		var amount float32
+		require.NoError(t, testDao.QueryRow(ctx, `SELECT "amount" FROM "rental_payments" WHERE "code" = $1`, code).Scan(&amount))
+		return amount
+	}
+
+	pc, err := testPropertyRepo.CreatePropertyServicePriceChange(ctx, &dto.CreatePropertyServicePriceChange{
+		ServiceID:           ps.ID,
+		CreatorID:           p.CreatorID,
+		OldPrice:            ps.DefaultPrice,
+		NewPrice:            360000,
+		OldBillingFrequency: database.SERVICEBILLINGFREQUENCYMONTHLY,
+		NewBillingFrequency: database.SERVICEBILLINGFREQUENCYMONTHLY,
+	})
+	require.NoError(t, err)
+	rentalIds, err := testPropertyRepo.ApplyPropertyServicePriceChange(ctx, pc.ID, ps.ID, 360000)
+	require.NoError(t, err)
+	require.Equal(t, []int64{rentalId}, rentalIds)
+
+	require.NotEqual(t, float32(300000), getAmount(codes[subscribed]))
+	// the payments of the other service are left untouched
+	require.Equal(t, float32(300000), getAmount(codes[other]))
+}
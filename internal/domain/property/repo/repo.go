@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	application_dto "github.com/user2410/rrms-backend/internal/domain/application/dto"
@@ -39,6 +40,22 @@ type Repo interface {
 	GetPropertiesVerificationStatus(ctx context.Context, ids []uuid.UUID) ([]property_dto.GetPropertyVerificationStatus, error)
 	GetPropertyVerificationRequestsOfProperty(ctx context.Context, pid uuid.UUID, limit, offset int32) ([]property_model.PropertyVerificationRequest, error)
 	UpdatePropertyVerificationRequestStatus(ctx context.Context, id int64, data *property_dto.UpdatePropertyVerificationRequestStatus) error
+
+	CreatePropertyService(ctx context.Context, data *property_dto.CreatePropertyService) (property_model.PropertyServiceModel, error)
+	GetPropertyService(ctx context.Context, id int64) (property_model.PropertyServiceModel, error)
+	GetPropertyServices(ctx context.Context, pid uuid.UUID) ([]property_model.PropertyServiceModel, error)
+	UpdatePropertyService(ctx context.Context, data *property_dto.UpdatePropertyService) error
+	DeletePropertyService(ctx context.Context, id int64) error
+	GetPropertyServiceSubscribers(ctx context.Context, id int64) ([]int64, error) // Get ids of in-progress rentals subscribed to the catalog service
+	CreatePropertyServicePriceChange(ctx context.Context, data *property_dto.CreatePropertyServicePriceChange) (property_model.PropertyServicePriceChange, error)
+	GetPropertyServicePriceChange(ctx context.Context, id int64) (property_model.PropertyServicePriceChange, error)
+	GetPropertyServicePriceChanges(ctx context.Context, serviceId int64) ([]property_model.PropertyServicePriceChange, error)
+	DismissPropertyServicePriceChange(ctx context.Context, id int64) error
+	ApplyPropertyServicePriceChange(ctx context.Context, id, serviceId int64, monthlyPrice float32) ([]int64, error)
+	CreatePropertyExpense(ctx context.Context, data *property_dto.CreatePropertyExpense) (property_model.PropertyExpenseModel, error)
+	GetPropertyExpense(ctx context.Context, id int64) (property_model.PropertyExpenseModel, error)
+	GetPropertyExpenses(ctx context.Context, pid uuid.UUID, from, to time.Time) ([]property_model.PropertyExpenseModel, error)
+	DeletePropertyExpense(ctx context.Context, id int64) error
 }
 
 type repo struct {
@@ -14,9 +14,18 @@ import (
 )
 
 func (r *repo) CreatePropertyVerificationRequest(ctx context.Context, data *property_dto.CreatePropertyVerificationRequest) (property_model.PropertyVerificationRequest, error) {
-	res, err := r.dao.CreatePropertyVerificationRequest(ctx, data.ToCreatePropertyVerificationRequestParams())
-	if err != nil {
-		return property_model.PropertyVerificationRequest{}, err
+	var res database.PropertyVerificationRequest
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		var err error
+		res, err = tx.CreatePropertyVerificationRequest(ctx, data.ToCreatePropertyVerificationRequestParams())
+		if err != nil {
+			return err
+		}
+		// the latest request determines the verification status indexed with the listings
+		return tx.CreateVerificationSearchOutboxEvents(ctx, res.ID)
+	})
+	if txErr != nil {
+		return property_model.PropertyVerificationRequest{}, txErr
 	}
 	return property_model.ToPropertyVerificationRequest(&res), nil
 }
@@ -150,12 +159,22 @@ func (r *repo) GetPropertiesVerificationStatus(ctx context.Context, ids []uuid.U
 }
 
 func (r *repo) UpdatePropertyVerificationRequestStatus(ctx context.Context, id int64, data *property_dto.UpdatePropertyVerificationRequestStatus) error {
-	return r.dao.UpdatePropertyVerificationRequest(ctx, database.UpdatePropertyVerificationRequestParams{
-		ID: id,
-		Status: database.NullPROPERTYVERIFICATIONSTATUS{
-			PROPERTYVERIFICATIONSTATUS: data.Status,
-			Valid:                      data.Status != "",
-		},
-		Feedback: types.StrN(data.Feedback),
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		err := tx.UpdatePropertyVerificationRequest(ctx, database.UpdatePropertyVerificationRequestParams{
+			ID: id,
+			Status: database.NullPROPERTYVERIFICATIONSTATUS{
+				PROPERTYVERIFICATIONSTATUS: data.Status,
+				Valid:                      data.Status != "",
+			},
+			Feedback: types.StrN(data.Feedback),
+		})
+		if err != nil {
+			return err
+		}
+		return tx.CreateVerificationSearchOutboxEvents(ctx, id)
 	})
+	if txErr != nil {
+		return txErr
+	}
+	return nil
 }
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	unit_model "github.com/user2410/rrms-backend/internal/domain/unit/model"
+	unit_utils "github.com/user2410/rrms-backend/internal/domain/unit/utils"
+)
+
+// GetPropertyCalendar returns the occupancy timeline of every unit of the property over [from, to)
+func (s *service) GetPropertyCalendar(id uuid.UUID, from, to time.Time) ([]unit_model.UnitTimeline, error) {
+	from, to = unit_utils.TruncateToDate(from), unit_utils.TruncateToDate(to)
+	units, err := s.domainRepo.UnitRepo.GetUnitsOfProperty(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+	uids := make([]uuid.UUID, 0, len(units))
+	for _, u := range units {
+		uids = append(uids, u.ID)
+	}
+	if len(uids) == 0 {
+		return []unit_model.UnitTimeline{}, nil
+	}
+
+	occupancies, err := s.domainRepo.UnitRepo.GetUnitOccupancies(context.Background(), uids, from, to)
+	if err != nil {
+		return nil, err
+	}
+	availableFrom, err := s.domainRepo.UnitRepo.GetUnitsAvailableFrom(context.Background(), uids, from)
+	if err != nil {
+		return nil, err
+	}
+	return unit_utils.BuildUnitTimelines(uids, occupancies, availableFrom, from, to), nil
+}
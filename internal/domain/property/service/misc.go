@@ -39,6 +39,14 @@ func (s *service) GetApplicationsOfProperty(id uuid.UUID, query *application_dto
 	return s.domainRepo.ApplicationRepo.GetApplicationsByIds(context.Background(), ids, query.Fields)
 }
 
+func (s *service) GetScreeningConfig(id uuid.UUID) (application_model.ScreeningConfigModel, error) {
+	return s.domainRepo.ApplicationRepo.GetScreeningConfig(context.Background(), id)
+}
+
+func (s *service) UpdateScreeningConfig(id uuid.UUID, data *application_dto.UpdateScreeningConfig) (application_model.ScreeningConfigModel, error) {
+	return s.domainRepo.ApplicationRepo.UpsertScreeningConfig(context.Background(), id, data)
+}
+
 func (s *service) GetRentalsOfProperty(id uuid.UUID, query *rental_dto.GetRentalsOfPropertyQuery) ([]rental_model.RentalModel, error) {
 	ids, err := s.domainRepo.PropertyRepo.GetRentalsOfProperty(context.Background(), id, query)
 	if err != nil {
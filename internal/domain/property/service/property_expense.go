@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	property_dto "github.com/user2410/rrms-backend/internal/domain/property/dto"
+	property_model "github.com/user2410/rrms-backend/internal/domain/property/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+func (s *service) CreatePropertyExpense(data *property_dto.CreatePropertyExpense) (property_model.PropertyExpenseModel, error) {
+	return s.domainRepo.PropertyRepo.CreatePropertyExpense(context.Background(), data)
+}
+
+func (s *service) GetPropertyExpenses(pid uuid.UUID, query *property_dto.GetPropertyExpensesQuery) ([]property_model.PropertyExpenseModel, error) {
+	return s.domainRepo.PropertyRepo.GetPropertyExpenses(context.Background(), pid, query.From, query.To)
+}
+
+// DeletePropertyExpense deletes the expense only if it belongs to the given property
+func (s *service) DeletePropertyExpense(pid uuid.UUID, id int64) error {
+	pe, err := s.domainRepo.PropertyRepo.GetPropertyExpense(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if pe.PropertyID != pid {
+		return database.ErrRecordNotFound
+	}
+	return s.domainRepo.PropertyRepo.DeletePropertyExpense(context.Background(), id)
+}
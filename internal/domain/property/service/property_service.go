@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	property_dto "github.com/user2410/rrms-backend/internal/domain/property/dto"
+	property_model "github.com/user2410/rrms-backend/internal/domain/property/model"
+	property_utils "github.com/user2410/rrms-backend/internal/domain/property/utils"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+var ErrPropertyServicePriceChangeNotPending = errors.New("price change is no longer pending")
+
+func (s *service) CreatePropertyService(data *property_dto.CreatePropertyService) (property_model.PropertyServiceModel, error) {
+	return s.domainRepo.PropertyRepo.CreatePropertyService(context.Background(), data)
+}
+
+func (s *service) GetPropertyServices(pid uuid.UUID) ([]property_model.PropertyServiceModel, error) {
+	return s.domainRepo.PropertyRepo.GetPropertyServices(context.Background(), pid)
+}
+
+// GetPropertyService returns the catalog service only if it belongs to the given property
+func (s *service) GetPropertyService(pid uuid.UUID, id int64) (property_model.PropertyServiceModel, error) {
+	ps, err := s.domainRepo.PropertyRepo.GetPropertyService(context.Background(), id)
+	if err != nil {
+		return property_model.PropertyServiceModel{}, err
+	}
+	if ps.PropertyID != pid {
+		return property_model.PropertyServiceModel{}, database.ErrRecordNotFound
+	}
+	return ps, nil
+}
+
+// UpdatePropertyService updates a catalog service. A change of the default price or of the billing cycle is not propagated to
+// the rentals subscribed to the service right away: a pending price change is recorded instead and
+// the managers have to apply it explicitly.
+func (s *service) UpdatePropertyService(pid uuid.UUID, data *property_dto.UpdatePropertyService, userId uuid.UUID) (*property_dto.UpdatePropertyServiceResponse, error) {
+	ps, err := s.GetPropertyService(pid, data.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.domainRepo.PropertyRepo.UpdatePropertyService(context.Background(), data); err != nil {
+		return nil, err
+	}
+
+	res := property_dto.UpdatePropertyServiceResponse{}
+	change := property_utils.GetServicePriceChange(&ps, data)
+	if change == nil {
+		return &res, nil
+	}
+	rentalIds, err := s.domainRepo.PropertyRepo.GetPropertyServiceSubscribers(context.Background(), ps.ID)
+	if err != nil {
+		return nil, err
+	}
+	res.Subscribers = len(rentalIds)
+	if len(rentalIds) == 0 {
+		return &res, nil
+	}
+	change.CreatorID = userId
+	pc, err := s.domainRepo.PropertyRepo.CreatePropertyServicePriceChange(context.Background(), change)
+	if err != nil {
+		return nil, err
+	}
+	res.PriceChange = &pc
+	return &res, nil
+}
+
+func (s *service) DeletePropertyService(pid uuid.UUID, id int64) error {
+	if _, err := s.GetPropertyService(pid, id); err != nil {
+		return err
+	}
+	return s.domainRepo.PropertyRepo.DeletePropertyService(context.Background(), id)
+}
+
+func (s *service) GetPropertyServicePriceChanges(pid uuid.UUID, sid int64) ([]property_model.PropertyServicePriceChange, error) {
+	if _, err := s.GetPropertyService(pid, sid); err != nil {
+		return nil, err
+	}
+	return s.domainRepo.PropertyRepo.GetPropertyServicePriceChanges(context.Background(), sid)
+}
+
+// UpdatePropertyServicePriceChangeStatus applies a pending price change to the subscribed rentals or dismisses it.
+func (s *service) UpdatePropertyServicePriceChangeStatus(pid uuid.UUID, sid, id int64, data *property_dto.UpdatePropertyServicePriceChangeStatus) error {
+	ps, err := s.GetPropertyService(pid, sid)
+	if err != nil {
+		return err
+	}
+	pc, err := s.domainRepo.PropertyRepo.GetPropertyServicePriceChange(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if pc.ServiceID != ps.ID {
+		return database.ErrRecordNotFound
+	}
+	if pc.Status != database.SERVICEPRICECHANGESTATUSPENDING {
+		return ErrPropertyServicePriceChangeNotPending
+	}
+
+	if data.Status == database.SERVICEPRICECHANGESTATUSDISMISSED {
+		return s.domainRepo.PropertyRepo.DismissPropertyServicePriceChange(context.Background(), id)
+	}
+	_, err = s.domainRepo.PropertyRepo.ApplyPropertyServicePriceChange(
+		context.Background(),
+		pc.ID, ps.ID,
+		property_model.GetMonthlyPrice(pc.NewPrice, pc.NewBillingFrequency),
+	)
+	return err
+}
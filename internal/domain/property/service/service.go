@@ -44,8 +44,11 @@ type Service interface {
 	GetPropertyById(id uuid.UUID) (*property_model.PropertyModel, error)
 	GetPropertiesByIds(ids []uuid.UUID, fields []string, userId uuid.UUID) ([]property_model.PropertyModel, error)
 	GetUnitsOfProperty(id uuid.UUID) ([]unit_model.UnitModel, error)
+	GetPropertyCalendar(id uuid.UUID, from, to time.Time) ([]unit_model.UnitTimeline, error)
 	GetListingsOfProperty(id uuid.UUID, query *listing_dto.GetListingsOfPropertyQuery) ([]listing_model.ListingModel, error)
 	GetApplicationsOfProperty(id uuid.UUID, query *application_dto.GetApplicationsOfPropertyQuery) ([]application_model.ApplicationModel, error)
+	GetScreeningConfig(id uuid.UUID) (application_model.ScreeningConfigModel, error)
+	UpdateScreeningConfig(id uuid.UUID, data *application_dto.UpdateScreeningConfig) (application_model.ScreeningConfigModel, error)
 	GetManagedProperties(userId uuid.UUID, query *property_dto.GetPropertiesQuery) (int, []GetManagedPropertiesItem, error)
 	SearchListingCombination(data *property_dto.SearchPropertyCombinationQuery) (*property_dto.SearchPropertyCombinationResponse, error)
 	PreUpdateProperty(data *property_dto.PreUpdateProperty, creatorID uuid.UUID) error
@@ -70,6 +73,17 @@ type Service interface {
 		request *property_model.PropertyVerificationRequest,
 		data *property_dto.UpdatePropertyVerificationRequestStatus,
 	) error
+
+	CreatePropertyService(data *property_dto.CreatePropertyService) (property_model.PropertyServiceModel, error)
+	GetPropertyServices(pid uuid.UUID) ([]property_model.PropertyServiceModel, error)
+	GetPropertyService(pid uuid.UUID, id int64) (property_model.PropertyServiceModel, error)
+	UpdatePropertyService(pid uuid.UUID, data *property_dto.UpdatePropertyService, userId uuid.UUID) (*property_dto.UpdatePropertyServiceResponse, error)
+	DeletePropertyService(pid uuid.UUID, id int64) error
+	GetPropertyServicePriceChanges(pid uuid.UUID, sid int64) ([]property_model.PropertyServicePriceChange, error)
+	UpdatePropertyServicePriceChangeStatus(pid uuid.UUID, sid, id int64, data *property_dto.UpdatePropertyServicePriceChangeStatus) error
+	CreatePropertyExpense(data *property_dto.CreatePropertyExpense) (property_model.PropertyExpenseModel, error)
+	GetPropertyExpenses(pid uuid.UUID, query *property_dto.GetPropertyExpensesQuery) ([]property_model.PropertyExpenseModel, error)
+	DeletePropertyExpense(pid uuid.UUID, id int64) error
 }
 
 type service struct {
@@ -2,22 +2,16 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
 	"path/filepath"
 	"time"
 
-	"github.com/elastic/go-elasticsearch/v8/typedapi/core/update"
 	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
 	"github.com/user2410/rrms-backend/internal/infrastructure/database"
-	"github.com/user2410/rrms-backend/internal/infrastructure/es"
-	"github.com/user2410/rrms-backend/internal/utils/types"
 
 	"github.com/google/uuid"
 	auth_model "github.com/user2410/rrms-backend/internal/domain/auth/model"
-	listing_dto "github.com/user2410/rrms-backend/internal/domain/listing/dto"
 	misc_dto "github.com/user2410/rrms-backend/internal/domain/misc/dto"
 	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
 	property_dto "github.com/user2410/rrms-backend/internal/domain/property/dto"
@@ -120,38 +114,6 @@ func (s *service) UpdatePropertyVerificationRequestStatus(id int64, data *proper
 		return err
 	}
 
-	// update corresponding listings documents in ES
-	doc := map[string]interface{}{
-		"property": map[string]interface{}{
-			"verification_status": data.Status,
-		},
-	}
-	docByte, err := json.Marshal(doc)
-	if err != nil {
-		return err
-	}
-
-	listingIds, err := s.domainRepo.PropertyRepo.GetListingsOfProperty(context.Background(), request.PropertyID, &listing_dto.GetListingsOfPropertyQuery{
-		Limit:  types.Ptr[int32](math.MaxInt32),
-		Offset: types.Ptr[int32](0),
-	})
-	if err != nil {
-		return err
-	}
-
-	// TODO: batch update
-	client := s.esClient.GetTypedClient()
-	for _, listingId := range listingIds {
-		_, err = client.Update(string(es.LISTINGINDEX), listingId.String()).
-			Request(&update.Request{
-				Doc: json.RawMessage(docByte),
-			}).
-			Do(context.Background())
-		if err != nil {
-			return err
-		}
-	}
-
 	// send notification about the update of verification status
 	err = s.asynctaskDistributor.DistributeTaskJSON(context.Background(), asynctask.PROPERTY_VERIFICATION_UPDATE, property_dto.UpdatePropertyVerificationRequestStatusNotification{
 		Request:    &request,
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"github.com/user2410/rrms-backend/internal/domain/property/dto"
+	"github.com/user2410/rrms-backend/internal/domain/property/model"
+)
+
+// GetServicePriceChange returns the price change an update makes to a catalog service, or nil if neither its price
+// nor its billing cycle changes. Changing the billing cycle alone changes the monthly price billed to the rentals.
+func GetServicePriceChange(ps *model.PropertyServiceModel, data *dto.UpdatePropertyService) *dto.CreatePropertyServicePriceChange {
+	newPrice := data.DefaultPrice
+	if newPrice == nil {
+		newPrice = ps.DefaultPrice
+	}
+	newFrequency := data.BillingFrequency
+	if newFrequency == "" {
+		newFrequency = ps.BillingFrequency
+	}
+	if newPrice == nil {
+		return nil
+	}
+	if ps.DefaultPrice != nil && *ps.DefaultPrice == *newPrice && ps.BillingFrequency == newFrequency {
+		return nil
+	}
+	return &dto.CreatePropertyServicePriceChange{
+		ServiceID:           ps.ID,
+		OldPrice:            ps.DefaultPrice,
+		NewPrice:            *newPrice,
+		OldBillingFrequency: ps.BillingFrequency,
+		NewBillingFrequency: newFrequency,
+	}
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/property/dto"
+	"github.com/user2410/rrms-backend/internal/domain/property/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func TestGetServicePriceChange(t *testing.T) {
+	ps := model.PropertyServiceModel{
+		ID:               1,
+		DefaultPrice:     types.Ptr[float32](300000),
+		BillingFrequency: database.SERVICEBILLINGFREQUENCYMONTHLY,
+	}
+
+	// no price nor billing cycle change
+	require.Nil(t, GetServicePriceChange(&ps, &dto.UpdatePropertyService{ID: ps.ID, Name: types.Ptr("Internet")}))
+	require.Nil(t, GetServicePriceChange(&ps, &dto.UpdatePropertyService{
+		ID:               ps.ID,
+		DefaultPrice:     types.Ptr[float32](300000),
+		BillingFrequency: database.SERVICEBILLINGFREQUENCYMONTHLY,
+	}))
+
+	pc := GetServicePriceChange(&ps, &dto.UpdatePropertyService{ID: ps.ID, DefaultPrice: types.Ptr[float32](350000)})
+	require.NotNil(t, pc)
+	require.Equal(t, dto.CreatePropertyServicePriceChange{
+		ServiceID:           ps.ID,
+		OldPrice:            ps.DefaultPrice,
+		NewPrice:            350000,
+		OldBillingFrequency: database.SERVICEBILLINGFREQUENCYMONTHLY,
+		NewBillingFrequency: database.SERVICEBILLINGFREQUENCYMONTHLY,
+	}, *pc)
+
+	// the same price billed quarterly divides the monthly price by 3
+	pc = GetServicePriceChange(&ps, &dto.UpdatePropertyService{ID: ps.ID, BillingFrequency: database.SERVICEBILLINGFREQUENCYQUARTERLY})
+	require.NotNil(t, pc)
+	require.Equal(t, float32(300000), pc.NewPrice)
+	require.Equal(t, database.SERVICEBILLINGFREQUENCYMONTHLY, pc.OldBillingFrequency)
+	require.Equal(t, database.SERVICEBILLINGFREQUENCYQUARTERLY, pc.NewBillingFrequency)
+	require.Equal(t, float32(100000), model.GetMonthlyPrice(pc.NewPrice, pc.NewBillingFrequency))
+
+	// a service without a price has nothing to bill until one is set
+	ps.DefaultPrice = nil
+	require.Nil(t, GetServicePriceChange(&ps, &dto.UpdatePropertyService{ID: ps.ID, BillingFrequency: database.SERVICEBILLINGFREQUENCYYEARLY}))
+	pc = GetServicePriceChange(&ps, &dto.UpdatePropertyService{ID: ps.ID, DefaultPrice: types.Ptr[float32](1200000)})
+	require.NotNil(t, pc)
+	require.Nil(t, pc.OldPrice)
+	require.Equal(t, float32(1200000), pc.NewPrice)
+}
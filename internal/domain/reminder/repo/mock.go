@@ -88,6 +88,20 @@ func (mr *MockRepoMockRecorder) CreateReminder(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReminder", reflect.TypeOf((*MockRepo)(nil).CreateReminder), arg0, arg1)
 }
 
+// DeleteReminder mocks base method.
+func (m *MockRepo) DeleteReminder(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReminder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReminder indicates an expected call of DeleteReminder.
+func (mr *MockRepoMockRecorder) DeleteReminder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReminder", reflect.TypeOf((*MockRepo)(nil).DeleteReminder), arg0, arg1)
+}
+
 // GetReminder mocks base method.
 func (m *MockRepo) GetReminder(arg0 context.Context, arg1 int64) (model.ReminderModel, error) {
 	m.ctrl.T.Helper()
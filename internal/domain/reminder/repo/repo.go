@@ -22,6 +22,7 @@ type Repo interface {
 	CheckReminderVisibility(ctx context.Context, id int64, userId uuid.UUID) (bool, error)
 	CheckOverlappingReminder(ctx context.Context, userID uuid.UUID, startTime, endTime time.Time) (bool, error)
 	UpdateReminder(ctx context.Context, data *dto.UpdateReminder) (int, error)
+	DeleteReminder(ctx context.Context, id int64) error
 }
 
 type repo struct {
@@ -143,6 +144,16 @@ func (r *repo) UpdateReminder(ctx context.Context, data *dto.UpdateReminder) (in
 	return len(res), nil
 }
 
+func (r *repo) DeleteReminder(ctx context.Context, id int64) error {
+	if err := r.dao.DeleteReminder(ctx, id); err != nil {
+		return err
+	}
+
+	// remove from cache
+	r.redisClient.Del(ctx, fmt.Sprintf("reminder:%d", id))
+	return nil
+}
+
 func (r *repo) CheckReminderVisibility(ctx context.Context, id int64, userId uuid.UUID) (bool, error) {
 	return r.dao.CheckReminderVisibility(ctx, database.CheckReminderVisibilityParams{
 		ID:        id,
@@ -76,19 +76,22 @@ func (pm *CreateRentalPet) ToCreateRentalPetDB(id int64) database.CreateRentalPe
 }
 
 type CreateRentalService struct {
-	Name     string   `json:"name" validate:"required"`
-	Setupby  string   `json:"setupby" validate:"required,oneof=LANDLORD TENANT"`
-	Provider *string  `json:"provider" validate:"omitempty"`
-	Price    *float32 `json:"price" validate:"omitempty"`
+	// Catalog service of the property picked for the rental, unset fields are filled from the catalog
+	CatalogServiceID *int64   `json:"catalogServiceId" validate:"omitempty"`
+	Name             string   `json:"name" validate:"required_without=CatalogServiceID"`
+	Setupby          string   `json:"setupby" validate:"required_without=CatalogServiceID,omitempty,oneof=LANDLORD TENANT"`
+	Provider         *string  `json:"provider" validate:"omitempty"`
+	Price            *float32 `json:"price" validate:"omitempty"`
 }
 
 func (pm *CreateRentalService) ToCreateRentalServiceDB(id int64) database.CreateRentalServiceParams {
 	return database.CreateRentalServiceParams{
-		RentalID: id,
-		Name:     pm.Name,
-		SetupBy:  pm.Setupby,
-		Provider: types.StrN(pm.Provider),
-		Price:    types.Float32N(pm.Price),
+		RentalID:         id,
+		Name:             pm.Name,
+		SetupBy:          pm.Setupby,
+		Provider:         types.StrN(pm.Provider),
+		Price:            types.Float32N(pm.Price),
+		CatalogServiceID: types.Int64N(pm.CatalogServiceID),
 	}
 }
 
@@ -156,7 +159,7 @@ type CreateRental struct {
 	Coaps    []CreateRentalCoap    `json:"coaps"`
 	Minors   []CreateRentalMinor   `json:"minors"`
 	Pets     []CreateRentalPet     `json:"pets"`
-	Services []CreateRentalService `json:"services"`
+	Services []CreateRentalService `json:"services" validate:"dive"`
 	Policies []CreateRentalPolicy  `json:"policies"`
 }
 
@@ -0,0 +1,7 @@
+package dto
+
+type ExpiringRental struct {
+	RentalID int64 `json:"rentalId"`
+	// Whether any expiry notice has been sent for this rental before
+	Notified bool `json:"notified"`
+}
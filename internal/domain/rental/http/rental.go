@@ -9,6 +9,7 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 	auth_http "github.com/user2410/rrms-backend/internal/domain/auth/http"
 	"github.com/user2410/rrms-backend/internal/domain/rental/dto"
+	"github.com/user2410/rrms-backend/internal/domain/rental/service"
 	"github.com/user2410/rrms-backend/internal/infrastructure/database"
 	"github.com/user2410/rrms-backend/internal/interfaces/rest/responses"
 	"github.com/user2410/rrms-backend/internal/utils/token"
@@ -51,6 +52,12 @@ func (a *adapter) createPreRental() fiber.Handler {
 
 		res, err := a.service.CreatePreRental(&payload, tkPayload.UserID)
 		if err != nil {
+			if errors.Is(err, service.ErrInvalidCatalogService) {
+				return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+			}
+			if errors.Is(err, service.ErrUnitNotAvailable) {
+				return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{"message": err.Error()})
+			}
 			if dbErr, ok := err.(*pgconn.PgError); ok {
 				return responses.DBErrorResponse(ctx, dbErr)
 			}
@@ -181,6 +188,9 @@ func (a *adapter) updatePreRentalState() fiber.Handler {
 			if errors.Is(err, database.ErrRecordNotFound) {
 				return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{"message": err.Error()})
 			}
+			if errors.Is(err, service.ErrUnitNotAvailable) {
+				return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{"message": err.Error()})
+			}
 
 			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
 		}
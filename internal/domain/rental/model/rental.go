@@ -74,19 +74,21 @@ type RentalService struct {
 	RentalID int64  `json:"rental_id"`
 	Name     string `json:"name"`
 	// The party who set up the service, either "LANDLORD" or "TENANT"
-	SetupBy  string   `json:"setupBy"`
-	Provider *string  `json:"provider"`
-	Price    *float32 `json:"price"`
+	SetupBy          string   `json:"setupBy"`
+	Provider         *string  `json:"provider"`
+	Price            *float32 `json:"price"`
+	CatalogServiceID *int64   `json:"catalogServiceId"`
 }
 
 func ToRentalService(pr *database.RentalService) RentalService {
 	return RentalService{
-		ID:       pr.ID,
-		RentalID: pr.RentalID,
-		Name:     pr.Name,
-		SetupBy:  pr.SetupBy,
-		Provider: types.PNStr(pr.Provider),
-		Price:    types.PNFloat32(pr.Price),
+		ID:               pr.ID,
+		RentalID:         pr.RentalID,
+		Name:             pr.Name,
+		SetupBy:          pr.SetupBy,
+		Provider:         types.PNStr(pr.Provider),
+		Price:            types.PNFloat32(pr.Price),
+		CatalogServiceID: types.PNInt64(pr.CatalogServiceID),
 	}
 }
 
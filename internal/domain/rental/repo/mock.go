@@ -147,6 +147,20 @@ func (mr *MockRepoMockRecorder) CreateRentalComplaintReply(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRentalComplaintReply", reflect.TypeOf((*MockRepo)(nil).CreateRentalComplaintReply), arg0, arg1)
 }
 
+// CreateRentalExpiryNotice mocks base method.
+func (m *MockRepo) CreateRentalExpiryNotice(arg0 context.Context, arg1 int64, arg2 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRentalExpiryNotice", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRentalExpiryNotice indicates an expected call of CreateRentalExpiryNotice.
+func (mr *MockRepoMockRecorder) CreateRentalExpiryNotice(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRentalExpiryNotice", reflect.TypeOf((*MockRepo)(nil).CreateRentalExpiryNotice), arg0, arg1, arg2)
+}
+
 // CreateRentalPayment mocks base method.
 func (m *MockRepo) CreateRentalPayment(arg0 context.Context, arg1 *dto.CreateRentalPayment) (model.RentalPayment, error) {
 	m.ctrl.T.Helper()
@@ -222,6 +236,21 @@ func (mr *MockRepoMockRecorder) GetContractsByIds(arg0, arg1, arg2 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractsByIds", reflect.TypeOf((*MockRepo)(nil).GetContractsByIds), arg0, arg1, arg2)
 }
 
+// GetExpiringRentals mocks base method.
+func (m *MockRepo) GetExpiringRentals(arg0 context.Context, arg1 int32) ([]dto.ExpiringRental, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiringRentals", arg0, arg1)
+	ret0, _ := ret[0].([]dto.ExpiringRental)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiringRentals indicates an expected call of GetExpiringRentals.
+func (mr *MockRepoMockRecorder) GetExpiringRentals(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiringRentals", reflect.TypeOf((*MockRepo)(nil).GetExpiringRentals), arg0, arg1)
+}
+
 // GetManagedPreRentals mocks base method.
 func (m *MockRepo) GetManagedPreRentals(arg0 context.Context, arg1 uuid.UUID, arg2 *dto.GetPreRentalsQuery) ([]model.RentalModel, error) {
 	m.ctrl.T.Helper()
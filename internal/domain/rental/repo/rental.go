@@ -465,3 +465,25 @@ func (r *repo) FilterVisibleRentals(ctx context.Context, uid uuid.UUID, ids []in
 
 	return resIDs, err
 }
+
+func (r *repo) GetExpiringRentals(ctx context.Context, daysBefore int32) ([]dto.ExpiringRental, error) {
+	res, err := r.dao.GetExpiringRentals(ctx, daysBefore)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]dto.ExpiringRental, 0, len(res))
+	for _, row := range res {
+		items = append(items, dto.ExpiringRental{
+			RentalID: row.ID,
+			Notified: row.Notified,
+		})
+	}
+	return items, nil
+}
+
+func (r *repo) CreateRentalExpiryNotice(ctx context.Context, rentalId int64, daysBefore int32) error {
+	return r.dao.CreateRentalExpiryNotice(ctx, database.CreateRentalExpiryNoticeParams{
+		RentalID:   rentalId,
+		DaysBefore: daysBefore,
+	})
+}
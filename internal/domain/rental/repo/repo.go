@@ -27,6 +27,8 @@ type Repo interface {
 	CheckRentalVisibility(ctx context.Context, id int64, userId uuid.UUID) (bool, error)
 	FilterVisibleRentals(ctx context.Context, userId uuid.UUID, ids []int64) ([]int64, error)
 	CheckPreRentalVisibility(ctx context.Context, id int64, userId uuid.UUID) (bool, error)
+	GetExpiringRentals(ctx context.Context, daysBefore int32) ([]dto.ExpiringRental, error)
+	CreateRentalExpiryNotice(ctx context.Context, rentalId int64, daysBefore int32) error
 
 	CreateContract(ctx context.Context, data *dto.CreateContract) (*model.ContractModel, error)
 	GetRentalContractsOfUser(ctx context.Context, userId uuid.UUID, query *dto.GetRentalContracts) ([]int64, error)
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	rental_util "github.com/user2410/rrms-backend/internal/domain/rental/utils"
+	unit_model "github.com/user2410/rrms-backend/internal/domain/unit/model"
+	unit_utils "github.com/user2410/rrms-backend/internal/domain/unit/utils"
+)
+
+// checkUnitAvailability makes sure that the unit is not occupied, reserved or blocked during the rental period.
+// The prerental being accepted and the application the rental originates from do not count as conflicts.
+func (s *service) checkUnitAvailability(unitId uuid.UUID, startDate time.Time, rentalPeriod int32, prerentalId int64, applicationId *int64) error {
+	start := unit_utils.TruncateToDate(startDate)
+	end := unit_utils.TruncateToDate(rental_util.GetRentalEndDate(startDate, rentalPeriod))
+	occupancies, err := s.domainRepo.UnitRepo.GetUnitOccupancies(context.Background(), []uuid.UUID{unitId}, start, end)
+	if err != nil {
+		return err
+	}
+	conflicts := unit_utils.FindOccupancyConflicts(occupancies, start, end, func(o *unit_model.UnitOccupancyModel) bool {
+		switch o.Type {
+		case unit_model.UNITOCCUPANCYTYPE_PRERENTAL:
+			return o.RefID == prerentalId
+		case unit_model.UNITOCCUPANCYTYPE_APPLICATION:
+			return applicationId != nil && o.RefID == *applicationId
+		}
+		return false
+	})
+	if len(conflicts) > 0 {
+		return ErrUnitNotAvailable
+	}
+	return nil
+}
@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrInvalidRentalExpired         = errors.New("rental expired")
 	ErrInvalidPaymentTypeTransition = errors.New("invalid type transition")
+	ErrInvalidCatalogService        = errors.New("catalog service does not belong to the property")
+	ErrUnitNotAvailable             = errors.New("unit is not available during the rental period")
 )
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"path/filepath"
@@ -10,7 +11,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/user2410/rrms-backend/internal/domain/rental/dto"
 	rental_model "github.com/user2410/rrms-backend/internal/domain/rental/model"
+	rental_util "github.com/user2410/rrms-backend/internal/domain/rental/utils"
 	"github.com/user2410/rrms-backend/internal/infrastructure/asynctask"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
 	"github.com/user2410/rrms-backend/internal/utils/types"
 	"github.com/user2410/rrms-backend/pkg/ds/set"
 )
@@ -40,6 +43,12 @@ func (s *service) CreatePreRental(data *dto.CreateRental, userId uuid.UUID) (ren
 
 	// TODO: validate applicationId, propertyId, unitId
 	data.CreatorID = userId
+	if err := s.fillCatalogServices(data); err != nil {
+		return rental_model.RentalModel{}, err
+	}
+	if err := s.checkUnitAvailability(data.UnitID, data.StartDate, data.RentalPeriod, 0, data.ApplicationID); err != nil {
+		return rental_model.RentalModel{}, err
+	}
 	rental, err := s.domainRepo.RentalRepo.CreatePreRental(context.Background(), data)
 	if err != nil {
 		return rental_model.RentalModel{}, err
@@ -51,11 +60,40 @@ func (s *service) CreatePreRental(data *dto.CreateRental, userId uuid.UUID) (ren
 			Secret: s.secret,
 		}
 		err = s.asynctaskDistributor.DistributeTaskJSON(context.Background(), asynctask.RENTAL_PRERENTAL_NEW, notifyData)
+		if err != nil {
+			return rental, err
+		}
+	}
+
+	// the unit is taken, the other applicants are moved to the waitlist
+	if data.ApplicationID != nil {
+		err = s.asynctaskDistributor.DistributeTaskJSON(context.Background(), asynctask.APPLICATION_WAITLIST_CREATE, *data.ApplicationID)
 	}
 
 	return rental, err
 }
 
+// fillCatalogServices completes the services picked from the property service catalog with the catalog values
+func (s *service) fillCatalogServices(data *dto.CreateRental) error {
+	for i := range data.Services {
+		rs := &data.Services[i]
+		if rs.CatalogServiceID == nil {
+			continue
+		}
+		ps, err := s.domainRepo.PropertyRepo.GetPropertyService(context.Background(), *rs.CatalogServiceID)
+		if err != nil {
+			if errors.Is(err, database.ErrRecordNotFound) {
+				return ErrInvalidCatalogService
+			}
+			return err
+		}
+		if !rental_util.FillCatalogService(rs, &ps, data.PropertyID) {
+			return ErrInvalidCatalogService
+		}
+	}
+	return nil
+}
+
 // func (s *service) CreateRental(data *dto.CreateRental, userId uuid.UUID) (rental_model.RentalModel, error) {
 // 	expiryDate := data.MoveinDate.AddDate(0, int(data.RentalPeriod), 0)
 // 	today := time.Now().Truncate(24 * time.Hour) // time representing today at 00:00:00
@@ -173,8 +211,16 @@ func (s *service) UpdatePreRentalState(id int64, payload *dto.UpdatePreRental) (
 	}
 
 	if payload.State == "APPROVED" {
+		err = s.checkUnitAvailability(preRental.UnitID, preRental.StartDate, preRental.RentalPeriod, preRental.ID, preRental.ApplicationID)
+		if err != nil {
+			return 0, err
+		}
 		rental, err = s.domainRepo.RentalRepo.MovePreRentalToRental(context.Background(), id)
 		if err != nil {
+			// another rental of the unit was created for the period since the check
+			if database.ErrorCode(err) == database.ExclusionViolation {
+				return 0, ErrUnitNotAvailable
+			}
 			return 0, err
 		}
 		// plan rental payments
@@ -203,6 +249,13 @@ func (s *service) UpdatePreRentalState(id int64, payload *dto.UpdatePreRental) (
 		if err != nil {
 			return 0, err
 		}
+		// the unit is free again, give it to the next applicant on the waitlist
+		if preRental.ApplicationID != nil {
+			err = s.asynctaskDistributor.DistributeTaskJSON(context.Background(), asynctask.APPLICATION_WAITLIST_PROMOTE, *preRental.ApplicationID)
+			if err != nil {
+				return 0, err
+			}
+		}
 		// send notification to managers about the rejection
 		// err = s.NotifyUpdatePreRental(&preRental, nil, payload)
 		notifyData = dto.NotifyUpdatePreRental{
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	misc_dto "github.com/user2410/rrms-backend/internal/domain/misc/dto"
+	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
+	property_model "github.com/user2410/rrms-backend/internal/domain/property/model"
+	reminder_dto "github.com/user2410/rrms-backend/internal/domain/reminder/dto"
+	rental_model "github.com/user2410/rrms-backend/internal/domain/rental/model"
+	rental_util "github.com/user2410/rrms-backend/internal/domain/rental/utils"
+	unit_model "github.com/user2410/rrms-backend/internal/domain/unit/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	template_util "github.com/user2410/rrms-backend/internal/utils/template"
+	html_util "github.com/user2410/rrms-backend/internal/utils/template/html"
+	text_util "github.com/user2410/rrms-backend/internal/utils/template/text"
+)
+
+// notifyExpiringRentals sends tiered expiry notices for in-progress rentals approaching their end date.
+// Tiers are processed from the nearest to the farthest so that a rental only gets the most relevant notice.
+func (s *service) notifyExpiringRentals() error {
+	var errs []error
+	for _, tier := range rental_util.RentalExpiryNoticeTiers {
+		ers, err := s.domainRepo.RentalRepo.GetExpiringRentals(context.Background(), tier)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, er := range ers {
+			r, err := s.domainRepo.RentalRepo.GetRental(context.Background(), er.RentalID)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if err = s.NotifyRentalExpiry(&r, tier); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			// managers get a calendar reminder on the first notice only
+			if !er.Notified {
+				if err = s.createRentalExpiryReminders(&r); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			if err = s.domainRepo.RentalRepo.CreateRentalExpiryNotice(context.Background(), r.ID, tier); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *service) createRentalExpiryReminders(r *rental_model.RentalModel) error {
+	managers, err := s.domainRepo.PropertyRepo.GetPropertyManagers(context.Background(), r.PropertyID)
+	if err != nil {
+		return err
+	}
+	ps, err := s.domainRepo.PropertyRepo.GetPropertiesByIds(context.Background(), []uuid.UUID{r.PropertyID}, []string{"name", "full_address"})
+	if err != nil {
+		return err
+	}
+	if len(ps) == 0 {
+		return database.ErrRecordNotFound
+	}
+
+	endDate := rental_util.GetRentalEndDate(r.StartDate, r.RentalPeriod)
+	startAt := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 8, 0, 0, 0, time.Local)
+	note := fmt.Sprintf("%s/manage/rentals/rental/%d", s.feSite, r.ID)
+	for _, m := range managers {
+		_, err = s.domainRepo.ReminderRepo.CreateReminder(context.Background(), &reminder_dto.CreateReminder{
+			CreatorID: m.ManagerID,
+			Title:     fmt.Sprintf("Kết thúc hợp đồng thuê nhà của %s tại %s", r.TenantName, ps[0].Name),
+			StartAt:   startAt,
+			EndAt:     startAt.Add(time.Hour),
+			Note:      &note,
+			Location:  ps[0].FullAddress,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *service) NotifyRentalExpiry(
+	r *rental_model.RentalModel,
+	daysBefore int32,
+) error {
+	// get target emails and push tokens
+	var (
+		targets  = make([]misc_dto.CreateNotificationTarget, 0)
+		property property_model.PropertyModel
+		unit     unit_model.UnitModel
+	)
+	{
+		ts, err := s.mService.GetNotificationManagersTargets(r.PropertyID)
+		if err != nil {
+			return err
+		}
+		targets = append(targets, ts...)
+		t, err := s.mService.GetNotificationTenantTargets(r.TenantID, r.TenantEmail)
+		if err != nil {
+			return err
+		}
+		targets = append(targets, t)
+
+		ps, err := s.domainRepo.PropertyRepo.GetPropertiesByIds(context.Background(), []uuid.UUID{r.PropertyID}, []string{"name"})
+		if err != nil {
+			return err
+		}
+		if len(ps) == 0 {
+			return database.ErrRecordNotFound
+		}
+		property = ps[0]
+
+		us, err := s.domainRepo.UnitRepo.GetUnitsByIds(context.Background(), []uuid.UUID{r.UnitID}, []string{"name"})
+		if err != nil {
+			return err
+		}
+		if len(us) == 0 {
+			return database.ErrRecordNotFound
+		}
+		unit = us[0]
+	}
+
+	endDate := rental_util.GetRentalEndDate(r.StartDate, r.RentalPeriod)
+	data := struct {
+		FESite   string
+		Rental   *rental_model.RentalModel
+		Property property_model.PropertyModel
+		Unit     unit_model.UnitModel
+		EndDate  time.Time
+		DaysLeft int32
+	}{
+		FESite:   s.feSite,
+		Rental:   r,
+		Property: property,
+		Unit:     unit,
+		EndDate:  endDate,
+		DaysLeft: rental_util.GetRentalRemainingDays(r.StartDate, r.RentalPeriod, time.Now()),
+	}
+
+	title, err := text_util.RenderText(
+		data,
+		fmt.Sprintf("%s/title/notify_rentalexpiry.txt", basePath),
+		map[string]any{
+			"Dereference": template_util.Dereference("-"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	emailContent, err := html_util.RenderHtml(
+		data,
+		fmt.Sprintf("%s/email/notify_rentalexpiry.gohtml", basePath),
+		map[string]any{
+			"Dereference": template_util.Dereference("-"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	pushContent, err := text_util.RenderText(
+		data,
+		fmt.Sprintf("%s/push/notify_rentalexpiry.txt", basePath),
+		map[string]any{
+			"Dereference": template_util.Dereference("-"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	cn := misc_dto.CreateNotification{
+		Title:   string(title),
+		Content: string(emailContent),
+		Data: map[string]interface{}{
+			"notificationType": misc_service.NOTIFICATIONTYPE_RENTALEXPIRY,
+			"rentalId":         r.ID,
+			"daysBefore":       daysBefore,
+		},
+		Targets: func() []misc_dto.CreateNotificationTarget {
+			var ts []misc_dto.CreateNotificationTarget
+			for _, t := range targets {
+				ts = append(ts, misc_dto.CreateNotificationTarget{
+					UserId: t.UserId,
+					Emails: t.Emails,
+					Tokens: []string{},
+				})
+			}
+			return ts
+		}(),
+	}
+	if err = s.mService.SendNotification(&cn); err != nil {
+		return err
+	}
+
+	cn.Content = string(pushContent)
+	cn.Targets = func() []misc_dto.CreateNotificationTarget {
+		var ts []misc_dto.CreateNotificationTarget
+		for _, t := range targets {
+			ts = append(ts, misc_dto.CreateNotificationTarget{
+				UserId: t.UserId,
+				Emails: []string{},
+				Tokens: t.Tokens,
+			})
+		}
+		return ts
+	}()
+	return s.mService.SendNotification(&cn)
+}
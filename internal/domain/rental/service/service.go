@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"log"
 
 	"github.com/google/uuid"
 	"github.com/robfig/cron/v3"
@@ -106,6 +107,10 @@ type Service interface {
 		status database.RENTALCOMPLAINTSTATUS,
 		updatedBy uuid.UUID,
 	) error
+	NotifyRentalExpiry(
+		r *rental_model.RentalModel,
+		daysBefore int32,
+	) error
 }
 
 type service struct {
@@ -146,7 +151,7 @@ func NewService(
 	return res
 }
 
-// SetupCronjob periodically checks for rental payment due date and send reminder to user
+// SetupCronjob periodically checks for rental payment due date and rental end date and send reminder to user
 func (s *service) setupCronjob(c *cron.Cron) ([]cron.EntryID, error) {
 	var (
 		entryID cron.EntryID
@@ -158,6 +163,10 @@ func (s *service) setupCronjob(c *cron.Cron) ([]cron.EntryID, error) {
 		s.domainRepo.RentalRepo.PlanRentalPayments(context.Background())
 		// update fine payments
 		s.domainRepo.RentalRepo.UpdateFinePayments(context.Background())
+		// notify rentals approaching their end date
+		if err := s.notifyExpiringRentals(); err != nil {
+			log.Println("failed to notify expiring rentals:", err)
+		}
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"time"
+)
+
+// Tiers (in days before the rental end date) at which expiry notices are sent, in ascending order
+var RentalExpiryNoticeTiers = []int32{7, 30, 60}
+
+// GetRentalEndDate returns start date + rental period (in months).
+// Like Postgres' INTERVAL arithmetic, the day is clamped to the last day of the target month (e.g. Jan 31st + 1 month = Feb 28th)
+func GetRentalEndDate(startDate time.Time, rentalPeriod int32) time.Time {
+	y, m, d := startDate.Date()
+	firstOfTargetMonth := time.Date(y, m+time.Month(rentalPeriod), 1, 0, 0, 0, 0, startDate.Location())
+	lastDay := firstOfTargetMonth.AddDate(0, 1, -1).Day()
+	if d > lastDay {
+		d = lastDay
+	}
+	return time.Date(firstOfTargetMonth.Year(), firstOfTargetMonth.Month(), d, 0, 0, 0, 0, startDate.Location())
+}
+
+// GetRentalRemainingDays returns the number of days from now until the rental end date, negative if the rental has already ended
+func GetRentalRemainingDays(startDate time.Time, rentalPeriod int32, now time.Time) int32 {
+	endDate := GetRentalEndDate(startDate, rentalPeriod)
+	y, m, d := now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, endDate.Location())
+	return int32(endDate.Sub(today).Hours() / 24)
+}
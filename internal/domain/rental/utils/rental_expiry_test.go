@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRentalEndDate(t *testing.T) {
+	startDate, err := time.Parse("2006-01-02", "2024-03-15")
+	require.NoError(t, err)
+	require.Equal(t, "2025-03-15", GetRentalEndDate(startDate, 12).Format("2006-01-02"))
+	require.Equal(t, "2024-03-15", GetRentalEndDate(startDate, 0).Format("2006-01-02"))
+
+	// clamp to the last day of month
+	startDate, err = time.Parse("2006-01-02", "2024-01-31")
+	require.NoError(t, err)
+	require.Equal(t, "2024-02-29", GetRentalEndDate(startDate, 1).Format("2006-01-02"))
+	require.Equal(t, "2025-02-28", GetRentalEndDate(startDate, 13).Format("2006-01-02"))
+	require.Equal(t, "2024-04-30", GetRentalEndDate(startDate, 3).Format("2006-01-02"))
+}
+
+func TestGetRentalRemainingDays(t *testing.T) {
+	startDate, err := time.Parse("2006-01-02", "2024-01-01")
+	require.NoError(t, err)
+
+	now, err := time.Parse("2006-01-02 15:04", "2024-06-24 18:30")
+	require.NoError(t, err)
+	require.Equal(t, int32(7), GetRentalRemainingDays(startDate, 6, now))
+
+	now, err = time.Parse("2006-01-02", "2024-07-01")
+	require.NoError(t, err)
+	require.Equal(t, int32(0), GetRentalRemainingDays(startDate, 6, now))
+
+	now, err = time.Parse("2006-01-02", "2024-07-11")
+	require.NoError(t, err)
+	require.Equal(t, int32(-10), GetRentalRemainingDays(startDate, 6, now))
+}
@@ -52,6 +52,14 @@ func GetRentalPaymentCode(rentalID int64, paymentType RentalPaymentType, service
 	return fmt.Sprintf("%d_%s_%02d%d%02d%d", rentalID, paymentType, startDate.Month(), startDate.Year(), endDate.Month(), endDate.Year())
 }
 
+// GetRentalPaymentTypeName returns the display name of a payment type, or the type itself if it is unknown
+func GetRentalPaymentTypeName(t RentalPaymentType) string {
+	if name, ok := mapRentalPaymentTypeToServiceName[t]; ok {
+		return name
+	}
+	return string(t)
+}
+
 func GetServiceName(rpCode string, rServices []model.RentalService) (string, error) {
 	parts := strings.Split(rpCode, "_")
 	if len(parts) < 3 {
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"github.com/google/uuid"
+	property_model "github.com/user2410/rrms-backend/internal/domain/property/model"
+	"github.com/user2410/rrms-backend/internal/domain/rental/dto"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// FillCatalogService completes a rental service picked from the property service catalog with the catalog values,
+// the catalog price is converted to a monthly price. It returns false if the catalog service belongs to another property.
+func FillCatalogService(rs *dto.CreateRentalService, ps *property_model.PropertyServiceModel, propertyId uuid.UUID) bool {
+	if ps.PropertyID != propertyId {
+		return false
+	}
+	if rs.Name == "" {
+		rs.Name = ps.Name
+	}
+	if rs.Setupby == "" {
+		rs.Setupby = ps.SetupBy
+	}
+	if rs.Provider == nil {
+		rs.Provider = ps.Provider
+	}
+	if rs.Price == nil && ps.DefaultPrice != nil {
+		rs.Price = types.Ptr(property_model.GetMonthlyPrice(*ps.DefaultPrice, ps.BillingFrequency))
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	property_model "github.com/user2410/rrms-backend/internal/domain/property/model"
+	"github.com/user2410/rrms-backend/internal/domain/rental/dto"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+	"github.com/user2410/rrms-backend/internal/utils/validation"
+)
+
+func TestCreateRentalServiceValidation(t *testing.T) {
+	valid := []dto.CreateRentalService{
+		{Name: "Internet", Setupby: "LANDLORD"},
+		// the name and the party setting up the service come from the catalog
+		{CatalogServiceID: types.Ptr[int64](1)},
+		{CatalogServiceID: types.Ptr[int64](1), Setupby: "TENANT"},
+	}
+	for _, rs := range valid {
+		require.Empty(t, validation.ValidateStruct(nil, rs), rs)
+	}
+
+	invalid := []dto.CreateRentalService{
+		{Name: "Internet"},
+		{Setupby: "LANDLORD"},
+		{Name: "Internet", Setupby: "OWNER"},
+		{CatalogServiceID: types.Ptr[int64](1), Setupby: "OWNER"},
+	}
+	for _, rs := range invalid {
+		require.NotEmpty(t, validation.ValidateStruct(nil, rs), rs)
+	}
+
+	// services are validated with the rental
+	errs := validation.ValidateStruct(nil, dto.CreateRental{Services: invalid[:1]})
+	require.Contains(t, errs, validation.ErrorResponse{IsError: true, FailedField: "Setupby", Tag: "required_without", Value: ""})
+}
+
+func TestFillCatalogService(t *testing.T) {
+	ps := property_model.PropertyServiceModel{
+		ID:               1,
+		PropertyID:       uuid.New(),
+		Type:             database.PROPERTYSERVICETYPEINTERNET,
+		Name:             "Internet",
+		SetupBy:          "LANDLORD",
+		Provider:         types.Ptr("VNPT"),
+		DefaultPrice:     types.Ptr[float32](600000),
+		BillingFrequency: database.SERVICEBILLINGFREQUENCYQUARTERLY,
+	}
+
+	rs := dto.CreateRentalService{CatalogServiceID: &ps.ID}
+	require.False(t, FillCatalogService(&rs, &ps, uuid.New()))
+	require.Equal(t, dto.CreateRentalService{CatalogServiceID: &ps.ID}, rs)
+
+	require.True(t, FillCatalogService(&rs, &ps, ps.PropertyID))
+	require.Equal(t, "Internet", rs.Name)
+	require.Equal(t, "LANDLORD", rs.Setupby)
+	require.Equal(t, "VNPT", *rs.Provider)
+	// the quarterly price is billed monthly
+	require.Equal(t, float32(200000), *rs.Price)
+
+	// the values given for the rental are kept
+	rs = dto.CreateRentalService{
+		CatalogServiceID: &ps.ID,
+		Name:             "Wifi",
+		Setupby:          "TENANT",
+		Price:            types.Ptr[float32](150000),
+	}
+	require.True(t, FillCatalogService(&rs, &ps, ps.PropertyID))
+	require.Equal(t, "Wifi", rs.Name)
+	require.Equal(t, "TENANT", rs.Setupby)
+	require.Equal(t, float32(150000), *rs.Price)
+}
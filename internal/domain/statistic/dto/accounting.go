@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ACCOUNTINGSOURCE string
+
+const (
+	ACCOUNTINGSOURCE_RENTALPAYMENT ACCOUNTINGSOURCE = "RENTAL_PAYMENT"
+	ACCOUNTINGSOURCE_LISTINGFEE    ACCOUNTINGSOURCE = "LISTING_FEE"
+	ACCOUNTINGSOURCE_REFUND        ACCOUNTINGSOURCE = "REFUND"
+)
+
+// Accounting export in a bookkeeping format, JSON is returned when Format is omitted.
+// Both From and To are inclusive.
+type AccountingExportQuery struct {
+	Format     string     `query:"format" validate:"omitempty,oneof=GL IIF MISA"`
+	From       time.Time  `query:"from" validate:"required"`
+	To         time.Time  `query:"to" validate:"required,gtefield=From"`
+	PropertyID *uuid.UUID `query:"propertyId" validate:"omitempty"`
+}
+
+// A money movement to be booked: a collected rental payment, a paid listing fee or a refunded listing fee.
+// Category is the RentalPaymentType of rental payments and empty otherwise.
+type AccountingTransaction struct {
+	Source       ACCOUNTINGSOURCE `json:"source"`
+	Reference    string           `json:"reference"`
+	Date         time.Time        `json:"date"`
+	Category     string           `json:"category"`
+	Description  string           `json:"description"`
+	PropertyID   *uuid.UUID       `json:"propertyId"`
+	PropertyName string           `json:"propertyName"`
+	Amount       float32          `json:"amount"`
+}
+
+// A double-entry journal line: Amount is debited to DebitAccount and credited to CreditAccount
+type AccountingEntry struct {
+	AccountingTransaction
+	DebitAccount  string `json:"debitAccount"`
+	CreditAccount string `json:"creditAccount"`
+}
@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListingAnalyticsQuery selects the days from StartDate to EndDate, both included
+type ListingAnalyticsQuery struct {
+	StartDate time.Time `query:"startDate" validate:"required"`
+	EndDate   time.Time `query:"endDate" validate:"required,gtefield=StartDate"`
+}
+
+type ListingAnalyticsCounts struct {
+	// number of sessions the listings appeared in the search results of
+	Impressions      int64 `json:"impressions"`
+	Views            int64 `json:"views"`
+	Contacts         int64 `json:"contacts"`
+	ApplicationLinks int64 `json:"applicationLinks"`
+	Applications     int64 `json:"applications"`
+	// number of tenants who attended a viewing
+	Viewings int64 `json:"viewings"`
+}
+
+func (c *ListingAnalyticsCounts) Add(o *ListingAnalyticsCounts) {
+	c.Impressions += o.Impressions
+	c.Views += o.Views
+	c.Contacts += o.Contacts
+	c.ApplicationLinks += o.ApplicationLinks
+	c.Applications += o.Applications
+	c.Viewings += o.Viewings
+}
+
+// ListingConversionRates are the ratios between the successive stages of the funnel,
+// impressions to views to contacts and applications, and contacts to attended viewings. A rate is 0 when its previous stage is empty.
+type ListingConversionRates struct {
+	ViewRate        float64 `json:"viewRate"`
+	ContactRate     float64 `json:"contactRate"`
+	ApplicationRate float64 `json:"applicationRate"`
+	ViewingRate     float64 `json:"viewingRate"`
+}
+
+type ListingAnalyticsDay struct {
+	ListingAnalyticsCounts
+	Date     time.Time `json:"date"`
+	Priority int32     `json:"priority"`
+}
+
+type ListingFunnel struct {
+	ListingID uuid.UUID              `json:"listingId"`
+	Days      []ListingAnalyticsDay  `json:"days"`
+	Totals    ListingAnalyticsCounts `json:"totals"`
+	Rates     ListingConversionRates `json:"rates"`
+}
+
+// ListingPriorityStats are the performances of the listings of a priority level, across the platform
+type ListingPriorityStats struct {
+	Priority int32 `json:"priority"`
+	Listings int64 `json:"listings"`
+	// number of (listing, day) pairs with at least one event
+	ListingDays int64                  `json:"listingDays"`
+	Totals      ListingAnalyticsCounts `json:"totals"`
+	// averages per listing and day
+	AvgImpressions  float64                `json:"avgImpressions"`
+	AvgViews        float64                `json:"avgViews"`
+	AvgContacts     float64                `json:"avgContacts"`
+	AvgApplications float64                `json:"avgApplications"`
+	Rates           ListingConversionRates `json:"rates"`
+}
@@ -94,24 +94,3 @@ type ListingsSuggestionItem struct {
 type ListingsSuggestionResult struct {
 	Hits []ListingsSuggestionItem `json:"hits"`
 }
-
-type ListingSuggestionQuery struct {
-	PTypes       []string `query:"ptypes" validate:"omitempty"`
-	PFeatures    []int64  `query:"pfeatures" validate:"omitempty"`
-	PMinArea     *float32 `query:"pminArea" validate:"omitempty"`
-	PMaxArea     *float32 `query:"pmaxArea" validate:"omitempty"`
-	PCity        []string `query:"pcity" validate:"omitempty"`
-	PDistrict    []string `query:"pdistrict" validate:"omitempty"`
-	PWard        []string `query:"pward" validate:"omitempty"`
-	POrientation []string `query:"porientation" validate:"omitempty"`
-	// PProject             []string `query:"pproject" validate:"omitempty"`
-	UNumberOfLivingRooms *int32   `query:"unumberOfLivingRooms" validate:"omitempty"`
-	UNumberOfBedrooms    *int32   `query:"unumberOfBedrooms" validate:"omitempty"`
-	UNumberOfBathrooms   *int32   `query:"unumberOfBathrooms" validate:"omitempty"`
-	UNumberOfToilets     *int32   `query:"unumberOfToilets" validate:"omitempty"`
-	UNumberOfKitchens    *int32   `query:"unumberOfKitchens" validate:"omitempty"`
-	UNumberOfBalconies   *int32   `query:"unumberOfBalconies" validate:"omitempty"`
-	UAmenities           []int64  `query:"uamenities" validate:"omitempty"`
-	LMinPrice            *float32 `json:"lminPrice"`
-	LMaxPrice            *float32 `json:"lmaxPrice"`
-}
@@ -74,3 +74,21 @@ type TenantArrearsStatistic struct {
 	Total    float32         `json:"total"`
 	Payments []RentalPayment `json:"payments"`
 }
+
+type OverdueRentalsQuery struct {
+	Limit  int32 `query:"limit" validate:"omitempty,gte=0"`
+	Offset int32 `query:"offset" validate:"omitempty,gte=0"`
+}
+
+// Rental past its end date but still INPROGRESS
+type OverdueRentalItem struct {
+	RentalID     int64     `json:"rentalId"`
+	PropertyID   uuid.UUID `json:"propertyId"`
+	UnitID       uuid.UUID `json:"unitId"`
+	TenantID     uuid.UUID `json:"tenantId"`
+	TenantName   string    `json:"tenantName"`
+	StartDate    time.Time `json:"startDate"`
+	RentalPeriod int32     `json:"rentalPeriod"`
+	EndDate      time.Time `json:"endDate"`
+	OverdueDays  int32     `json:"overdueDays"`
+}
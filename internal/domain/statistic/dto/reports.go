@@ -0,0 +1,117 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+// Format of an exported report, JSON is returned when it is omitted
+type ReportExportQuery struct {
+	Format string `query:"format" validate:"omitempty,oneof=CSV XLSX PDF"`
+}
+
+type RentRollQuery struct {
+	ReportExportQuery
+	PropertyID *uuid.UUID `query:"propertyId" validate:"omitempty"`
+}
+
+// A unit of the rent roll, rental fields are nil when the unit is vacant
+type RentRollItem struct {
+	UnitID       uuid.UUID  `json:"unitId"`
+	UnitName     string     `json:"unitName"`
+	PropertyID   uuid.UUID  `json:"propertyId"`
+	PropertyName string     `json:"propertyName"`
+	RentalID     *int64     `json:"rentalId"`
+	TenantName   *string    `json:"tenantName"`
+	StartDate    *time.Time `json:"startDate"`
+	EndDate      *time.Time `json:"endDate"`
+	RentalPrice  *float32   `json:"rentalPrice"`
+	Balance      float32    `json:"balance"`
+	Deposit      float32    `json:"deposit"`
+}
+
+type OwnerStatementQuery struct {
+	ReportExportQuery
+	PropertyID        uuid.UUID `query:"propertyId" validate:"required"`
+	Month             time.Time `query:"month" validate:"required"`
+	ManagementFeeRate float32   `query:"managementFeeRate" validate:"gte=0,lte=100"`
+}
+
+type OwnerStatementLine struct {
+	Category string  `json:"category"`
+	Amount   float32 `json:"amount"`
+}
+
+type OwnerStatement struct {
+	PropertyID        uuid.UUID            `json:"propertyId"`
+	PropertyName      string               `json:"propertyName"`
+	Month             time.Time            `json:"month"`
+	Incomes           []OwnerStatementLine `json:"incomes"`
+	Expenses          []OwnerStatementLine `json:"expenses"`
+	TotalIncome       float32              `json:"totalIncome"`
+	TotalExpense      float32              `json:"totalExpense"`
+	ManagementFeeRate float32              `json:"managementFeeRate"`
+	ManagementFee     float32              `json:"managementFee"`
+	NetPayout         float32              `json:"netPayout"`
+}
+
+type CreateReportSchedule struct {
+	UserID            uuid.UUID             `json:"userId" validate:"required,uuid4"`
+	PropertyID        *uuid.UUID            `json:"propertyId" validate:"required_if=Type OWNER_STATEMENT"`
+	Type              database.REPORTTYPE   `json:"type" validate:"required,oneof=RENT_ROLL OWNER_STATEMENT"`
+	Format            database.REPORTFORMAT `json:"format" validate:"required,oneof=CSV XLSX PDF"`
+	ManagementFeeRate float32               `json:"managementFeeRate" validate:"gte=0,lte=100"`
+	Recipients        []string              `json:"recipients" validate:"omitempty,dive,email"`
+}
+
+func (c *CreateReportSchedule) ToCreateReportScheduleDB() database.CreateReportScheduleParams {
+	p := database.CreateReportScheduleParams{
+		UserID:            c.UserID,
+		Type:              c.Type,
+		Format:            c.Format,
+		ManagementFeeRate: c.ManagementFeeRate,
+		Recipients:        c.Recipients,
+	}
+	if c.PropertyID != nil {
+		p.PropertyID = pgtype.UUID{Bytes: *c.PropertyID, Valid: true}
+	}
+	if p.Recipients == nil {
+		p.Recipients = []string{}
+	}
+	return p
+}
+
+type ReportSchedule struct {
+	ID                int64                 `json:"id"`
+	UserID            uuid.UUID             `json:"userId"`
+	PropertyID        *uuid.UUID            `json:"propertyId"`
+	Type              database.REPORTTYPE   `json:"type"`
+	Format            database.REPORTFORMAT `json:"format"`
+	ManagementFeeRate float32               `json:"managementFeeRate"`
+	Recipients        []string              `json:"recipients"`
+	LastSentAt        *time.Time            `json:"lastSentAt"`
+	CreatedAt         time.Time             `json:"createdAt"`
+}
+
+func ToReportSchedule(rs *database.ReportSchedule) ReportSchedule {
+	res := ReportSchedule{
+		ID:                rs.ID,
+		UserID:            rs.UserID,
+		Type:              rs.Type,
+		Format:            rs.Format,
+		ManagementFeeRate: rs.ManagementFeeRate,
+		Recipients:        rs.Recipients,
+		CreatedAt:         rs.CreatedAt,
+	}
+	if rs.PropertyID.Valid {
+		res.PropertyID = types.Ptr[uuid.UUID](rs.PropertyID.Bytes)
+	}
+	if rs.LastSentAt.Valid {
+		res.LastSentAt = types.Ptr(rs.LastSentAt.Time)
+	}
+	return res
+}
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	auth_http "github.com/user2410/rrms-backend/internal/domain/auth/http"
+	"github.com/user2410/rrms-backend/internal/domain/statistic/dto"
+	"github.com/user2410/rrms-backend/internal/domain/statistic/service"
+	statistic_util "github.com/user2410/rrms-backend/internal/domain/statistic/utils"
+	"github.com/user2410/rrms-backend/internal/utils/token"
+	"github.com/user2410/rrms-backend/internal/utils/validation"
+)
+
+func (a *adapter) getAccountingExport() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		tkPayload := ctx.Locals(auth_http.AuthorizationPayloadKey).(*token.Payload)
+
+		var query dto.AccountingExportQuery
+		if err := ctx.QueryParser(&query); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": err.Error()})
+		}
+		if errs := validation.ValidateStruct(nil, query); len(errs) > 0 {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"message": validation.GetValidationError(errs)})
+		}
+
+		res, err := a.service.GetAccountingEntries(tkPayload.UserID, &query)
+		if err != nil {
+			if errors.Is(err, service.ErrPropertyNotManaged) {
+				return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{"message": err.Error()})
+			}
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+
+		if query.Format == "" {
+			return ctx.Status(fiber.StatusOK).JSON(res)
+		}
+		f := statistic_util.AccountingFormat(query.Format)
+		var buf bytes.Buffer
+		if err := statistic_util.WriteAccountingExport(&buf, f, res); err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
+		}
+		ctx.Set(fiber.HeaderContentType, f.ContentType())
+		ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="accounting-%s-%s%s"`, query.From.Format("20060102"), query.To.Format("20060102"), f.Extension()))
+		return ctx.Status(fiber.StatusOK).Send(buf.Bytes())
+	}
+}
@@ -35,6 +35,15 @@ func (a *adapter) RegisterServer(route *fiber.Router, tokenMaker token.Maker, un
 	managerStatisticRoute.Get("/tenants", a.getTotalTenantsStatistic())
 	managerStatisticRoute.Get("/rentals/payments/arrears", a.getRentalPaymentArrearsStatistic())
 	managerStatisticRoute.Get("/rentals/payments/incomes", a.getRentalPaymentIncomesStatistic())
+	managerStatisticRoute.Get("/rentals/overdue", a.getOverdueRentalsStatistic())
+	managerStatisticRoute.Get("/reports/rent-roll", a.getRentRollReport())
+	managerStatisticRoute.Get("/reports/owner-statement", a.getOwnerStatementReport())
+	managerStatisticRoute.Post("/reports/schedules", a.createReportSchedule())
+	managerStatisticRoute.Get("/reports/schedules", a.getReportSchedules())
+	managerStatisticRoute.Delete("/reports/schedules/schedule/:id", a.deleteReportSchedule())
+	managerStatisticRoute.Get("/reports/accounting", a.getAccountingExport())
+	managerStatisticRoute.Get("/listings/listing/:id/funnel", a.getListingFunnel())
+	managerStatisticRoute.Get("/listings/priorities", a.getListingPriorityComparison())
 
 	tenantStatisticRoute := statisticRoute.Group("/tenant")
 	tenantStatisticRoute.Get("/rentals", a.getTenantRentalStatistic())
@@ -0,0 +1,115 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	rental_util "github.com/user2410/rrms-backend/internal/domain/rental/utils"
+	statistic_dto "github.com/user2410/rrms-backend/internal/domain/statistic/dto"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func toPgUUID(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+// GetAccountingRentalPayments returns the rental payments collected in [from, to) on properties managed by the user
+func (r *repo) GetAccountingRentalPayments(ctx context.Context, userId uuid.UUID, propertyId *uuid.UUID, from, to time.Time) ([]statistic_dto.AccountingTransaction, error) {
+	res, err := r.dao.GetAccountingRentalPayments(ctx, database.GetAccountingRentalPaymentsParams{
+		ManagerID:  userId,
+		PropertyID: toPgUUID(propertyId),
+		StartDate:  pgtype.Date{Time: from, Valid: true},
+		EndDate:    pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]statistic_dto.AccountingTransaction, 0, len(res))
+	for _, v := range res {
+		items = append(items, statistic_dto.AccountingTransaction{
+			Source:       statistic_dto.ACCOUNTINGSOURCE_RENTALPAYMENT,
+			Reference:    v.Code,
+			Date:         v.PaymentDate.Time,
+			Category:     v.Category,
+			Description:  fmt.Sprintf("Thu %s - %s", rental_util.GetRentalPaymentTypeName(rental_util.RentalPaymentType(v.Category)), v.TenantName),
+			PropertyID:   types.Ptr(v.PropertyID),
+			PropertyName: v.PropertyName,
+			Amount:       v.Paid,
+		})
+	}
+	return items, nil
+}
+
+// listingPaymentDescription strips the "[TYPE_object]" prefix from the order info of a listing payment
+func listingPaymentDescription(orderInfo string) string {
+	if i := strings.Index(orderInfo, "]"); i != -1 {
+		return strings.TrimSpace(orderInfo[i+1:])
+	}
+	return orderInfo
+}
+
+// GetAccountingListingPayments returns the listing fees successfully paid by the user in [from, to)
+func (r *repo) GetAccountingListingPayments(ctx context.Context, userId uuid.UUID, propertyId *uuid.UUID, from, to time.Time) ([]statistic_dto.AccountingTransaction, error) {
+	res, err := r.dao.GetAccountingListingPayments(ctx, database.GetAccountingListingPaymentsParams{
+		UserID:     userId,
+		PropertyID: toPgUUID(propertyId),
+		StartTime:  from,
+		EndTime:    to,
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]statistic_dto.AccountingTransaction, 0, len(res))
+	for _, v := range res {
+		item := statistic_dto.AccountingTransaction{
+			Source:       statistic_dto.ACCOUNTINGSOURCE_LISTINGFEE,
+			Reference:    fmt.Sprintf("PAY%d", v.ID),
+			Date:         v.UpdatedAt,
+			Description:  listingPaymentDescription(v.OrderInfo),
+			PropertyName: v.PropertyName.String,
+			Amount:       v.Amount,
+		}
+		if v.PropertyID.Valid {
+			item.PropertyID = types.Ptr[uuid.UUID](v.PropertyID.Bytes)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// GetAccountingRefunds returns the refunds of the user's payments made in [from, to)
+func (r *repo) GetAccountingRefunds(ctx context.Context, userId uuid.UUID, propertyId *uuid.UUID, from, to time.Time) ([]statistic_dto.AccountingTransaction, error) {
+	res, err := r.dao.GetAccountingRefunds(ctx, database.GetAccountingRefundsParams{
+		UserID:     userId,
+		PropertyID: toPgUUID(propertyId),
+		StartTime:  from,
+		EndTime:    to,
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]statistic_dto.AccountingTransaction, 0, len(res))
+	for _, v := range res {
+		item := statistic_dto.AccountingTransaction{
+			Source:       statistic_dto.ACCOUNTINGSOURCE_REFUND,
+			Reference:    fmt.Sprintf("RF%d", v.ID),
+			Date:         v.CreatedAt,
+			Description:  v.Reason,
+			PropertyName: v.PropertyName.String,
+			Amount:       v.Amount,
+		}
+		if v.PropertyID.Valid {
+			item.PropertyID = types.Ptr[uuid.UUID](v.PropertyID.Bytes)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
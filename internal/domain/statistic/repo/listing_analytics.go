@@ -0,0 +1,67 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	statistic_dto "github.com/user2410/rrms-backend/internal/domain/statistic/dto"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+// GetListingDailyStats returns the stats of the listing for the days in [from, to)
+func (r *repo) GetListingDailyStats(ctx context.Context, listingId uuid.UUID, from, to time.Time) ([]statistic_dto.ListingAnalyticsDay, error) {
+	res, err := r.dao.GetListingDailyStats(ctx, database.GetListingDailyStatsParams{
+		ListingID: listingId,
+		StartDate: pgtype.Date{Time: from, Valid: true},
+		EndDate:   pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]statistic_dto.ListingAnalyticsDay, 0, len(res))
+	for _, v := range res {
+		items = append(items, statistic_dto.ListingAnalyticsDay{
+			ListingAnalyticsCounts: statistic_dto.ListingAnalyticsCounts{
+				Impressions:      v.Impressions,
+				Views:            v.Views,
+				Contacts:         v.Contacts,
+				ApplicationLinks: v.ApplicationLinks,
+				Applications:     v.Applications,
+				Viewings:         v.Viewings,
+			},
+			Date:     v.Date.Time,
+			Priority: v.Priority,
+		})
+	}
+	return items, nil
+}
+
+// GetListingStatsByPriority returns the totals of the days in [from, to) grouped by listing priority
+func (r *repo) GetListingStatsByPriority(ctx context.Context, from, to time.Time) ([]statistic_dto.ListingPriorityStats, error) {
+	res, err := r.dao.GetListingStatsByPriority(ctx, database.GetListingStatsByPriorityParams{
+		StartDate: pgtype.Date{Time: from, Valid: true},
+		EndDate:   pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]statistic_dto.ListingPriorityStats, 0, len(res))
+	for _, v := range res {
+		items = append(items, statistic_dto.ListingPriorityStats{
+			Priority:    v.Priority,
+			Listings:    v.Listings,
+			ListingDays: v.ListingDays,
+			Totals: statistic_dto.ListingAnalyticsCounts{
+				Impressions:      v.Impressions,
+				Views:            v.Views,
+				Contacts:         v.Contacts,
+				ApplicationLinks: v.ApplicationLinks,
+				Applications:     v.Applications,
+				Viewings:         v.Viewings,
+			},
+		})
+	}
+	return items, nil
+}
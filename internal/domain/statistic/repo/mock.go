@@ -43,6 +43,80 @@ func (m *MockRepo) EXPECT() *MockRepoMockRecorder {
 	return m.recorder
 }
 
+// CreateReportSchedule mocks base method.
+func (m *MockRepo) CreateReportSchedule(arg0 context.Context, arg1 *dto.CreateReportSchedule) (dto.ReportSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReportSchedule", arg0, arg1)
+	ret0, _ := ret[0].(dto.ReportSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReportSchedule indicates an expected call of CreateReportSchedule.
+func (mr *MockRepoMockRecorder) CreateReportSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReportSchedule", reflect.TypeOf((*MockRepo)(nil).CreateReportSchedule), arg0, arg1)
+}
+
+// DeleteReportSchedule mocks base method.
+func (m *MockRepo) DeleteReportSchedule(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReportSchedule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReportSchedule indicates an expected call of DeleteReportSchedule.
+func (mr *MockRepoMockRecorder) DeleteReportSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReportSchedule", reflect.TypeOf((*MockRepo)(nil).DeleteReportSchedule), arg0, arg1)
+}
+
+// GetAccountingListingPayments mocks base method.
+func (m *MockRepo) GetAccountingListingPayments(arg0 context.Context, arg1 uuid.UUID, arg2 *uuid.UUID, arg3, arg4 time.Time) ([]dto.AccountingTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountingListingPayments", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]dto.AccountingTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountingListingPayments indicates an expected call of GetAccountingListingPayments.
+func (mr *MockRepoMockRecorder) GetAccountingListingPayments(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountingListingPayments", reflect.TypeOf((*MockRepo)(nil).GetAccountingListingPayments), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetAccountingRefunds mocks base method.
+func (m *MockRepo) GetAccountingRefunds(arg0 context.Context, arg1 uuid.UUID, arg2 *uuid.UUID, arg3, arg4 time.Time) ([]dto.AccountingTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountingRefunds", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]dto.AccountingTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountingRefunds indicates an expected call of GetAccountingRefunds.
+func (mr *MockRepoMockRecorder) GetAccountingRefunds(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountingRefunds", reflect.TypeOf((*MockRepo)(nil).GetAccountingRefunds), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetAccountingRentalPayments mocks base method.
+func (m *MockRepo) GetAccountingRentalPayments(arg0 context.Context, arg1 uuid.UUID, arg2 *uuid.UUID, arg3, arg4 time.Time) ([]dto.AccountingTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountingRentalPayments", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]dto.AccountingTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountingRentalPayments indicates an expected call of GetAccountingRentalPayments.
+func (mr *MockRepoMockRecorder) GetAccountingRentalPayments(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountingRentalPayments", reflect.TypeOf((*MockRepo)(nil).GetAccountingRentalPayments), arg0, arg1, arg2, arg3, arg4)
+}
+
 // GetApplicationsInMonth mocks base method.
 func (m *MockRepo) GetApplicationsInMonth(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) ([]int64, error) {
 	m.ctrl.T.Helper()
@@ -58,6 +132,21 @@ func (mr *MockRepoMockRecorder) GetApplicationsInMonth(arg0, arg1, arg2 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationsInMonth", reflect.TypeOf((*MockRepo)(nil).GetApplicationsInMonth), arg0, arg1, arg2)
 }
 
+// GetDueReportSchedules mocks base method.
+func (m *MockRepo) GetDueReportSchedules(arg0 context.Context) ([]dto.ReportSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueReportSchedules", arg0)
+	ret0, _ := ret[0].([]dto.ReportSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueReportSchedules indicates an expected call of GetDueReportSchedules.
+func (mr *MockRepoMockRecorder) GetDueReportSchedules(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueReportSchedules", reflect.TypeOf((*MockRepo)(nil).GetDueReportSchedules), arg0)
+}
+
 // GetLeastRentedProperties mocks base method.
 func (m *MockRepo) GetLeastRentedProperties(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int32) ([]dto.ExtremelyRentedPropertyItem, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +177,36 @@ func (mr *MockRepoMockRecorder) GetLeastRentedUnits(arg0, arg1, arg2, arg3 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeastRentedUnits", reflect.TypeOf((*MockRepo)(nil).GetLeastRentedUnits), arg0, arg1, arg2, arg3)
 }
 
+// GetListingDailyStats mocks base method.
+func (m *MockRepo) GetListingDailyStats(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 time.Time) ([]dto.ListingAnalyticsDay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingDailyStats", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]dto.ListingAnalyticsDay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingDailyStats indicates an expected call of GetListingDailyStats.
+func (mr *MockRepoMockRecorder) GetListingDailyStats(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingDailyStats", reflect.TypeOf((*MockRepo)(nil).GetListingDailyStats), arg0, arg1, arg2, arg3)
+}
+
+// GetListingStatsByPriority mocks base method.
+func (m *MockRepo) GetListingStatsByPriority(arg0 context.Context, arg1, arg2 time.Time) ([]dto.ListingPriorityStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingStatsByPriority", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]dto.ListingPriorityStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingStatsByPriority indicates an expected call of GetListingStatsByPriority.
+func (mr *MockRepoMockRecorder) GetListingStatsByPriority(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingStatsByPriority", reflect.TypeOf((*MockRepo)(nil).GetListingStatsByPriority), arg0, arg1, arg2)
+}
+
 // GetMaintenanceRequests mocks base method.
 func (m *MockRepo) GetMaintenanceRequests(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) ([]int64, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +312,51 @@ func (mr *MockRepoMockRecorder) GetOccupiedUnits(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOccupiedUnits", reflect.TypeOf((*MockRepo)(nil).GetOccupiedUnits), arg0, arg1)
 }
 
+// GetOverdueRentals mocks base method.
+func (m *MockRepo) GetOverdueRentals(arg0 context.Context, arg1 uuid.UUID, arg2 *dto.OverdueRentalsQuery) ([]dto.OverdueRentalItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverdueRentals", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]dto.OverdueRentalItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverdueRentals indicates an expected call of GetOverdueRentals.
+func (mr *MockRepoMockRecorder) GetOverdueRentals(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverdueRentals", reflect.TypeOf((*MockRepo)(nil).GetOverdueRentals), arg0, arg1, arg2)
+}
+
+// GetOwnerStatementExpenses mocks base method.
+func (m *MockRepo) GetOwnerStatementExpenses(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 time.Time) ([]dto.OwnerStatementLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnerStatementExpenses", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]dto.OwnerStatementLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnerStatementExpenses indicates an expected call of GetOwnerStatementExpenses.
+func (mr *MockRepoMockRecorder) GetOwnerStatementExpenses(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerStatementExpenses", reflect.TypeOf((*MockRepo)(nil).GetOwnerStatementExpenses), arg0, arg1, arg2, arg3)
+}
+
+// GetOwnerStatementIncomes mocks base method.
+func (m *MockRepo) GetOwnerStatementIncomes(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 time.Time) ([]dto.OwnerStatementLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnerStatementIncomes", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]dto.OwnerStatementLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnerStatementIncomes indicates an expected call of GetOwnerStatementIncomes.
+func (mr *MockRepoMockRecorder) GetOwnerStatementIncomes(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerStatementIncomes", reflect.TypeOf((*MockRepo)(nil).GetOwnerStatementIncomes), arg0, arg1, arg2, arg3)
+}
+
 // GetPaymentsStatistic mocks base method.
 func (m *MockRepo) GetPaymentsStatistic(arg0 context.Context, arg1 uuid.UUID, arg2 dto.PaymentsStatisticQuery) (float32, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +402,21 @@ func (mr *MockRepoMockRecorder) GetRecentListings(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentListings", reflect.TypeOf((*MockRepo)(nil).GetRecentListings), arg0, arg1)
 }
 
+// GetRentRoll mocks base method.
+func (m *MockRepo) GetRentRoll(arg0 context.Context, arg1 uuid.UUID, arg2 *uuid.UUID) ([]dto.RentRollItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRentRoll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]dto.RentRollItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRentRoll indicates an expected call of GetRentRoll.
+func (mr *MockRepoMockRecorder) GetRentRoll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRentRoll", reflect.TypeOf((*MockRepo)(nil).GetRentRoll), arg0, arg1, arg2)
+}
+
 // GetRentalComplaintStatistics mocks base method.
 func (m *MockRepo) GetRentalComplaintStatistics(arg0 context.Context, arg1 uuid.UUID, arg2 database.RENTALCOMPLAINTSTATUS) (int64, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +462,36 @@ func (mr *MockRepoMockRecorder) GetRentalPaymentIncomes(arg0, arg1, arg2 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRentalPaymentIncomes", reflect.TypeOf((*MockRepo)(nil).GetRentalPaymentIncomes), arg0, arg1, arg2)
 }
 
+// GetReportSchedule mocks base method.
+func (m *MockRepo) GetReportSchedule(arg0 context.Context, arg1 int64) (dto.ReportSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportSchedule", arg0, arg1)
+	ret0, _ := ret[0].(dto.ReportSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportSchedule indicates an expected call of GetReportSchedule.
+func (mr *MockRepoMockRecorder) GetReportSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportSchedule", reflect.TypeOf((*MockRepo)(nil).GetReportSchedule), arg0, arg1)
+}
+
+// GetReportSchedulesOfUser mocks base method.
+func (m *MockRepo) GetReportSchedulesOfUser(arg0 context.Context, arg1 uuid.UUID) ([]dto.ReportSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportSchedulesOfUser", arg0, arg1)
+	ret0, _ := ret[0].([]dto.ReportSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportSchedulesOfUser indicates an expected call of GetReportSchedulesOfUser.
+func (mr *MockRepoMockRecorder) GetReportSchedulesOfUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportSchedulesOfUser", reflect.TypeOf((*MockRepo)(nil).GetReportSchedulesOfUser), arg0, arg1)
+}
+
 // GetTenantExpenditure mocks base method.
 func (m *MockRepo) GetTenantExpenditure(arg0 context.Context, arg1 uuid.UUID, arg2 dto.RentalPaymentStatisticQuery) (float32, error) {
 	m.ctrl.T.Helper()
@@ -357,3 +566,17 @@ func (mr *MockRepoMockRecorder) GetTotalTenantsOfUnitStatistic(arg0, arg1 any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalTenantsOfUnitStatistic", reflect.TypeOf((*MockRepo)(nil).GetTotalTenantsOfUnitStatistic), arg0, arg1)
 }
+
+// UpdateReportScheduleLastSentAt mocks base method.
+func (m *MockRepo) UpdateReportScheduleLastSentAt(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReportScheduleLastSentAt", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReportScheduleLastSentAt indicates an expected call of UpdateReportScheduleLastSentAt.
+func (mr *MockRepoMockRecorder) UpdateReportScheduleLastSentAt(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReportScheduleLastSentAt", reflect.TypeOf((*MockRepo)(nil).UpdateReportScheduleLastSentAt), arg0, arg1)
+}
@@ -35,6 +35,24 @@ type Repo interface {
 	GetTotalTenantsManagedByUserStatistic(ctx context.Context, userId uuid.UUID, query *statistic_dto.RentalStatisticQuery) (int32, error)
 	GetTotalTenantsOfUnitStatistic(ctx context.Context, unitId uuid.UUID) (int32, error)
 	GetRentalComplaintStatistics(ctx context.Context, userId uuid.UUID, status database.RENTALCOMPLAINTSTATUS) (int64, error)
+	GetOverdueRentals(ctx context.Context, userId uuid.UUID, query *statistic_dto.OverdueRentalsQuery) ([]statistic_dto.OverdueRentalItem, error)
+	// Reports
+	GetRentRoll(ctx context.Context, userId uuid.UUID, propertyId *uuid.UUID) ([]statistic_dto.RentRollItem, error)
+	GetOwnerStatementIncomes(ctx context.Context, propertyId uuid.UUID, from, to time.Time) ([]statistic_dto.OwnerStatementLine, error)
+	GetOwnerStatementExpenses(ctx context.Context, propertyId uuid.UUID, from, to time.Time) ([]statistic_dto.OwnerStatementLine, error)
+	CreateReportSchedule(ctx context.Context, data *statistic_dto.CreateReportSchedule) (statistic_dto.ReportSchedule, error)
+	GetReportSchedule(ctx context.Context, id int64) (statistic_dto.ReportSchedule, error)
+	GetReportSchedulesOfUser(ctx context.Context, userId uuid.UUID) ([]statistic_dto.ReportSchedule, error)
+	GetDueReportSchedules(ctx context.Context) ([]statistic_dto.ReportSchedule, error)
+	UpdateReportScheduleLastSentAt(ctx context.Context, id int64) error
+	DeleteReportSchedule(ctx context.Context, id int64) error
+	// Accounting
+	GetAccountingRentalPayments(ctx context.Context, userId uuid.UUID, propertyId *uuid.UUID, from, to time.Time) ([]statistic_dto.AccountingTransaction, error)
+	GetAccountingListingPayments(ctx context.Context, userId uuid.UUID, propertyId *uuid.UUID, from, to time.Time) ([]statistic_dto.AccountingTransaction, error)
+	GetAccountingRefunds(ctx context.Context, userId uuid.UUID, propertyId *uuid.UUID, from, to time.Time) ([]statistic_dto.AccountingTransaction, error)
+	// Listing analytics
+	GetListingDailyStats(ctx context.Context, listingId uuid.UUID, from, to time.Time) ([]statistic_dto.ListingAnalyticsDay, error)
+	GetListingStatsByPriority(ctx context.Context, from, to time.Time) ([]statistic_dto.ListingPriorityStats, error)
 }
 
 type repo struct {
@@ -400,3 +418,30 @@ func (r *repo) GetTotalTenantsManagedByUserStatistic(ctx context.Context, userId
 func (r *repo) GetTotalTenantsOfUnitStatistic(ctx context.Context, unitId uuid.UUID) (int32, error) {
 	return r.dao.GetTotalTenantsOfUnitStatistic(ctx, unitId)
 }
+
+func (r *repo) GetOverdueRentals(ctx context.Context, userId uuid.UUID, query *statistic_dto.OverdueRentalsQuery) ([]statistic_dto.OverdueRentalItem, error) {
+	res, err := r.dao.GetOverdueRentals(ctx, database.GetOverdueRentalsParams{
+		ManagerID: userId,
+		Limit:     query.Limit,
+		Offset:    query.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]statistic_dto.OverdueRentalItem, len(res))
+	for i, v := range res {
+		items[i] = statistic_dto.OverdueRentalItem{
+			RentalID:     v.ID,
+			PropertyID:   v.PropertyID,
+			UnitID:       v.UnitID,
+			TenantID:     v.TenantID.Bytes,
+			TenantName:   v.TenantName,
+			StartDate:    v.StartDate.Time,
+			RentalPeriod: v.RentalPeriod,
+			EndDate:      v.EndDate.Time,
+			OverdueDays:  v.OverdueDays,
+		}
+	}
+	return items, nil
+}
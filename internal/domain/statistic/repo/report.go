@@ -0,0 +1,136 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	rental_util "github.com/user2410/rrms-backend/internal/domain/rental/utils"
+	statistic_dto "github.com/user2410/rrms-backend/internal/domain/statistic/dto"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func (r *repo) GetRentRoll(ctx context.Context, userId uuid.UUID, propertyId *uuid.UUID) ([]statistic_dto.RentRollItem, error) {
+	params := database.GetRentRollParams{
+		ManagerID: userId,
+	}
+	if propertyId != nil {
+		params.PropertyID = pgtype.UUID{Bytes: *propertyId, Valid: true}
+	}
+	res, err := r.dao.GetRentRoll(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]statistic_dto.RentRollItem, 0, len(res))
+	for _, v := range res {
+		item := statistic_dto.RentRollItem{
+			UnitID:       v.UnitID,
+			UnitName:     v.UnitName,
+			PropertyID:   v.PropertyID,
+			PropertyName: v.PropertyName,
+			RentalID:     types.PNInt64(v.RentalID),
+			TenantName:   types.PNStr(v.TenantName),
+			RentalPrice:  types.PNFloat32(v.RentalPrice),
+			Balance:      v.Balance,
+			Deposit:      v.Deposit,
+		}
+		if v.StartDate.Valid {
+			item.StartDate = types.Ptr(v.StartDate.Time)
+			item.EndDate = types.Ptr(rental_util.GetRentalEndDate(v.StartDate.Time, v.RentalPeriod.Int32))
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// GetOwnerStatementIncomes returns the amounts collected in [from, to) grouped by payment category, deposits excluded
+func (r *repo) GetOwnerStatementIncomes(ctx context.Context, propertyId uuid.UUID, from, to time.Time) ([]statistic_dto.OwnerStatementLine, error) {
+	res, err := r.dao.GetOwnerStatementIncomes(ctx, database.GetOwnerStatementIncomesParams{
+		PropertyID: propertyId,
+		StartDate:  pgtype.Date{Time: from, Valid: true},
+		EndDate:    pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]statistic_dto.OwnerStatementLine, 0, len(res))
+	for _, v := range res {
+		items = append(items, statistic_dto.OwnerStatementLine{
+			Category: v.Category,
+			Amount:   v.Amount,
+		})
+	}
+	return items, nil
+}
+
+// GetOwnerStatementExpenses returns the property expenses incurred in [from, to) grouped by category
+func (r *repo) GetOwnerStatementExpenses(ctx context.Context, propertyId uuid.UUID, from, to time.Time) ([]statistic_dto.OwnerStatementLine, error) {
+	res, err := r.dao.GetOwnerStatementExpenses(ctx, database.GetOwnerStatementExpensesParams{
+		PropertyID: propertyId,
+		StartDate:  pgtype.Date{Time: from, Valid: true},
+		EndDate:    pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]statistic_dto.OwnerStatementLine, 0, len(res))
+	for _, v := range res {
+		items = append(items, statistic_dto.OwnerStatementLine{
+			Category: v.Category,
+			Amount:   v.Amount,
+		})
+	}
+	return items, nil
+}
+
+func (r *repo) CreateReportSchedule(ctx context.Context, data *statistic_dto.CreateReportSchedule) (statistic_dto.ReportSchedule, error) {
+	res, err := r.dao.CreateReportSchedule(ctx, data.ToCreateReportScheduleDB())
+	if err != nil {
+		return statistic_dto.ReportSchedule{}, err
+	}
+	return statistic_dto.ToReportSchedule(&res), nil
+}
+
+func (r *repo) GetReportSchedule(ctx context.Context, id int64) (statistic_dto.ReportSchedule, error) {
+	res, err := r.dao.GetReportSchedule(ctx, id)
+	if err != nil {
+		return statistic_dto.ReportSchedule{}, err
+	}
+	return statistic_dto.ToReportSchedule(&res), nil
+}
+
+func (r *repo) GetReportSchedulesOfUser(ctx context.Context, userId uuid.UUID) ([]statistic_dto.ReportSchedule, error) {
+	res, err := r.dao.GetReportSchedulesOfUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]statistic_dto.ReportSchedule, 0, len(res))
+	for i := range res {
+		items = append(items, statistic_dto.ToReportSchedule(&res[i]))
+	}
+	return items, nil
+}
+
+// GetDueReportSchedules returns schedules that have not been sent yet in the current month
+func (r *repo) GetDueReportSchedules(ctx context.Context) ([]statistic_dto.ReportSchedule, error) {
+	res, err := r.dao.GetDueReportSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]statistic_dto.ReportSchedule, 0, len(res))
+	for i := range res {
+		items = append(items, statistic_dto.ToReportSchedule(&res[i]))
+	}
+	return items, nil
+}
+
+func (r *repo) UpdateReportScheduleLastSentAt(ctx context.Context, id int64) error {
+	return r.dao.UpdateReportScheduleLastSentAt(ctx, id)
+}
+
+func (r *repo) DeleteReportSchedule(ctx context.Context, id int64) error {
+	return r.dao.DeleteReportSchedule(ctx, id)
+}
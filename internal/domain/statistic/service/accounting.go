@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/statistic/dto"
+	statistic_util "github.com/user2410/rrms-backend/internal/domain/statistic/utils"
+)
+
+// GetAccountingEntries books the rental payments collected, the listing fees paid and the refunds received
+// between query.From and query.To (both inclusive), ordered by date
+func (s *service) GetAccountingEntries(userId uuid.UUID, query *dto.AccountingExportQuery) ([]dto.AccountingEntry, error) {
+	if query.PropertyID != nil {
+		if err := s.checkPropertyManageability(*query.PropertyID, userId); err != nil {
+			return nil, err
+		}
+	}
+
+	from := query.From
+	to := query.To.AddDate(0, 0, 1)
+	rentalPayments, err := s.domainRepo.StatisticRepo.GetAccountingRentalPayments(context.Background(), userId, query.PropertyID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	listingPayments, err := s.domainRepo.StatisticRepo.GetAccountingListingPayments(context.Background(), userId, query.PropertyID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	refunds, err := s.domainRepo.StatisticRepo.GetAccountingRefunds(context.Background(), userId, query.PropertyID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]dto.AccountingTransaction, 0, len(rentalPayments)+len(listingPayments)+len(refunds))
+	txs = append(txs, rentalPayments...)
+	txs = append(txs, listingPayments...)
+	txs = append(txs, refunds...)
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].Date.Before(txs[j].Date)
+	})
+
+	config := statistic_util.GetAccountingConfig()
+	return statistic_util.ToAccountingEntries(&config, txs), nil
+}
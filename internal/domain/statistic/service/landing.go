@@ -4,15 +4,10 @@ import (
 	"context"
 	"encoding/json"
 
-	"github.com/elastic/go-elasticsearch/v8/typedapi/core/search"
-	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
-	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/functionboostmode"
-	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/functionscoremode"
-	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/sortorder"
 	"github.com/google/uuid"
+	listing_dto "github.com/user2410/rrms-backend/internal/domain/listing/dto"
 	listing_model "github.com/user2410/rrms-backend/internal/domain/listing/model"
 	statistic_dto "github.com/user2410/rrms-backend/internal/domain/statistic/dto"
-	"github.com/user2410/rrms-backend/internal/infrastructure/es"
 	"github.com/user2410/rrms-backend/internal/utils/types"
 )
 
@@ -50,45 +45,9 @@ func (s *service) GetSimilarListingsToListing(id uuid.UUID, limit int) (statisti
 		}
 	}
 
-	// prepare queries and score functions
-	// should queries
-	shouldQueries := []estypes.Query{
-		{
-			Term: map[string]estypes.TermQuery{
-				"active": {
-					Value: true,
-				},
-			},
-		},
-		{
-			Range: map[string]estypes.RangeQuery{
-				"expired_at": estypes.DateRangeQuery{
-					Gte: types.Ptr("now"),
-				},
-			},
-		},
-		{
-			Term: map[string]estypes.TermQuery{
-				"property.city": {
-					Value: property.City,
-				},
-			},
-		},
-	}
-
-	// mustnot queries
-	mustNotQueries := []estypes.Query{
-		{
-			Term: map[string]estypes.TermQuery{
-				"id": {
-					Value: id.String(),
-				},
-			},
-		},
-	}
-
 	// prepare query
-	query := statistic_dto.ListingSuggestionQuery{
+	query := listing_dto.SimilarListingsQuery{
+		ExcludeID: id,
 		PTypes:    []string{string(property.Type)},
 		PCity:     []string{property.City},
 		PDistrict: []string{property.District},
@@ -127,14 +86,14 @@ func (s *service) GetSimilarListingsToListing(id uuid.UUID, limit int) (statisti
 	}
 
 	var res statistic_dto.ListingsSuggestionResult
-	searchRes, err := s.SuggestSimilarListings(&query, []estypes.Query{}, mustNotQueries, shouldQueries, limit)
+	docs, err := s.searcher.SearchSimilarListings(context.Background(), &query, limit)
 	if err != nil {
 		return res, err
 	}
-	res.Hits = make([]statistic_dto.ListingsSuggestionItem, 0, len(searchRes.Hits.Hits))
-	for _, h := range searchRes.Hits.Hits {
+	res.Hits = make([]statistic_dto.ListingsSuggestionItem, 0, len(docs))
+	for _, doc := range docs {
 		var i statistic_dto.ListingsSuggestionItem
-		err := json.Unmarshal(h.Source_, &i)
+		err := json.Unmarshal(doc, &i)
 		if err != nil {
 			return res, err
 		}
@@ -143,330 +102,3 @@ func (s *service) GetSimilarListingsToListing(id uuid.UUID, limit int) (statisti
 
 	return res, nil
 }
-
-func (s *service) SuggestSimilarListings(query *statistic_dto.ListingSuggestionQuery, mustQueries, mustNotQueries, shouldQueries []estypes.Query, limit int) (*search.Response, error) {
-	esClient := s.esClient.GetTypedClient()
-
-	// score functions
-	scoreFns := []estypes.FunctionScore{
-		{
-			Filter: &estypes.Query{
-				Term: map[string]estypes.TermQuery{
-					"property.verification_status": {
-						Value: "APPROVED",
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](3),
-		},
-		{
-			Filter: &estypes.Query{
-				Term: map[string]estypes.TermQuery{
-					"property.verification_status": {
-						Value: "PENDING",
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](2),
-		},
-		{
-			Filter: &estypes.Query{
-				Term: map[string]estypes.TermQuery{
-					"property.verification_status": {
-						Value: "REJECTED",
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](1),
-		},
-		{
-			Filter: &estypes.Query{
-				Bool: &estypes.BoolQuery{
-					MustNot: []estypes.Query{
-						{
-							Exists: &estypes.ExistsQuery{
-								Field: "property.verification_status",
-							},
-						},
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](0.5),
-		},
-	}
-	if len(query.PCity) > 0 {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Terms: &estypes.TermsQuery{
-					TermsQuery: map[string]estypes.TermsQueryField{
-						"property.city": query.PCity,
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](10),
-		})
-	}
-	if len(query.PDistrict) > 0 {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Terms: &estypes.TermsQuery{
-					TermsQuery: map[string]estypes.TermsQueryField{
-						"property.district": query.PDistrict,
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](15),
-		})
-	}
-	if len(query.PWard) > 0 {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Terms: &estypes.TermsQuery{
-					TermsQuery: map[string]estypes.TermsQueryField{
-						"property.ward": query.PWard,
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](20),
-		})
-	}
-	if query.LMaxPrice != nil {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Range: map[string]estypes.RangeQuery{
-					"price": estypes.NumberRangeQuery{
-						Lte: types.Ptr(estypes.Float64(*query.LMaxPrice)),
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](4.5),
-		})
-	}
-	if query.LMinPrice != nil {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Range: map[string]estypes.RangeQuery{
-					"price": estypes.NumberRangeQuery{
-						Gte: types.Ptr(estypes.Float64(*query.LMinPrice)),
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](4.5),
-		})
-	}
-	if query.PMaxArea != nil {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Range: map[string]estypes.RangeQuery{
-					"property.area": estypes.NumberRangeQuery{
-						Lte: types.Ptr(estypes.Float64(*query.PMaxArea)),
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](4.5),
-		})
-	}
-	if query.PMinArea != nil {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Range: map[string]estypes.RangeQuery{
-					"property.area": estypes.NumberRangeQuery{
-						Gte: types.Ptr(estypes.Float64(*query.PMinArea)),
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](4.5),
-		})
-	}
-	if len(query.PTypes) > 0 {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Terms: &estypes.TermsQuery{
-					TermsQuery: map[string]estypes.TermsQueryField{
-						"property.type": query.PTypes,
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](4.5),
-		})
-	}
-	if query.UNumberOfBedrooms != nil {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Nested: &estypes.NestedQuery{
-					Path: "listing_units",
-					Query: &estypes.Query{
-						Range: map[string]estypes.RangeQuery{
-							"listing_units.number_of_bedrooms": estypes.NumberRangeQuery{
-								Gte: types.Ptr(estypes.Float64(*query.UNumberOfBedrooms)),
-							},
-						},
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](3),
-		})
-	}
-	if query.UNumberOfBathrooms != nil {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Nested: &estypes.NestedQuery{
-					Path: "listing_units",
-					Query: &estypes.Query{
-						Range: map[string]estypes.RangeQuery{
-							"listing_units.number_of_bathrooms": estypes.NumberRangeQuery{
-								Gte: types.Ptr(estypes.Float64(*query.UNumberOfBathrooms)),
-							},
-						},
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](3),
-		})
-	}
-	if query.UNumberOfBalconies != nil {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Nested: &estypes.NestedQuery{
-					Path: "listing_units",
-					Query: &estypes.Query{
-						Range: map[string]estypes.RangeQuery{
-							"listing_units.number_of_balconies": estypes.NumberRangeQuery{
-								Gte: types.Ptr(estypes.Float64(*query.UNumberOfBalconies)),
-							},
-						},
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](3),
-		})
-	}
-	if query.UNumberOfToilets != nil {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Nested: &estypes.NestedQuery{
-					Path: "listing_units",
-					Query: &estypes.Query{
-						Range: map[string]estypes.RangeQuery{
-							"listing_units.number_of_toilets": estypes.NumberRangeQuery{
-								Gte: types.Ptr(estypes.Float64(*query.UNumberOfToilets)),
-							},
-						},
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](3),
-		})
-	}
-	if query.UNumberOfLivingRooms != nil {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Nested: &estypes.NestedQuery{
-					Path: "listing_units",
-					Query: &estypes.Query{
-						Range: map[string]estypes.RangeQuery{
-							"listing_units.number_of_living_rooms": estypes.NumberRangeQuery{
-								Gte: types.Ptr(estypes.Float64(*query.UNumberOfLivingRooms)),
-							},
-						},
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](2),
-		})
-	}
-	if query.UNumberOfKitchens != nil {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Nested: &estypes.NestedQuery{
-					Path: "listing_units",
-					Query: &estypes.Query{
-						Range: map[string]estypes.RangeQuery{
-							"listing_units.number_of_kitchens": estypes.NumberRangeQuery{
-								Gte: types.Ptr(estypes.Float64(*query.UNumberOfKitchens)),
-							},
-						},
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](2),
-		})
-	}
-	if len(query.POrientation) > 0 {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Terms: &estypes.TermsQuery{
-					TermsQuery: map[string]estypes.TermsQueryField{
-						"property.orientation": query.POrientation,
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](3),
-		})
-	}
-	if len(query.PFeatures) > 0 {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Nested: &estypes.NestedQuery{
-					Path: "property.features",
-					Query: &estypes.Query{
-						Terms: &estypes.TermsQuery{
-							TermsQuery: map[string]estypes.TermsQueryField{
-								"property.features.feature_id": query.PFeatures,
-							},
-						},
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](1),
-		})
-	}
-	if len(query.UAmenities) > 0 {
-		scoreFns = append(scoreFns, estypes.FunctionScore{
-			Filter: &estypes.Query{
-				Nested: &estypes.NestedQuery{
-					Path: "listing_units.amenities",
-					Query: &estypes.Query{
-						Terms: &estypes.TermsQuery{
-							TermsQuery: map[string]estypes.TermsQueryField{
-								"listing_units.amenities.amenity_id": query.UAmenities,
-							},
-						},
-					},
-				},
-			},
-			Weight: types.Ptr[estypes.Float64](1),
-		})
-	}
-
-	search := esClient.Search().
-		Index(string(es.LISTINGINDEX)).
-		Request(&search.Request{
-			Size: types.Ptr(limit),
-			Query: &estypes.Query{
-				FunctionScore: &estypes.FunctionScoreQuery{
-					Query: &estypes.Query{
-						Bool: &estypes.BoolQuery{
-							Should:  shouldQueries,
-							Must:    mustQueries,
-							MustNot: mustNotQueries,
-						},
-					},
-					Functions: scoreFns,
-					ScoreMode: &functionscoremode.Sum,
-					BoostMode: &functionboostmode.Replace,
-				},
-			},
-			Sort: []estypes.SortCombinations{
-				estypes.SortOptions{
-					SortOptions: map[string]estypes.FieldSort{
-						"priority": {
-							Order: &sortorder.Desc,
-						},
-					},
-				},
-			},
-		})
-
-	return search.Do(context.Background())
-}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/statistic/dto"
+	statistic_util "github.com/user2410/rrms-backend/internal/domain/statistic/utils"
+)
+
+var ErrListingNotManaged = errors.New("operation not permitted on this listing")
+
+// analyticsRange returns the days of the query as the half-open range [from, to)
+func analyticsRange(query *dto.ListingAnalyticsQuery) (time.Time, time.Time) {
+	from := time.Date(query.StartDate.Year(), query.StartDate.Month(), query.StartDate.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(query.EndDate.Year(), query.EndDate.Month(), query.EndDate.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	return from, to
+}
+
+func (s *service) GetListingFunnel(userId, listingId uuid.UUID, query *dto.ListingAnalyticsQuery) (dto.ListingFunnel, error) {
+	isOwner, err := s.domainRepo.ListingRepo.CheckListingOwnership(context.Background(), listingId, userId)
+	if err != nil {
+		return dto.ListingFunnel{}, err
+	}
+	if !isOwner {
+		return dto.ListingFunnel{}, ErrListingNotManaged
+	}
+
+	from, to := analyticsRange(query)
+	days, err := s.domainRepo.StatisticRepo.GetListingDailyStats(context.Background(), listingId, from, to)
+	if err != nil {
+		return dto.ListingFunnel{}, err
+	}
+	res := dto.ListingFunnel{
+		ListingID: listingId,
+		Days:      days,
+	}
+	for i := range days {
+		res.Totals.Add(&days[i].ListingAnalyticsCounts)
+	}
+	res.Rates = statistic_util.GetListingConversionRates(&res.Totals)
+	return res, nil
+}
+
+// GetListingPriorityComparison compares the performances of the listings of each priority level across the platform
+func (s *service) GetListingPriorityComparison(query *dto.ListingAnalyticsQuery) ([]dto.ListingPriorityStats, error) {
+	from, to := analyticsRange(query)
+	res, err := s.domainRepo.StatisticRepo.GetListingStatsByPriority(context.Background(), from, to)
+	if err != nil {
+		return nil, err
+	}
+	for i := range res {
+		statistic_util.ComputeListingPriorityStats(&res[i])
+	}
+	return res, nil
+}
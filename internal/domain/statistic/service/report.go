@@ -0,0 +1,224 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	misc_dto "github.com/user2410/rrms-backend/internal/domain/misc/dto"
+	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
+	"github.com/user2410/rrms-backend/internal/domain/statistic/dto"
+	statistic_util "github.com/user2410/rrms-backend/internal/domain/statistic/utils"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/export"
+	template_util "github.com/user2410/rrms-backend/internal/utils/template"
+	html_util "github.com/user2410/rrms-backend/internal/utils/template/html"
+	text_util "github.com/user2410/rrms-backend/internal/utils/template/text"
+)
+
+const (
+	basePath = "internal/domain/statistic/service/templates"
+	// lifetime of the download link sent with a scheduled report
+	REPORT_URL_LIFETIME = 7 * 24 * time.Hour
+)
+
+var ErrPropertyNotManaged = errors.New("operation not permitted on this property")
+
+func (s *service) checkPropertyManageability(pid, userId uuid.UUID) error {
+	managers, err := s.domainRepo.PropertyRepo.GetPropertyManagers(context.Background(), pid)
+	if err != nil {
+		return err
+	}
+	for _, m := range managers {
+		if m.ManagerID == userId {
+			return nil
+		}
+	}
+	return ErrPropertyNotManaged
+}
+
+func (s *service) GetRentRoll(userId uuid.UUID, query *dto.RentRollQuery) ([]dto.RentRollItem, error) {
+	return s.domainRepo.StatisticRepo.GetRentRoll(context.Background(), userId, query.PropertyID)
+}
+
+func (s *service) GetOwnerStatement(userId uuid.UUID, query *dto.OwnerStatementQuery) (dto.OwnerStatement, error) {
+	if err := s.checkPropertyManageability(query.PropertyID, userId); err != nil {
+		return dto.OwnerStatement{}, err
+	}
+	return s.getOwnerStatement(query.PropertyID, query.Month, query.ManagementFeeRate)
+}
+
+func (s *service) getOwnerStatement(pid uuid.UUID, month time.Time, managementFeeRate float32) (dto.OwnerStatement, error) {
+	ps, err := s.domainRepo.PropertyRepo.GetPropertiesByIds(context.Background(), []uuid.UUID{pid}, []string{"name"})
+	if err != nil {
+		return dto.OwnerStatement{}, err
+	}
+	if len(ps) == 0 {
+		return dto.OwnerStatement{}, database.ErrRecordNotFound
+	}
+
+	from, to := statistic_util.GetMonthRange(month)
+	res := dto.OwnerStatement{
+		PropertyID:        pid,
+		PropertyName:      ps[0].Name,
+		Month:             from,
+		ManagementFeeRate: managementFeeRate,
+	}
+	res.Incomes, err = s.domainRepo.StatisticRepo.GetOwnerStatementIncomes(context.Background(), pid, from, to)
+	if err != nil {
+		return dto.OwnerStatement{}, err
+	}
+	res.Expenses, err = s.domainRepo.StatisticRepo.GetOwnerStatementExpenses(context.Background(), pid, from, to)
+	if err != nil {
+		return dto.OwnerStatement{}, err
+	}
+	statistic_util.ComputeOwnerStatement(&res)
+	return res, nil
+}
+
+func (s *service) CreateReportSchedule(data *dto.CreateReportSchedule) (dto.ReportSchedule, error) {
+	if data.PropertyID != nil {
+		if err := s.checkPropertyManageability(*data.PropertyID, data.UserID); err != nil {
+			return dto.ReportSchedule{}, err
+		}
+	}
+	return s.domainRepo.StatisticRepo.CreateReportSchedule(context.Background(), data)
+}
+
+func (s *service) GetReportSchedules(userId uuid.UUID) ([]dto.ReportSchedule, error) {
+	return s.domainRepo.StatisticRepo.GetReportSchedulesOfUser(context.Background(), userId)
+}
+
+func (s *service) DeleteReportSchedule(userId uuid.UUID, id int64) error {
+	rs, err := s.domainRepo.StatisticRepo.GetReportSchedule(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if rs.UserID != userId {
+		return database.ErrRecordNotFound
+	}
+	return s.domainRepo.StatisticRepo.DeleteReportSchedule(context.Background(), id)
+}
+
+// sendScheduledReports sends the reports of the previous month to the users who scheduled them.
+// A schedule is marked as sent for the current month so that it is not sent twice.
+func (s *service) sendScheduledReports() error {
+	schedules, err := s.domainRepo.StatisticRepo.GetDueReportSchedules(context.Background())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range schedules {
+		if err = s.sendScheduledReport(&schedules[i], time.Now()); err != nil {
+			errs = append(errs, fmt.Errorf("report schedule %d: %w", schedules[i].ID, err))
+			continue
+		}
+		if err = s.domainRepo.StatisticRepo.UpdateReportScheduleLastSentAt(context.Background(), schedules[i].ID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *service) sendScheduledReport(rs *dto.ReportSchedule, now time.Time) error {
+	var (
+		doc   *export.Document
+		month = now.AddDate(0, -1, 0)
+	)
+	switch rs.Type {
+	case database.REPORTTYPERENTROLL:
+		items, err := s.domainRepo.StatisticRepo.GetRentRoll(context.Background(), rs.UserID, rs.PropertyID)
+		if err != nil {
+			return err
+		}
+		doc = statistic_util.RentRollDocument(items, now)
+	case database.REPORTTYPEOWNERSTATEMENT:
+		if rs.PropertyID == nil {
+			return database.ErrRecordNotFound
+		}
+		// the manager may have left the property since the report was scheduled
+		if err := s.checkPropertyManageability(*rs.PropertyID, rs.UserID); err != nil {
+			return err
+		}
+		st, err := s.getOwnerStatement(*rs.PropertyID, month, rs.ManagementFeeRate)
+		if err != nil {
+			return err
+		}
+		doc = statistic_util.OwnerStatementDocument(&st)
+	default:
+		return fmt.Errorf("unknown report type %s", rs.Type)
+	}
+
+	format := export.Format(rs.Format)
+	var buf bytes.Buffer
+	if err := export.Write(&buf, format, doc); err != nil {
+		return err
+	}
+	objKey := fmt.Sprintf("reports/%s/%d/%s%s", rs.UserID.String(), rs.ID, month.Format("200601"), format.Extension())
+	if err := s.s3Client.UploadLargeObject(s.imageBucketName, objKey, buf.Bytes()); err != nil {
+		return err
+	}
+	url, err := s.s3Client.GetGetObjectPresignedURL(s.imageBucketName, objKey, REPORT_URL_LIFETIME)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.domainRepo.AuthRepo.GetUserById(context.Background(), rs.UserID)
+	if err != nil {
+		return err
+	}
+
+	data := struct {
+		FESite      string
+		Title       string
+		Format      string
+		DownloadURL string
+		ExpiresAt   time.Time
+	}{
+		FESite:      s.feSite,
+		Title:       doc.Title,
+		Format:      string(rs.Format),
+		DownloadURL: url.URL,
+		ExpiresAt:   now.Add(REPORT_URL_LIFETIME),
+	}
+	title, err := text_util.RenderText(
+		data,
+		fmt.Sprintf("%s/title/notify_scheduledreport.txt", basePath),
+		map[string]any{
+			"Dereference": template_util.Dereference("-"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	emailContent, err := html_util.RenderHtml(
+		data,
+		fmt.Sprintf("%s/email/notify_scheduledreport.gohtml", basePath),
+		map[string]any{
+			"Dereference": template_util.Dereference("-"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.mService.SendNotification(&misc_dto.CreateNotification{
+		Title:   string(title),
+		Content: string(emailContent),
+		Data: map[string]interface{}{
+			"notificationType": misc_service.NOTIFICATIONTYPE_SCHEDULEDREPORT,
+			"reportScheduleId": rs.ID,
+		},
+		Targets: []misc_dto.CreateNotificationTarget{
+			{
+				UserId: rs.UserID,
+				Emails: append([]string{user.Email}, rs.Recipients...),
+				Tokens: []string{},
+			},
+		},
+	})
+}
@@ -1,11 +1,16 @@
 package service
 
 import (
+	"log"
+
 	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
 	repos "github.com/user2410/rrms-backend/internal/domain/_repos"
 	listing_model "github.com/user2410/rrms-backend/internal/domain/listing/model"
+	listing_search "github.com/user2410/rrms-backend/internal/domain/listing/search"
+	misc_service "github.com/user2410/rrms-backend/internal/domain/misc/service"
 	statistic_dto "github.com/user2410/rrms-backend/internal/domain/statistic/dto"
-	"github.com/user2410/rrms-backend/internal/infrastructure/es"
+	"github.com/user2410/rrms-backend/internal/infrastructure/aws/s3"
 )
 
 type Service interface {
@@ -21,6 +26,17 @@ type Service interface {
 	GetTenantArrearsStatistic(userId uuid.UUID, query *statistic_dto.RentalPaymentStatisticQuery) (statistic_dto.TenantArrearsStatistic, error)
 	GetTotalTenantsManagedByUserStatistic(userId uuid.UUID, query *statistic_dto.RentalStatisticQuery) (int32, error)
 	GetTotalTenantsOfUnitStatistic(unitId uuid.UUID) (int32, error)
+	GetOverdueRentals(userId uuid.UUID, query *statistic_dto.OverdueRentalsQuery) ([]statistic_dto.OverdueRentalItem, error)
+	// Reports
+	GetRentRoll(userId uuid.UUID, query *statistic_dto.RentRollQuery) ([]statistic_dto.RentRollItem, error)
+	GetOwnerStatement(userId uuid.UUID, query *statistic_dto.OwnerStatementQuery) (statistic_dto.OwnerStatement, error)
+	CreateReportSchedule(data *statistic_dto.CreateReportSchedule) (statistic_dto.ReportSchedule, error)
+	GetReportSchedules(userId uuid.UUID) ([]statistic_dto.ReportSchedule, error)
+	DeleteReportSchedule(userId uuid.UUID, id int64) error
+	GetAccountingEntries(userId uuid.UUID, query *statistic_dto.AccountingExportQuery) ([]statistic_dto.AccountingEntry, error)
+	// Listing analytics
+	GetListingFunnel(userId, listingId uuid.UUID, query *statistic_dto.ListingAnalyticsQuery) (statistic_dto.ListingFunnel, error)
+	GetListingPriorityComparison(query *statistic_dto.ListingAnalyticsQuery) ([]statistic_dto.ListingPriorityStats, error)
 	// Landing
 	GetRecentListings(limit int32, fields []string) ([]listing_model.ListingModel, error)
 	GetSimilarListingsToListing(id uuid.UUID, limit int) (statistic_dto.ListingsSuggestionResult, error)
@@ -29,17 +45,52 @@ type Service interface {
 type service struct {
 	// Repositories
 	domainRepo repos.DomainRepo
-	// ElasticSearch
-	esClient *es.ElasticSearchClient
+	// Listing search
+	searcher listing_search.Searcher
+
+	mService misc_service.Service
+
+	s3Client        s3.S3Client
+	imageBucketName string
+
+	cronEntries []cron.EntryID
+	feSite      string
 }
 
 func NewService(
 	domainRepo repos.DomainRepo,
-	esClient *es.ElasticSearchClient,
+	searcher listing_search.Searcher,
+	mService misc_service.Service,
+	s3Client s3.S3Client, imageBucketName string,
+	c *cron.Cron,
+	feSite string,
 ) Service {
-	return &service{
+	res := &service{
 		domainRepo: domainRepo,
 
-		esClient: esClient,
+		searcher: searcher,
+
+		mService:        mService,
+		s3Client:        s3Client,
+		imageBucketName: imageBucketName,
+		cronEntries:     []cron.EntryID{},
+		feSite:          feSite,
 	}
+	res.setupCronjob(c)
+	return res
+}
+
+// setupCronjob sends the scheduled reports on the first day of every month
+func (s *service) setupCronjob(c *cron.Cron) ([]cron.EntryID, error) {
+	entryID, err := c.AddFunc("0 7 1 * *", func() {
+		if err := s.sendScheduledReports(); err != nil {
+			log.Println("failed to send scheduled reports:", err)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.cronEntries = append(s.cronEntries, entryID)
+
+	return s.cronEntries, nil
 }
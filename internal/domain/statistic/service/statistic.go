@@ -98,6 +98,13 @@ func (s *service) GetTotalTenantsManagedByUserStatistic(userId uuid.UUID, query
 	return s.domainRepo.StatisticRepo.GetTotalTenantsManagedByUserStatistic(context.Background(), userId, query)
 }
 
+func (s *service) GetOverdueRentals(userId uuid.UUID, query *dto.OverdueRentalsQuery) ([]dto.OverdueRentalItem, error) {
+	if query.Limit == 0 {
+		query.Limit = math.MaxInt32
+	}
+	return s.domainRepo.StatisticRepo.GetOverdueRentals(context.Background(), userId, query)
+}
+
 func (s *service) GetRentalPaymentArrears(userId uuid.UUID, query *dto.RentalPaymentStatisticQuery) (res []dto.RentalPaymentArrearsItem, err error) {
 	user, err := s.domainRepo.AuthRepo.GetUserById(context.Background(), userId)
 	if err != nil {
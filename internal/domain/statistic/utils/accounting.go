@@ -0,0 +1,225 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	rental_util "github.com/user2410/rrms-backend/internal/domain/rental/utils"
+	"github.com/user2410/rrms-backend/internal/domain/statistic/dto"
+	"github.com/user2410/rrms-backend/internal/utils"
+	"github.com/user2410/rrms-backend/internal/utils/export"
+)
+
+type AccountingFormat string
+
+const (
+	// General-ledger CSV, one line per debit or credit
+	ACCOUNTINGFORMAT_GL AccountingFormat = "GL"
+	// QuickBooks Intuit Interchange Format
+	ACCOUNTINGFORMAT_IIF AccountingFormat = "IIF"
+	// Excel sheet laid out like the MISA voucher import template
+	ACCOUNTINGFORMAT_MISA AccountingFormat = "MISA"
+)
+
+var ErrUnsupportedAccountingFormat = errors.New("unsupported accounting format")
+
+// AccountingConfig maps the booked transactions to chart-of-accounts codes
+type AccountingConfig struct {
+	// account receiving and paying out money
+	CashAccount string `json:"cashAccount"`
+	// expense account of listing fees, refunds are credited back to it
+	ListingFeeAccount string `json:"listingFeeAccount"`
+	// credit account of rental payment types missing from RentalPayments
+	DefaultAccount string                                   `json:"defaultAccount"`
+	RentalPayments map[rental_util.RentalPaymentType]string `json:"rentalPayments"`
+}
+
+var accountingConfig AccountingConfig
+
+func init() {
+	basepath := utils.GetBasePath()
+	file, err := os.ReadFile(fmt.Sprintf("%s/internal/config/accounting.json", basepath))
+	if err != nil {
+		panic(err)
+	}
+	err = json.Unmarshal(file, &accountingConfig)
+	if err != nil {
+		panic(err)
+	}
+	log.Println("Accounting config loaded successfully", accountingConfig)
+}
+
+func GetAccountingConfig() AccountingConfig {
+	return accountingConfig
+}
+
+// RentalPaymentAccount returns the account credited when a rental payment of type t is collected
+func (c *AccountingConfig) RentalPaymentAccount(t rental_util.RentalPaymentType) string {
+	if a, ok := c.RentalPayments[t]; ok {
+		return a
+	}
+	return c.DefaultAccount
+}
+
+// ToAccountingEntries books each transaction as a double entry:
+// collected rental payments debit cash, listing fees credit cash and refunds reverse the listing fee
+func ToAccountingEntries(c *AccountingConfig, txs []dto.AccountingTransaction) []dto.AccountingEntry {
+	entries := make([]dto.AccountingEntry, 0, len(txs))
+	for _, tx := range txs {
+		e := dto.AccountingEntry{AccountingTransaction: tx}
+		switch tx.Source {
+		case dto.ACCOUNTINGSOURCE_RENTALPAYMENT:
+			e.DebitAccount = c.CashAccount
+			e.CreditAccount = c.RentalPaymentAccount(rental_util.RentalPaymentType(tx.Category))
+		case dto.ACCOUNTINGSOURCE_LISTINGFEE:
+			e.DebitAccount = c.ListingFeeAccount
+			e.CreditAccount = c.CashAccount
+		case dto.ACCOUNTINGSOURCE_REFUND:
+			e.DebitAccount = c.CashAccount
+			e.CreditAccount = c.ListingFeeAccount
+		default:
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func (f AccountingFormat) ContentType() string {
+	switch f {
+	case ACCOUNTINGFORMAT_GL:
+		return export.FORMAT_CSV.ContentType()
+	case ACCOUNTINGFORMAT_MISA:
+		return export.FORMAT_XLSX.ContentType()
+	}
+	return "text/plain; charset=utf-8"
+}
+
+func (f AccountingFormat) Extension() string {
+	switch f {
+	case ACCOUNTINGFORMAT_GL:
+		return export.FORMAT_CSV.Extension()
+	case ACCOUNTINGFORMAT_MISA:
+		return export.FORMAT_XLSX.Extension()
+	}
+	return ".iif"
+}
+
+// WriteAccountingExport renders the entries in the given bookkeeping format
+func WriteAccountingExport(w io.Writer, f AccountingFormat, entries []dto.AccountingEntry) error {
+	switch f {
+	case ACCOUNTINGFORMAT_GL:
+		return export.WriteCSV(w, GeneralLedgerDocument(entries))
+	case ACCOUNTINGFORMAT_IIF:
+		return WriteIIF(w, entries)
+	case ACCOUNTINGFORMAT_MISA:
+		return export.WriteXLSX(w, MisaDocument(entries))
+	}
+	return ErrUnsupportedAccountingFormat
+}
+
+// GeneralLedgerDocument lists every entry as a debit line followed by its credit line
+func GeneralLedgerDocument(entries []dto.AccountingEntry) *export.Document {
+	t := export.Table{
+		Title: "General ledger",
+		Columns: []export.Column{
+			{Name: "Date"},
+			{Name: "Reference"},
+			{Name: "Account"},
+			{Name: "Debit", Numeric: true},
+			{Name: "Credit", Numeric: true},
+			{Name: "Description"},
+			{Name: "Property"},
+		},
+		Rows: make([][]string, 0, 2*len(entries)),
+	}
+	for _, e := range entries {
+		date := e.Date.Format("2006-01-02")
+		amount := formatAmount(e.Amount)
+		t.Rows = append(t.Rows,
+			[]string{date, e.Reference, e.DebitAccount, amount, "", e.Description, e.PropertyName},
+			[]string{date, e.Reference, e.CreditAccount, "", amount, e.Description, e.PropertyName},
+		)
+	}
+	return &export.Document{
+		Title:  "General ledger",
+		Tables: []export.Table{t},
+	}
+}
+
+// MisaDocument lays the entries out like the MISA "Chứng từ nghiệp vụ khác" import sheet, one voucher line per entry
+func MisaDocument(entries []dto.AccountingEntry) *export.Document {
+	t := export.Table{
+		Title: "Chứng từ",
+		Columns: []export.Column{
+			{Name: "Ngày hạch toán"},
+			{Name: "Ngày chứng từ"},
+			{Name: "Số chứng từ"},
+			{Name: "Diễn giải"},
+			{Name: "TK Nợ"},
+			{Name: "TK Có"},
+			{Name: "Số tiền", Numeric: true},
+			{Name: "Đối tượng"},
+		},
+		Rows: make([][]string, 0, len(entries)),
+	}
+	for _, e := range entries {
+		date := formatDate(&e.Date)
+		t.Rows = append(t.Rows, []string{
+			date,
+			date,
+			e.Reference,
+			e.Description,
+			e.DebitAccount,
+			e.CreditAccount,
+			formatAmount(e.Amount),
+			e.PropertyName,
+		})
+	}
+	return &export.Document{
+		Title:  "Chứng từ nghiệp vụ khác",
+		Tables: []export.Table{t},
+	}
+}
+
+// iifField removes the characters that would break the tab separated IIF layout
+func iifField(s string) string {
+	return strings.NewReplacer("\t", " ", "\r", " ", "\n", " ", `"`, "'").Replace(s)
+}
+
+// WriteIIF writes the entries as QuickBooks general journal transactions: the debit is the TRNS line and the credit its SPL line
+func WriteIIF(w io.Writer, entries []dto.AccountingEntry) error {
+	bw := bufio.NewWriter(w)
+	header := []string{
+		"!TRNS\tTRNSID\tTRNSTYPE\tDATE\tACCNT\tNAME\tAMOUNT\tDOCNUM\tMEMO",
+		"!SPL\tSPLID\tTRNSTYPE\tDATE\tACCNT\tNAME\tAMOUNT\tDOCNUM\tMEMO",
+		"!ENDTRNS",
+	}
+	for _, h := range header {
+		if _, err := bw.WriteString(h + "\r\n"); err != nil {
+			return err
+		}
+	}
+	for _, e := range entries {
+		date := e.Date.Format("01/02/2006")
+		name, ref, memo := iifField(e.PropertyName), iifField(e.Reference), iifField(e.Description)
+		amount := formatAmount(e.Amount)
+		lines := []string{
+			strings.Join([]string{"TRNS", "", "GENERAL JOURNAL", date, e.DebitAccount, name, amount, ref, memo}, "\t"),
+			strings.Join([]string{"SPL", "", "GENERAL JOURNAL", date, e.CreditAccount, name, "-" + amount, ref, memo}, "\t"),
+			"ENDTRNS",
+		}
+		for _, l := range lines {
+			if _, err := bw.WriteString(l + "\r\n"); err != nil {
+				return err
+			}
+		}
+	}
+	return bw.Flush()
+}
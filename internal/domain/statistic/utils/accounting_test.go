@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	rental_util "github.com/user2410/rrms-backend/internal/domain/rental/utils"
+	"github.com/user2410/rrms-backend/internal/domain/statistic/dto"
+)
+
+var testAccountingConfig = AccountingConfig{
+	CashAccount:       "1121",
+	ListingFeeAccount: "6418",
+	DefaultAccount:    "5113",
+	RentalPayments: map[rental_util.RentalPaymentType]string{
+		rental_util.RENTALPAYMENTTYPERENTAL:  "5113",
+		rental_util.RENTALPAYMENTTYPEDEPOSIT: "3386",
+	},
+}
+
+func testAccountingEntries() []dto.AccountingEntry {
+	date := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+	return ToAccountingEntries(&testAccountingConfig, []dto.AccountingTransaction{
+		{Source: dto.ACCOUNTINGSOURCE_RENTALPAYMENT, Reference: "1_DEPOSIT_0320240320", Date: date, Category: "DEPOSIT", Description: "Thu Đặt cọc - Nguyễn Văn A", PropertyName: "Nhà A", Amount: 5_000_000},
+		{Source: dto.ACCOUNTINGSOURCE_RENTALPAYMENT, Reference: "1_WATER_0320240320", Date: date, Category: "WATER", Description: "Thu Tiền nước", PropertyName: "Nhà A", Amount: 100_000},
+		{Source: dto.ACCOUNTINGSOURCE_LISTINGFEE, Reference: "PAY7", Date: date, Description: "Phi dang tin\tnha", PropertyName: "Nhà A", Amount: 30_000},
+		{Source: dto.ACCOUNTINGSOURCE_REFUND, Reference: "RF2", Date: date, Description: "Hoan tien GD ma:05101010", Amount: 30_000},
+	})
+}
+
+func TestAccountingConfigLoaded(t *testing.T) {
+	config := GetAccountingConfig()
+	require.NotEmpty(t, config.CashAccount)
+	require.NotEmpty(t, config.ListingFeeAccount)
+	require.NotEmpty(t, config.DefaultAccount)
+	for _, pt := range []rental_util.RentalPaymentType{
+		rental_util.RENTALPAYMENTTYPERENTAL, rental_util.RENTALPAYMENTTYPEDEPOSIT, rental_util.RENTALPAYMENTTYPEELECTRICITY,
+		rental_util.RENTALPAYMENTTYPEWATER, rental_util.RENTALPAYMENTTYPESERVICE, rental_util.RENTALPAYMENTTYPEMAINTENANCE,
+	} {
+		require.NotEmpty(t, config.RentalPayments[pt], pt)
+	}
+}
+
+func TestToAccountingEntries(t *testing.T) {
+	entries := testAccountingEntries()
+	require.Len(t, entries, 4)
+	require.Equal(t, [2]string{"1121", "3386"}, [2]string{entries[0].DebitAccount, entries[0].CreditAccount})
+	// unmapped payment types fall back to the default account
+	require.Equal(t, [2]string{"1121", "5113"}, [2]string{entries[1].DebitAccount, entries[1].CreditAccount})
+	require.Equal(t, [2]string{"6418", "1121"}, [2]string{entries[2].DebitAccount, entries[2].CreditAccount})
+	require.Equal(t, [2]string{"1121", "6418"}, [2]string{entries[3].DebitAccount, entries[3].CreditAccount})
+}
+
+func TestGeneralLedgerDocument(t *testing.T) {
+	doc := GeneralLedgerDocument(testAccountingEntries())
+	require.Len(t, doc.Tables, 1)
+	require.Len(t, doc.Tables[0].Rows, 8)
+	require.Equal(t, []string{"2024-03-05", "1_DEPOSIT_0320240320", "1121", "5000000", "", "Thu Đặt cọc - Nguyễn Văn A", "Nhà A"}, doc.Tables[0].Rows[0])
+	require.Equal(t, []string{"2024-03-05", "1_DEPOSIT_0320240320", "3386", "", "5000000", "Thu Đặt cọc - Nguyễn Văn A", "Nhà A"}, doc.Tables[0].Rows[1])
+}
+
+func TestMisaDocument(t *testing.T) {
+	doc := MisaDocument(testAccountingEntries())
+	require.Len(t, doc.Tables, 1)
+	require.Len(t, doc.Tables[0].Rows, 4)
+	require.Equal(t, []string{"05/03/2024", "05/03/2024", "PAY7", "Phi dang tin\tnha", "6418", "1121", "30000", "Nhà A"}, doc.Tables[0].Rows[2])
+}
+
+func TestWriteIIF(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteIIF(&buf, testAccountingEntries()))
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+	require.Len(t, lines, 3+4*3)
+	require.Equal(t, "!ENDTRNS", lines[2])
+	require.Equal(t, "TRNS\t\tGENERAL JOURNAL\t03/05/2024\t6418\tNhà A\t30000\tPAY7\tPhi dang tin nha", lines[9])
+	require.Equal(t, "SPL\t\tGENERAL JOURNAL\t03/05/2024\t1121\tNhà A\t-30000\tPAY7\tPhi dang tin nha", lines[10])
+	require.Equal(t, "ENDTRNS", lines[11])
+}
+
+func TestWriteAccountingExport(t *testing.T) {
+	for _, f := range []AccountingFormat{ACCOUNTINGFORMAT_GL, ACCOUNTINGFORMAT_IIF, ACCOUNTINGFORMAT_MISA} {
+		var buf bytes.Buffer
+		require.NoError(t, WriteAccountingExport(&buf, f, testAccountingEntries()), f)
+		require.NotZero(t, buf.Len(), f)
+	}
+	require.ErrorIs(t, WriteAccountingExport(&bytes.Buffer{}, "QBO", nil), ErrUnsupportedAccountingFormat)
+}
@@ -0,0 +1,28 @@
+package utils
+
+import "github.com/user2410/rrms-backend/internal/domain/statistic/dto"
+
+func ratio(a, b int64) float64 {
+	if b == 0 {
+		return 0
+	}
+	return float64(a) / float64(b)
+}
+
+func GetListingConversionRates(c *dto.ListingAnalyticsCounts) dto.ListingConversionRates {
+	return dto.ListingConversionRates{
+		ViewRate:        ratio(c.Views, c.Impressions),
+		ContactRate:     ratio(c.Contacts, c.Views),
+		ApplicationRate: ratio(c.Applications, c.Views),
+		ViewingRate:     ratio(c.Viewings, c.Contacts),
+	}
+}
+
+// ComputeListingPriorityStats fills the averages and rates of the stats from their totals
+func ComputeListingPriorityStats(s *dto.ListingPriorityStats) {
+	s.AvgImpressions = ratio(s.Totals.Impressions, s.ListingDays)
+	s.AvgViews = ratio(s.Totals.Views, s.ListingDays)
+	s.AvgContacts = ratio(s.Totals.Contacts, s.ListingDays)
+	s.AvgApplications = ratio(s.Totals.Applications, s.ListingDays)
+	s.Rates = GetListingConversionRates(&s.Totals)
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/statistic/dto"
+)
+
+func TestGetListingConversionRates(t *testing.T) {
+	rates := GetListingConversionRates(&dto.ListingAnalyticsCounts{
+		Impressions:  200,
+		Views:        50,
+		Contacts:     10,
+		Applications: 5,
+		Viewings:     4,
+	})
+	require.InDelta(t, 0.25, rates.ViewRate, 1e-9)
+	require.InDelta(t, 0.2, rates.ContactRate, 1e-9)
+	require.InDelta(t, 0.1, rates.ApplicationRate, 1e-9)
+	require.InDelta(t, 0.4, rates.ViewingRate, 1e-9)
+
+	// no division by zero on empty stages
+	rates = GetListingConversionRates(&dto.ListingAnalyticsCounts{Contacts: 1})
+	require.Zero(t, rates.ViewRate)
+	require.Zero(t, rates.ContactRate)
+	require.Zero(t, rates.ApplicationRate)
+	require.Zero(t, rates.ViewingRate)
+}
+
+func TestComputeListingPriorityStats(t *testing.T) {
+	s := dto.ListingPriorityStats{
+		Priority:    3,
+		Listings:    2,
+		ListingDays: 4,
+		Totals: dto.ListingAnalyticsCounts{
+			Impressions:  400,
+			Views:        40,
+			Contacts:     8,
+			Applications: 2,
+		},
+	}
+	ComputeListingPriorityStats(&s)
+	require.InDelta(t, 100, s.AvgImpressions, 1e-9)
+	require.InDelta(t, 10, s.AvgViews, 1e-9)
+	require.InDelta(t, 2, s.AvgContacts, 1e-9)
+	require.InDelta(t, 0.5, s.AvgApplications, 1e-9)
+	require.InDelta(t, 0.1, s.Rates.ViewRate, 1e-9)
+}
@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	rental_util "github.com/user2410/rrms-backend/internal/domain/rental/utils"
+	"github.com/user2410/rrms-backend/internal/domain/statistic/dto"
+	"github.com/user2410/rrms-backend/internal/utils/export"
+)
+
+// GetMonthRange returns the first day of the month of t and the first day of the following month
+func GetMonthRange(t time.Time) (time.Time, time.Time) {
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	return start, start.AddDate(0, 1, 0)
+}
+
+// ComputeOwnerStatement fills the totals of the statement: the management fee is a percentage of the collected income,
+// the net payout is what remains for the owner after expenses and management fee
+func ComputeOwnerStatement(st *dto.OwnerStatement) {
+	st.TotalIncome, st.TotalExpense = 0, 0
+	for _, l := range st.Incomes {
+		st.TotalIncome += l.Amount
+	}
+	for _, l := range st.Expenses {
+		st.TotalExpense += l.Amount
+	}
+	st.ManagementFee = st.TotalIncome * st.ManagementFeeRate / 100
+	st.NetPayout = st.TotalIncome - st.TotalExpense - st.ManagementFee
+}
+
+func formatAmount(v float32) string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 32)
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("02/01/2006")
+}
+
+func RentRollDocument(items []dto.RentRollItem, at time.Time) *export.Document {
+	t := export.Table{
+		Title: "Danh sách thuê",
+		Columns: []export.Column{
+			{Name: "Nhà cho thuê"},
+			{Name: "Phòng"},
+			{Name: "Người thuê"},
+			{Name: "Ngày bắt đầu"},
+			{Name: "Ngày kết thúc"},
+			{Name: "Giá thuê", Numeric: true},
+			{Name: "Tiền cọc", Numeric: true},
+			{Name: "Còn nợ", Numeric: true},
+		},
+		Rows: make([][]string, 0, len(items)),
+	}
+	for _, i := range items {
+		var tenantName, rentalPrice string
+		if i.TenantName != nil {
+			tenantName = *i.TenantName
+		} else {
+			tenantName = "(Trống)"
+		}
+		if i.RentalPrice != nil {
+			rentalPrice = formatAmount(*i.RentalPrice)
+		}
+		t.Rows = append(t.Rows, []string{
+			i.PropertyName,
+			i.UnitName,
+			tenantName,
+			formatDate(i.StartDate),
+			formatDate(i.EndDate),
+			rentalPrice,
+			formatAmount(i.Deposit),
+			formatAmount(i.Balance),
+		})
+	}
+	return &export.Document{
+		Title:  fmt.Sprintf("Báo cáo danh sách thuê ngày %s", at.Format("02/01/2006")),
+		Tables: []export.Table{t},
+	}
+}
+
+func OwnerStatementDocument(st *dto.OwnerStatement) *export.Document {
+	columns := []export.Column{{Name: "Khoản mục"}, {Name: "Số tiền", Numeric: true}}
+	incomes := export.Table{Title: "Thu nhập", Columns: columns}
+	for _, l := range st.Incomes {
+		incomes.Rows = append(incomes.Rows, []string{rental_util.GetRentalPaymentTypeName(rental_util.RentalPaymentType(l.Category)), formatAmount(l.Amount)})
+	}
+	expenses := export.Table{Title: "Chi phí", Columns: columns}
+	for _, l := range st.Expenses {
+		expenses.Rows = append(expenses.Rows, []string{l.Category, formatAmount(l.Amount)})
+	}
+	summary := export.Table{
+		Title:   "Tổng kết",
+		Columns: columns,
+		Rows: [][]string{
+			{"Tổng thu nhập", formatAmount(st.TotalIncome)},
+			{"Tổng chi phí", formatAmount(st.TotalExpense)},
+			{fmt.Sprintf("Phí quản lý (%s%%)", formatAmount(st.ManagementFeeRate)), formatAmount(st.ManagementFee)},
+			{"Thực nhận", formatAmount(st.NetPayout)},
+		},
+	}
+	return &export.Document{
+		Title:  fmt.Sprintf("Báo cáo chủ nhà %s tháng %s", st.PropertyName, st.Month.Format("01/2006")),
+		Tables: []export.Table{incomes, expenses, summary},
+	}
+}
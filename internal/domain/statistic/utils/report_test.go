@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/statistic/dto"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func TestGetMonthRange(t *testing.T) {
+	start, end := GetMonthRange(time.Date(2024, time.December, 15, 10, 30, 0, 0, time.UTC))
+	require.Equal(t, time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), end)
+}
+
+func TestComputeOwnerStatement(t *testing.T) {
+	st := dto.OwnerStatement{
+		Incomes:           []dto.OwnerStatementLine{{Category: "RENTAL", Amount: 10_000_000}, {Category: "SERVICE", Amount: 500_000}},
+		Expenses:          []dto.OwnerStatementLine{{Category: "Sửa chữa", Amount: 1_200_000}},
+		ManagementFeeRate: 10,
+	}
+	ComputeOwnerStatement(&st)
+	require.Equal(t, float32(10_500_000), st.TotalIncome)
+	require.Equal(t, float32(1_200_000), st.TotalExpense)
+	require.Equal(t, float32(1_050_000), st.ManagementFee)
+	require.Equal(t, float32(8_250_000), st.NetPayout)
+
+	doc := OwnerStatementDocument(&st)
+	require.Len(t, doc.Tables, 3)
+	require.Equal(t, []string{"Thuê nhà", "10000000"}, doc.Tables[0].Rows[0])
+	require.Equal(t, []string{"Phí quản lý (10%)", "1050000"}, doc.Tables[2].Rows[2])
+	require.Equal(t, []string{"Thực nhận", "8250000"}, doc.Tables[2].Rows[3])
+}
+
+func TestRentRollDocument(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	doc := RentRollDocument([]dto.RentRollItem{
+		{
+			PropertyName: "Nhà A", UnitName: "P.101",
+			RentalID: types.Ptr[int64](1), TenantName: types.Ptr("Nguyễn Văn A"),
+			StartDate: &start, EndDate: &end, RentalPrice: types.Ptr[float32](5_000_000),
+			Deposit: 5_000_000, Balance: 1_500_000,
+		},
+		{PropertyName: "Nhà A", UnitName: "P.102"},
+	}, start)
+
+	require.Len(t, doc.Tables, 1)
+	require.Equal(t, []string{"Nhà A", "P.101", "Nguyễn Văn A", "01/01/2024", "01/01/2025", "5000000", "5000000", "1500000"}, doc.Tables[0].Rows[0])
+	require.Equal(t, []string{"Nhà A", "P.102", "(Trống)", "", "", "", "0", "0"}, doc.Tables[0].Rows[1])
+}
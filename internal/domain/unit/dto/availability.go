@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type CreateUnitMaintenanceBlock struct {
+	UnitID    uuid.UUID `json:"unitId" validate:"required,uuid4"`
+	CreatorID uuid.UUID `json:"creatorId" validate:"required,uuid4"`
+	StartDate time.Time `json:"startDate" validate:"required"`
+	EndDate   time.Time `json:"endDate" validate:"required,gtfield=StartDate"`
+	Reason    *string   `json:"reason" validate:"omitempty"`
+}
+
+func (c *CreateUnitMaintenanceBlock) ToCreateUnitMaintenanceBlockDB() database.CreateUnitMaintenanceBlockParams {
+	return database.CreateUnitMaintenanceBlockParams{
+		UnitID:    c.UnitID,
+		CreatorID: c.CreatorID,
+		StartDate: pgtype.Date{Time: c.StartDate, Valid: true},
+		EndDate:   pgtype.Date{Time: c.EndDate, Valid: true},
+		Reason:    types.StrN(c.Reason),
+	}
+}
+
+type GetUnitTimelineQuery struct {
+	From time.Time `query:"from" validate:"required"`
+	To   time.Time `query:"to" validate:"required,gtfield=From"`
+}
@@ -50,6 +50,10 @@ func (a *adapter) RegisterServer(router *fiber.Router, tokenMaker token.Maker) {
 	unitRoute.Post("/create", a.createUnit())
 	unitRoute.Patch("/unit/:id", CheckUnitManageability(a.uService), a.updateUnit())
 	unitRoute.Delete("/unit/:id", CheckUnitManageability(a.uService), a.deleteUnit())
+	unitRoute.Get("/unit/:id/timeline", CheckUnitManageability(a.uService), a.getUnitTimeline())
+	unitRoute.Post("/unit/:id/maintenance-blocks", CheckUnitManageability(a.uService), a.createUnitMaintenanceBlock())
+	unitRoute.Get("/unit/:id/maintenance-blocks", CheckUnitManageability(a.uService), a.getUnitMaintenanceBlocks())
+	unitRoute.Delete("/unit/:id/maintenance-blocks/:bid", CheckUnitManageability(a.uService), a.deleteUnitMaintenanceBlock())
 }
 
 func (a *adapter) preCreateUnit() fiber.Handler {
@@ -198,6 +202,9 @@ func (a *adapter) updateUnit() fiber.Handler {
 			if dbErr, ok := err.(*pgconn.PgError); ok {
 				return responses.DBErrorResponse(ctx, dbErr)
 			}
+			if dbErr, ok := err.(*database.TXError); ok {
+				return responses.DBTXErrorResponse(ctx, dbErr)
+			}
 			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
 		}
 		return nil
@@ -217,6 +224,9 @@ func (a *adapter) deleteUnit() fiber.Handler {
 			if dbErr, ok := err.(*pgconn.PgError); ok {
 				return responses.DBErrorResponse(ctx, dbErr)
 			}
+			if dbErr, ok := err.(*database.TXError); ok {
+				return responses.DBTXErrorResponse(ctx, dbErr)
+			}
 
 			ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": err.Error()})
 		}
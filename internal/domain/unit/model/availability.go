@@ -0,0 +1,94 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+type UNITOCCUPANCYTYPE string
+
+const (
+	UNITOCCUPANCYTYPE_RENTAL      UNITOCCUPANCYTYPE = "RENTAL"
+	UNITOCCUPANCYTYPE_PRERENTAL   UNITOCCUPANCYTYPE = "PRERENTAL"
+	UNITOCCUPANCYTYPE_APPLICATION UNITOCCUPANCYTYPE = "APPLICATION"
+	UNITOCCUPANCYTYPE_MAINTENANCE UNITOCCUPANCYTYPE = "MAINTENANCE"
+)
+
+type UNITAVAILABILITYSTATUS string
+
+const (
+	UNITAVAILABILITYSTATUS_OCCUPIED  UNITAVAILABILITYSTATUS = "OCCUPIED"
+	UNITAVAILABILITYSTATUS_RESERVED  UNITAVAILABILITYSTATUS = "RESERVED"
+	UNITAVAILABILITYSTATUS_BLOCKED   UNITAVAILABILITYSTATUS = "BLOCKED"
+	UNITAVAILABILITYSTATUS_AVAILABLE UNITAVAILABILITYSTATUS = "AVAILABLE"
+)
+
+// UnitOccupancyModel is a period [StartDate, EndDate) during which the unit cannot be rented to someone else
+type UnitOccupancyModel struct {
+	Type      UNITOCCUPANCYTYPE `json:"type"`
+	RefID     int64             `json:"refId"` // id of the rental, prerental, application or maintenance block
+	UnitID    uuid.UUID         `json:"unitId"`
+	StartDate time.Time         `json:"startDate"`
+	EndDate   time.Time         `json:"endDate"`
+}
+
+func ToUnitOccupancyModel(o *database.UnitOccupancy) UnitOccupancyModel {
+	return UnitOccupancyModel{
+		Type:      UNITOCCUPANCYTYPE(o.Type),
+		RefID:     o.RefID,
+		UnitID:    o.UnitID,
+		StartDate: o.StartDate.Time,
+		EndDate:   o.EndDate.Time,
+	}
+}
+
+// Status returns the availability status of the unit during the occupancy
+func (o *UnitOccupancyModel) Status() UNITAVAILABILITYSTATUS {
+	switch o.Type {
+	case UNITOCCUPANCYTYPE_RENTAL:
+		return UNITAVAILABILITYSTATUS_OCCUPIED
+	case UNITOCCUPANCYTYPE_MAINTENANCE:
+		return UNITAVAILABILITYSTATUS_BLOCKED
+	default:
+		return UNITAVAILABILITYSTATUS_RESERVED
+	}
+}
+
+type UnitTimelineSegment struct {
+	Status    UNITAVAILABILITYSTATUS `json:"status"`
+	StartDate time.Time              `json:"startDate"`
+	EndDate   time.Time              `json:"endDate"`
+	Type      *UNITOCCUPANCYTYPE     `json:"type,omitempty"`
+	RefID     *int64                 `json:"refId,omitempty"`
+}
+
+type UnitTimeline struct {
+	UnitID        uuid.UUID             `json:"unitId"`
+	AvailableFrom time.Time             `json:"availableFrom"`
+	Segments      []UnitTimelineSegment `json:"segments"`
+}
+
+type UnitMaintenanceBlockModel struct {
+	ID        int64     `json:"id"`
+	UnitID    uuid.UUID `json:"unitId"`
+	CreatorID uuid.UUID `json:"creatorId"`
+	StartDate time.Time `json:"startDate"`
+	EndDate   time.Time `json:"endDate"`
+	Reason    *string   `json:"reason"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func ToUnitMaintenanceBlockModel(b *database.UnitMaintenanceBlock) UnitMaintenanceBlockModel {
+	return UnitMaintenanceBlockModel{
+		ID:        b.ID,
+		UnitID:    b.UnitID,
+		CreatorID: b.CreatorID,
+		StartDate: b.StartDate.Time,
+		EndDate:   b.EndDate.Time,
+		Reason:    types.PNStr(b.Reason),
+		CreatedAt: b.CreatedAt,
+	}
+}
@@ -0,0 +1,75 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/user2410/rrms-backend/internal/domain/unit/dto"
+	"github.com/user2410/rrms-backend/internal/domain/unit/model"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+func (r *repo) CreateUnitMaintenanceBlock(ctx context.Context, data *dto.CreateUnitMaintenanceBlock) (model.UnitMaintenanceBlockModel, error) {
+	res, err := r.dao.CreateUnitMaintenanceBlock(ctx, data.ToCreateUnitMaintenanceBlockDB())
+	if err != nil {
+		return model.UnitMaintenanceBlockModel{}, err
+	}
+	return model.ToUnitMaintenanceBlockModel(&res), nil
+}
+
+func (r *repo) GetUnitMaintenanceBlock(ctx context.Context, id int64) (model.UnitMaintenanceBlockModel, error) {
+	res, err := r.dao.GetUnitMaintenanceBlock(ctx, id)
+	if err != nil {
+		return model.UnitMaintenanceBlockModel{}, err
+	}
+	return model.ToUnitMaintenanceBlockModel(&res), nil
+}
+
+func (r *repo) GetUnitMaintenanceBlocks(ctx context.Context, uid uuid.UUID) ([]model.UnitMaintenanceBlockModel, error) {
+	res, err := r.dao.GetUnitMaintenanceBlocks(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.UnitMaintenanceBlockModel, 0, len(res))
+	for i := range res {
+		items = append(items, model.ToUnitMaintenanceBlockModel(&res[i]))
+	}
+	return items, nil
+}
+
+func (r *repo) DeleteUnitMaintenanceBlock(ctx context.Context, id int64) error {
+	return r.dao.DeleteUnitMaintenanceBlock(ctx, id)
+}
+
+func (r *repo) GetUnitOccupancies(ctx context.Context, uids []uuid.UUID, from, to time.Time) ([]model.UnitOccupancyModel, error) {
+	res, err := r.dao.GetUnitOccupancies(ctx, database.GetUnitOccupanciesParams{
+		UnitIds:  uids,
+		FromDate: pgtype.Date{Time: from, Valid: true},
+		ToDate:   pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.UnitOccupancyModel, 0, len(res))
+	for i := range res {
+		items = append(items, model.ToUnitOccupancyModel(&res[i]))
+	}
+	return items, nil
+}
+
+func (r *repo) GetUnitsAvailableFrom(ctx context.Context, uids []uuid.UUID, from time.Time) (map[uuid.UUID]time.Time, error) {
+	res, err := r.dao.GetUnitsAvailableFrom(ctx, database.GetUnitsAvailableFromParams{
+		FromDate: pgtype.Date{Time: from, Valid: true},
+		UnitIds:  uids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make(map[uuid.UUID]time.Time, len(res))
+	for _, item := range res {
+		items[item.ID] = item.AvailableFrom.Time
+	}
+	return items, nil
+}
@@ -12,6 +12,7 @@ package repo
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	dto "github.com/user2410/rrms-backend/internal/domain/unit/dto"
@@ -87,6 +88,21 @@ func (mr *MockRepoMockRecorder) CreateUnit(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUnit", reflect.TypeOf((*MockRepo)(nil).CreateUnit), arg0, arg1)
 }
 
+// CreateUnitMaintenanceBlock mocks base method.
+func (m *MockRepo) CreateUnitMaintenanceBlock(arg0 context.Context, arg1 *dto.CreateUnitMaintenanceBlock) (model.UnitMaintenanceBlockModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUnitMaintenanceBlock", arg0, arg1)
+	ret0, _ := ret[0].(model.UnitMaintenanceBlockModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUnitMaintenanceBlock indicates an expected call of CreateUnitMaintenanceBlock.
+func (mr *MockRepoMockRecorder) CreateUnitMaintenanceBlock(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUnitMaintenanceBlock", reflect.TypeOf((*MockRepo)(nil).CreateUnitMaintenanceBlock), arg0, arg1)
+}
+
 // DeleteUnit mocks base method.
 func (m *MockRepo) DeleteUnit(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -101,19 +117,18 @@ func (mr *MockRepoMockRecorder) DeleteUnit(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnit", reflect.TypeOf((*MockRepo)(nil).DeleteUnit), arg0, arg1)
 }
 
-// GetRentalsOfUnit mocks base method.
-func (m *MockRepo) GetRentalsOfUnit(arg0 context.Context, arg1 uuid.UUID) ([]int64, error) {
+// DeleteUnitMaintenanceBlock mocks base method.
+func (m *MockRepo) DeleteUnitMaintenanceBlock(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRentalsOfUnit", arg0, arg1)
-	ret0, _ := ret[0].([]int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteUnitMaintenanceBlock", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetRentalsOfUnit indicates an expected call of GetRentalsOfUnit.
-func (mr *MockRepoMockRecorder) GetRentalsOfUnit(arg0, arg1 any) *gomock.Call {
+// DeleteUnitMaintenanceBlock indicates an expected call of DeleteUnitMaintenanceBlock.
+func (mr *MockRepoMockRecorder) DeleteUnitMaintenanceBlock(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRentalsOfUnit", reflect.TypeOf((*MockRepo)(nil).GetRentalsOfUnit), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnitMaintenanceBlock", reflect.TypeOf((*MockRepo)(nil).DeleteUnitMaintenanceBlock), arg0, arg1)
 }
 
 // GetUnitById mocks base method.
@@ -131,6 +146,66 @@ func (mr *MockRepoMockRecorder) GetUnitById(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitById", reflect.TypeOf((*MockRepo)(nil).GetUnitById), arg0, arg1)
 }
 
+// GetUnitMaintenanceBlock mocks base method.
+func (m *MockRepo) GetUnitMaintenanceBlock(arg0 context.Context, arg1 int64) (model.UnitMaintenanceBlockModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitMaintenanceBlock", arg0, arg1)
+	ret0, _ := ret[0].(model.UnitMaintenanceBlockModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitMaintenanceBlock indicates an expected call of GetUnitMaintenanceBlock.
+func (mr *MockRepoMockRecorder) GetUnitMaintenanceBlock(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitMaintenanceBlock", reflect.TypeOf((*MockRepo)(nil).GetUnitMaintenanceBlock), arg0, arg1)
+}
+
+// GetUnitMaintenanceBlocks mocks base method.
+func (m *MockRepo) GetUnitMaintenanceBlocks(arg0 context.Context, arg1 uuid.UUID) ([]model.UnitMaintenanceBlockModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitMaintenanceBlocks", arg0, arg1)
+	ret0, _ := ret[0].([]model.UnitMaintenanceBlockModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitMaintenanceBlocks indicates an expected call of GetUnitMaintenanceBlocks.
+func (mr *MockRepoMockRecorder) GetUnitMaintenanceBlocks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitMaintenanceBlocks", reflect.TypeOf((*MockRepo)(nil).GetUnitMaintenanceBlocks), arg0, arg1)
+}
+
+// GetUnitOccupancies mocks base method.
+func (m *MockRepo) GetUnitOccupancies(arg0 context.Context, arg1 []uuid.UUID, arg2, arg3 time.Time) ([]model.UnitOccupancyModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitOccupancies", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]model.UnitOccupancyModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitOccupancies indicates an expected call of GetUnitOccupancies.
+func (mr *MockRepoMockRecorder) GetUnitOccupancies(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitOccupancies", reflect.TypeOf((*MockRepo)(nil).GetUnitOccupancies), arg0, arg1, arg2, arg3)
+}
+
+// GetUnitsAvailableFrom mocks base method.
+func (m *MockRepo) GetUnitsAvailableFrom(arg0 context.Context, arg1 []uuid.UUID, arg2 time.Time) (map[uuid.UUID]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitsAvailableFrom", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[uuid.UUID]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitsAvailableFrom indicates an expected call of GetUnitsAvailableFrom.
+func (mr *MockRepoMockRecorder) GetUnitsAvailableFrom(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitsAvailableFrom", reflect.TypeOf((*MockRepo)(nil).GetUnitsAvailableFrom), arg0, arg1, arg2)
+}
+
 // GetUnitsByIds mocks base method.
 func (m *MockRepo) GetUnitsByIds(arg0 context.Context, arg1 []uuid.UUID, arg2 []string) ([]model.UnitModel, error) {
 	m.ctrl.T.Helper()
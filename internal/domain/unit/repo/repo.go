@@ -31,6 +31,13 @@ type Repo interface {
 	IsPublic(ctx context.Context, id uuid.UUID) (bool, error)
 	UpdateUnit(ctx context.Context, data *dto.UpdateUnit) error
 	DeleteUnit(ctx context.Context, id uuid.UUID) error
+
+	CreateUnitMaintenanceBlock(ctx context.Context, data *dto.CreateUnitMaintenanceBlock) (model.UnitMaintenanceBlockModel, error)
+	GetUnitMaintenanceBlock(ctx context.Context, id int64) (model.UnitMaintenanceBlockModel, error)
+	GetUnitMaintenanceBlocks(ctx context.Context, uid uuid.UUID) ([]model.UnitMaintenanceBlockModel, error)
+	DeleteUnitMaintenanceBlock(ctx context.Context, id int64) error
+	GetUnitOccupancies(ctx context.Context, uids []uuid.UUID, from, to time.Time) ([]model.UnitOccupancyModel, error) // Get occupancies of units overlapping [from, to)
+	GetUnitsAvailableFrom(ctx context.Context, uids []uuid.UUID, from time.Time) (map[uuid.UUID]time.Time, error)
 }
 
 type repo struct {
@@ -210,11 +217,46 @@ func (r *repo) SearchUnitCombination(ctx context.Context, query *dto.SearchUnitC
 }
 
 func (r *repo) UpdateUnit(ctx context.Context, data *dto.UpdateUnit) error {
-	return r.dao.UpdateUnit(ctx, *data.ToUpdateUnitDB())
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		if err := tx.UpdateUnit(ctx, *data.ToUpdateUnitDB()); err != nil {
+			return err
+		}
+		return enqueueSearchSync(ctx, tx, data.ID)
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	// evict cache
+	r.redisClient.Del(ctx, fmt.Sprintf("unit:%s", data.ID.String()))
+
+	return nil
 }
 
 func (r *repo) DeleteUnit(ctx context.Context, id uuid.UUID) error {
-	return r.dao.DeleteUnit(ctx, id)
+	txErr := r.dao.ExecTx(ctx, nil, func(tx database.DAO) error {
+		// record the events while the listing units still reference the unit
+		if err := enqueueSearchSync(ctx, tx, id); err != nil {
+			return err
+		}
+		return tx.DeleteUnit(ctx, id)
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	// evict cache
+	r.redisClient.Del(ctx, fmt.Sprintf("unit:%s", id.String()))
+
+	return nil
+}
+
+// enqueueSearchSync records in the search outbox that the listings containing the unit must be reprojected
+func enqueueSearchSync(ctx context.Context, tx database.DAO, uid uuid.UUID) error {
+	return tx.CreateUnitSearchOutboxEvents(ctx, database.CreateUnitSearchOutboxEventsParams{
+		Source: database.SEARCHOUTBOXSOURCEUNIT,
+		UnitID: uid,
+	})
 }
 
 func (r *repo) CheckUnitManageability(ctx context.Context, id uuid.UUID, userId uuid.UUID) (bool, error) {
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/unit/dto"
+	"github.com/user2410/rrms-backend/internal/domain/unit/model"
+	unit_utils "github.com/user2410/rrms-backend/internal/domain/unit/utils"
+	"github.com/user2410/rrms-backend/internal/infrastructure/database"
+)
+
+var ErrMaintenanceBlockOverlapsRental = errors.New("maintenance block overlaps a rental of the unit")
+
+func (s *service) GetUnitTimeline(id uuid.UUID, from, to time.Time) (model.UnitTimeline, error) {
+	from, to = unit_utils.TruncateToDate(from), unit_utils.TruncateToDate(to)
+	occupancies, err := s.domainRepo.UnitRepo.GetUnitOccupancies(context.Background(), []uuid.UUID{id}, from, to)
+	if err != nil {
+		return model.UnitTimeline{}, err
+	}
+	availableFrom, err := s.domainRepo.UnitRepo.GetUnitsAvailableFrom(context.Background(), []uuid.UUID{id}, from)
+	if err != nil {
+		return model.UnitTimeline{}, err
+	}
+	return unit_utils.BuildUnitTimelines([]uuid.UUID{id}, occupancies, availableFrom, from, to)[0], nil
+}
+
+// CreateUnitMaintenanceBlock blocks the unit for maintenance. Blocking a period rented out to a tenant is not allowed.
+func (s *service) CreateUnitMaintenanceBlock(data *dto.CreateUnitMaintenanceBlock) (model.UnitMaintenanceBlockModel, error) {
+	data.StartDate, data.EndDate = unit_utils.TruncateToDate(data.StartDate), unit_utils.TruncateToDate(data.EndDate)
+	occupancies, err := s.domainRepo.UnitRepo.GetUnitOccupancies(context.Background(), []uuid.UUID{data.UnitID}, data.StartDate, data.EndDate)
+	if err != nil {
+		return model.UnitMaintenanceBlockModel{}, err
+	}
+	conflicts := unit_utils.FindOccupancyConflicts(occupancies, data.StartDate, data.EndDate, func(o *model.UnitOccupancyModel) bool {
+		return o.Type != model.UNITOCCUPANCYTYPE_RENTAL
+	})
+	if len(conflicts) > 0 {
+		return model.UnitMaintenanceBlockModel{}, ErrMaintenanceBlockOverlapsRental
+	}
+	return s.domainRepo.UnitRepo.CreateUnitMaintenanceBlock(context.Background(), data)
+}
+
+func (s *service) GetUnitMaintenanceBlocks(id uuid.UUID) ([]model.UnitMaintenanceBlockModel, error) {
+	return s.domainRepo.UnitRepo.GetUnitMaintenanceBlocks(context.Background(), id)
+}
+
+func (s *service) DeleteUnitMaintenanceBlock(uid uuid.UUID, id int64) error {
+	b, err := s.domainRepo.UnitRepo.GetUnitMaintenanceBlock(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if b.UnitID != uid {
+		return database.ErrRecordNotFound
+	}
+	return s.domainRepo.UnitRepo.DeleteUnitMaintenanceBlock(context.Background(), id)
+}
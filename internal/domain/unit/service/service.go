@@ -30,6 +30,11 @@ type Service interface {
 	CheckVisibility(id uuid.UUID, uid uuid.UUID) (bool, error)
 	CheckUnitManageability(id uuid.UUID, userId uuid.UUID) (bool, error)
 	CheckUnitOfProperty(pid, uid uuid.UUID) (bool, error)
+
+	GetUnitTimeline(id uuid.UUID, from, to time.Time) (model.UnitTimeline, error)
+	CreateUnitMaintenanceBlock(data *dto.CreateUnitMaintenanceBlock) (model.UnitMaintenanceBlockModel, error)
+	GetUnitMaintenanceBlocks(id uuid.UUID) ([]model.UnitMaintenanceBlockModel, error)
+	DeleteUnitMaintenanceBlock(uid uuid.UUID, id int64) error
 }
 
 type service struct {
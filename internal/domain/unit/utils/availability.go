@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user2410/rrms-backend/internal/domain/unit/model"
+)
+
+// statusPriority decides which status is shown when occupancies overlap
+var statusPriority = map[model.UNITAVAILABILITYSTATUS]int{
+	model.UNITAVAILABILITYSTATUS_OCCUPIED: 3,
+	model.UNITAVAILABILITYSTATUS_BLOCKED:  2,
+	model.UNITAVAILABILITYSTATUS_RESERVED: 1,
+}
+
+// BuildUnitTimeline splits [from, to) into consecutive segments, each covered by the same occupancy
+// (the one with the highest priority when several overlap) or by none (AVAILABLE).
+func BuildUnitTimeline(occupancies []model.UnitOccupancyModel, from, to time.Time) []model.UnitTimelineSegment {
+	if !from.Before(to) {
+		return []model.UnitTimelineSegment{}
+	}
+
+	boundaries := []time.Time{from, to}
+	for _, o := range occupancies {
+		if o.StartDate.After(from) && o.StartDate.Before(to) {
+			boundaries = append(boundaries, o.StartDate)
+		}
+		if o.EndDate.After(from) && o.EndDate.Before(to) {
+			boundaries = append(boundaries, o.EndDate)
+		}
+	}
+	slices.SortFunc(boundaries, func(a, b time.Time) int { return a.Compare(b) })
+	boundaries = slices.CompactFunc(boundaries, func(a, b time.Time) bool { return a.Equal(b) })
+
+	segments := make([]model.UnitTimelineSegment, 0, len(boundaries)-1)
+	for i := 0; i < len(boundaries)-1; i++ {
+		start, end := boundaries[i], boundaries[i+1]
+		var cover *model.UnitOccupancyModel
+		for j := range occupancies {
+			o := &occupancies[j]
+			if o.StartDate.After(start) || !o.EndDate.After(start) {
+				continue
+			}
+			if cover == nil || statusPriority[o.Status()] > statusPriority[cover.Status()] {
+				cover = o
+			}
+		}
+
+		segment := model.UnitTimelineSegment{
+			Status:    model.UNITAVAILABILITYSTATUS_AVAILABLE,
+			StartDate: start,
+			EndDate:   end,
+		}
+		if cover != nil {
+			segment.Status = cover.Status()
+			segment.Type = &cover.Type
+			segment.RefID = &cover.RefID
+		}
+
+		// merge with the previous segment if it is the same period of availability or of the same occupancy
+		if n := len(segments); n > 0 {
+			prev := &segments[n-1]
+			if prev.Status == segment.Status && (cover == nil || (*prev.Type == *segment.Type && *prev.RefID == *segment.RefID)) {
+				prev.EndDate = end
+				continue
+			}
+		}
+		segments = append(segments, segment)
+	}
+	return segments
+}
+
+// GetUnitAvailableFrom returns the first date from `from` on that is not covered by any occupancy
+func GetUnitAvailableFrom(occupancies []model.UnitOccupancyModel, from time.Time) time.Time {
+	sorted := slices.Clone(occupancies)
+	slices.SortFunc(sorted, func(a, b model.UnitOccupancyModel) int { return a.StartDate.Compare(b.StartDate) })
+
+	available := from
+	for _, o := range sorted {
+		if !o.EndDate.After(available) {
+			continue
+		}
+		if o.StartDate.After(available) {
+			break
+		}
+		available = o.EndDate
+	}
+	return available
+}
+
+// FindOccupancyConflicts returns the occupancies overlapping [start, end), except the ones accepted by the ignore function
+func FindOccupancyConflicts(
+	occupancies []model.UnitOccupancyModel,
+	start, end time.Time,
+	ignore func(o *model.UnitOccupancyModel) bool,
+) []model.UnitOccupancyModel {
+	conflicts := make([]model.UnitOccupancyModel, 0)
+	for i := range occupancies {
+		o := &occupancies[i]
+		if !o.StartDate.Before(end) || !o.EndDate.After(start) {
+			continue
+		}
+		if ignore != nil && ignore(o) {
+			continue
+		}
+		conflicts = append(conflicts, *o)
+	}
+	return conflicts
+}
+
+// TruncateToDate drops the time of day so that t can be compared with DATE columns
+func TruncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// BuildUnitTimelines builds the timeline of each unit over [from, to) from the occupancies of all the units
+func BuildUnitTimelines(
+	uids []uuid.UUID,
+	occupancies []model.UnitOccupancyModel,
+	availableFrom map[uuid.UUID]time.Time,
+	from, to time.Time,
+) []model.UnitTimeline {
+	byUnit := make(map[uuid.UUID][]model.UnitOccupancyModel, len(uids))
+	for _, o := range occupancies {
+		byUnit[o.UnitID] = append(byUnit[o.UnitID], o)
+	}
+	timelines := make([]model.UnitTimeline, 0, len(uids))
+	for _, uid := range uids {
+		af, ok := availableFrom[uid]
+		if !ok {
+			af = GetUnitAvailableFrom(byUnit[uid], from)
+		}
+		timelines = append(timelines, model.UnitTimeline{
+			UnitID:        uid,
+			AvailableFrom: af,
+			Segments:      BuildUnitTimeline(byUnit[uid], from, to),
+		})
+	}
+	return timelines
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/domain/unit/model"
+)
+
+func date(t *testing.T, s string) time.Time {
+	d, err := time.Parse("2006-01-02", s)
+	require.NoError(t, err)
+	return d
+}
+
+func TestBuildUnitTimeline(t *testing.T) {
+	unitId := uuid.New()
+	occupancies := []model.UnitOccupancyModel{
+		{Type: model.UNITOCCUPANCYTYPE_RENTAL, RefID: 1, UnitID: unitId, StartDate: date(t, "2024-01-01"), EndDate: date(t, "2024-07-01")},
+		{Type: model.UNITOCCUPANCYTYPE_MAINTENANCE, RefID: 2, UnitID: unitId, StartDate: date(t, "2024-07-01"), EndDate: date(t, "2024-07-15")},
+		{Type: model.UNITOCCUPANCYTYPE_APPLICATION, RefID: 3, UnitID: unitId, StartDate: date(t, "2024-08-01"), EndDate: date(t, "2025-08-01")},
+		// overlapped by the rental
+		{Type: model.UNITOCCUPANCYTYPE_PRERENTAL, RefID: 4, UnitID: unitId, StartDate: date(t, "2024-06-01"), EndDate: date(t, "2024-07-01")},
+	}
+
+	segments := BuildUnitTimeline(occupancies, date(t, "2024-06-01"), date(t, "2024-09-01"))
+	require.Len(t, segments, 4)
+
+	require.Equal(t, model.UNITAVAILABILITYSTATUS_OCCUPIED, segments[0].Status)
+	require.Equal(t, int64(1), *segments[0].RefID)
+	require.Equal(t, date(t, "2024-06-01"), segments[0].StartDate)
+	require.Equal(t, date(t, "2024-07-01"), segments[0].EndDate)
+
+	require.Equal(t, model.UNITAVAILABILITYSTATUS_BLOCKED, segments[1].Status)
+	require.Equal(t, date(t, "2024-07-15"), segments[1].EndDate)
+
+	require.Equal(t, model.UNITAVAILABILITYSTATUS_AVAILABLE, segments[2].Status)
+	require.Nil(t, segments[2].RefID)
+	require.Equal(t, date(t, "2024-07-15"), segments[2].StartDate)
+	require.Equal(t, date(t, "2024-08-01"), segments[2].EndDate)
+
+	require.Equal(t, model.UNITAVAILABILITYSTATUS_RESERVED, segments[3].Status)
+	require.Equal(t, date(t, "2024-09-01"), segments[3].EndDate)
+
+	require.Empty(t, BuildUnitTimeline(occupancies, date(t, "2024-09-01"), date(t, "2024-09-01")))
+
+	segments = BuildUnitTimeline(nil, date(t, "2024-06-01"), date(t, "2024-09-01"))
+	require.Len(t, segments, 1)
+	require.Equal(t, model.UNITAVAILABILITYSTATUS_AVAILABLE, segments[0].Status)
+}
+
+func TestGetUnitAvailableFrom(t *testing.T) {
+	occupancies := []model.UnitOccupancyModel{
+		{Type: model.UNITOCCUPANCYTYPE_MAINTENANCE, RefID: 2, StartDate: date(t, "2024-07-01"), EndDate: date(t, "2024-07-15")},
+		{Type: model.UNITOCCUPANCYTYPE_RENTAL, RefID: 1, StartDate: date(t, "2024-01-01"), EndDate: date(t, "2024-07-01")},
+		{Type: model.UNITOCCUPANCYTYPE_APPLICATION, RefID: 3, StartDate: date(t, "2024-08-01"), EndDate: date(t, "2025-08-01")},
+	}
+
+	require.Equal(t, date(t, "2024-07-15"), GetUnitAvailableFrom(occupancies, date(t, "2024-03-01")))
+	require.Equal(t, date(t, "2024-07-20"), GetUnitAvailableFrom(occupancies, date(t, "2024-07-20")))
+	require.Equal(t, date(t, "2025-08-01"), GetUnitAvailableFrom(occupancies, date(t, "2024-08-01")))
+}
+
+func TestFindOccupancyConflicts(t *testing.T) {
+	occupancies := []model.UnitOccupancyModel{
+		{Type: model.UNITOCCUPANCYTYPE_RENTAL, RefID: 1, StartDate: date(t, "2024-01-01"), EndDate: date(t, "2024-07-01")},
+		{Type: model.UNITOCCUPANCYTYPE_APPLICATION, RefID: 3, StartDate: date(t, "2024-08-01"), EndDate: date(t, "2025-08-01")},
+	}
+
+	// adjacent periods do not overlap
+	require.Empty(t, FindOccupancyConflicts(occupancies, date(t, "2024-07-01"), date(t, "2024-08-01"), nil))
+
+	conflicts := FindOccupancyConflicts(occupancies, date(t, "2024-06-01"), date(t, "2024-09-01"), nil)
+	require.Len(t, conflicts, 2)
+
+	conflicts = FindOccupancyConflicts(occupancies, date(t, "2024-06-01"), date(t, "2024-09-01"), func(o *model.UnitOccupancyModel) bool {
+		return o.Type == model.UNITOCCUPANCYTYPE_APPLICATION && o.RefID == 3
+	})
+	require.Len(t, conflicts, 1)
+	require.Equal(t, int64(1), conflicts[0].RefID)
+}
@@ -54,7 +54,7 @@ func (d *RedisTaskDistributor) DistributeTask(
 	task := asynq.NewTask(taskType, payload, opts...)
 	info, err := d.client.EnqueueContext(ctx, task)
 	if err != nil {
-		return ErrEnqueueTask
+		return fmt.Errorf("%w: %w", ErrEnqueueTask, err)
 	}
 
 	log.Info().
@@ -82,8 +82,12 @@ func (d *RedisTaskDistributor) DistributeTaskJSON(
 }
 
 const (
-	APPLICATION_NEW    = "applications/new"
-	APPLICATION_UPDATE = "applications/application/update"
+	APPLICATION_NEW              = "applications/new"
+	APPLICATION_UPDATE           = "applications/application/update"
+	APPLICATION_DOCUMENTS_REMIND = "applications/documents/remind"
+	APPLICATION_WAITLIST_CREATE  = "applications/waitlist/create"
+	APPLICATION_WAITLIST_PROMOTE = "applications/waitlist/promote"
+	APPLICATION_EXPIRE           = "applications/expire"
 
 	RENTAL_PRERENTAL_NEW           = "rentals/prerental/new"
 	RENTAL_PRERENTAL_UPDATE        = "rentals/prerental/update"
@@ -97,4 +101,17 @@ const (
 
 	PROPERTY_VERIFICATION_CREATE = "properties/verification/create"
 	PROPERTY_VERIFICATION_UPDATE = "properties/verification/update"
+
+	LISTING_SEARCH_OUTBOX_PROCESS = "listings/search/outbox/process"
+	LISTING_SAVED_SEARCH_MATCH    = "listings/saved-search/match"
+	LISTING_SAVED_SEARCH_ALERT    = "listings/saved-search/alert"
+	LISTING_MODERATION_CHECK      = "listings/moderation/check"
+	LISTING_MODERATION_NOTIFY     = "listings/moderation/notify"
+	LISTING_MODERATION_REFUND     = "listings/moderation/refund"
+	LISTING_DUPLICATE_DETECT      = "listings/duplicate/detect"
+	LISTING_LIFECYCLE_PROCESS     = "listings/lifecycle/process"
+	LISTING_EXPIRY_WARN           = "listings/expiry/warn"
+	LISTING_VIEWING_NOTIFY        = "listings/viewings/notify"
+	LISTING_VIEWING_REMIND        = "listings/viewings/remind"
+	LISTING_ANALYTICS_FLUSH       = "listings/analytics/flush"
 )
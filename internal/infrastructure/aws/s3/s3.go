@@ -22,6 +22,7 @@ type S3Client interface {
 	BucketExists(bucketName string) (bool, error)
 	DeleteBucket(bucketName string) (*s3.DeleteBucketOutput, error)
 	GetPutObjectPresignedURL(bucketName string, objectKey, contentType string, contentLength int64, lifetime time.Duration) (*v4.PresignedHTTPRequest, error)
+	GetGetObjectPresignedURL(bucketName string, objectKey string, lifetime time.Duration) (*v4.PresignedHTTPRequest, error)
 	ListObjects(bucketName string) ([]types.Object, error)
 	UploadLargeObject(bucketName string, objectKey string, largeObject []byte) error
 	DownloadFile(bucketName string, objectKey string, fileName string) error
@@ -102,6 +103,21 @@ func (c *s3Client) GetPutObjectPresignedURL(
 	})
 }
 
+// GetGetObjectPresignedURL makes a presigned URL that can be used to GET an object in a bucket.
+// The presigned URL is valid for the specified duration.
+func (c *s3Client) GetGetObjectPresignedURL(
+	bucketName string,
+	objectKey string,
+	lifetime time.Duration,
+) (*v4.PresignedHTTPRequest, error) {
+	return c.presigner.PresignGetObject(context.TODO(), &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	}, func(opts *s3.PresignOptions) {
+		opts.Expires = lifetime
+	})
+}
+
 // ListObjects lists the objects in a bucket.
 func (c *s3Client) ListObjects(bucketName string) ([]types.Object, error) {
 	result, err := c.s3Client.ListObjectsV2(context.TODO(), &s3.ListObjectsV2Input{
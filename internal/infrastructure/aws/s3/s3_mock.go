@@ -115,6 +115,21 @@ func (mr *MockS3ClientMockRecorder) DownloadFile(arg0, arg1, arg2 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadFile", reflect.TypeOf((*MockS3Client)(nil).DownloadFile), arg0, arg1, arg2)
 }
 
+// GetGetObjectPresignedURL mocks base method.
+func (m *MockS3Client) GetGetObjectPresignedURL(arg0, arg1 string, arg2 time.Duration) (*v4.PresignedHTTPRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGetObjectPresignedURL", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v4.PresignedHTTPRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGetObjectPresignedURL indicates an expected call of GetGetObjectPresignedURL.
+func (mr *MockS3ClientMockRecorder) GetGetObjectPresignedURL(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGetObjectPresignedURL", reflect.TypeOf((*MockS3Client)(nil).GetGetObjectPresignedURL), arg0, arg1, arg2)
+}
+
 // GetPutObjectPresignedURL mocks base method.
 func (m *MockS3Client) GetPutObjectPresignedURL(arg0, arg1, arg2 string, arg3 int64, arg4 time.Duration) (*v4.PresignedHTTPRequest, error) {
 	m.ctrl.T.Helper()
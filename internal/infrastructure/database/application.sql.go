@@ -130,7 +130,7 @@ INSERT INTO applications (
   -- identity
   -- sqlc.arg(identity_type),
   -- sqlc.arg(identity_number)
-) RETURNING id, creator_id, listing_id, property_id, unit_id, listing_price, offered_price, status, created_at, updated_at, tenant_type, full_name, email, phone, dob, profile_image, movein_date, preferred_term, rental_intention, organization_name, organization_hq_address, organization_scale, rh_address, rh_city, rh_district, rh_ward, rh_rental_duration, rh_monthly_payment, rh_reason_for_leaving, employment_status, employment_company_name, employment_position, employment_monthly_income, employment_comment
+) RETURNING id, creator_id, listing_id, property_id, unit_id, listing_price, offered_price, status, created_at, updated_at, tenant_type, full_name, email, phone, dob, profile_image, movein_date, preferred_term, rental_intention, organization_name, organization_hq_address, organization_scale, rh_address, rh_city, rh_district, rh_ward, rh_rental_duration, rh_monthly_payment, rh_reason_for_leaving, employment_status, employment_company_name, employment_position, employment_monthly_income, employment_comment, documents_reminded_at
 `
 
 type CreateApplicationParams struct {
@@ -235,6 +235,7 @@ func (q *Queries) CreateApplication(ctx context.Context, arg CreateApplicationPa
 		&i.EmploymentPosition,
 		&i.EmploymentMonthlyIncome,
 		&i.EmploymentComment,
+		&i.DocumentsRemindedAt,
 	)
 	return i, err
 }
@@ -435,8 +436,44 @@ func (q *Queries) DeleteApplication(ctx context.Context, id int64) error {
 	return err
 }
 
+const expireStaleApplications = `-- name: ExpireStaleApplications :many
+UPDATE applications 
+SET 
+  status = 'EXPIRED', 
+  updated_at = NOW() 
+WHERE id IN (
+  SELECT applications.id FROM applications 
+    INNER JOIN listings ON listings.id = applications.listing_id
+  WHERE 
+    applications.status = 'PENDING'
+    AND applications.updated_at < NOW() - (INTERVAL '1 day' * listings.application_validity_days)
+  LIMIT $1
+)
+RETURNING id
+`
+
+func (q *Queries) ExpireStaleApplications(ctx context.Context, limit int32) ([]int64, error) {
+	rows, err := q.db.Query(ctx, expireStaleApplications, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getApplicationByID = `-- name: GetApplicationByID :one
-SELECT id, creator_id, listing_id, property_id, unit_id, listing_price, offered_price, status, created_at, updated_at, tenant_type, full_name, email, phone, dob, profile_image, movein_date, preferred_term, rental_intention, organization_name, organization_hq_address, organization_scale, rh_address, rh_city, rh_district, rh_ward, rh_rental_duration, rh_monthly_payment, rh_reason_for_leaving, employment_status, employment_company_name, employment_position, employment_monthly_income, employment_comment FROM applications WHERE id = $1 LIMIT 1
+SELECT id, creator_id, listing_id, property_id, unit_id, listing_price, offered_price, status, created_at, updated_at, tenant_type, full_name, email, phone, dob, profile_image, movein_date, preferred_term, rental_intention, organization_name, organization_hq_address, organization_scale, rh_address, rh_city, rh_district, rh_ward, rh_rental_duration, rh_monthly_payment, rh_reason_for_leaving, employment_status, employment_company_name, employment_position, employment_monthly_income, employment_comment, documents_reminded_at FROM applications WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetApplicationByID(ctx context.Context, id int64) (Application, error) {
@@ -477,6 +514,7 @@ func (q *Queries) GetApplicationByID(ctx context.Context, id int64) (Application
 		&i.EmploymentPosition,
 		&i.EmploymentMonthlyIncome,
 		&i.EmploymentComment,
+		&i.DocumentsRemindedAt,
 	)
 	return i, err
 }
@@ -666,6 +704,39 @@ func (q *Queries) GetApplicationsOfListing(ctx context.Context, listingID uuid.U
 	return items, nil
 }
 
+const getApplicationsOfUnit = `-- name: GetApplicationsOfUnit :many
+SELECT id FROM applications 
+WHERE 
+  unit_id = $1 
+  AND status::TEXT = ANY($2::TEXT[])
+ORDER BY created_at ASC
+`
+
+type GetApplicationsOfUnitParams struct {
+	UnitID   uuid.UUID `json:"unit_id"`
+	Statuses []string  `json:"statuses"`
+}
+
+func (q *Queries) GetApplicationsOfUnit(ctx context.Context, arg GetApplicationsOfUnitParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getApplicationsOfUnit, arg.UnitID, arg.Statuses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getApplicationsToUser = `-- name: GetApplicationsToUser :many
 SELECT 
   id 
@@ -706,6 +777,30 @@ func (q *Queries) GetApplicationsToUser(ctx context.Context, arg GetApplications
 	return items, nil
 }
 
+const transitApplicationStatus = `-- name: TransitApplicationStatus :execrows
+UPDATE applications 
+SET 
+  status = $1, 
+  updated_at = NOW() 
+WHERE 
+  id = $2
+  AND status::TEXT = ANY($3::TEXT[])
+`
+
+type TransitApplicationStatusParams struct {
+	Status       APPLICATIONSTATUS `json:"status"`
+	ID           int64             `json:"id"`
+	FromStatuses []string          `json:"from_statuses"`
+}
+
+func (q *Queries) TransitApplicationStatus(ctx context.Context, arg TransitApplicationStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, transitApplicationStatus, arg.Status, arg.ID, arg.FromStatuses)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateApplicationStatus = `-- name: UpdateApplicationStatus :many
 UPDATE applications 
 SET 
@@ -745,3 +840,34 @@ func (q *Queries) UpdateApplicationStatus(ctx context.Context, arg UpdateApplica
 	}
 	return items, nil
 }
+
+const withdrawApplicationsOfListing = `-- name: WithdrawApplicationsOfListing :many
+UPDATE applications 
+SET 
+  status = 'WITHDRAWN', 
+  updated_at = NOW() 
+WHERE 
+  listing_id = $1
+  AND status IN ('PENDING', 'CONDITIONALLY_APPROVED', 'WAITLISTED')
+RETURNING id
+`
+
+func (q *Queries) WithdrawApplicationsOfListing(ctx context.Context, listingID uuid.UUID) ([]int64, error) {
+	rows, err := q.db.Query(ctx, withdrawApplicationsOfListing, listingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
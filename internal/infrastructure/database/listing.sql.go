@@ -7,6 +7,7 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -79,6 +80,17 @@ func (q *Queries) CheckValidUnitForListing(ctx context.Context, arg CheckValidUn
 	return count, err
 }
 
+const countListings = `-- name: CountListings :one
+SELECT count(*) FROM listings
+`
+
+func (q *Queries) CountListings(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countListings)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createListing = `-- name: CreateListing :one
 INSERT INTO listings (
   creator_id,
@@ -96,6 +108,9 @@ INSERT INTO listings (
   pets_allowed,
   number_of_residents,
   priority,
+  post_at,
+  auto_renew,
+  application_validity_days,
   created_at,
   updated_at,
   expired_at
@@ -115,28 +130,35 @@ INSERT INTO listings (
   $13,
   $14,
   $15,
+  $16,
+  coalesce($17, FALSE),
+  coalesce($18, 30),
   NOW(), NOW(), 
-  NOW() + (INTERVAL'1 day' * $16)
-) RETURNING id, creator_id, property_id, title, description, full_name, email, phone, contact_type, price, price_negotiable, security_deposit, lease_term, pets_allowed, number_of_residents, priority, active, created_at, updated_at, expired_at
+  -- the listing stays public for as long as paid, from the time it goes public
+  coalesce($16, NOW()) + (INTERVAL'1 day' * $19)
+) RETURNING id, creator_id, property_id, title, description, full_name, email, phone, contact_type, price, price_negotiable, security_deposit, lease_term, pets_allowed, number_of_residents, priority, active, created_at, updated_at, expired_at, activated_at, post_at, auto_renew, expiry_warned_at, application_validity_days
 `
 
 type CreateListingParams struct {
-	CreatorID         uuid.UUID     `json:"creator_id"`
-	PropertyID        uuid.UUID     `json:"property_id"`
-	Title             string        `json:"title"`
-	Description       string        `json:"description"`
-	FullName          string        `json:"full_name"`
-	Email             string        `json:"email"`
-	Phone             string        `json:"phone"`
-	ContactType       string        `json:"contact_type"`
-	Price             float32       `json:"price"`
-	PriceNegotiable   pgtype.Bool   `json:"price_negotiable"`
-	SecurityDeposit   pgtype.Float4 `json:"security_deposit"`
-	LeaseTerm         pgtype.Int4   `json:"lease_term"`
-	PetsAllowed       pgtype.Bool   `json:"pets_allowed"`
-	NumberOfResidents pgtype.Int4   `json:"number_of_residents"`
-	Priority          int32         `json:"priority"`
-	PostDuration      interface{}   `json:"post_duration"`
+	CreatorID               uuid.UUID          `json:"creator_id"`
+	PropertyID              uuid.UUID          `json:"property_id"`
+	Title                   string             `json:"title"`
+	Description             string             `json:"description"`
+	FullName                string             `json:"full_name"`
+	Email                   string             `json:"email"`
+	Phone                   string             `json:"phone"`
+	ContactType             string             `json:"contact_type"`
+	Price                   float32            `json:"price"`
+	PriceNegotiable         pgtype.Bool        `json:"price_negotiable"`
+	SecurityDeposit         pgtype.Float4      `json:"security_deposit"`
+	LeaseTerm               pgtype.Int4        `json:"lease_term"`
+	PetsAllowed             pgtype.Bool        `json:"pets_allowed"`
+	NumberOfResidents       pgtype.Int4        `json:"number_of_residents"`
+	Priority                int32              `json:"priority"`
+	PostAt                  pgtype.Timestamptz `json:"post_at"`
+	AutoRenew               pgtype.Bool        `json:"auto_renew"`
+	ApplicationValidityDays pgtype.Int4        `json:"application_validity_days"`
+	PostDuration            interface{}        `json:"post_duration"`
 }
 
 func (q *Queries) CreateListing(ctx context.Context, arg CreateListingParams) (Listing, error) {
@@ -156,6 +178,9 @@ func (q *Queries) CreateListing(ctx context.Context, arg CreateListingParams) (L
 		arg.PetsAllowed,
 		arg.NumberOfResidents,
 		arg.Priority,
+		arg.PostAt,
+		arg.AutoRenew,
+		arg.ApplicationValidityDays,
 		arg.PostDuration,
 	)
 	var i Listing
@@ -180,6 +205,11 @@ func (q *Queries) CreateListing(ctx context.Context, arg CreateListingParams) (L
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiredAt,
+		&i.ActivatedAt,
+		&i.PostAt,
+		&i.AutoRenew,
+		&i.ExpiryWarnedAt,
+		&i.ApplicationValidityDays,
 	)
 	return i, err
 }
@@ -316,8 +346,56 @@ func (q *Queries) GetAllRentalPolicies(ctx context.Context) ([]LPolicy, error) {
 	return items, nil
 }
 
+const getExistingListingIds = `-- name: GetExistingListingIds :many
+SELECT id FROM listings WHERE id = ANY($1::UUID[])
+`
+
+func (q *Queries) GetExistingListingIds(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getExistingListingIds, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExpiredActiveListings = `-- name: GetExpiredActiveListings :many
+SELECT id FROM listings WHERE active AND expired_at <= NOW() ORDER BY expired_at LIMIT $1
+`
+
+func (q *Queries) GetExpiredActiveListings(ctx context.Context, limit int32) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getExpiredActiveListings, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getListingByID = `-- name: GetListingByID :one
-SELECT id, creator_id, property_id, title, description, full_name, email, phone, contact_type, price, price_negotiable, security_deposit, lease_term, pets_allowed, number_of_residents, priority, active, created_at, updated_at, expired_at FROM listings WHERE id = $1 LIMIT 1
+SELECT id, creator_id, property_id, title, description, full_name, email, phone, contact_type, price, price_negotiable, security_deposit, lease_term, pets_allowed, number_of_residents, priority, active, created_at, updated_at, expired_at, activated_at, post_at, auto_renew, expiry_warned_at, application_validity_days FROM listings WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetListingByID(ctx context.Context, id uuid.UUID) (Listing, error) {
@@ -344,10 +422,49 @@ func (q *Queries) GetListingByID(ctx context.Context, id uuid.UUID) (Listing, er
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiredAt,
+		&i.ActivatedAt,
+		&i.PostAt,
+		&i.AutoRenew,
+		&i.ExpiryWarnedAt,
+		&i.ApplicationValidityDays,
 	)
 	return i, err
 }
 
+const getListingIdsAfter = `-- name: GetListingIdsAfter :many
+SELECT id, updated_at FROM listings WHERE id > $1 ORDER BY id LIMIT $2
+`
+
+type GetListingIdsAfterParams struct {
+	ID    uuid.UUID `json:"id"`
+	Limit int32     `json:"limit"`
+}
+
+type GetListingIdsAfterRow struct {
+	ID        uuid.UUID `json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (q *Queries) GetListingIdsAfter(ctx context.Context, arg GetListingIdsAfterParams) ([]GetListingIdsAfterRow, error) {
+	rows, err := q.db.Query(ctx, getListingIdsAfter, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetListingIdsAfterRow
+	for rows.Next() {
+		var i GetListingIdsAfterRow
+		if err := rows.Scan(&i.ID, &i.UpdatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getListingPolicies = `-- name: GetListingPolicies :many
 SELECT listing_id, policy_id, note FROM listing_policies WHERE listing_id = $1
 `
@@ -471,6 +588,83 @@ func (q *Queries) GetListingsOfProperty(ctx context.Context, arg GetListingsOfPr
 	return items, nil
 }
 
+const getListingsToPublish = `-- name: GetListingsToPublish :many
+SELECT listings.id
+FROM listings
+WHERE
+  NOT listings.active
+  AND coalesce(listings.post_at, listings.created_at) <= NOW()
+  AND listings.expired_at > NOW()
+  AND (
+    SELECT listing_moderations.status
+    FROM listing_moderations
+    WHERE listing_moderations.listing_id = listings.id
+    ORDER BY listing_moderations.created_at DESC
+    LIMIT 1
+  ) = 'APPROVED'
+ORDER BY coalesce(listings.post_at, listings.created_at)
+LIMIT $1
+`
+
+// Approved listings whose time to go public has come, including the expired ones extended since
+func (q *Queries) GetListingsToPublish(ctx context.Context, limit int32) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getListingsToPublish, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getListingsToWarnExpiry = `-- name: GetListingsToWarnExpiry :many
+SELECT id
+FROM listings
+WHERE
+  active
+  AND NOT auto_renew
+  AND expiry_warned_at IS NULL
+  AND expired_at > NOW()
+  AND expired_at <= NOW() + (INTERVAL '1 day' * $1::INTEGER)
+ORDER BY expired_at
+LIMIT $2
+`
+
+type GetListingsToWarnExpiryParams struct {
+	Days int32 `json:"days"`
+	Lim  int32 `json:"lim"`
+}
+
+func (q *Queries) GetListingsToWarnExpiry(ctx context.Context, arg GetListingsToWarnExpiryParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getListingsToWarnExpiry, arg.Days, arg.Lim)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRecentListings = `-- name: GetRecentListings :many
 SELECT id 
 FROM listings 
@@ -499,7 +693,7 @@ func (q *Queries) GetRecentListings(ctx context.Context, limit int32) ([]uuid.UU
 }
 
 const getSomeListings = `-- name: GetSomeListings :many
-SELECT id, creator_id, property_id, title, description, full_name, email, phone, contact_type, price, price_negotiable, security_deposit, lease_term, pets_allowed, number_of_residents, priority, active, created_at, updated_at, expired_at
+SELECT id, creator_id, property_id, title, description, full_name, email, phone, contact_type, price, price_negotiable, security_deposit, lease_term, pets_allowed, number_of_residents, priority, active, created_at, updated_at, expired_at, activated_at, post_at, auto_renew, expiry_warned_at, application_validity_days
 FROM listings
 LIMIT $1 OFFSET $2
 `
@@ -539,6 +733,11 @@ func (q *Queries) GetSomeListings(ctx context.Context, arg GetSomeListingsParams
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ExpiredAt,
+			&i.ActivatedAt,
+			&i.PostAt,
+			&i.AutoRenew,
+			&i.ExpiryWarnedAt,
+			&i.ApplicationValidityDays,
 		); err != nil {
 			return nil, err
 		}
@@ -550,6 +749,15 @@ func (q *Queries) GetSomeListings(ctx context.Context, arg GetSomeListingsParams
 	return items, nil
 }
 
+const markListingExpiryWarned = `-- name: MarkListingExpiryWarned :exec
+UPDATE listings SET expiry_warned_at = NOW() WHERE id = $1
+`
+
+func (q *Queries) MarkListingExpiryWarned(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markListingExpiryWarned, id)
+	return err
+}
+
 const updateListing = `-- name: UpdateListing :exec
 UPDATE listings SET
   title = coalesce($1, title),
@@ -564,25 +772,32 @@ UPDATE listings SET
   lease_term = coalesce($10, lease_term),
   pets_allowed = coalesce($11, pets_allowed),
   number_of_residents = coalesce($12, number_of_residents),
+  post_at = coalesce($13, post_at),
+  -- the expiry follows the time the listing goes public
+  expired_at = expired_at + (coalesce($13, post_at, created_at) - coalesce(post_at, created_at)),
+  auto_renew = coalesce($14, auto_renew),
+  application_validity_days = coalesce($15, application_validity_days),
   updated_at = NOW()
-  -- post_at = coalesce(sqlc.narg(post_at), post_at)
-WHERE id = $13
+WHERE id = $16
 `
 
 type UpdateListingParams struct {
-	Title             pgtype.Text   `json:"title"`
-	Description       pgtype.Text   `json:"description"`
-	FullName          pgtype.Text   `json:"full_name"`
-	Email             pgtype.Text   `json:"email"`
-	Phone             pgtype.Text   `json:"phone"`
-	ContactType       pgtype.Text   `json:"contact_type"`
-	Price             pgtype.Float4 `json:"price"`
-	PriceNegotiable   pgtype.Bool   `json:"price_negotiable"`
-	SecurityDeposit   pgtype.Float4 `json:"security_deposit"`
-	LeaseTerm         pgtype.Int4   `json:"lease_term"`
-	PetsAllowed       pgtype.Bool   `json:"pets_allowed"`
-	NumberOfResidents pgtype.Int4   `json:"number_of_residents"`
-	ID                uuid.UUID     `json:"id"`
+	Title                   pgtype.Text        `json:"title"`
+	Description             pgtype.Text        `json:"description"`
+	FullName                pgtype.Text        `json:"full_name"`
+	Email                   pgtype.Text        `json:"email"`
+	Phone                   pgtype.Text        `json:"phone"`
+	ContactType             pgtype.Text        `json:"contact_type"`
+	Price                   pgtype.Float4      `json:"price"`
+	PriceNegotiable         pgtype.Bool        `json:"price_negotiable"`
+	SecurityDeposit         pgtype.Float4      `json:"security_deposit"`
+	LeaseTerm               pgtype.Int4        `json:"lease_term"`
+	PetsAllowed             pgtype.Bool        `json:"pets_allowed"`
+	NumberOfResidents       pgtype.Int4        `json:"number_of_residents"`
+	PostAt                  pgtype.Timestamptz `json:"post_at"`
+	AutoRenew               pgtype.Bool        `json:"auto_renew"`
+	ApplicationValidityDays pgtype.Int4        `json:"application_validity_days"`
+	ID                      uuid.UUID          `json:"id"`
 }
 
 func (q *Queries) UpdateListing(ctx context.Context, arg UpdateListingParams) error {
@@ -599,6 +814,9 @@ func (q *Queries) UpdateListing(ctx context.Context, arg UpdateListingParams) er
 		arg.LeaseTerm,
 		arg.PetsAllowed,
 		arg.NumberOfResidents,
+		arg.PostAt,
+		arg.AutoRenew,
+		arg.ApplicationValidityDays,
 		arg.ID,
 	)
 	return err
@@ -626,6 +844,7 @@ const updateListingStatus = `-- name: UpdateListingStatus :exec
 UPDATE listings 
 SET 
   active = $1,
+  activated_at = CASE WHEN $1 AND NOT active THEN NOW() ELSE activated_at END,
   updated_at = NOW()
  WHERE id = $2
 `
@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "rental_expiry_notices" (
+  "rental_id" BIGINT NOT NULL,
+  "days_before" INTEGER NOT NULL CHECK (days_before >= 0),
+  "created_at" TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+
+  PRIMARY KEY ("rental_id", "days_before")
+);
+ALTER TABLE "rental_expiry_notices" ADD CONSTRAINT "rental_expiry_notices_rental_id_fkey" FOREIGN KEY ("rental_id") REFERENCES "rentals"("id") ON DELETE CASCADE;
+COMMENT ON TABLE "rental_expiry_notices" IS 'Expiry notices already sent for a rental, one row per notice tier (days before the rental end date).';
+
+END;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "property_service_price_changes";
+ALTER TABLE "rental_services" DROP CONSTRAINT IF EXISTS "rental_services_catalog_service_id_fkey";
+ALTER TABLE "rental_services" DROP COLUMN IF EXISTS "catalog_service_id";
+DROP TABLE IF EXISTS "property_services";
+DROP TYPE IF EXISTS "SERVICEPRICECHANGESTATUS";
+DROP TYPE IF EXISTS "SERVICEBILLINGFREQUENCY";
+DROP TYPE IF EXISTS "PROPERTYSERVICETYPE";
+
+END;
@@ -0,0 +1,44 @@
+BEGIN;
+
+CREATE TYPE "PROPERTYSERVICETYPE" AS ENUM ('INTERNET', 'PARKING', 'CLEANING', 'TRASH', 'OTHER');
+CREATE TYPE "SERVICEBILLINGFREQUENCY" AS ENUM ('MONTHLY', 'QUARTERLY', 'YEARLY');
+CREATE TYPE "SERVICEPRICECHANGESTATUS" AS ENUM ('PENDING', 'APPLIED', 'DISMISSED');
+
+CREATE TABLE IF NOT EXISTS "property_services" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "property_id" UUID NOT NULL,
+  "type" "PROPERTYSERVICETYPE" NOT NULL,
+  "name" VARCHAR(100) NOT NULL,
+  "setup_by" VARCHAR(10) NOT NULL,
+  "provider" TEXT,
+  "default_price" REAL CHECK (default_price >= 0),
+  "billing_frequency" "SERVICEBILLINGFREQUENCY" NOT NULL DEFAULT 'MONTHLY',
+  "created_at" TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  "updated_at" TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+ALTER TABLE "property_services" ADD CONSTRAINT "property_services_property_id_fkey" FOREIGN KEY ("property_id") REFERENCES "properties"("id") ON DELETE CASCADE;
+COMMENT ON COLUMN "property_services"."setup_by" IS 'The party who set up the service, either "LANDLORD" or "TENANT"';
+COMMENT ON COLUMN "property_services"."default_price" IS 'Price of one billing cycle';
+
+ALTER TABLE "rental_services" ADD COLUMN "catalog_service_id" BIGINT;
+ALTER TABLE "rental_services" ADD CONSTRAINT "rental_services_catalog_service_id_fkey" FOREIGN KEY ("catalog_service_id") REFERENCES "property_services"("id") ON DELETE SET NULL;
+COMMENT ON COLUMN "rental_services"."catalog_service_id" IS 'The property service this rental service subscribes to, price changes of the catalog item can be propagated to it';
+
+CREATE TABLE IF NOT EXISTS "property_service_price_changes" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "service_id" BIGINT NOT NULL,
+  "creator_id" UUID NOT NULL,
+  "old_price" REAL,
+  "new_price" REAL NOT NULL CHECK (new_price >= 0),
+  "old_billing_frequency" "SERVICEBILLINGFREQUENCY" NOT NULL,
+  "new_billing_frequency" "SERVICEBILLINGFREQUENCY" NOT NULL,
+  "status" "SERVICEPRICECHANGESTATUS" NOT NULL DEFAULT 'PENDING',
+  "created_at" TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  "updated_at" TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+ALTER TABLE "property_service_price_changes" ADD CONSTRAINT "property_service_price_changes_service_id_fkey" FOREIGN KEY ("service_id") REFERENCES "property_services"("id") ON DELETE CASCADE;
+ALTER TABLE "property_service_price_changes" ADD CONSTRAINT "property_service_price_changes_creator_id_fkey" FOREIGN KEY ("creator_id") REFERENCES "User"("id") ON DELETE CASCADE;
+COMMENT ON TABLE "property_service_price_changes" IS 'Catalog price changes waiting for a manager to confirm propagating them to the subscribed rentals';
+COMMENT ON COLUMN "property_service_price_changes"."new_billing_frequency" IS 'Billing cycle of the new price, the price of the subscribed rentals is its monthly equivalent';
+
+END;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP FUNCTION IF EXISTS unit_available_from(UUID, DATE);
+DROP VIEW IF EXISTS "unit_occupancies";
+DROP TABLE IF EXISTS "unit_maintenance_blocks";
+ALTER TABLE "rentals" DROP CONSTRAINT IF EXISTS "rentals_unit_id_period_excl";
+
+END;
@@ -0,0 +1,63 @@
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS "btree_gist";
+
+CREATE TABLE IF NOT EXISTS "unit_maintenance_blocks" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "unit_id" UUID NOT NULL,
+  "creator_id" UUID NOT NULL,
+  "start_date" DATE NOT NULL,
+  "end_date" DATE NOT NULL,
+  "reason" TEXT,
+  "created_at" TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+  CHECK ("end_date" > "start_date")
+);
+ALTER TABLE "unit_maintenance_blocks" ADD CONSTRAINT "unit_maintenance_blocks_unit_id_fkey" FOREIGN KEY ("unit_id") REFERENCES "units"("id") ON DELETE CASCADE;
+ALTER TABLE "unit_maintenance_blocks" ADD CONSTRAINT "unit_maintenance_blocks_creator_id_fkey" FOREIGN KEY ("creator_id") REFERENCES "User"("id") ON DELETE CASCADE;
+COMMENT ON COLUMN "unit_maintenance_blocks"."end_date" IS 'Exclusive, the unit is available again from this date';
+CREATE INDEX IF NOT EXISTS "unit_maintenance_blocks_unit_id_idx" ON "unit_maintenance_blocks" ("unit_id", "start_date");
+
+-- The in-progress rentals of a unit never overlap, even when they are created concurrently
+ALTER TABLE "rentals" ADD CONSTRAINT "rentals_unit_id_period_excl" EXCLUDE USING gist (
+  "unit_id" WITH =,
+  daterange("start_date", ("start_date" + INTERVAL '1 month' * "rental_period")::DATE) WITH &&
+) WHERE ("status" = 'INPROGRESS');
+
+-- Occupancy intervals [start_date, end_date) of units: in-progress rentals, prerentals waiting for the tenant,
+-- approved applications not yet turned into a prerental or rental, and maintenance blocks.
+CREATE OR REPLACE VIEW "unit_occupancies" AS
+  SELECT 'RENTAL' AS "type", "id" AS "ref_id", "unit_id", "start_date", (start_date + INTERVAL '1 month' * rental_period)::DATE AS "end_date"
+  FROM "rentals" WHERE "status" = 'INPROGRESS'
+  UNION ALL
+  SELECT 'PRERENTAL', "id", "unit_id", "start_date", (start_date + INTERVAL '1 month' * rental_period)::DATE
+  FROM "prerentals"
+  UNION ALL
+  SELECT 'APPLICATION', "id", "unit_id", "movein_date", (movein_date + INTERVAL '1 month' * preferred_term)::DATE
+  FROM "applications"
+  WHERE "status" IN ('APPROVED', 'CONDITIONALLY_APPROVED')
+    AND NOT EXISTS (SELECT 1 FROM "rentals" WHERE "rentals"."application_id" = "applications"."id")
+    AND NOT EXISTS (SELECT 1 FROM "prerentals" WHERE "prerentals"."application_id" = "applications"."id")
+  UNION ALL
+  SELECT 'MAINTENANCE', "id", "unit_id", "start_date", "end_date"
+  FROM "unit_maintenance_blocks";
+
+-- The first date from from_date on the unit is not covered by any occupancy
+CREATE OR REPLACE FUNCTION unit_available_from(uid UUID, from_date DATE)
+RETURNS DATE AS $$
+DECLARE
+  available DATE := from_date;
+  occupancy RECORD;
+BEGIN
+  FOR occupancy IN
+    SELECT "start_date", "end_date" FROM "unit_occupancies" WHERE "unit_id" = uid AND "end_date" > from_date ORDER BY "start_date"
+  LOOP
+    EXIT WHEN occupancy.start_date > available;
+    IF occupancy.end_date > available THEN
+      available := occupancy.end_date;
+    END IF;
+  END LOOP;
+  RETURN available;
+END;
+$$ LANGUAGE plpgsql STABLE;
+
+END;
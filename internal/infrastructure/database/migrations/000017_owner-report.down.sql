@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "report_schedules";
+DROP TABLE IF EXISTS "property_expenses";
+DROP TYPE IF EXISTS "REPORTFORMAT";
+DROP TYPE IF EXISTS "REPORTTYPE";
+
+END;
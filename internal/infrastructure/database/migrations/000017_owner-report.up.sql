@@ -0,0 +1,37 @@
+BEGIN;
+
+CREATE TYPE "REPORTTYPE" AS ENUM ('RENT_ROLL', 'OWNER_STATEMENT');
+CREATE TYPE "REPORTFORMAT" AS ENUM ('CSV', 'XLSX', 'PDF');
+
+CREATE TABLE IF NOT EXISTS "property_expenses" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "property_id" UUID NOT NULL,
+  "creator_id" UUID NOT NULL,
+  "category" VARCHAR(64) NOT NULL,
+  "amount" REAL NOT NULL CHECK (amount >= 0),
+  "incurred_at" DATE NOT NULL,
+  "note" TEXT,
+  "created_at" TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+ALTER TABLE "property_expenses" ADD CONSTRAINT "property_expenses_property_id_fkey" FOREIGN KEY ("property_id") REFERENCES "properties"("id") ON DELETE CASCADE;
+ALTER TABLE "property_expenses" ADD CONSTRAINT "property_expenses_creator_id_fkey" FOREIGN KEY ("creator_id") REFERENCES "User"("id") ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS "property_expenses_property_id_idx" ON "property_expenses" ("property_id", "incurred_at");
+
+CREATE TABLE IF NOT EXISTS "report_schedules" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "user_id" UUID NOT NULL,
+  "property_id" UUID,
+  "type" "REPORTTYPE" NOT NULL,
+  "format" "REPORTFORMAT" NOT NULL,
+  "management_fee_rate" REAL NOT NULL DEFAULT 0 CHECK (management_fee_rate >= 0 AND management_fee_rate <= 100),
+  "recipients" TEXT[] NOT NULL DEFAULT '{}',
+  "last_sent_at" TIMESTAMPTZ,
+  "created_at" TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+ALTER TABLE "report_schedules" ADD CONSTRAINT "report_schedules_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "User"("id") ON DELETE CASCADE;
+ALTER TABLE "report_schedules" ADD CONSTRAINT "report_schedules_property_id_fkey" FOREIGN KEY ("property_id") REFERENCES "properties"("id") ON DELETE CASCADE;
+COMMENT ON COLUMN "report_schedules"."property_id" IS 'Property the report is about, NULL means every property managed by the user (rent roll only)';
+COMMENT ON COLUMN "report_schedules"."management_fee_rate" IS 'Percentage of the collected income charged as management fee in owner statements';
+COMMENT ON COLUMN "report_schedules"."recipients" IS 'Extra email addresses receiving the report besides the user';
+
+END;
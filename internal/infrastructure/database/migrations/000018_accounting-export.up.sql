@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "payment_refunds" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "payment_id" BIGINT NOT NULL,
+  "amount" REAL NOT NULL,
+  "reason" TEXT NOT NULL,
+  "created_by" TEXT NOT NULL,
+  "created_at" TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+ALTER TABLE "payment_refunds" ADD CONSTRAINT "fk_payment_refunds_payment_id" FOREIGN KEY ("payment_id") REFERENCES "payments" ("id") ON DELETE CASCADE;
+
+END;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "search_outbox";
+DROP TYPE IF EXISTS "SEARCHOUTBOXSOURCE";
+
+END;
@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TYPE "SEARCHOUTBOXSOURCE" AS ENUM ('LISTING', 'PROPERTY', 'UNIT', 'VERIFICATION');
+
+CREATE TABLE IF NOT EXISTS "search_outbox" (
+  "id" BIGSERIAL PRIMARY KEY,
+  -- no foreign key: the event must outlive the listing so that its deletion reaches the index
+  "listing_id" UUID NOT NULL,
+  "source" "SEARCHOUTBOXSOURCE" NOT NULL,
+  "attempts" INTEGER NOT NULL DEFAULT 0,
+  "last_error" TEXT,
+  "next_attempt_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "processed_at" TIMESTAMPTZ,
+  "dead_at" TIMESTAMPTZ,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS "search_outbox_pending_idx" ON "search_outbox" ("next_attempt_at", "id") WHERE "processed_at" IS NULL AND "dead_at" IS NULL;
+
+END;
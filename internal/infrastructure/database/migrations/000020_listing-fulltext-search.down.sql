@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS "properties_full_address_trgm_idx";
+DROP INDEX IF EXISTS "listings_fulltext_idx";
+DROP FUNCTION IF EXISTS f_unaccent(TEXT);
+DROP EXTENSION IF EXISTS "pg_trgm";
+DROP EXTENSION IF EXISTS "unaccent";
+
+END;
@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS "unaccent";
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+
+-- unaccent() is only STABLE because its dictionary can be changed, pinning the dictionary makes it usable in indexes.
+-- Also folds "đ", which is a letter of its own rather than an accented "d".
+CREATE OR REPLACE FUNCTION f_unaccent(TEXT) RETURNS TEXT AS $$
+  SELECT translate(public.unaccent('public.unaccent', $1), 'đĐ', 'dD')
+$$ LANGUAGE SQL IMMUTABLE PARALLEL SAFE STRICT;
+
+-- the search document of a listing for the Postgres search backend, the expression must match the one used by the queries
+CREATE INDEX IF NOT EXISTS "listings_fulltext_idx" ON "listings" USING GIN (
+  (setweight(to_tsvector('simple', f_unaccent("title")), 'A') || setweight(to_tsvector('simple', f_unaccent("description")), 'B'))
+);
+CREATE INDEX IF NOT EXISTS "properties_full_address_trgm_idx" ON "properties" USING GIN (f_unaccent("full_address") gin_trgm_ops);
+
+END;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS "listings_title_trgm_idx";
+
+END;
@@ -0,0 +1,6 @@
+BEGIN;
+
+-- typo tolerant matching and autocomplete on listing titles for the Postgres search backend
+CREATE INDEX IF NOT EXISTS "listings_title_trgm_idx" ON "listings" USING GIN (f_unaccent("title") gin_trgm_ops);
+
+END;
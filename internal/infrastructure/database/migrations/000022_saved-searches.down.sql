@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "saved_search_matches";
+DROP TABLE IF EXISTS "saved_searches";
+DROP TYPE IF EXISTS "SAVEDSEARCHFREQUENCY";
+DROP INDEX IF EXISTS "listings_activated_at_idx";
+ALTER TABLE "listings" DROP COLUMN IF EXISTS "activated_at";
+
+END;
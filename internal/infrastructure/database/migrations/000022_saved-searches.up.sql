@@ -0,0 +1,42 @@
+BEGIN;
+
+ALTER TABLE "listings" ADD COLUMN IF NOT EXISTS "activated_at" TIMESTAMPTZ;
+COMMENT ON COLUMN "listings"."activated_at" IS 'The last time the listing was activated, saved searches are matched against the listings activated since their last check';
+UPDATE "listings" SET "activated_at" = "updated_at" WHERE "active";
+CREATE INDEX IF NOT EXISTS "listings_activated_at_idx" ON "listings" ("activated_at");
+
+CREATE TYPE "SAVEDSEARCHFREQUENCY" AS ENUM ('INSTANT', 'DAILY', 'WEEKLY');
+
+CREATE TABLE IF NOT EXISTS "saved_searches" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "user_id" UUID NOT NULL,
+  "name" VARCHAR(128) NOT NULL,
+  "query" JSONB NOT NULL,
+  "frequency" "SAVEDSEARCHFREQUENCY" NOT NULL DEFAULT 'DAILY',
+  "active" BOOLEAN NOT NULL DEFAULT TRUE,
+  "last_checked_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "last_sent_at" TIMESTAMPTZ,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+ALTER TABLE "saved_searches" ADD CONSTRAINT "saved_searches_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "User"("id") ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS "saved_searches_user_id_idx" ON "saved_searches" ("user_id");
+COMMENT ON COLUMN "saved_searches"."query" IS 'The listing search query, as sent to the listing search endpoint';
+COMMENT ON COLUMN "saved_searches"."last_checked_at" IS 'Listings activated after this time have not been matched against the search yet';
+
+CREATE TABLE IF NOT EXISTS "saved_search_matches" (
+  "user_id" UUID NOT NULL,
+  "listing_id" UUID NOT NULL,
+  "saved_search_id" BIGINT,
+  "notified_at" TIMESTAMPTZ,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY ("user_id", "listing_id")
+);
+COMMENT ON TABLE "saved_search_matches" IS 'Every listing matched for a user, whichever of their saved searches matched it. A listing is never sent twice to the same user.';
+ALTER TABLE "saved_search_matches" ADD CONSTRAINT "saved_search_matches_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "User"("id") ON DELETE CASCADE;
+ALTER TABLE "saved_search_matches" ADD CONSTRAINT "saved_search_matches_listing_id_fkey" FOREIGN KEY ("listing_id") REFERENCES "listings"("id") ON DELETE CASCADE;
+-- the matches outlive the search so that a listing is not sent again by another search of the user
+ALTER TABLE "saved_search_matches" ADD CONSTRAINT "saved_search_matches_saved_search_id_fkey" FOREIGN KEY ("saved_search_id") REFERENCES "saved_searches"("id") ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS "saved_search_matches_pending_idx" ON "saved_search_matches" ("saved_search_id") WHERE "notified_at" IS NULL;
+
+END;
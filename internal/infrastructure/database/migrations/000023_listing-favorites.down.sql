@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "favorite_listings";
+DROP TABLE IF EXISTS "favorite_folders";
+
+END;
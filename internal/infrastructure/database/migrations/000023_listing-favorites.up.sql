@@ -0,0 +1,28 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "favorite_folders" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "user_id" UUID NOT NULL,
+  "name" VARCHAR(64) NOT NULL,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+ALTER TABLE "favorite_folders" ADD CONSTRAINT "favorite_folders_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "User"("id") ON DELETE CASCADE;
+ALTER TABLE "favorite_folders" ADD CONSTRAINT "favorite_folders_user_id_name_key" UNIQUE ("user_id", "name");
+
+CREATE TABLE IF NOT EXISTS "favorite_listings" (
+  "user_id" UUID NOT NULL,
+  "listing_id" UUID NOT NULL,
+  "folder_id" BIGINT,
+  "note" TEXT,
+  "saved_price" REAL NOT NULL,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY ("user_id", "listing_id")
+);
+COMMENT ON COLUMN "favorite_listings"."saved_price" IS 'Price of the listing when it was added to the favorites, to flag price changes';
+ALTER TABLE "favorite_listings" ADD CONSTRAINT "favorite_listings_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "User"("id") ON DELETE CASCADE;
+ALTER TABLE "favorite_listings" ADD CONSTRAINT "favorite_listings_listing_id_fkey" FOREIGN KEY ("listing_id") REFERENCES "listings"("id") ON DELETE CASCADE;
+-- deleting a folder keeps its listings in the favorites
+ALTER TABLE "favorite_listings" ADD CONSTRAINT "favorite_listings_folder_id_fkey" FOREIGN KEY ("folder_id") REFERENCES "favorite_folders"("id") ON DELETE SET NULL;
+
+END;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "listing_daily_stats";
+
+END;
@@ -0,0 +1,20 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "listing_daily_stats" (
+  "listing_id" UUID NOT NULL,
+  "date" DATE NOT NULL,
+  "priority" INTEGER NOT NULL,
+  "impressions" BIGINT NOT NULL DEFAULT 0,
+  "views" BIGINT NOT NULL DEFAULT 0,
+  "contacts" BIGINT NOT NULL DEFAULT 0,
+  "application_links" BIGINT NOT NULL DEFAULT 0,
+  "applications" BIGINT NOT NULL DEFAULT 0,
+  PRIMARY KEY ("listing_id", "date")
+);
+COMMENT ON TABLE "listing_daily_stats" IS 'Deduplicated listing events of a day, aggregated in Redis then flushed periodically';
+COMMENT ON COLUMN "listing_daily_stats"."priority" IS 'Priority of the listing at the last flush of the day';
+COMMENT ON COLUMN "listing_daily_stats"."impressions" IS 'Number of sessions the listing appeared in the search results of';
+ALTER TABLE "listing_daily_stats" ADD CONSTRAINT "listing_daily_stats_listing_id_fkey" FOREIGN KEY ("listing_id") REFERENCES "listings"("id") ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS "listing_daily_stats_date_idx" ON "listing_daily_stats" ("date");
+
+END;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE "payments" DROP COLUMN IF EXISTS "transaction_date";
+
+DROP TABLE IF EXISTS "property_media_hashes";
+DROP TABLE IF EXISTS "moderation_banned_words";
+DROP TABLE IF EXISTS "listing_moderations";
+DROP TYPE IF EXISTS "LISTINGMODERATIONSTATUS";
+
+END;
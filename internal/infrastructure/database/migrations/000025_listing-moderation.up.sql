@@ -0,0 +1,55 @@
+BEGIN;
+
+CREATE TYPE "LISTINGMODERATIONSTATUS" AS ENUM ('PENDING', 'APPROVED', 'REJECTED', 'CHANGES_REQUESTED');
+
+CREATE TABLE IF NOT EXISTS "listing_moderations" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "listing_id" UUID NOT NULL,
+  "status" "LISTINGMODERATIONSTATUS" NOT NULL DEFAULT 'PENDING',
+  "flags" JSONB NOT NULL DEFAULT '[]',
+  "checked_at" TIMESTAMPTZ,
+  "reason" TEXT,
+  "reviewer_id" UUID,
+  "reviewed_at" TIMESTAMPTZ,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+COMMENT ON COLUMN "listing_moderations"."flags" IS 'Findings of the automatic content policy checks';
+COMMENT ON COLUMN "listing_moderations"."checked_at" IS 'The time when the automatic checks were run, NULL while they are pending';
+COMMENT ON COLUMN "listing_moderations"."reason" IS 'Reason of the decision, sent to the creator of the listing';
+ALTER TABLE "listing_moderations" ADD CONSTRAINT "listing_moderations_listing_id_fkey" FOREIGN KEY ("listing_id") REFERENCES "listings"("id") ON DELETE CASCADE;
+ALTER TABLE "listing_moderations" ADD CONSTRAINT "listing_moderations_reviewer_id_fkey" FOREIGN KEY ("reviewer_id") REFERENCES "User"("id") ON DELETE SET NULL;
+-- a listing waits in the queue once at a time
+CREATE UNIQUE INDEX IF NOT EXISTS "listing_moderations_pending_idx" ON "listing_moderations" ("listing_id") WHERE "status" = 'PENDING';
+CREATE INDEX IF NOT EXISTS "listing_moderations_listing_id_idx" ON "listing_moderations" ("listing_id", "created_at");
+
+CREATE TABLE IF NOT EXISTS "moderation_banned_words" (
+  "word" TEXT PRIMARY KEY,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+COMMENT ON TABLE "moderation_banned_words" IS 'Words and phrases not allowed in listings, matched case and diacritics insensitively';
+INSERT INTO "moderation_banned_words" ("word") VALUES
+('lừa đảo'),
+('cờ bạc'),
+('cá độ'),
+('ma túy'),
+('mại dâm'),
+('tín dụng đen'),
+('vay nóng'),
+('chuyển khoản trước'),
+('đặt cọc online'),
+('không cần xem nhà');
+
+CREATE TABLE IF NOT EXISTS "property_media_hashes" (
+  "media_id" BIGINT PRIMARY KEY,
+  "hash" BIGINT NOT NULL,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+COMMENT ON COLUMN "property_media_hashes"."hash" IS '64-bit difference hash of the image, similar images have hashes with a small hamming distance';
+ALTER TABLE "property_media_hashes" ADD CONSTRAINT "property_media_hashes_media_id_fkey" FOREIGN KEY ("media_id") REFERENCES "property_media"("id") ON DELETE CASCADE;
+
+-- rejected listings are refunded through VNPay
+ALTER TABLE "payments" ADD COLUMN IF NOT EXISTS "transaction_date" TIMESTAMPTZ;
+COMMENT ON COLUMN "payments"."transaction_date" IS 'Creation date of the VNPay transaction of the order, VNPay requires it to query or refund the transaction';
+
+END;
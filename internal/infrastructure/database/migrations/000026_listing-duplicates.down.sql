@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "listing_duplicates";
+DROP TABLE IF EXISTS "listing_duplicate_clusters";
+DROP TABLE IF EXISTS "listing_similarities";
+
+END;
@@ -0,0 +1,34 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "listing_similarities" (
+  "listing_id" UUID NOT NULL,
+  "similar_listing_id" UUID NOT NULL,
+  "score" REAL NOT NULL,
+  "reasons" TEXT[] NOT NULL DEFAULT '{}',
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY ("listing_id", "similar_listing_id"),
+  -- each pair is stored once
+  CHECK ("listing_id" < "similar_listing_id")
+);
+COMMENT ON TABLE "listing_similarities" IS 'Pairs of listings suspected to advertise the same unit';
+COMMENT ON COLUMN "listing_similarities"."score" IS 'Similarity of the listings between 0 and 1';
+COMMENT ON COLUMN "listing_similarities"."reasons" IS 'The criteria on which the listings are similar: LOCATION, UNIT, PRICE, DESCRIPTION, IMAGES';
+ALTER TABLE "listing_similarities" ADD CONSTRAINT "listing_similarities_listing_id_fkey" FOREIGN KEY ("listing_id") REFERENCES "listings"("id") ON DELETE CASCADE;
+ALTER TABLE "listing_similarities" ADD CONSTRAINT "listing_similarities_similar_listing_id_fkey" FOREIGN KEY ("similar_listing_id") REFERENCES "listings"("id") ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS "listing_similarities_similar_listing_id_idx" ON "listing_similarities" ("similar_listing_id");
+
+CREATE TABLE IF NOT EXISTS "listing_duplicate_clusters" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+COMMENT ON TABLE "listing_duplicate_clusters" IS 'Groups of listings connected by their similarities, search results only show the highest-priority listing of a group';
+
+CREATE TABLE IF NOT EXISTS "listing_duplicates" (
+  "listing_id" UUID PRIMARY KEY,
+  "cluster_id" BIGINT NOT NULL
+);
+ALTER TABLE "listing_duplicates" ADD CONSTRAINT "listing_duplicates_listing_id_fkey" FOREIGN KEY ("listing_id") REFERENCES "listings"("id") ON DELETE CASCADE;
+ALTER TABLE "listing_duplicates" ADD CONSTRAINT "listing_duplicates_cluster_id_fkey" FOREIGN KEY ("cluster_id") REFERENCES "listing_duplicate_clusters"("id") ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS "listing_duplicates_cluster_id_idx" ON "listing_duplicates" ("cluster_id");
+
+END;
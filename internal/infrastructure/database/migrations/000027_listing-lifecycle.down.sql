@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "payment_tokens";
+DROP INDEX IF EXISTS "listings_post_at_idx";
+DROP INDEX IF EXISTS "listings_expired_at_idx";
+ALTER TABLE "listings" DROP COLUMN IF EXISTS "expiry_warned_at";
+ALTER TABLE "listings" DROP COLUMN IF EXISTS "auto_renew";
+ALTER TABLE "listings" DROP COLUMN IF EXISTS "post_at";
+
+END;
@@ -0,0 +1,23 @@
+BEGIN;
+
+ALTER TABLE "listings" ADD COLUMN IF NOT EXISTS "post_at" TIMESTAMPTZ;
+COMMENT ON COLUMN "listings"."post_at" IS 'The time when the listing goes public, the listing stays a draft until then. NULL to go public once approved';
+ALTER TABLE "listings" ADD COLUMN IF NOT EXISTS "auto_renew" BOOLEAN NOT NULL DEFAULT FALSE;
+COMMENT ON COLUMN "listings"."auto_renew" IS 'Whether the listing is extended at its expiry, charged to the saved payment token of its creator';
+ALTER TABLE "listings" ADD COLUMN IF NOT EXISTS "expiry_warned_at" TIMESTAMPTZ;
+COMMENT ON COLUMN "listings"."expiry_warned_at" IS 'The time the creator was warned of the expiry of the listing, reset when the listing is extended';
+CREATE INDEX IF NOT EXISTS "listings_expired_at_idx" ON "listings" ("expired_at") WHERE "active";
+CREATE INDEX IF NOT EXISTS "listings_post_at_idx" ON "listings" ("post_at") WHERE NOT "active";
+
+CREATE TABLE IF NOT EXISTS "payment_tokens" (
+  "user_id" UUID PRIMARY KEY,
+  "token" TEXT NOT NULL,
+  "card_number" VARCHAR(32),
+  "bank_code" VARCHAR(32),
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+COMMENT ON TABLE "payment_tokens" IS 'The card of a user tokenized by the payment gateway, charged without the user for automatic renewals';
+COMMENT ON COLUMN "payment_tokens"."card_number" IS 'The masked card number, as returned by the payment gateway';
+ALTER TABLE "payment_tokens" ADD CONSTRAINT "payment_tokens_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "User"("id") ON DELETE CASCADE;
+
+END;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS "rentals_created_at_idx";
+DROP TABLE IF EXISTS "listing_price_history";
+
+END;
@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "listing_price_history" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "listing_id" UUID NOT NULL,
+  "unit_id" UUID,
+  "old_price" REAL NOT NULL,
+  "new_price" REAL NOT NULL,
+  "changed_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+COMMENT ON TABLE "listing_price_history" IS 'Changes of the prices of listings and of their units';
+COMMENT ON COLUMN "listing_price_history"."unit_id" IS 'The unit whose price changed, NULL for the price of the listing';
+ALTER TABLE "listing_price_history" ADD CONSTRAINT "listing_price_history_listing_id_fkey" FOREIGN KEY ("listing_id") REFERENCES "listings"("id") ON DELETE CASCADE;
+ALTER TABLE "listing_price_history" ADD CONSTRAINT "listing_price_history_unit_id_fkey" FOREIGN KEY ("unit_id") REFERENCES "units"("id") ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS "listing_price_history_listing_id_idx" ON "listing_price_history" ("listing_id", "changed_at");
+
+CREATE INDEX IF NOT EXISTS "rentals_created_at_idx" ON "rentals" ("created_at");
+
+END;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE "listing_daily_stats" DROP COLUMN IF EXISTS "viewings";
+DROP TABLE IF EXISTS "listing_viewings";
+DROP TYPE IF EXISTS "LISTINGVIEWINGSTATUS";
+DROP TABLE IF EXISTS "listing_viewing_slots";
+
+END;
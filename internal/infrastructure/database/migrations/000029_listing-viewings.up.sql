@@ -0,0 +1,52 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "listing_viewing_slots" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "listing_id" UUID NOT NULL,
+  "manager_id" UUID NOT NULL,
+  "start_at" TIMESTAMPTZ NOT NULL,
+  "end_at" TIMESTAMPTZ NOT NULL,
+  "capacity" INTEGER NOT NULL DEFAULT 1 CHECK ("capacity" >= 1),
+  "booked" INTEGER NOT NULL DEFAULT 0 CHECK ("booked" >= 0 AND "booked" <= "capacity"),
+  "open_house" BOOLEAN NOT NULL DEFAULT FALSE,
+  "series_id" UUID,
+  "note" TEXT,
+  "reminder_id" BIGINT,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CHECK ("end_at" > "start_at")
+);
+COMMENT ON TABLE "listing_viewing_slots" IS 'Time slots during which a manager shows the property of a listing to prospective tenants';
+COMMENT ON COLUMN "listing_viewing_slots"."manager_id" IS 'The manager hosting the viewings, who cannot host two overlapping slots';
+COMMENT ON COLUMN "listing_viewing_slots"."booked" IS 'Number of bookings not canceled';
+COMMENT ON COLUMN "listing_viewing_slots"."series_id" IS 'Shared by the slots of a recurring open house';
+COMMENT ON COLUMN "listing_viewing_slots"."reminder_id" IS 'Reminder of the manager, created on the first booking';
+ALTER TABLE "listing_viewing_slots" ADD CONSTRAINT "listing_viewing_slots_listing_id_fkey" FOREIGN KEY ("listing_id") REFERENCES "listings"("id") ON DELETE CASCADE;
+ALTER TABLE "listing_viewing_slots" ADD CONSTRAINT "listing_viewing_slots_manager_id_fkey" FOREIGN KEY ("manager_id") REFERENCES "User"("id") ON DELETE CASCADE;
+ALTER TABLE "listing_viewing_slots" ADD CONSTRAINT "listing_viewing_slots_reminder_id_fkey" FOREIGN KEY ("reminder_id") REFERENCES "reminders"("id") ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS "listing_viewing_slots_listing_id_idx" ON "listing_viewing_slots" ("listing_id", "start_at");
+CREATE INDEX IF NOT EXISTS "listing_viewing_slots_manager_id_idx" ON "listing_viewing_slots" ("manager_id", "start_at");
+
+CREATE TYPE "LISTINGVIEWINGSTATUS" AS ENUM ('BOOKED', 'CANCELED', 'ATTENDED', 'NO_SHOW');
+
+CREATE TABLE IF NOT EXISTS "listing_viewings" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "slot_id" BIGINT NOT NULL,
+  "tenant_id" UUID NOT NULL,
+  "note" TEXT,
+  "status" "LISTINGVIEWINGSTATUS" NOT NULL DEFAULT 'BOOKED',
+  "reminded_at" TIMESTAMPTZ,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+COMMENT ON TABLE "listing_viewings" IS 'Bookings of viewing slots by prospective tenants';
+COMMENT ON COLUMN "listing_viewings"."reminded_at" IS 'The time when both sides were reminded of the viewing';
+ALTER TABLE "listing_viewings" ADD CONSTRAINT "listing_viewings_slot_id_fkey" FOREIGN KEY ("slot_id") REFERENCES "listing_viewing_slots"("id") ON DELETE CASCADE;
+ALTER TABLE "listing_viewings" ADD CONSTRAINT "listing_viewings_tenant_id_fkey" FOREIGN KEY ("tenant_id") REFERENCES "User"("id") ON DELETE CASCADE;
+-- a tenant books a slot once
+CREATE UNIQUE INDEX IF NOT EXISTS "listing_viewings_slot_tenant_idx" ON "listing_viewings" ("slot_id", "tenant_id") WHERE "status" <> 'CANCELED';
+CREATE INDEX IF NOT EXISTS "listing_viewings_tenant_id_idx" ON "listing_viewings" ("tenant_id");
+
+ALTER TABLE "listing_daily_stats" ADD COLUMN IF NOT EXISTS "viewings" BIGINT NOT NULL DEFAULT 0;
+COMMENT ON COLUMN "listing_daily_stats"."viewings" IS 'Number of tenants who attended a viewing';
+
+END;
@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "listing_translations" (
+  "listing_id" UUID NOT NULL,
+  "language" VARCHAR(8) NOT NULL,
+  "title" TEXT NOT NULL,
+  "description" TEXT NOT NULL,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY ("listing_id", "language")
+);
+COMMENT ON TABLE "listing_translations" IS 'Titles and descriptions of listings in languages other than Vietnamese, the language of the listings table';
+COMMENT ON COLUMN "listing_translations"."language" IS 'ISO 639-1 code of the language';
+ALTER TABLE "listing_translations" ADD CONSTRAINT "listing_translations_listing_id_fkey" FOREIGN KEY ("listing_id") REFERENCES "listings"("id") ON DELETE CASCADE;
+
+END;
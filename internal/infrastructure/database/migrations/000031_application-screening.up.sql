@@ -0,0 +1,22 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "property_screening_configs" (
+  "property_id" UUID PRIMARY KEY,
+  "income_weight" REAL NOT NULL DEFAULT 30 CHECK ("income_weight" >= 0),
+  "household_income_weight" REAL NOT NULL DEFAULT 20 CHECK ("household_income_weight" >= 0),
+  "rental_history_weight" REAL NOT NULL DEFAULT 15 CHECK ("rental_history_weight" >= 0),
+  "policy_weight" REAL NOT NULL DEFAULT 15 CHECK ("policy_weight" >= 0),
+  "payment_history_weight" REAL NOT NULL DEFAULT 20 CHECK ("payment_history_weight" >= 0),
+  "min_income_ratio" REAL NOT NULL DEFAULT 3 CHECK ("min_income_ratio" > 0),
+  "min_household_income_ratio" REAL NOT NULL DEFAULT 3 CHECK ("min_household_income_ratio" > 0),
+  "min_rental_history_months" INTEGER NOT NULL DEFAULT 12 CHECK ("min_rental_history_months" >= 0),
+  "min_on_time_rate" REAL NOT NULL DEFAULT 0.9 CHECK ("min_on_time_rate" >= 0 AND "min_on_time_rate" <= 1),
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+COMMENT ON TABLE "property_screening_configs" IS 'Weights and thresholds the managers of a property use to screen its rental applications';
+COMMENT ON COLUMN "property_screening_configs"."min_income_ratio" IS 'Minimum ratio of the applicant monthly income to the offered price';
+COMMENT ON COLUMN "property_screening_configs"."min_household_income_ratio" IS 'Minimum ratio of the applicant and co-applicants monthly income to the offered price';
+COMMENT ON COLUMN "property_screening_configs"."min_on_time_rate" IS 'Minimum share of the payments of previous rentals on the platform paid before their expiry date';
+ALTER TABLE "property_screening_configs" ADD CONSTRAINT "property_screening_configs_property_id_fkey" FOREIGN KEY ("property_id") REFERENCES "properties"("id") ON DELETE CASCADE;
+
+END;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE "applications" DROP COLUMN IF EXISTS "documents_reminded_at";
+DROP TABLE IF EXISTS "application_documents";
+DROP TABLE IF EXISTS "listing_required_documents";
+DROP TYPE IF EXISTS "APPLICATIONDOCUMENTSTATUS";
+DROP TYPE IF EXISTS "APPLICATIONDOCUMENTTYPE";
+
+END;
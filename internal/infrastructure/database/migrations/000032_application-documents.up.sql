@@ -0,0 +1,39 @@
+BEGIN;
+
+CREATE TYPE "APPLICATIONDOCUMENTTYPE" AS ENUM ('ID_CARD', 'PROOF_OF_INCOME', 'EMPLOYMENT_LETTER', 'LANDLORD_REFERENCE');
+CREATE TYPE "APPLICATIONDOCUMENTSTATUS" AS ENUM ('UPLOADED', 'VERIFIED', 'REJECTED');
+
+CREATE TABLE IF NOT EXISTS "listing_required_documents" (
+  "listing_id" UUID NOT NULL,
+  "type" "APPLICATIONDOCUMENTTYPE" NOT NULL,
+  "mandatory" BOOLEAN NOT NULL DEFAULT TRUE,
+  "note" TEXT,
+  PRIMARY KEY ("listing_id", "type")
+);
+COMMENT ON TABLE "listing_required_documents" IS 'Documents the applicants to a listing are asked to upload';
+COMMENT ON COLUMN "listing_required_documents"."mandatory" IS 'Applications cannot be approved until the document is verified, unless a manager overrides';
+ALTER TABLE "listing_required_documents" ADD CONSTRAINT "listing_required_documents_listing_id_fkey" FOREIGN KEY ("listing_id") REFERENCES "listings"("id") ON DELETE CASCADE;
+
+CREATE TABLE IF NOT EXISTS "application_documents" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "application_id" BIGINT NOT NULL,
+  "type" "APPLICATIONDOCUMENTTYPE" NOT NULL,
+  "url" TEXT NOT NULL,
+  "status" "APPLICATIONDOCUMENTSTATUS" NOT NULL DEFAULT 'UPLOADED',
+  "note" TEXT,
+  "verified_by" UUID,
+  "verified_at" TIMESTAMPTZ,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE ("application_id", "type")
+);
+COMMENT ON TABLE "application_documents" IS 'Documents uploaded by applicants, one per type, replaced on upload';
+COMMENT ON COLUMN "application_documents"."note" IS 'Remark of the manager, e.g. why the document is rejected';
+COMMENT ON COLUMN "application_documents"."verified_by" IS 'The manager who verified or rejected the document';
+ALTER TABLE "application_documents" ADD CONSTRAINT "application_documents_application_id_fkey" FOREIGN KEY ("application_id") REFERENCES "applications"("id") ON DELETE CASCADE;
+ALTER TABLE "application_documents" ADD CONSTRAINT "application_documents_verified_by_fkey" FOREIGN KEY ("verified_by") REFERENCES "User"("id") ON DELETE SET NULL;
+
+ALTER TABLE "applications" ADD COLUMN IF NOT EXISTS "documents_reminded_at" TIMESTAMPTZ;
+COMMENT ON COLUMN "applications"."documents_reminded_at" IS 'The last time the applicant was reminded of missing documents';
+
+END;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "application_answers";
+DROP TABLE IF EXISTS "listing_questions";
+DROP TYPE IF EXISTS "APPLICATIONQUESTIONTYPE";
+
+END;
@@ -0,0 +1,36 @@
+BEGIN;
+
+CREATE TYPE "APPLICATIONQUESTIONTYPE" AS ENUM ('TEXT', 'NUMBER', 'SINGLE_CHOICE', 'MULTI_CHOICE', 'DATE', 'FILE');
+
+CREATE TABLE IF NOT EXISTS "listing_questions" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "listing_id" UUID NOT NULL,
+  "question" TEXT NOT NULL,
+  "type" "APPLICATIONQUESTIONTYPE" NOT NULL,
+  "required" BOOLEAN NOT NULL DEFAULT FALSE,
+  "options" TEXT[] NOT NULL DEFAULT '{}',
+  "position" INTEGER NOT NULL DEFAULT 0,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+COMMENT ON TABLE "listing_questions" IS 'Questions the landlord asks the applicants to a listing in addition to the application form';
+COMMENT ON COLUMN "listing_questions"."options" IS 'Choices of SINGLE_CHOICE and MULTI_CHOICE questions';
+ALTER TABLE "listing_questions" ADD CONSTRAINT "listing_questions_listing_id_fkey" FOREIGN KEY ("listing_id") REFERENCES "listings"("id") ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS "listing_questions_listing_id_idx" ON "listing_questions" ("listing_id", "position");
+
+CREATE TABLE IF NOT EXISTS "application_answers" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "application_id" BIGINT NOT NULL,
+  "question_id" BIGINT,
+  "question" TEXT NOT NULL,
+  "type" "APPLICATIONQUESTIONTYPE" NOT NULL,
+  "values" TEXT[] NOT NULL
+);
+COMMENT ON TABLE "application_answers" IS 'Answers of applicants to the questions of listings';
+COMMENT ON COLUMN "application_answers"."question" IS 'The question as it was asked, kept when the question is edited or deleted';
+COMMENT ON COLUMN "application_answers"."values" IS 'A single value except for MULTI_CHOICE questions. Numbers are in decimal notation, dates in YYYY-MM-DD, files are urls';
+ALTER TABLE "application_answers" ADD CONSTRAINT "application_answers_application_id_fkey" FOREIGN KEY ("application_id") REFERENCES "applications"("id") ON DELETE CASCADE;
+ALTER TABLE "application_answers" ADD CONSTRAINT "application_answers_question_id_fkey" FOREIGN KEY ("question_id") REFERENCES "listing_questions"("id") ON DELETE SET NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS "application_answers_application_question_idx" ON "application_answers" ("application_id", "question_id");
+
+END;
@@ -0,0 +1,27 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "application_references" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "application_id" BIGINT NOT NULL,
+  "referee_name" VARCHAR(100) NOT NULL,
+  "referee_email" VARCHAR(100) NOT NULL,
+  "referee_phone" VARCHAR(20),
+  "rental_address" TEXT,
+  "expires_at" TIMESTAMPTZ NOT NULL,
+  "referee_id" UUID,
+  "paid_on_time" BOOLEAN,
+  "caused_damage" BOOLEAN,
+  "would_rent_again" BOOLEAN,
+  "rental_duration" INTEGER,
+  "comment" TEXT,
+  "responded_at" TIMESTAMPTZ,
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+COMMENT ON TABLE "application_references" IS 'Questionnaires sent to the previous landlords of applicants';
+COMMENT ON COLUMN "application_references"."referee_id" IS 'Set when the referee is a user of the platform';
+COMMENT ON COLUMN "application_references"."rental_duration" IS 'Rental duration in months, as told by the referee';
+ALTER TABLE "application_references" ADD CONSTRAINT "application_references_application_id_fkey" FOREIGN KEY ("application_id") REFERENCES "applications"("id") ON DELETE CASCADE;
+ALTER TABLE "application_references" ADD CONSTRAINT "application_references_referee_id_fkey" FOREIGN KEY ("referee_id") REFERENCES "User"("id") ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS "application_references_application_id_idx" ON "application_references" ("application_id");
+
+END;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- This migration is lossy: Postgres cannot drop an enum value, so 'WAITLISTED' stays in the APPLICATION_STATUS type,
+-- and the waitlisted applications are moved back to PENDING, which cannot be told apart from the other pending ones afterwards
+UPDATE "applications" SET "status" = 'PENDING' WHERE "status" = 'WAITLISTED';
+
+END;
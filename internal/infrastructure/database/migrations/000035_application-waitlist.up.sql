@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TYPE "APPLICATION_STATUS" ADD VALUE IF NOT EXISTS 'WAITLISTED';
+
+END;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE "listings" DROP COLUMN IF EXISTS "application_validity_days";
+
+-- This migration is lossy: Postgres cannot drop an enum value, so 'EXPIRED' stays in the APPLICATION_STATUS type,
+-- and the expired applications are moved back to PENDING, which cannot be told apart from the other pending ones afterwards
+UPDATE "applications" SET "status" = 'PENDING' WHERE "status" = 'EXPIRED';
+
+END;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TYPE "APPLICATION_STATUS" ADD VALUE IF NOT EXISTS 'EXPIRED';
+
+ALTER TABLE "listings" ADD COLUMN IF NOT EXISTS "application_validity_days" INTEGER NOT NULL DEFAULT 30;
+COMMENT ON COLUMN "listings"."application_validity_days" IS 'Number of days a pending application to the listing stays valid without any update';
+
+END;
@@ -13,6 +13,139 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type APPLICATIONDOCUMENTSTATUS string
+
+const (
+	APPLICATIONDOCUMENTSTATUSUPLOADED APPLICATIONDOCUMENTSTATUS = "UPLOADED"
+	APPLICATIONDOCUMENTSTATUSVERIFIED APPLICATIONDOCUMENTSTATUS = "VERIFIED"
+	APPLICATIONDOCUMENTSTATUSREJECTED APPLICATIONDOCUMENTSTATUS = "REJECTED"
+)
+
+func (e *APPLICATIONDOCUMENTSTATUS) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = APPLICATIONDOCUMENTSTATUS(s)
+	case string:
+		*e = APPLICATIONDOCUMENTSTATUS(s)
+	default:
+		return fmt.Errorf("unsupported scan type for APPLICATIONDOCUMENTSTATUS: %T", src)
+	}
+	return nil
+}
+
+type NullAPPLICATIONDOCUMENTSTATUS struct {
+	APPLICATIONDOCUMENTSTATUS APPLICATIONDOCUMENTSTATUS `json:"APPLICATIONDOCUMENTSTATUS"`
+	Valid                     bool                      `json:"valid"` // Valid is true if APPLICATIONDOCUMENTSTATUS is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAPPLICATIONDOCUMENTSTATUS) Scan(value interface{}) error {
+	if value == nil {
+		ns.APPLICATIONDOCUMENTSTATUS, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.APPLICATIONDOCUMENTSTATUS.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAPPLICATIONDOCUMENTSTATUS) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.APPLICATIONDOCUMENTSTATUS), nil
+}
+
+type APPLICATIONDOCUMENTTYPE string
+
+const (
+	APPLICATIONDOCUMENTTYPEIDCARD            APPLICATIONDOCUMENTTYPE = "ID_CARD"
+	APPLICATIONDOCUMENTTYPEPROOFOFINCOME     APPLICATIONDOCUMENTTYPE = "PROOF_OF_INCOME"
+	APPLICATIONDOCUMENTTYPEEMPLOYMENTLETTER  APPLICATIONDOCUMENTTYPE = "EMPLOYMENT_LETTER"
+	APPLICATIONDOCUMENTTYPELANDLORDREFERENCE APPLICATIONDOCUMENTTYPE = "LANDLORD_REFERENCE"
+)
+
+func (e *APPLICATIONDOCUMENTTYPE) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = APPLICATIONDOCUMENTTYPE(s)
+	case string:
+		*e = APPLICATIONDOCUMENTTYPE(s)
+	default:
+		return fmt.Errorf("unsupported scan type for APPLICATIONDOCUMENTTYPE: %T", src)
+	}
+	return nil
+}
+
+type NullAPPLICATIONDOCUMENTTYPE struct {
+	APPLICATIONDOCUMENTTYPE APPLICATIONDOCUMENTTYPE `json:"APPLICATIONDOCUMENTTYPE"`
+	Valid                   bool                    `json:"valid"` // Valid is true if APPLICATIONDOCUMENTTYPE is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAPPLICATIONDOCUMENTTYPE) Scan(value interface{}) error {
+	if value == nil {
+		ns.APPLICATIONDOCUMENTTYPE, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.APPLICATIONDOCUMENTTYPE.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAPPLICATIONDOCUMENTTYPE) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.APPLICATIONDOCUMENTTYPE), nil
+}
+
+type APPLICATIONQUESTIONTYPE string
+
+const (
+	APPLICATIONQUESTIONTYPETEXT         APPLICATIONQUESTIONTYPE = "TEXT"
+	APPLICATIONQUESTIONTYPENUMBER       APPLICATIONQUESTIONTYPE = "NUMBER"
+	APPLICATIONQUESTIONTYPESINGLECHOICE APPLICATIONQUESTIONTYPE = "SINGLE_CHOICE"
+	APPLICATIONQUESTIONTYPEMULTICHOICE  APPLICATIONQUESTIONTYPE = "MULTI_CHOICE"
+	APPLICATIONQUESTIONTYPEDATE         APPLICATIONQUESTIONTYPE = "DATE"
+	APPLICATIONQUESTIONTYPEFILE         APPLICATIONQUESTIONTYPE = "FILE"
+)
+
+func (e *APPLICATIONQUESTIONTYPE) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = APPLICATIONQUESTIONTYPE(s)
+	case string:
+		*e = APPLICATIONQUESTIONTYPE(s)
+	default:
+		return fmt.Errorf("unsupported scan type for APPLICATIONQUESTIONTYPE: %T", src)
+	}
+	return nil
+}
+
+type NullAPPLICATIONQUESTIONTYPE struct {
+	APPLICATIONQUESTIONTYPE APPLICATIONQUESTIONTYPE `json:"APPLICATIONQUESTIONTYPE"`
+	Valid                   bool                    `json:"valid"` // Valid is true if APPLICATIONQUESTIONTYPE is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAPPLICATIONQUESTIONTYPE) Scan(value interface{}) error {
+	if value == nil {
+		ns.APPLICATIONQUESTIONTYPE, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.APPLICATIONQUESTIONTYPE.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAPPLICATIONQUESTIONTYPE) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.APPLICATIONQUESTIONTYPE), nil
+}
+
 type APPLICATIONSTATUS string
 
 const (
@@ -21,6 +154,8 @@ const (
 	APPLICATIONSTATUSCONDITIONALLYAPPROVED APPLICATIONSTATUS = "CONDITIONALLY_APPROVED"
 	APPLICATIONSTATUSREJECTED              APPLICATIONSTATUS = "REJECTED"
 	APPLICATIONSTATUSWITHDRAWN             APPLICATIONSTATUS = "WITHDRAWN"
+	APPLICATIONSTATUSWAITLISTED            APPLICATIONSTATUS = "WAITLISTED"
+	APPLICATIONSTATUSEXPIRED               APPLICATIONSTATUS = "EXPIRED"
 )
 
 func (e *APPLICATIONSTATUS) Scan(src interface{}) error {
@@ -147,6 +282,94 @@ func (ns NullLATEPAYMENTPENALTYSCHEME) Value() (driver.Value, error) {
 	return string(ns.LATEPAYMENTPENALTYSCHEME), nil
 }
 
+type LISTINGMODERATIONSTATUS string
+
+const (
+	LISTINGMODERATIONSTATUSPENDING          LISTINGMODERATIONSTATUS = "PENDING"
+	LISTINGMODERATIONSTATUSAPPROVED         LISTINGMODERATIONSTATUS = "APPROVED"
+	LISTINGMODERATIONSTATUSREJECTED         LISTINGMODERATIONSTATUS = "REJECTED"
+	LISTINGMODERATIONSTATUSCHANGESREQUESTED LISTINGMODERATIONSTATUS = "CHANGES_REQUESTED"
+)
+
+func (e *LISTINGMODERATIONSTATUS) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LISTINGMODERATIONSTATUS(s)
+	case string:
+		*e = LISTINGMODERATIONSTATUS(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LISTINGMODERATIONSTATUS: %T", src)
+	}
+	return nil
+}
+
+type NullLISTINGMODERATIONSTATUS struct {
+	LISTINGMODERATIONSTATUS LISTINGMODERATIONSTATUS `json:"LISTINGMODERATIONSTATUS"`
+	Valid                   bool                    `json:"valid"` // Valid is true if LISTINGMODERATIONSTATUS is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLISTINGMODERATIONSTATUS) Scan(value interface{}) error {
+	if value == nil {
+		ns.LISTINGMODERATIONSTATUS, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LISTINGMODERATIONSTATUS.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLISTINGMODERATIONSTATUS) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LISTINGMODERATIONSTATUS), nil
+}
+
+type LISTINGVIEWINGSTATUS string
+
+const (
+	LISTINGVIEWINGSTATUSBOOKED   LISTINGVIEWINGSTATUS = "BOOKED"
+	LISTINGVIEWINGSTATUSCANCELED LISTINGVIEWINGSTATUS = "CANCELED"
+	LISTINGVIEWINGSTATUSATTENDED LISTINGVIEWINGSTATUS = "ATTENDED"
+	LISTINGVIEWINGSTATUSNOSHOW   LISTINGVIEWINGSTATUS = "NO_SHOW"
+)
+
+func (e *LISTINGVIEWINGSTATUS) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LISTINGVIEWINGSTATUS(s)
+	case string:
+		*e = LISTINGVIEWINGSTATUS(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LISTINGVIEWINGSTATUS: %T", src)
+	}
+	return nil
+}
+
+type NullLISTINGVIEWINGSTATUS struct {
+	LISTINGVIEWINGSTATUS LISTINGVIEWINGSTATUS `json:"LISTINGVIEWINGSTATUS"`
+	Valid                bool                 `json:"valid"` // Valid is true if LISTINGVIEWINGSTATUS is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLISTINGVIEWINGSTATUS) Scan(value interface{}) error {
+	if value == nil {
+		ns.LISTINGVIEWINGSTATUS, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LISTINGVIEWINGSTATUS.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLISTINGVIEWINGSTATUS) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LISTINGVIEWINGSTATUS), nil
+}
+
 type MEDIATYPE string
 
 const (
@@ -402,6 +625,51 @@ func (ns NullPLATFORM) Value() (driver.Value, error) {
 	return string(ns.PLATFORM), nil
 }
 
+type PROPERTYSERVICETYPE string
+
+const (
+	PROPERTYSERVICETYPEINTERNET PROPERTYSERVICETYPE = "INTERNET"
+	PROPERTYSERVICETYPEPARKING  PROPERTYSERVICETYPE = "PARKING"
+	PROPERTYSERVICETYPECLEANING PROPERTYSERVICETYPE = "CLEANING"
+	PROPERTYSERVICETYPETRASH    PROPERTYSERVICETYPE = "TRASH"
+	PROPERTYSERVICETYPEOTHER    PROPERTYSERVICETYPE = "OTHER"
+)
+
+func (e *PROPERTYSERVICETYPE) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PROPERTYSERVICETYPE(s)
+	case string:
+		*e = PROPERTYSERVICETYPE(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PROPERTYSERVICETYPE: %T", src)
+	}
+	return nil
+}
+
+type NullPROPERTYSERVICETYPE struct {
+	PROPERTYSERVICETYPE PROPERTYSERVICETYPE `json:"PROPERTYSERVICETYPE"`
+	Valid               bool                `json:"valid"` // Valid is true if PROPERTYSERVICETYPE is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPROPERTYSERVICETYPE) Scan(value interface{}) error {
+	if value == nil {
+		ns.PROPERTYSERVICETYPE, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PROPERTYSERVICETYPE.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPROPERTYSERVICETYPE) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PROPERTYSERVICETYPE), nil
+}
+
 type PROPERTYTYPE string
 
 const (
@@ -709,6 +977,264 @@ func (ns NullRENTALSTATUS) Value() (driver.Value, error) {
 	return string(ns.RENTALSTATUS), nil
 }
 
+type REPORTFORMAT string
+
+const (
+	REPORTFORMATCSV  REPORTFORMAT = "CSV"
+	REPORTFORMATXLSX REPORTFORMAT = "XLSX"
+	REPORTFORMATPDF  REPORTFORMAT = "PDF"
+)
+
+func (e *REPORTFORMAT) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = REPORTFORMAT(s)
+	case string:
+		*e = REPORTFORMAT(s)
+	default:
+		return fmt.Errorf("unsupported scan type for REPORTFORMAT: %T", src)
+	}
+	return nil
+}
+
+type NullREPORTFORMAT struct {
+	REPORTFORMAT REPORTFORMAT `json:"REPORTFORMAT"`
+	Valid        bool         `json:"valid"` // Valid is true if REPORTFORMAT is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullREPORTFORMAT) Scan(value interface{}) error {
+	if value == nil {
+		ns.REPORTFORMAT, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.REPORTFORMAT.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullREPORTFORMAT) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.REPORTFORMAT), nil
+}
+
+type REPORTTYPE string
+
+const (
+	REPORTTYPERENTROLL       REPORTTYPE = "RENT_ROLL"
+	REPORTTYPEOWNERSTATEMENT REPORTTYPE = "OWNER_STATEMENT"
+)
+
+func (e *REPORTTYPE) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = REPORTTYPE(s)
+	case string:
+		*e = REPORTTYPE(s)
+	default:
+		return fmt.Errorf("unsupported scan type for REPORTTYPE: %T", src)
+	}
+	return nil
+}
+
+type NullREPORTTYPE struct {
+	REPORTTYPE REPORTTYPE `json:"REPORTTYPE"`
+	Valid      bool       `json:"valid"` // Valid is true if REPORTTYPE is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullREPORTTYPE) Scan(value interface{}) error {
+	if value == nil {
+		ns.REPORTTYPE, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.REPORTTYPE.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullREPORTTYPE) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.REPORTTYPE), nil
+}
+
+type SAVEDSEARCHFREQUENCY string
+
+const (
+	SAVEDSEARCHFREQUENCYINSTANT SAVEDSEARCHFREQUENCY = "INSTANT"
+	SAVEDSEARCHFREQUENCYDAILY   SAVEDSEARCHFREQUENCY = "DAILY"
+	SAVEDSEARCHFREQUENCYWEEKLY  SAVEDSEARCHFREQUENCY = "WEEKLY"
+)
+
+func (e *SAVEDSEARCHFREQUENCY) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SAVEDSEARCHFREQUENCY(s)
+	case string:
+		*e = SAVEDSEARCHFREQUENCY(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SAVEDSEARCHFREQUENCY: %T", src)
+	}
+	return nil
+}
+
+type NullSAVEDSEARCHFREQUENCY struct {
+	SAVEDSEARCHFREQUENCY SAVEDSEARCHFREQUENCY `json:"SAVEDSEARCHFREQUENCY"`
+	Valid                bool                 `json:"valid"` // Valid is true if SAVEDSEARCHFREQUENCY is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSAVEDSEARCHFREQUENCY) Scan(value interface{}) error {
+	if value == nil {
+		ns.SAVEDSEARCHFREQUENCY, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SAVEDSEARCHFREQUENCY.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSAVEDSEARCHFREQUENCY) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SAVEDSEARCHFREQUENCY), nil
+}
+
+type SEARCHOUTBOXSOURCE string
+
+const (
+	SEARCHOUTBOXSOURCELISTING      SEARCHOUTBOXSOURCE = "LISTING"
+	SEARCHOUTBOXSOURCEPROPERTY     SEARCHOUTBOXSOURCE = "PROPERTY"
+	SEARCHOUTBOXSOURCEUNIT         SEARCHOUTBOXSOURCE = "UNIT"
+	SEARCHOUTBOXSOURCEVERIFICATION SEARCHOUTBOXSOURCE = "VERIFICATION"
+)
+
+func (e *SEARCHOUTBOXSOURCE) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SEARCHOUTBOXSOURCE(s)
+	case string:
+		*e = SEARCHOUTBOXSOURCE(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SEARCHOUTBOXSOURCE: %T", src)
+	}
+	return nil
+}
+
+type NullSEARCHOUTBOXSOURCE struct {
+	SEARCHOUTBOXSOURCE SEARCHOUTBOXSOURCE `json:"SEARCHOUTBOXSOURCE"`
+	Valid              bool               `json:"valid"` // Valid is true if SEARCHOUTBOXSOURCE is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSEARCHOUTBOXSOURCE) Scan(value interface{}) error {
+	if value == nil {
+		ns.SEARCHOUTBOXSOURCE, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SEARCHOUTBOXSOURCE.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSEARCHOUTBOXSOURCE) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SEARCHOUTBOXSOURCE), nil
+}
+
+type SERVICEBILLINGFREQUENCY string
+
+const (
+	SERVICEBILLINGFREQUENCYMONTHLY   SERVICEBILLINGFREQUENCY = "MONTHLY"
+	SERVICEBILLINGFREQUENCYQUARTERLY SERVICEBILLINGFREQUENCY = "QUARTERLY"
+	SERVICEBILLINGFREQUENCYYEARLY    SERVICEBILLINGFREQUENCY = "YEARLY"
+)
+
+func (e *SERVICEBILLINGFREQUENCY) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SERVICEBILLINGFREQUENCY(s)
+	case string:
+		*e = SERVICEBILLINGFREQUENCY(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SERVICEBILLINGFREQUENCY: %T", src)
+	}
+	return nil
+}
+
+type NullSERVICEBILLINGFREQUENCY struct {
+	SERVICEBILLINGFREQUENCY SERVICEBILLINGFREQUENCY `json:"SERVICEBILLINGFREQUENCY"`
+	Valid                   bool                    `json:"valid"` // Valid is true if SERVICEBILLINGFREQUENCY is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSERVICEBILLINGFREQUENCY) Scan(value interface{}) error {
+	if value == nil {
+		ns.SERVICEBILLINGFREQUENCY, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SERVICEBILLINGFREQUENCY.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSERVICEBILLINGFREQUENCY) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SERVICEBILLINGFREQUENCY), nil
+}
+
+type SERVICEPRICECHANGESTATUS string
+
+const (
+	SERVICEPRICECHANGESTATUSPENDING   SERVICEPRICECHANGESTATUS = "PENDING"
+	SERVICEPRICECHANGESTATUSAPPLIED   SERVICEPRICECHANGESTATUS = "APPLIED"
+	SERVICEPRICECHANGESTATUSDISMISSED SERVICEPRICECHANGESTATUS = "DISMISSED"
+)
+
+func (e *SERVICEPRICECHANGESTATUS) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SERVICEPRICECHANGESTATUS(s)
+	case string:
+		*e = SERVICEPRICECHANGESTATUS(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SERVICEPRICECHANGESTATUS: %T", src)
+	}
+	return nil
+}
+
+type NullSERVICEPRICECHANGESTATUS struct {
+	SERVICEPRICECHANGESTATUS SERVICEPRICECHANGESTATUS `json:"SERVICEPRICECHANGESTATUS"`
+	Valid                    bool                     `json:"valid"` // Valid is true if SERVICEPRICECHANGESTATUS is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSERVICEPRICECHANGESTATUS) Scan(value interface{}) error {
+	if value == nil {
+		ns.SERVICEPRICECHANGESTATUS, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SERVICEPRICECHANGESTATUS.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSERVICEPRICECHANGESTATUS) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SERVICEPRICECHANGESTATUS), nil
+}
+
 type TENANTTYPE string
 
 const (
@@ -888,6 +1414,20 @@ type Application struct {
 	EmploymentPosition      pgtype.Text       `json:"employment_position"`
 	EmploymentMonthlyIncome pgtype.Int8       `json:"employment_monthly_income"`
 	EmploymentComment       pgtype.Text       `json:"employment_comment"`
+	// The last time the applicant was reminded of missing documents
+	DocumentsRemindedAt pgtype.Timestamptz `json:"documents_reminded_at"`
+}
+
+// Answers of applicants to the questions of listings
+type ApplicationAnswer struct {
+	ID            int64       `json:"id"`
+	ApplicationID int64       `json:"application_id"`
+	QuestionID    pgtype.Int8 `json:"question_id"`
+	// The question as it was asked, kept when the question is edited or deleted
+	Question string                  `json:"question"`
+	Type     APPLICATIONQUESTIONTYPE `json:"type"`
+	// A single value except for MULTI_CHOICE questions. Numbers are in decimal notation, dates in YYYY-MM-DD, files are urls
+	Values []string `json:"values"`
 }
 
 type ApplicationCoap struct {
@@ -901,6 +1441,22 @@ type ApplicationCoap struct {
 	Description   pgtype.Text `json:"description"`
 }
 
+// Documents uploaded by applicants, one per type, replaced on upload
+type ApplicationDocument struct {
+	ID            int64                     `json:"id"`
+	ApplicationID int64                     `json:"application_id"`
+	Type          APPLICATIONDOCUMENTTYPE   `json:"type"`
+	Url           string                    `json:"url"`
+	Status        APPLICATIONDOCUMENTSTATUS `json:"status"`
+	// Remark of the manager, e.g. why the document is rejected
+	Note pgtype.Text `json:"note"`
+	// The manager who verified or rejected the document
+	VerifiedBy pgtype.UUID        `json:"verified_by"`
+	VerifiedAt pgtype.Timestamptz `json:"verified_at"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+}
+
 type ApplicationMinor struct {
 	ApplicationID int64       `json:"application_id"`
 	FullName      string      `json:"full_name"`
@@ -917,6 +1473,27 @@ type ApplicationPet struct {
 	Description   pgtype.Text   `json:"description"`
 }
 
+// Questionnaires sent to the previous landlords of applicants
+type ApplicationReference struct {
+	ID            int64       `json:"id"`
+	ApplicationID int64       `json:"application_id"`
+	RefereeName   string      `json:"referee_name"`
+	RefereeEmail  string      `json:"referee_email"`
+	RefereePhone  pgtype.Text `json:"referee_phone"`
+	RentalAddress pgtype.Text `json:"rental_address"`
+	ExpiresAt     time.Time   `json:"expires_at"`
+	// Set when the referee is a user of the platform
+	RefereeID      pgtype.UUID `json:"referee_id"`
+	PaidOnTime     pgtype.Bool `json:"paid_on_time"`
+	CausedDamage   pgtype.Bool `json:"caused_damage"`
+	WouldRentAgain pgtype.Bool `json:"would_rent_again"`
+	// Rental duration in months, as told by the referee
+	RentalDuration pgtype.Int4        `json:"rental_duration"`
+	Comment        pgtype.Text        `json:"comment"`
+	RespondedAt    pgtype.Timestamptz `json:"responded_at"`
+	CreatedAt      time.Time          `json:"created_at"`
+}
+
 type ApplicationVehicle struct {
 	ApplicationID int64       `json:"application_id"`
 	Type          string      `json:"type"`
@@ -967,6 +1544,24 @@ type Contract struct {
 	UpdatedBy                 uuid.UUID      `json:"updated_by"`
 }
 
+type FavoriteFolder struct {
+	ID        int64     `json:"id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type FavoriteListing struct {
+	UserID    uuid.UUID   `json:"user_id"`
+	ListingID uuid.UUID   `json:"listing_id"`
+	FolderID  pgtype.Int8 `json:"folder_id"`
+	Note      pgtype.Text `json:"note"`
+	// Price of the listing when it was added to the favorites, to flag price changes
+	SavedPrice float32   `json:"saved_price"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
 type LPolicy struct {
 	ID     int64  `json:"id"`
 	Policy string `json:"policy"`
@@ -997,6 +1592,59 @@ type Listing struct {
 	UpdatedAt time.Time `json:"updated_at"`
 	// The time when the listing is expired. The listing is expired if the current time is greater than this time.
 	ExpiredAt time.Time `json:"expired_at"`
+	// The last time the listing was activated, saved searches are matched against the listings activated since their last check
+	ActivatedAt pgtype.Timestamptz `json:"activated_at"`
+	// The time when the listing goes public, the listing stays a draft until then. NULL to go public once approved
+	PostAt pgtype.Timestamptz `json:"post_at"`
+	// Whether the listing is extended at its expiry, charged to the saved payment token of its creator
+	AutoRenew bool `json:"auto_renew"`
+	// The time the creator was warned of the expiry of the listing, reset when the listing is extended
+	ExpiryWarnedAt pgtype.Timestamptz `json:"expiry_warned_at"`
+	// Number of days a pending application to the listing stays valid without any update
+	ApplicationValidityDays int32 `json:"application_validity_days"`
+}
+
+// Deduplicated listing events of a day, aggregated in Redis then flushed periodically
+type ListingDailyStat struct {
+	ListingID uuid.UUID   `json:"listing_id"`
+	Date      pgtype.Date `json:"date"`
+	// Priority of the listing at the last flush of the day
+	Priority int32 `json:"priority"`
+	// Number of sessions the listing appeared in the search results of
+	Impressions      int64 `json:"impressions"`
+	Views            int64 `json:"views"`
+	Contacts         int64 `json:"contacts"`
+	ApplicationLinks int64 `json:"application_links"`
+	Applications     int64 `json:"applications"`
+	// Number of tenants who attended a viewing
+	Viewings int64 `json:"viewings"`
+}
+
+type ListingDuplicate struct {
+	ListingID uuid.UUID `json:"listing_id"`
+	ClusterID int64     `json:"cluster_id"`
+}
+
+// Groups of listings connected by their similarities, search results only show the highest-priority listing of a group
+type ListingDuplicateCluster struct {
+	ID        int64     `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListingModeration struct {
+	ID        int64                   `json:"id"`
+	ListingID uuid.UUID               `json:"listing_id"`
+	Status    LISTINGMODERATIONSTATUS `json:"status"`
+	// Findings of the automatic content policy checks
+	Flags []byte `json:"flags"`
+	// The time when the automatic checks were run, NULL while they are pending
+	CheckedAt pgtype.Timestamptz `json:"checked_at"`
+	// Reason of the decision, sent to the creator of the listing
+	Reason     pgtype.Text        `json:"reason"`
+	ReviewerID uuid.UUID          `json:"reviewer_id"`
+	ReviewedAt pgtype.Timestamptz `json:"reviewed_at"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
 }
 
 type ListingPolicy struct {
@@ -1005,18 +1653,107 @@ type ListingPolicy struct {
 	Note      pgtype.Text `json:"note"`
 }
 
+// Changes of the prices of listings and of their units
+type ListingPriceHistory struct {
+	ID        int64     `json:"id"`
+	ListingID uuid.UUID `json:"listing_id"`
+	// The unit whose price changed, NULL for the price of the listing
+	UnitID    pgtype.UUID `json:"unit_id"`
+	OldPrice  float32     `json:"old_price"`
+	NewPrice  float32     `json:"new_price"`
+	ChangedAt time.Time   `json:"changed_at"`
+}
+
+// Questions the landlord asks the applicants to a listing in addition to the application form
+type ListingQuestion struct {
+	ID        int64                   `json:"id"`
+	ListingID uuid.UUID               `json:"listing_id"`
+	Question  string                  `json:"question"`
+	Type      APPLICATIONQUESTIONTYPE `json:"type"`
+	Required  bool                    `json:"required"`
+	// Choices of SINGLE_CHOICE and MULTI_CHOICE questions
+	Options   []string  `json:"options"`
+	Position  int32     `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Documents the applicants to a listing are asked to upload
+type ListingRequiredDocument struct {
+	ListingID uuid.UUID               `json:"listing_id"`
+	Type      APPLICATIONDOCUMENTTYPE `json:"type"`
+	// Applications cannot be approved until the document is verified, unless a manager overrides
+	Mandatory bool        `json:"mandatory"`
+	Note      pgtype.Text `json:"note"`
+}
+
+// Pairs of listings suspected to advertise the same unit
+type ListingSimilarity struct {
+	ListingID        uuid.UUID `json:"listing_id"`
+	SimilarListingID uuid.UUID `json:"similar_listing_id"`
+	// Similarity of the listings between 0 and 1
+	Score float32 `json:"score"`
+	// The criteria on which the listings are similar: LOCATION, UNIT, PRICE, DESCRIPTION, IMAGES
+	Reasons   []string  `json:"reasons"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type ListingTag struct {
 	ID        int64     `json:"id"`
 	ListingID uuid.UUID `json:"listing_id"`
 	Tag       string    `json:"tag"`
 }
 
+// Titles and descriptions of listings in languages other than Vietnamese, the language of the listings table
+type ListingTranslation struct {
+	ListingID uuid.UUID `json:"listing_id"`
+	// ISO 639-1 code of the language
+	Language    string    `json:"language"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
 type ListingUnit struct {
 	ListingID uuid.UUID `json:"listing_id"`
 	UnitID    uuid.UUID `json:"unit_id"`
 	Price     int64     `json:"price"`
 }
 
+// Bookings of viewing slots by prospective tenants
+type ListingViewing struct {
+	ID       int64                `json:"id"`
+	SlotID   int64                `json:"slot_id"`
+	TenantID uuid.UUID            `json:"tenant_id"`
+	Note     pgtype.Text          `json:"note"`
+	Status   LISTINGVIEWINGSTATUS `json:"status"`
+	// The time when both sides were reminded of the viewing
+	RemindedAt pgtype.Timestamptz `json:"reminded_at"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+}
+
+// Time slots during which a manager shows the property of a listing to prospective tenants
+type ListingViewingSlot struct {
+	ID        int64     `json:"id"`
+	ListingID uuid.UUID `json:"listing_id"`
+	// The manager hosting the viewings, who cannot host two overlapping slots
+	ManagerID uuid.UUID `json:"manager_id"`
+	StartAt   time.Time `json:"start_at"`
+	EndAt     time.Time `json:"end_at"`
+	Capacity  int32     `json:"capacity"`
+	// Number of bookings not canceled
+	Booked    int32 `json:"booked"`
+	OpenHouse bool  `json:"open_house"`
+	// Shared by the slots of a recurring open house
+	SeriesID pgtype.UUID `json:"series_id"`
+	Note     pgtype.Text `json:"note"`
+	// Reminder of the manager, created on the first booking
+	ReminderID pgtype.Int8 `json:"reminder_id"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
 type Message struct {
 	ID        int64         `json:"id"`
 	GroupID   int64         `json:"group_id"`
@@ -1028,6 +1765,12 @@ type Message struct {
 	UpdatedAt time.Time     `json:"updated_at"`
 }
 
+// Words and phrases not allowed in listings, matched case and diacritics insensitively
+type ModerationBannedWord struct {
+	Word      string    `json:"word"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type MsgGroup struct {
 	GroupID   int64     `json:"group_id"`
 	Name      string    `json:"name"`
@@ -1080,6 +1823,8 @@ type Payment struct {
 	Status    PAYMENTSTATUS `json:"status"`
 	CreatedAt time.Time     `json:"created_at"`
 	UpdatedAt time.Time     `json:"updated_at"`
+	// Creation date of the VNPay transaction of the order, VNPay requires it to query or refund the transaction
+	TransactionDate pgtype.Timestamptz `json:"transaction_date"`
 }
 
 type PaymentItem struct {
@@ -1090,6 +1835,25 @@ type PaymentItem struct {
 	Discount  int32   `json:"discount"`
 }
 
+type PaymentRefund struct {
+	ID        int64     `json:"id"`
+	PaymentID int64     `json:"payment_id"`
+	Amount    float32   `json:"amount"`
+	Reason    string    `json:"reason"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// The card of a user tokenized by the payment gateway, charged without the user for automatic renewals
+type PaymentToken struct {
+	UserID uuid.UUID `json:"user_id"`
+	Token  string    `json:"token"`
+	// The masked card number, as returned by the payment gateway
+	CardNumber pgtype.Text `json:"card_number"`
+	BankCode   pgtype.Text `json:"bank_code"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
 type Prerental struct {
 	ID                       int64                        `json:"id"`
 	CreatorID                uuid.UUID                    `json:"creator_id"`
@@ -1161,6 +1925,17 @@ type Property struct {
 	UpdatedAt     time.Time     `json:"updated_at"`
 }
 
+type PropertyExpense struct {
+	ID         int64       `json:"id"`
+	PropertyID uuid.UUID   `json:"property_id"`
+	CreatorID  uuid.UUID   `json:"creator_id"`
+	Category   string      `json:"category"`
+	Amount     float32     `json:"amount"`
+	IncurredAt pgtype.Date `json:"incurred_at"`
+	Note       pgtype.Text `json:"note"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
 type PropertyFeature struct {
 	PropertyID  uuid.UUID   `json:"property_id"`
 	FeatureID   int64       `json:"feature_id"`
@@ -1173,6 +1948,13 @@ type PropertyManager struct {
 	Role       string    `json:"role"`
 }
 
+type PropertyMediaHash struct {
+	MediaID int64 `json:"media_id"`
+	// 64-bit difference hash of the image, similar images have hashes with a small hamming distance
+	Hash      int64     `json:"hash"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type PropertyMedium struct {
 	ID          int64       `json:"id"`
 	PropertyID  uuid.UUID   `json:"property_id"`
@@ -1181,6 +1963,54 @@ type PropertyMedium struct {
 	Description pgtype.Text `json:"description"`
 }
 
+// Weights and thresholds the managers of a property use to screen its rental applications
+type PropertyScreeningConfig struct {
+	PropertyID            uuid.UUID `json:"property_id"`
+	IncomeWeight          float32   `json:"income_weight"`
+	HouseholdIncomeWeight float32   `json:"household_income_weight"`
+	RentalHistoryWeight   float32   `json:"rental_history_weight"`
+	PolicyWeight          float32   `json:"policy_weight"`
+	PaymentHistoryWeight  float32   `json:"payment_history_weight"`
+	// Minimum ratio of the applicant monthly income to the offered price
+	MinIncomeRatio float32 `json:"min_income_ratio"`
+	// Minimum ratio of the applicant and co-applicants monthly income to the offered price
+	MinHouseholdIncomeRatio float32 `json:"min_household_income_ratio"`
+	MinRentalHistoryMonths  int32   `json:"min_rental_history_months"`
+	// Minimum share of the payments of previous rentals on the platform paid before their expiry date
+	MinOnTimeRate float32   `json:"min_on_time_rate"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type PropertyService struct {
+	ID         int64               `json:"id"`
+	PropertyID uuid.UUID           `json:"property_id"`
+	Type       PROPERTYSERVICETYPE `json:"type"`
+	Name       string              `json:"name"`
+	// The party who set up the service, either "LANDLORD" or "TENANT"
+	SetupBy  string      `json:"setup_by"`
+	Provider pgtype.Text `json:"provider"`
+	// Price of one billing cycle
+	DefaultPrice     pgtype.Float4           `json:"default_price"`
+	BillingFrequency SERVICEBILLINGFREQUENCY `json:"billing_frequency"`
+	CreatedAt        time.Time               `json:"created_at"`
+	UpdatedAt        time.Time               `json:"updated_at"`
+}
+
+// Catalog price changes waiting for a manager to confirm propagating them to the subscribed rentals
+type PropertyServicePriceChange struct {
+	ID                  int64                   `json:"id"`
+	ServiceID           int64                   `json:"service_id"`
+	CreatorID           uuid.UUID               `json:"creator_id"`
+	OldPrice            pgtype.Float4           `json:"old_price"`
+	NewPrice            float32                 `json:"new_price"`
+	OldBillingFrequency SERVICEBILLINGFREQUENCY `json:"old_billing_frequency"`
+	// Billing cycle of the new price, the price of the subscribed rentals is its monthly equivalent
+	NewBillingFrequency SERVICEBILLINGFREQUENCY  `json:"new_billing_frequency"`
+	Status              SERVICEPRICECHANGESTATUS `json:"status"`
+	CreatedAt           time.Time                `json:"created_at"`
+	UpdatedAt           time.Time                `json:"updated_at"`
+}
+
 type PropertyTag struct {
 	ID         int64     `json:"id"`
 	PropertyID uuid.UUID `json:"property_id"`
@@ -1291,6 +2121,12 @@ type RentalComplaintReply struct {
 	CreatedAt   time.Time `json:"created_at"`
 }
 
+type RentalExpiryNotice struct {
+	RentalID   int64     `json:"rental_id"`
+	DaysBefore int32     `json:"days_before"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
 type RentalMinor struct {
 	RentalID    int64       `json:"rental_id"`
 	FullName    string      `json:"full_name"`
@@ -1343,6 +2179,59 @@ type RentalService struct {
 	SetupBy  string        `json:"setup_by"`
 	Provider pgtype.Text   `json:"provider"`
 	Price    pgtype.Float4 `json:"price"`
+	// The property service this rental service subscribes to, price changes of the catalog item can be propagated to it
+	CatalogServiceID pgtype.Int8 `json:"catalog_service_id"`
+}
+
+type ReportSchedule struct {
+	ID     int64     `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	// Property the report is about, NULL means every property managed by the user (rent roll only)
+	PropertyID pgtype.UUID  `json:"property_id"`
+	Type       REPORTTYPE   `json:"type"`
+	Format     REPORTFORMAT `json:"format"`
+	// Percentage of the collected income charged as management fee in owner statements
+	ManagementFeeRate float32 `json:"management_fee_rate"`
+	// Extra email addresses receiving the report besides the user
+	Recipients []string           `json:"recipients"`
+	LastSentAt pgtype.Timestamptz `json:"last_sent_at"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+type SavedSearch struct {
+	ID     int64     `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	Name   string    `json:"name"`
+	// The listing search query, as sent to the listing search endpoint
+	Query     []byte               `json:"query"`
+	Frequency SAVEDSEARCHFREQUENCY `json:"frequency"`
+	Active    bool                 `json:"active"`
+	// Listings activated after this time have not been matched against the search yet
+	LastCheckedAt time.Time          `json:"last_checked_at"`
+	LastSentAt    pgtype.Timestamptz `json:"last_sent_at"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
+
+// Every listing matched for a user, whichever of their saved searches matched it. A listing is never sent twice to the same user.
+type SavedSearchMatch struct {
+	UserID        uuid.UUID          `json:"user_id"`
+	ListingID     uuid.UUID          `json:"listing_id"`
+	SavedSearchID pgtype.Int8        `json:"saved_search_id"`
+	NotifiedAt    pgtype.Timestamptz `json:"notified_at"`
+	CreatedAt     time.Time          `json:"created_at"`
+}
+
+type SearchOutbox struct {
+	ID            int64              `json:"id"`
+	ListingID     uuid.UUID          `json:"listing_id"`
+	Source        SEARCHOUTBOXSOURCE `json:"source"`
+	Attempts      int32              `json:"attempts"`
+	LastError     pgtype.Text        `json:"last_error"`
+	NextAttemptAt time.Time          `json:"next_attempt_at"`
+	ProcessedAt   pgtype.Timestamptz `json:"processed_at"`
+	DeadAt        pgtype.Timestamptz `json:"dead_at"`
+	CreatedAt     time.Time          `json:"created_at"`
 }
 
 type Session struct {
@@ -1385,6 +2274,17 @@ type UnitAmenity struct {
 	Description pgtype.Text `json:"description"`
 }
 
+type UnitMaintenanceBlock struct {
+	ID        int64       `json:"id"`
+	UnitID    uuid.UUID   `json:"unit_id"`
+	CreatorID uuid.UUID   `json:"creator_id"`
+	StartDate pgtype.Date `json:"start_date"`
+	// Exclusive, the unit is available again from this date
+	EndDate   pgtype.Date `json:"end_date"`
+	Reason    pgtype.Text `json:"reason"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
 type UnitMedium struct {
 	ID          int64       `json:"id"`
 	UnitID      uuid.UUID   `json:"unit_id"`
@@ -1393,6 +2293,14 @@ type UnitMedium struct {
 	Description pgtype.Text `json:"description"`
 }
 
+type UnitOccupancy struct {
+	Type      string      `json:"type"`
+	RefID     int64       `json:"ref_id"`
+	UnitID    uuid.UUID   `json:"unit_id"`
+	StartDate pgtype.Date `json:"start_date"`
+	EndDate   pgtype.Date `json:"end_date"`
+}
+
 // User info table
 type User struct {
 	ID        uuid.UUID   `json:"id"`
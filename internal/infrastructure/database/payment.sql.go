@@ -39,7 +39,7 @@ INSERT INTO "payments" (
   $2,
   $3,
   $4
-) RETURNING id, user_id, order_id, order_info, amount, status, created_at, updated_at
+) RETURNING id, user_id, order_id, order_info, amount, status, created_at, updated_at, transaction_date
 `
 
 type CreatePaymentParams struct {
@@ -66,6 +66,7 @@ func (q *Queries) CreatePayment(ctx context.Context, arg CreatePaymentParams) (P
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TransactionDate,
 	)
 	return i, err
 }
@@ -113,6 +114,48 @@ func (q *Queries) CreatePaymentItem(ctx context.Context, arg CreatePaymentItemPa
 	return i, err
 }
 
+const createPaymentRefund = `-- name: CreatePaymentRefund :one
+INSERT INTO "payment_refunds" (
+  "payment_id",
+  "amount",
+  "reason",
+  "created_by",
+  "created_at"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  NOW()
+) RETURNING id, payment_id, amount, reason, created_by, created_at
+`
+
+type CreatePaymentRefundParams struct {
+	PaymentID int64   `json:"payment_id"`
+	Amount    float32 `json:"amount"`
+	Reason    string  `json:"reason"`
+	CreatedBy string  `json:"created_by"`
+}
+
+func (q *Queries) CreatePaymentRefund(ctx context.Context, arg CreatePaymentRefundParams) (PaymentRefund, error) {
+	row := q.db.QueryRow(ctx, createPaymentRefund,
+		arg.PaymentID,
+		arg.Amount,
+		arg.Reason,
+		arg.CreatedBy,
+	)
+	var i PaymentRefund
+	err := row.Scan(
+		&i.ID,
+		&i.PaymentID,
+		&i.Amount,
+		&i.Reason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const deletePayment = `-- name: DeletePayment :exec
 DELETE FROM "payments" WHERE "id" = $1
 `
@@ -122,8 +165,17 @@ func (q *Queries) DeletePayment(ctx context.Context, id int64) error {
 	return err
 }
 
+const deletePaymentToken = `-- name: DeletePaymentToken :exec
+DELETE FROM "payment_tokens" WHERE "user_id" = $1
+`
+
+func (q *Queries) DeletePaymentToken(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deletePaymentToken, userID)
+	return err
+}
+
 const getPaymentById = `-- name: GetPaymentById :one
-SELECT id, user_id, order_id, order_info, amount, status, created_at, updated_at FROM "payments" WHERE "id" = $1
+SELECT id, user_id, order_id, order_info, amount, status, created_at, updated_at, transaction_date FROM "payments" WHERE "id" = $1
 `
 
 func (q *Queries) GetPaymentById(ctx context.Context, id int64) (Payment, error) {
@@ -138,6 +190,28 @@ func (q *Queries) GetPaymentById(ctx context.Context, id int64) (Payment, error)
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TransactionDate,
+	)
+	return i, err
+}
+
+const getPaymentByOrderId = `-- name: GetPaymentByOrderId :one
+SELECT id, user_id, order_id, order_info, amount, status, created_at, updated_at, transaction_date FROM "payments" WHERE "order_id" = $1 AND "status" = 'SUCCESS' ORDER BY "updated_at" DESC LIMIT 1
+`
+
+func (q *Queries) GetPaymentByOrderId(ctx context.Context, orderID string) (Payment, error) {
+	row := q.db.QueryRow(ctx, getPaymentByOrderId, orderID)
+	var i Payment
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.OrderID,
+		&i.OrderInfo,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TransactionDate,
 	)
 	return i, err
 }
@@ -172,8 +246,56 @@ func (q *Queries) GetPaymentItemsByPaymentId(ctx context.Context, paymentID int6
 	return items, nil
 }
 
+const getPaymentRefunds = `-- name: GetPaymentRefunds :many
+SELECT id, payment_id, amount, reason, created_by, created_at FROM "payment_refunds" WHERE "payment_id" = $1 ORDER BY "created_at"
+`
+
+func (q *Queries) GetPaymentRefunds(ctx context.Context, paymentID int64) ([]PaymentRefund, error) {
+	rows, err := q.db.Query(ctx, getPaymentRefunds, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PaymentRefund
+	for rows.Next() {
+		var i PaymentRefund
+		if err := rows.Scan(
+			&i.ID,
+			&i.PaymentID,
+			&i.Amount,
+			&i.Reason,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPaymentToken = `-- name: GetPaymentToken :one
+SELECT user_id, token, card_number, bank_code, created_at FROM "payment_tokens" WHERE "user_id" = $1
+`
+
+func (q *Queries) GetPaymentToken(ctx context.Context, userID uuid.UUID) (PaymentToken, error) {
+	row := q.db.QueryRow(ctx, getPaymentToken, userID)
+	var i PaymentToken
+	err := row.Scan(
+		&i.UserID,
+		&i.Token,
+		&i.CardNumber,
+		&i.BankCode,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getPaymentsOfUser = `-- name: GetPaymentsOfUser :many
-SELECT id, user_id, order_id, order_info, amount, status, created_at, updated_at 
+SELECT id, user_id, order_id, order_info, amount, status, created_at, updated_at, transaction_date 
 FROM "payments" 
 WHERE "user_id" = $3
 ORDER BY "created_at" DESC
@@ -204,6 +326,7 @@ func (q *Queries) GetPaymentsOfUser(ctx context.Context, arg GetPaymentsOfUserPa
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TransactionDate,
 		); err != nil {
 			return nil, err
 		}
@@ -221,16 +344,18 @@ UPDATE "payments" SET
   order_info = coalesce($3, order_info),
   amount = coalesce($4, amount),
   status = coalesce($5, status),
+  transaction_date = coalesce($6, transaction_date),
   updated_at = NOW()
 WHERE "id" = $1
 `
 
 type UpdatePaymentParams struct {
-	ID        int64             `json:"id"`
-	OrderID   pgtype.Text       `json:"order_id"`
-	OrderInfo pgtype.Text       `json:"order_info"`
-	Amount    pgtype.Float4     `json:"amount"`
-	Status    NullPAYMENTSTATUS `json:"status"`
+	ID              int64              `json:"id"`
+	OrderID         pgtype.Text        `json:"order_id"`
+	OrderInfo       pgtype.Text        `json:"order_info"`
+	Amount          pgtype.Float4      `json:"amount"`
+	Status          NullPAYMENTSTATUS  `json:"status"`
+	TransactionDate pgtype.Timestamptz `json:"transaction_date"`
 }
 
 func (q *Queries) UpdatePayment(ctx context.Context, arg UpdatePaymentParams) error {
@@ -240,6 +365,53 @@ func (q *Queries) UpdatePayment(ctx context.Context, arg UpdatePaymentParams) er
 		arg.OrderInfo,
 		arg.Amount,
 		arg.Status,
+		arg.TransactionDate,
 	)
 	return err
 }
+
+const upsertPaymentToken = `-- name: UpsertPaymentToken :one
+INSERT INTO "payment_tokens" (
+  "user_id",
+  "token",
+  "card_number",
+  "bank_code",
+  "created_at"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  NOW()
+) ON CONFLICT ("user_id") DO UPDATE SET
+  "token" = EXCLUDED."token",
+  "card_number" = EXCLUDED."card_number",
+  "bank_code" = EXCLUDED."bank_code",
+  "created_at" = EXCLUDED."created_at"
+RETURNING user_id, token, card_number, bank_code, created_at
+`
+
+type UpsertPaymentTokenParams struct {
+	UserID     uuid.UUID   `json:"user_id"`
+	Token      string      `json:"token"`
+	CardNumber pgtype.Text `json:"card_number"`
+	BankCode   pgtype.Text `json:"bank_code"`
+}
+
+func (q *Queries) UpsertPaymentToken(ctx context.Context, arg UpsertPaymentTokenParams) (PaymentToken, error) {
+	row := q.db.QueryRow(ctx, upsertPaymentToken,
+		arg.UserID,
+		arg.Token,
+		arg.CardNumber,
+		arg.BankCode,
+	)
+	var i PaymentToken
+	err := row.Scan(
+		&i.UserID,
+		&i.Token,
+		&i.CardNumber,
+		&i.BankCode,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -196,6 +196,58 @@ func (q *Queries) CreateProperty(ctx context.Context, arg CreatePropertyParams)
 	return i, err
 }
 
+const createPropertyExpense = `-- name: CreatePropertyExpense :one
+INSERT INTO "property_expenses" (
+  "property_id",
+  "creator_id",
+  "category",
+  "amount",
+  "incurred_at",
+  "note",
+  "created_at"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  NOW()
+) RETURNING id, property_id, creator_id, category, amount, incurred_at, note, created_at
+`
+
+type CreatePropertyExpenseParams struct {
+	PropertyID uuid.UUID   `json:"property_id"`
+	CreatorID  uuid.UUID   `json:"creator_id"`
+	Category   string      `json:"category"`
+	Amount     float32     `json:"amount"`
+	IncurredAt pgtype.Date `json:"incurred_at"`
+	Note       pgtype.Text `json:"note"`
+}
+
+func (q *Queries) CreatePropertyExpense(ctx context.Context, arg CreatePropertyExpenseParams) (PropertyExpense, error) {
+	row := q.db.QueryRow(ctx, createPropertyExpense,
+		arg.PropertyID,
+		arg.CreatorID,
+		arg.Category,
+		arg.Amount,
+		arg.IncurredAt,
+		arg.Note,
+	)
+	var i PropertyExpense
+	err := row.Scan(
+		&i.ID,
+		&i.PropertyID,
+		&i.CreatorID,
+		&i.Category,
+		&i.Amount,
+		&i.IncurredAt,
+		&i.Note,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const createPropertyFeature = `-- name: CreatePropertyFeature :one
 INSERT INTO property_features (
   property_id,
@@ -285,6 +337,122 @@ func (q *Queries) CreatePropertyMedia(ctx context.Context, arg CreatePropertyMed
 	return i, err
 }
 
+const createPropertyService = `-- name: CreatePropertyService :one
+INSERT INTO "property_services" (
+  "property_id",
+  "type",
+  "name",
+  "setup_by",
+  "provider",
+  "default_price",
+  "billing_frequency",
+  "created_at",
+  "updated_at"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  NOW(),
+  NOW()
+) RETURNING id, property_id, type, name, setup_by, provider, default_price, billing_frequency, created_at, updated_at
+`
+
+type CreatePropertyServiceParams struct {
+	PropertyID       uuid.UUID               `json:"property_id"`
+	Type             PROPERTYSERVICETYPE     `json:"type"`
+	Name             string                  `json:"name"`
+	SetupBy          string                  `json:"setup_by"`
+	Provider         pgtype.Text             `json:"provider"`
+	DefaultPrice     pgtype.Float4           `json:"default_price"`
+	BillingFrequency SERVICEBILLINGFREQUENCY `json:"billing_frequency"`
+}
+
+func (q *Queries) CreatePropertyService(ctx context.Context, arg CreatePropertyServiceParams) (PropertyService, error) {
+	row := q.db.QueryRow(ctx, createPropertyService,
+		arg.PropertyID,
+		arg.Type,
+		arg.Name,
+		arg.SetupBy,
+		arg.Provider,
+		arg.DefaultPrice,
+		arg.BillingFrequency,
+	)
+	var i PropertyService
+	err := row.Scan(
+		&i.ID,
+		&i.PropertyID,
+		&i.Type,
+		&i.Name,
+		&i.SetupBy,
+		&i.Provider,
+		&i.DefaultPrice,
+		&i.BillingFrequency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createPropertyServicePriceChange = `-- name: CreatePropertyServicePriceChange :one
+INSERT INTO "property_service_price_changes" (
+  "service_id",
+  "creator_id",
+  "old_price",
+  "new_price",
+  "old_billing_frequency",
+  "new_billing_frequency",
+  "created_at",
+  "updated_at"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  NOW(),
+  NOW()
+) RETURNING id, service_id, creator_id, old_price, new_price, old_billing_frequency, new_billing_frequency, status, created_at, updated_at
+`
+
+type CreatePropertyServicePriceChangeParams struct {
+	ServiceID           int64                   `json:"service_id"`
+	CreatorID           uuid.UUID               `json:"creator_id"`
+	OldPrice            pgtype.Float4           `json:"old_price"`
+	NewPrice            float32                 `json:"new_price"`
+	OldBillingFrequency SERVICEBILLINGFREQUENCY `json:"old_billing_frequency"`
+	NewBillingFrequency SERVICEBILLINGFREQUENCY `json:"new_billing_frequency"`
+}
+
+func (q *Queries) CreatePropertyServicePriceChange(ctx context.Context, arg CreatePropertyServicePriceChangeParams) (PropertyServicePriceChange, error) {
+	row := q.db.QueryRow(ctx, createPropertyServicePriceChange,
+		arg.ServiceID,
+		arg.CreatorID,
+		arg.OldPrice,
+		arg.NewPrice,
+		arg.OldBillingFrequency,
+		arg.NewBillingFrequency,
+	)
+	var i PropertyServicePriceChange
+	err := row.Scan(
+		&i.ID,
+		&i.ServiceID,
+		&i.CreatorID,
+		&i.OldPrice,
+		&i.NewPrice,
+		&i.OldBillingFrequency,
+		&i.NewBillingFrequency,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const createPropertyTag = `-- name: CreatePropertyTag :one
 INSERT INTO property_tags (
   property_id,
@@ -383,6 +551,15 @@ func (q *Queries) DeleteProperty(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const deletePropertyExpense = `-- name: DeletePropertyExpense :exec
+DELETE FROM "property_expenses" WHERE "id" = $1
+`
+
+func (q *Queries) DeletePropertyExpense(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deletePropertyExpense, id)
+	return err
+}
+
 const deletePropertyFeature = `-- name: DeletePropertyFeature :exec
 DELETE FROM property_features WHERE property_id = $1 AND feature_id = $2
 `
@@ -425,6 +602,15 @@ func (q *Queries) DeletePropertyMedia(ctx context.Context, arg DeletePropertyMed
 	return err
 }
 
+const deletePropertyService = `-- name: DeletePropertyService :exec
+DELETE FROM "property_services" WHERE "id" = $1
+`
+
+func (q *Queries) DeletePropertyService(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deletePropertyService, id)
+	return err
+}
+
 const deletePropertyTag = `-- name: DeletePropertyTag :exec
 DELETE FROM property_tags WHERE property_id = $1 AND id = $2
 `
@@ -439,6 +625,19 @@ func (q *Queries) DeletePropertyTag(ctx context.Context, arg DeletePropertyTagPa
 	return err
 }
 
+const dismissPendingPropertyServicePriceChanges = `-- name: DismissPendingPropertyServicePriceChanges :exec
+UPDATE "property_service_price_changes" SET
+  "status" = 'DISMISSED',
+  "updated_at" = NOW()
+WHERE "service_id" = $1 AND "status" = 'PENDING'
+`
+
+// Supersede older pending price changes of a catalog service when a new one is recorded
+func (q *Queries) DismissPendingPropertyServicePriceChanges(ctx context.Context, serviceID int64) error {
+	_, err := q.db.Exec(ctx, dismissPendingPropertyServicePriceChanges, serviceID)
+	return err
+}
+
 const getAllPropertyFeatures = `-- name: GetAllPropertyFeatures :many
 SELECT id, feature FROM p_features
 `
@@ -562,6 +761,70 @@ func (q *Queries) GetPropertyById(ctx context.Context, id uuid.UUID) (Property,
 	return i, err
 }
 
+const getPropertyExpense = `-- name: GetPropertyExpense :one
+SELECT id, property_id, creator_id, category, amount, incurred_at, note, created_at FROM "property_expenses" WHERE "id" = $1 LIMIT 1
+`
+
+func (q *Queries) GetPropertyExpense(ctx context.Context, id int64) (PropertyExpense, error) {
+	row := q.db.QueryRow(ctx, getPropertyExpense, id)
+	var i PropertyExpense
+	err := row.Scan(
+		&i.ID,
+		&i.PropertyID,
+		&i.CreatorID,
+		&i.Category,
+		&i.Amount,
+		&i.IncurredAt,
+		&i.Note,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPropertyExpenses = `-- name: GetPropertyExpenses :many
+SELECT id, property_id, creator_id, category, amount, incurred_at, note, created_at FROM "property_expenses" 
+WHERE 
+  "property_id" = $1 AND
+  "incurred_at" >= $2 AND
+  "incurred_at" < $3
+ORDER BY "incurred_at" DESC, "id" DESC
+`
+
+type GetPropertyExpensesParams struct {
+	PropertyID uuid.UUID   `json:"property_id"`
+	StartDate  pgtype.Date `json:"start_date"`
+	EndDate    pgtype.Date `json:"end_date"`
+}
+
+func (q *Queries) GetPropertyExpenses(ctx context.Context, arg GetPropertyExpensesParams) ([]PropertyExpense, error) {
+	rows, err := q.db.Query(ctx, getPropertyExpenses, arg.PropertyID, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PropertyExpense
+	for rows.Next() {
+		var i PropertyExpense
+		if err := rows.Scan(
+			&i.ID,
+			&i.PropertyID,
+			&i.CreatorID,
+			&i.Category,
+			&i.Amount,
+			&i.IncurredAt,
+			&i.Note,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPropertyFeatures = `-- name: GetPropertyFeatures :many
 SELECT property_id, feature_id, description FROM property_features WHERE property_id = $1
 `
@@ -640,6 +903,150 @@ func (q *Queries) GetPropertyMedia(ctx context.Context, propertyID uuid.UUID) ([
 	return items, nil
 }
 
+const getPropertyService = `-- name: GetPropertyService :one
+SELECT id, property_id, type, name, setup_by, provider, default_price, billing_frequency, created_at, updated_at FROM "property_services" WHERE "id" = $1 LIMIT 1
+`
+
+func (q *Queries) GetPropertyService(ctx context.Context, id int64) (PropertyService, error) {
+	row := q.db.QueryRow(ctx, getPropertyService, id)
+	var i PropertyService
+	err := row.Scan(
+		&i.ID,
+		&i.PropertyID,
+		&i.Type,
+		&i.Name,
+		&i.SetupBy,
+		&i.Provider,
+		&i.DefaultPrice,
+		&i.BillingFrequency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPropertyServicePriceChange = `-- name: GetPropertyServicePriceChange :one
+SELECT id, service_id, creator_id, old_price, new_price, old_billing_frequency, new_billing_frequency, status, created_at, updated_at FROM "property_service_price_changes" WHERE "id" = $1 LIMIT 1
+`
+
+func (q *Queries) GetPropertyServicePriceChange(ctx context.Context, id int64) (PropertyServicePriceChange, error) {
+	row := q.db.QueryRow(ctx, getPropertyServicePriceChange, id)
+	var i PropertyServicePriceChange
+	err := row.Scan(
+		&i.ID,
+		&i.ServiceID,
+		&i.CreatorID,
+		&i.OldPrice,
+		&i.NewPrice,
+		&i.OldBillingFrequency,
+		&i.NewBillingFrequency,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPropertyServicePriceChanges = `-- name: GetPropertyServicePriceChanges :many
+SELECT id, service_id, creator_id, old_price, new_price, old_billing_frequency, new_billing_frequency, status, created_at, updated_at FROM "property_service_price_changes" WHERE "service_id" = $1 ORDER BY "created_at" DESC
+`
+
+func (q *Queries) GetPropertyServicePriceChanges(ctx context.Context, serviceID int64) ([]PropertyServicePriceChange, error) {
+	rows, err := q.db.Query(ctx, getPropertyServicePriceChanges, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PropertyServicePriceChange
+	for rows.Next() {
+		var i PropertyServicePriceChange
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServiceID,
+			&i.CreatorID,
+			&i.OldPrice,
+			&i.NewPrice,
+			&i.OldBillingFrequency,
+			&i.NewBillingFrequency,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPropertyServiceSubscribers = `-- name: GetPropertyServiceSubscribers :many
+SELECT "rental_services"."id", "rental_services"."rental_id" FROM "rental_services" INNER JOIN "rentals" ON "rentals"."id" = "rental_services"."rental_id"
+WHERE "rental_services"."catalog_service_id" = $1 AND "rentals"."status" = 'INPROGRESS'
+`
+
+type GetPropertyServiceSubscribersRow struct {
+	ID       int64 `json:"id"`
+	RentalID int64 `json:"rental_id"`
+}
+
+func (q *Queries) GetPropertyServiceSubscribers(ctx context.Context, catalogServiceID pgtype.Int8) ([]GetPropertyServiceSubscribersRow, error) {
+	rows, err := q.db.Query(ctx, getPropertyServiceSubscribers, catalogServiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPropertyServiceSubscribersRow
+	for rows.Next() {
+		var i GetPropertyServiceSubscribersRow
+		if err := rows.Scan(&i.ID, &i.RentalID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPropertyServices = `-- name: GetPropertyServices :many
+SELECT id, property_id, type, name, setup_by, provider, default_price, billing_frequency, created_at, updated_at FROM "property_services" WHERE "property_id" = $1 ORDER BY "id"
+`
+
+func (q *Queries) GetPropertyServices(ctx context.Context, propertyID uuid.UUID) ([]PropertyService, error) {
+	rows, err := q.db.Query(ctx, getPropertyServices, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PropertyService
+	for rows.Next() {
+		var i PropertyService
+		if err := rows.Scan(
+			&i.ID,
+			&i.PropertyID,
+			&i.Type,
+			&i.Name,
+			&i.SetupBy,
+			&i.Provider,
+			&i.DefaultPrice,
+			&i.BillingFrequency,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPropertyTags = `-- name: GetPropertyTags :many
 SELECT id, property_id, tag FROM property_tags WHERE property_id = $1
 `
@@ -792,6 +1199,27 @@ func (q *Queries) UpdateNewPropertyManagerRequest(ctx context.Context, arg Updat
 	return err
 }
 
+const updatePlannedRentalServicePayments = `-- name: UpdatePlannedRentalServicePayments :exec
+UPDATE "rental_payments" SET
+  "amount" = calculate_rental_fee("start_date", "end_date", 1, $1::REAL),
+  "updated_at" = NOW()
+WHERE "rental_id" = $2 AND "status" = 'PLAN'
+  -- the code of a service payment is <rental id>_SERVICE_<rental service id>_<period>
+  AND split_part("code", '_', 2) = 'SERVICE' AND split_part("code", '_', 3) = $3::BIGINT::TEXT
+`
+
+type UpdatePlannedRentalServicePaymentsParams struct {
+	Price           float32 `json:"price"`
+	RentalID        int64   `json:"rental_id"`
+	RentalServiceID int64   `json:"rental_service_id"`
+}
+
+// Re-price the planned (not yet issued) payments of a rental service
+func (q *Queries) UpdatePlannedRentalServicePayments(ctx context.Context, arg UpdatePlannedRentalServicePaymentsParams) error {
+	_, err := q.db.Exec(ctx, updatePlannedRentalServicePayments, arg.Price, arg.RentalID, arg.RentalServiceID)
+	return err
+}
+
 const updateProperty = `-- name: UpdateProperty :exec
 UPDATE properties SET
   name = coalesce($2, name),
@@ -863,6 +1291,58 @@ func (q *Queries) UpdateProperty(ctx context.Context, arg UpdatePropertyParams)
 	return err
 }
 
+const updatePropertyService = `-- name: UpdatePropertyService :exec
+UPDATE "property_services" SET
+  "type" = coalesce($2, type),
+  "name" = coalesce($3, name),
+  "setup_by" = coalesce($4, setup_by),
+  "provider" = coalesce($5, provider),
+  "default_price" = coalesce($6, default_price),
+  "billing_frequency" = coalesce($7, billing_frequency),
+  "updated_at" = NOW()
+WHERE "id" = $1
+`
+
+type UpdatePropertyServiceParams struct {
+	ID               int64                       `json:"id"`
+	Type             NullPROPERTYSERVICETYPE     `json:"type"`
+	Name             pgtype.Text                 `json:"name"`
+	SetupBy          pgtype.Text                 `json:"setup_by"`
+	Provider         pgtype.Text                 `json:"provider"`
+	DefaultPrice     pgtype.Float4               `json:"default_price"`
+	BillingFrequency NullSERVICEBILLINGFREQUENCY `json:"billing_frequency"`
+}
+
+func (q *Queries) UpdatePropertyService(ctx context.Context, arg UpdatePropertyServiceParams) error {
+	_, err := q.db.Exec(ctx, updatePropertyService,
+		arg.ID,
+		arg.Type,
+		arg.Name,
+		arg.SetupBy,
+		arg.Provider,
+		arg.DefaultPrice,
+		arg.BillingFrequency,
+	)
+	return err
+}
+
+const updatePropertyServicePriceChangeStatus = `-- name: UpdatePropertyServicePriceChangeStatus :exec
+UPDATE "property_service_price_changes" SET
+  "status" = $2,
+  "updated_at" = NOW()
+WHERE "id" = $1
+`
+
+type UpdatePropertyServicePriceChangeStatusParams struct {
+	ID     int64                    `json:"id"`
+	Status SERVICEPRICECHANGESTATUS `json:"status"`
+}
+
+func (q *Queries) UpdatePropertyServicePriceChangeStatus(ctx context.Context, arg UpdatePropertyServicePriceChangeStatusParams) error {
+	_, err := q.db.Exec(ctx, updatePropertyServicePriceChangeStatus, arg.ID, arg.Status)
+	return err
+}
+
 const updatePropertyVerificationRequest = `-- name: UpdatePropertyVerificationRequest :exec
 UPDATE "property_verification_requests" SET
   "video_url" = coalesce($2, video_url),
@@ -903,3 +1383,40 @@ func (q *Queries) UpdatePropertyVerificationRequest(ctx context.Context, arg Upd
 	)
 	return err
 }
+
+const updateSubscribedRentalServicesPrice = `-- name: UpdateSubscribedRentalServicesPrice :many
+UPDATE "rental_services" SET "price" = $1
+FROM "rentals"
+WHERE "rentals"."id" = "rental_services"."rental_id" AND "rental_services"."catalog_service_id" = $2 AND "rentals"."status" = 'INPROGRESS'
+RETURNING "rental_services"."id", "rental_services"."rental_id"
+`
+
+type UpdateSubscribedRentalServicesPriceParams struct {
+	Price     pgtype.Float4 `json:"price"`
+	ServiceID pgtype.Int8   `json:"service_id"`
+}
+
+type UpdateSubscribedRentalServicesPriceRow struct {
+	ID       int64 `json:"id"`
+	RentalID int64 `json:"rental_id"`
+}
+
+func (q *Queries) UpdateSubscribedRentalServicesPrice(ctx context.Context, arg UpdateSubscribedRentalServicesPriceParams) ([]UpdateSubscribedRentalServicesPriceRow, error) {
+	rows, err := q.db.Query(ctx, updateSubscribedRentalServicesPrice, arg.Price, arg.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UpdateSubscribedRentalServicesPriceRow
+	for rows.Next() {
+		var i UpdateSubscribedRentalServicesPriceRow
+		if err := rows.Scan(&i.ID, &i.RentalID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
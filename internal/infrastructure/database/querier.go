@@ -6,6 +6,7 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -19,6 +20,7 @@ type Querier interface {
 	CheckListingOwnership(ctx context.Context, arg CheckListingOwnershipParams) (int64, error)
 	CheckListingVisibility(ctx context.Context, arg CheckListingVisibilityParams) (bool, error)
 	CheckMsgGroupMembership(ctx context.Context, arg CheckMsgGroupMembershipParams) (bool, error)
+	CheckOverlappingViewingSlot(ctx context.Context, arg CheckOverlappingViewingSlotParams) (bool, error)
 	CheckPaymentAccessible(ctx context.Context, arg CheckPaymentAccessibleParams) (bool, error)
 	CheckPreRentalVisibility(ctx context.Context, arg CheckPreRentalVisibilityParams) (bool, error)
 	CheckReminderVisibility(ctx context.Context, arg CheckReminderVisibilityParams) (bool, error)
@@ -26,16 +28,30 @@ type Querier interface {
 	CheckUnitManageability(ctx context.Context, arg CheckUnitManageabilityParams) (int64, error)
 	CheckUnitOfProperty(ctx context.Context, arg CheckUnitOfPropertyParams) (int64, error)
 	CheckValidUnitForListing(ctx context.Context, arg CheckValidUnitForListingParams) (int64, error)
+	ClaimSearchOutboxEvents(ctx context.Context, limit int32) ([]SearchOutbox, error)
+	CountListings(ctx context.Context) (int64, error)
+	CountSearchOutboxEventsSince(ctx context.Context, createdAt time.Time) (int64, error)
 	CreateApplication(ctx context.Context, arg CreateApplicationParams) (Application, error)
+	CreateApplicationAnswer(ctx context.Context, arg CreateApplicationAnswerParams) (ApplicationAnswer, error)
 	CreateApplicationCoap(ctx context.Context, arg CreateApplicationCoapParams) (ApplicationCoap, error)
 	CreateApplicationMinor(ctx context.Context, arg CreateApplicationMinorParams) (ApplicationMinor, error)
 	CreateApplicationPet(ctx context.Context, arg CreateApplicationPetParams) (ApplicationPet, error)
+	CreateApplicationReference(ctx context.Context, arg CreateApplicationReferenceParams) (ApplicationReference, error)
 	CreateApplicationVehicle(ctx context.Context, arg CreateApplicationVehicleParams) (ApplicationVehicle, error)
 	CreateContract(ctx context.Context, arg CreateContractParams) (Contract, error)
+	CreateFavoriteFolder(ctx context.Context, arg CreateFavoriteFolderParams) (FavoriteFolder, error)
 	CreateListing(ctx context.Context, arg CreateListingParams) (Listing, error)
+	CreateListingDuplicateCluster(ctx context.Context) (ListingDuplicateCluster, error)
+	CreateListingDuplicates(ctx context.Context, arg CreateListingDuplicatesParams) error
+	CreateListingModeration(ctx context.Context, listingID uuid.UUID) (ListingModeration, error)
 	CreateListingPolicy(ctx context.Context, arg CreateListingPolicyParams) (ListingPolicy, error)
+	CreateListingPriceHistory(ctx context.Context, arg CreateListingPriceHistoryParams) error
+	CreateListingQuestion(ctx context.Context, arg CreateListingQuestionParams) (ListingQuestion, error)
+	CreateListingRequiredDocument(ctx context.Context, arg CreateListingRequiredDocumentParams) (ListingRequiredDocument, error)
 	CreateListingTag(ctx context.Context, arg CreateListingTagParams) (ListingTag, error)
 	CreateListingUnit(ctx context.Context, arg CreateListingUnitParams) (ListingUnit, error)
+	CreateListingViewing(ctx context.Context, arg CreateListingViewingParams) (ListingViewing, error)
+	CreateListingViewingSlot(ctx context.Context, arg CreateListingViewingSlotParams) (ListingViewingSlot, error)
 	CreateMessage(ctx context.Context, arg CreateMessageParams) (Message, error)
 	CreateMsgGroup(ctx context.Context, arg CreateMsgGroupParams) (MsgGroup, error)
 	CreateMsgGroupMember(ctx context.Context, arg CreateMsgGroupMemberParams) (MsgGroupMember, error)
@@ -44,11 +60,16 @@ type Querier interface {
 	CreateNotificationDevice(ctx context.Context, arg CreateNotificationDeviceParams) (UserNotificationDevice, error)
 	CreatePayment(ctx context.Context, arg CreatePaymentParams) (Payment, error)
 	CreatePaymentItem(ctx context.Context, arg CreatePaymentItemParams) (PaymentItem, error)
+	CreatePaymentRefund(ctx context.Context, arg CreatePaymentRefundParams) (PaymentRefund, error)
 	CreatePreRental(ctx context.Context, arg CreatePreRentalParams) (Prerental, error)
 	CreateProperty(ctx context.Context, arg CreatePropertyParams) (Property, error)
+	CreatePropertyExpense(ctx context.Context, arg CreatePropertyExpenseParams) (PropertyExpense, error)
 	CreatePropertyFeature(ctx context.Context, arg CreatePropertyFeatureParams) (PropertyFeature, error)
 	CreatePropertyManager(ctx context.Context, arg CreatePropertyManagerParams) (PropertyManager, error)
 	CreatePropertyMedia(ctx context.Context, arg CreatePropertyMediaParams) (PropertyMedium, error)
+	CreatePropertySearchOutboxEvents(ctx context.Context, arg CreatePropertySearchOutboxEventsParams) error
+	CreatePropertyService(ctx context.Context, arg CreatePropertyServiceParams) (PropertyService, error)
+	CreatePropertyServicePriceChange(ctx context.Context, arg CreatePropertyServicePriceChangeParams) (PropertyServicePriceChange, error)
 	CreatePropertyTag(ctx context.Context, arg CreatePropertyTagParams) (PropertyTag, error)
 	CreatePropertyVerificationRequest(ctx context.Context, arg CreatePropertyVerificationRequestParams) (PropertyVerificationRequest, error)
 	CreateReminder(ctx context.Context, arg CreateReminderParams) (Reminder, error)
@@ -56,67 +77,142 @@ type Querier interface {
 	CreateRentalCoap(ctx context.Context, arg CreateRentalCoapParams) (RentalCoap, error)
 	CreateRentalComplaint(ctx context.Context, arg CreateRentalComplaintParams) (RentalComplaint, error)
 	CreateRentalComplaintReply(ctx context.Context, arg CreateRentalComplaintReplyParams) (RentalComplaintReply, error)
+	CreateRentalExpiryNotice(ctx context.Context, arg CreateRentalExpiryNoticeParams) error
 	CreateRentalMinor(ctx context.Context, arg CreateRentalMinorParams) (RentalMinor, error)
 	CreateRentalPayment(ctx context.Context, arg CreateRentalPaymentParams) (RentalPayment, error)
 	CreateRentalPet(ctx context.Context, arg CreateRentalPetParams) (RentalPet, error)
 	CreateRentalPolicy(ctx context.Context, arg CreateRentalPolicyParams) (RentalPolicy, error)
 	CreateRentalService(ctx context.Context, arg CreateRentalServiceParams) (RentalService, error)
+	CreateReportSchedule(ctx context.Context, arg CreateReportScheduleParams) (ReportSchedule, error)
+	CreateSavedSearch(ctx context.Context, arg CreateSavedSearchParams) (SavedSearch, error)
+	CreateSavedSearchMatches(ctx context.Context, arg CreateSavedSearchMatchesParams) ([]uuid.UUID, error)
+	CreateSearchOutboxEvent(ctx context.Context, arg CreateSearchOutboxEventParams) error
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateUnit(ctx context.Context, arg CreateUnitParams) (Unit, error)
 	CreateUnitAmenity(ctx context.Context, arg CreateUnitAmenityParams) (UnitAmenity, error)
+	CreateUnitMaintenanceBlock(ctx context.Context, arg CreateUnitMaintenanceBlockParams) (UnitMaintenanceBlock, error)
 	CreateUnitMedia(ctx context.Context, arg CreateUnitMediaParams) (UnitMedium, error)
+	CreateUnitSearchOutboxEvents(ctx context.Context, arg CreateUnitSearchOutboxEventsParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateVerificationSearchOutboxEvents(ctx context.Context, id int64) error
 	DeleteApplication(ctx context.Context, id int64) error
 	DeleteExpiredTokens(ctx context.Context, interval int32) error
+	DeleteFavoriteFolder(ctx context.Context, id int64) error
+	DeleteFavoriteListing(ctx context.Context, arg DeleteFavoriteListingParams) error
 	DeleteListing(ctx context.Context, id uuid.UUID) error
+	DeleteListingDuplicateClusters(ctx context.Context, ids []int64) error
 	DeleteListingPolicies(ctx context.Context, listingID uuid.UUID) error
+	DeleteListingQuestion(ctx context.Context, arg DeleteListingQuestionParams) (int64, error)
+	DeleteListingRequiredDocuments(ctx context.Context, listingID uuid.UUID) error
+	DeleteListingSimilarities(ctx context.Context, listingID uuid.UUID) error
 	DeleteListingTags(ctx context.Context, listingID uuid.UUID) error
+	DeleteListingTranslation(ctx context.Context, arg DeleteListingTranslationParams) (int64, error)
 	DeleteListingUnits(ctx context.Context, listingID uuid.UUID) error
+	DeleteListingViewingSlot(ctx context.Context, id int64) error
 	DeleteMsgGroup(ctx context.Context, groupID int64) error
 	DeleteMsgGroupMember(ctx context.Context, arg DeleteMsgGroupMemberParams) error
 	DeleteNotificationDeviceToken(ctx context.Context, arg DeleteNotificationDeviceTokenParams) error
 	DeletePayment(ctx context.Context, id int64) error
+	DeletePaymentToken(ctx context.Context, userID uuid.UUID) error
 	DeletePreRental(ctx context.Context, id int64) error
 	DeleteProperty(ctx context.Context, id uuid.UUID) error
+	DeletePropertyExpense(ctx context.Context, id int64) error
 	DeletePropertyFeature(ctx context.Context, arg DeletePropertyFeatureParams) error
 	DeletePropertyManager(ctx context.Context, arg DeletePropertyManagerParams) error
 	DeletePropertyMedia(ctx context.Context, arg DeletePropertyMediaParams) error
+	DeletePropertyService(ctx context.Context, id int64) error
 	DeletePropertyTag(ctx context.Context, arg DeletePropertyTagParams) error
 	DeleteReminder(ctx context.Context, id int64) error
 	DeleteRental(ctx context.Context, id int64) error
+	DeleteReportSchedule(ctx context.Context, id int64) error
+	DeleteSavedSearch(ctx context.Context, id int64) error
 	DeleteUnit(ctx context.Context, id uuid.UUID) error
 	DeleteUnitAmenity(ctx context.Context, arg DeleteUnitAmenityParams) error
+	DeleteUnitMaintenanceBlock(ctx context.Context, id int64) error
 	DeleteUnitMedia(ctx context.Context, arg DeleteUnitMediaParams) error
+	// Supersede older pending price changes of a catalog service when a new one is recorded
+	DismissPendingPropertyServicePriceChanges(ctx context.Context, serviceID int64) error
+	ExpireStaleApplications(ctx context.Context, limit int32) ([]int64, error)
+	FailSearchOutboxEvents(ctx context.Context, arg FailSearchOutboxEventsParams) error
+	GetAccountingListingPayments(ctx context.Context, arg GetAccountingListingPaymentsParams) ([]GetAccountingListingPaymentsRow, error)
+	GetAccountingRefunds(ctx context.Context, arg GetAccountingRefundsParams) ([]GetAccountingRefundsRow, error)
+	GetAccountingRentalPayments(ctx context.Context, arg GetAccountingRentalPaymentsParams) ([]GetAccountingRentalPaymentsRow, error)
+	GetActiveSavedSearchesAfter(ctx context.Context, arg GetActiveSavedSearchesAfterParams) ([]SavedSearch, error)
 	GetAdminUsers(ctx context.Context) ([]uuid.UUID, error)
 	GetAllPropertyFeatures(ctx context.Context) ([]PFeature, error)
 	GetAllRentalPolicies(ctx context.Context) ([]LPolicy, error)
 	GetAllUnitAmenities(ctx context.Context) ([]UAmenity, error)
+	GetApplicationAnswers(ctx context.Context, applicationID int64) ([]ApplicationAnswer, error)
 	GetApplicationByID(ctx context.Context, id int64) (Application, error)
 	GetApplicationCoaps(ctx context.Context, applicationID int64) ([]ApplicationCoap, error)
+	GetApplicationDocuments(ctx context.Context, applicationID int64) ([]ApplicationDocument, error)
 	GetApplicationMinors(ctx context.Context, applicationID int64) ([]ApplicationMinor, error)
 	GetApplicationPets(ctx context.Context, applicationID int64) ([]ApplicationPet, error)
+	GetApplicationReference(ctx context.Context, id int64) (ApplicationReference, error)
+	GetApplicationReferences(ctx context.Context, applicationID int64) ([]ApplicationReference, error)
 	GetApplicationVehicles(ctx context.Context, applicationID int64) ([]ApplicationVehicle, error)
 	GetApplicationsByUserId(ctx context.Context, arg GetApplicationsByUserIdParams) ([]int64, error)
 	GetApplicationsInMonth(ctx context.Context, arg GetApplicationsInMonthParams) ([]int64, error)
+	GetApplicationsMissingDocuments(ctx context.Context, arg GetApplicationsMissingDocumentsParams) ([]int64, error)
 	GetApplicationsOfListing(ctx context.Context, listingID uuid.UUID) ([]int64, error)
+	GetApplicationsOfUnit(ctx context.Context, arg GetApplicationsOfUnitParams) ([]int64, error)
 	GetApplicationsToUser(ctx context.Context, arg GetApplicationsToUserParams) ([]int64, error)
 	GetContractByID(ctx context.Context, id int64) (Contract, error)
 	GetContractByRentalID(ctx context.Context, rentalID int64) (Contract, error)
+	GetDistrictListingPriceStats(ctx context.Context, arg GetDistrictListingPriceStatsParams) (GetDistrictListingPriceStatsRow, error)
+	GetDueReportSchedules(ctx context.Context) ([]ReportSchedule, error)
+	GetExistingListingIds(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error)
+	GetExpiredActiveListings(ctx context.Context, limit int32) ([]uuid.UUID, error)
+	GetExpiringRentals(ctx context.Context, daysBefore int32) ([]GetExpiringRentalsRow, error)
+	GetFavoriteFolder(ctx context.Context, id int64) (FavoriteFolder, error)
+	GetFavoriteFoldersOfUser(ctx context.Context, userID uuid.UUID) ([]FavoriteFolder, error)
+	GetFavoriteListingsOfUser(ctx context.Context, arg GetFavoriteListingsOfUserParams) ([]GetFavoriteListingsOfUserRow, error)
+	GetLatestListingModeration(ctx context.Context, listingID uuid.UUID) (ListingModeration, error)
 	GetLeastRentedProperties(ctx context.Context, arg GetLeastRentedPropertiesParams) ([]GetLeastRentedPropertiesRow, error)
 	GetLeastRentedUnits(ctx context.Context, arg GetLeastRentedUnitsParams) ([]GetLeastRentedUnitsRow, error)
 	GetListingByID(ctx context.Context, id uuid.UUID) (Listing, error)
+	GetListingDailyStats(ctx context.Context, arg GetListingDailyStatsParams) ([]ListingDailyStat, error)
+	GetListingDuplicateCandidates(ctx context.Context, arg GetListingDuplicateCandidatesParams) ([]uuid.UUID, error)
+	GetListingDuplicateCluster(ctx context.Context, id int64) (ListingDuplicateCluster, error)
+	GetListingDuplicateClusterMembers(ctx context.Context, clusterIds []int64) ([]ListingDuplicate, error)
+	GetListingDuplicateClusters(ctx context.Context, arg GetListingDuplicateClustersParams) ([]ListingDuplicateCluster, error)
+	GetListingDuplicates(ctx context.Context, listingIds []uuid.UUID) ([]ListingDuplicate, error)
+	GetListingIdsAfter(ctx context.Context, arg GetListingIdsAfterParams) ([]GetListingIdsAfterRow, error)
+	GetListingModeration(ctx context.Context, id int64) (ListingModeration, error)
+	GetListingModerations(ctx context.Context, arg GetListingModerationsParams) ([]ListingModeration, error)
 	GetListingPolicies(ctx context.Context, listingID uuid.UUID) ([]ListingPolicy, error)
+	GetListingPriceHistory(ctx context.Context, listingID uuid.UUID) ([]ListingPriceHistory, error)
+	GetListingQuestions(ctx context.Context, listingID uuid.UUID) ([]ListingQuestion, error)
+	GetListingRequiredDocuments(ctx context.Context, listingID uuid.UUID) ([]ListingRequiredDocument, error)
+	GetListingSimilarities(ctx context.Context, listingIds []uuid.UUID) ([]ListingSimilarity, error)
+	GetListingStatsByPriority(ctx context.Context, arg GetListingStatsByPriorityParams) ([]GetListingStatsByPriorityRow, error)
 	GetListingTags(ctx context.Context, listingID uuid.UUID) ([]ListingTag, error)
+	GetListingTranslations(ctx context.Context, listingID uuid.UUID) ([]ListingTranslation, error)
 	GetListingUnits(ctx context.Context, listingID uuid.UUID) ([]ListingUnit, error)
+	GetListingViewing(ctx context.Context, id int64) (ListingViewing, error)
+	GetListingViewingSlot(ctx context.Context, id int64) (ListingViewingSlot, error)
+	GetListingViewingSlotForUpdate(ctx context.Context, id int64) (ListingViewingSlot, error)
+	GetListingViewingSlots(ctx context.Context, arg GetListingViewingSlotsParams) ([]ListingViewingSlot, error)
+	GetListingViewingSlotsByIds(ctx context.Context, ids []int64) ([]ListingViewingSlot, error)
+	GetListingViewingsOfListing(ctx context.Context, listingID uuid.UUID) ([]ListingViewing, error)
+	GetListingViewingsOfSlot(ctx context.Context, slotID int64) ([]ListingViewing, error)
+	GetListingViewingsOfTenant(ctx context.Context, tenantID uuid.UUID) ([]ListingViewing, error)
+	GetListingViewingsToRemind(ctx context.Context, arg GetListingViewingsToRemindParams) ([]int64, error)
 	GetListingsCountByCity(ctx context.Context, city string) (int64, error)
+	GetListingsDuplicateFeatures(ctx context.Context, listingIds []uuid.UUID) ([]GetListingsDuplicateFeaturesRow, error)
 	// Get expired / active listings
 	GetListingsOfProperty(ctx context.Context, arg GetListingsOfPropertyParams) ([]uuid.UUID, error)
+	// Approved listings whose time to go public has come, including the expired ones extended since
+	GetListingsToPublish(ctx context.Context, limit int32) ([]uuid.UUID, error)
+	GetListingsToWarnExpiry(ctx context.Context, arg GetListingsToWarnExpiryParams) ([]uuid.UUID, error)
+	GetListingsTranslations(ctx context.Context, arg GetListingsTranslationsParams) ([]ListingTranslation, error)
 	GetMaintenanceRequests(ctx context.Context, arg GetMaintenanceRequestsParams) ([]int64, error)
 	GetManagedPreRentals(ctx context.Context, arg GetManagedPreRentalsParams) ([]Prerental, error)
 	GetManagedPropertiesByRole(ctx context.Context, arg GetManagedPropertiesByRoleParams) ([]uuid.UUID, error)
 	GetManagedRentals(ctx context.Context, arg GetManagedRentalsParams) ([]int64, error)
 	GetManagedUnits(ctx context.Context, managerID uuid.UUID) ([]uuid.UUID, error)
 	GetMessagesOfGroup(ctx context.Context, arg GetMessagesOfGroupParams) ([]Message, error)
+	GetModerationBannedWords(ctx context.Context) ([]string, error)
 	GetMostRentedProperties(ctx context.Context, arg GetMostRentedPropertiesParams) ([]GetMostRentedPropertiesRow, error)
 	GetMostRentedUnits(ctx context.Context, arg GetMostRentedUnitsParams) ([]GetMostRentedUnitsRow, error)
 	GetMsgGroup(ctx context.Context, groupID int64) (MsgGroup, error)
@@ -130,18 +226,35 @@ type Querier interface {
 	GetNotificationsOfUser(ctx context.Context, arg GetNotificationsOfUserParams) ([]Notification, error)
 	GetOccupiedProperties(ctx context.Context, managerID uuid.UUID) ([]uuid.UUID, error)
 	GetOccupiedUnits(ctx context.Context, managerID uuid.UUID) ([]uuid.UUID, error)
+	GetOverdueRentals(ctx context.Context, arg GetOverdueRentalsParams) ([]GetOverdueRentalsRow, error)
+	GetOwnerStatementExpenses(ctx context.Context, arg GetOwnerStatementExpensesParams) ([]GetOwnerStatementExpensesRow, error)
+	GetOwnerStatementIncomes(ctx context.Context, arg GetOwnerStatementIncomesParams) ([]GetOwnerStatementIncomesRow, error)
 	GetPaymentById(ctx context.Context, id int64) (Payment, error)
+	GetPaymentByOrderId(ctx context.Context, orderID string) (Payment, error)
 	GetPaymentItemsByPaymentId(ctx context.Context, paymentID int64) ([]PaymentItem, error)
+	GetPaymentRefunds(ctx context.Context, paymentID int64) ([]PaymentRefund, error)
+	GetPaymentToken(ctx context.Context, userID uuid.UUID) (PaymentToken, error)
 	GetPaymentsOfRental(ctx context.Context, rentalID int64) ([]RentalPayment, error)
 	GetPaymentsOfUser(ctx context.Context, arg GetPaymentsOfUserParams) ([]Payment, error)
 	GetPaymentsStatistic(ctx context.Context, arg GetPaymentsStatisticParams) (float32, error)
+	GetPendingSavedSearchMatches(ctx context.Context, frequency SAVEDSEARCHFREQUENCY) ([]GetPendingSavedSearchMatchesRow, error)
 	GetPreRental(ctx context.Context, id int64) (Prerental, error)
 	GetPreRentalsToTenant(ctx context.Context, arg GetPreRentalsToTenantParams) ([]Prerental, error)
+	GetPropertiesMediaHashes(ctx context.Context, propertyIds []uuid.UUID) ([]GetPropertiesMediaHashesRow, error)
 	GetPropertiesWithActiveListing(ctx context.Context, managerID uuid.UUID) ([]uuid.UUID, error)
 	GetPropertyById(ctx context.Context, id uuid.UUID) (Property, error)
+	GetPropertyExpense(ctx context.Context, id int64) (PropertyExpense, error)
+	GetPropertyExpenses(ctx context.Context, arg GetPropertyExpensesParams) ([]PropertyExpense, error)
 	GetPropertyFeatures(ctx context.Context, propertyID uuid.UUID) ([]PropertyFeature, error)
 	GetPropertyManagers(ctx context.Context, propertyID uuid.UUID) ([]PropertyManager, error)
 	GetPropertyMedia(ctx context.Context, propertyID uuid.UUID) ([]PropertyMedium, error)
+	GetPropertyMediaHashes(ctx context.Context, mediaIds []int64) ([]PropertyMediaHash, error)
+	GetPropertyScreeningConfig(ctx context.Context, propertyID uuid.UUID) (PropertyScreeningConfig, error)
+	GetPropertyService(ctx context.Context, id int64) (PropertyService, error)
+	GetPropertyServicePriceChange(ctx context.Context, id int64) (PropertyServicePriceChange, error)
+	GetPropertyServicePriceChanges(ctx context.Context, serviceID int64) ([]PropertyServicePriceChange, error)
+	GetPropertyServiceSubscribers(ctx context.Context, catalogServiceID pgtype.Int8) ([]GetPropertyServiceSubscribersRow, error)
+	GetPropertyServices(ctx context.Context, propertyID uuid.UUID) ([]PropertyService, error)
 	GetPropertyTags(ctx context.Context, propertyID uuid.UUID) ([]PropertyTag, error)
 	GetPropertyVerificationRequest(ctx context.Context, id int64) (PropertyVerificationRequest, error)
 	GetPropertyVerificationRequestsOfProperty(ctx context.Context, arg GetPropertyVerificationRequestsOfPropertyParams) ([]PropertyVerificationRequest, error)
@@ -150,6 +263,10 @@ type Querier interface {
 	GetReminderById(ctx context.Context, id int64) (Reminder, error)
 	GetRemindersByCreator(ctx context.Context, creatorID uuid.UUID) ([]Reminder, error)
 	GetRemindersInDate(ctx context.Context, dateTrunc pgtype.Interval) ([]Reminder, error)
+	// Prices of the units comparable to the given one: units of the active listings and units rented recently,
+	// in the same district (and ward if given), in properties of the same type, with a close area and the same room counts if given
+	GetRentComparablesStats(ctx context.Context, arg GetRentComparablesStatsParams) (GetRentComparablesStatsRow, error)
+	GetRentRoll(ctx context.Context, arg GetRentRollParams) ([]GetRentRollRow, error)
 	GetRental(ctx context.Context, id int64) (Rental, error)
 	GetRentalByApplicationId(ctx context.Context, applicationID pgtype.Int8) (Rental, error)
 	GetRentalCoapsByRentalID(ctx context.Context, rentalID int64) ([]RentalCoap, error)
@@ -171,47 +288,96 @@ type Querier interface {
 	GetRentalsOfProperty(ctx context.Context, arg GetRentalsOfPropertyParams) ([]int64, error)
 	GetRentalsOfUnit(ctx context.Context, unitID uuid.UUID) ([]int64, error)
 	GetRentedProperties(ctx context.Context, tenantID pgtype.UUID) ([]uuid.UUID, error)
+	GetReportSchedule(ctx context.Context, id int64) (ReportSchedule, error)
+	GetReportSchedulesOfUser(ctx context.Context, userID uuid.UUID) ([]ReportSchedule, error)
+	GetSavedSearch(ctx context.Context, id int64) (SavedSearch, error)
+	GetSavedSearchesOfUser(ctx context.Context, userID uuid.UUID) ([]SavedSearch, error)
+	GetSearchOutboxStats(ctx context.Context) (GetSearchOutboxStatsRow, error)
 	GetSessionById(ctx context.Context, id uuid.UUID) (Session, error)
+	GetSimilarPropertyMedia(ctx context.Context, arg GetSimilarPropertyMediaParams) ([]GetSimilarPropertyMediaRow, error)
 	GetSomeListings(ctx context.Context, arg GetSomeListingsParams) ([]Listing, error)
 	GetTenantExpenditure(ctx context.Context, arg GetTenantExpenditureParams) (float32, error)
+	GetTenantPaymentStats(ctx context.Context, tenantID pgtype.UUID) (GetTenantPaymentStatsRow, error)
 	GetTenantPendingPayments(ctx context.Context, arg GetTenantPendingPaymentsParams) ([]GetTenantPendingPaymentsRow, error)
 	GetTotalTenantPendingPayments(ctx context.Context, userID pgtype.UUID) (float32, error)
 	GetTotalTenantsManagedByUserStatistic(ctx context.Context, arg GetTotalTenantsManagedByUserStatisticParams) (int32, error)
 	GetTotalTenantsOfUnitStatistic(ctx context.Context, unitID uuid.UUID) (int32, error)
 	GetUnitAmenities(ctx context.Context, unitID uuid.UUID) ([]UnitAmenity, error)
 	GetUnitById(ctx context.Context, id uuid.UUID) (Unit, error)
+	GetUnitMaintenanceBlock(ctx context.Context, id int64) (UnitMaintenanceBlock, error)
+	GetUnitMaintenanceBlocks(ctx context.Context, unitID uuid.UUID) ([]UnitMaintenanceBlock, error)
 	GetUnitManagers(ctx context.Context, id uuid.UUID) ([]PropertyManager, error)
 	GetUnitMedia(ctx context.Context, unitID uuid.UUID) ([]UnitMedium, error)
+	GetUnitOccupancies(ctx context.Context, arg GetUnitOccupanciesParams) ([]UnitOccupancy, error)
+	GetUnitsAvailableFrom(ctx context.Context, arg GetUnitsAvailableFromParams) ([]GetUnitsAvailableFromRow, error)
 	GetUnitsOfProperty(ctx context.Context, propertyID uuid.UUID) ([]Unit, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserById(ctx context.Context, id uuid.UUID) (User, error)
 	IsPropertyVisible(ctx context.Context, arg IsPropertyVisibleParams) (pgtype.Bool, error)
 	IsUnitPublic(ctx context.Context, id uuid.UUID) (bool, error)
+	// Serializes the creation of the slots of a manager, so that concurrent slots cannot overlap
+	LockViewingManager(ctx context.Context, managerID string) error
+	MarkApplicationDocumentsReminded(ctx context.Context, id int64) error
+	MarkListingExpiryWarned(ctx context.Context, id uuid.UUID) error
+	MarkListingViewingReminded(ctx context.Context, id int64) error
+	MarkSavedSearchMatchesNotified(ctx context.Context, arg MarkSavedSearchMatchesNotifiedParams) error
+	MarkSearchOutboxEventsProcessed(ctx context.Context, ids []int64) error
 	PingContractByRentalID(ctx context.Context, rentalID int64) (PingContractByRentalIDRow, error)
 	PlanRentalPayment(ctx context.Context, rentalID int64) ([]int64, error)
 	PlanRentalPayments(ctx context.Context) ([]int64, error)
+	PurgeSearchOutboxEvents(ctx context.Context, processedAt pgtype.Timestamptz) (int64, error)
+	ReplayDeadSearchOutboxEvents(ctx context.Context) (int64, error)
+	ReplaySearchOutboxEventsSince(ctx context.Context, createdAt time.Time) (int64, error)
+	RespondApplicationReference(ctx context.Context, arg RespondApplicationReferenceParams) (int64, error)
+	ReviewListingModeration(ctx context.Context, arg ReviewListingModerationParams) (int64, error)
+	SetListingViewingSlotReminder(ctx context.Context, arg SetListingViewingSlotReminderParams) error
+	TransitApplicationStatus(ctx context.Context, arg TransitApplicationStatusParams) (int64, error)
+	UpdateApplicationDocumentStatus(ctx context.Context, arg UpdateApplicationDocumentStatusParams) (int64, error)
 	UpdateApplicationStatus(ctx context.Context, arg UpdateApplicationStatusParams) ([]int64, error)
 	UpdateContract(ctx context.Context, arg UpdateContractParams) error
 	UpdateContractContent(ctx context.Context, arg UpdateContractContentParams) error
+	UpdateFavoriteFolder(ctx context.Context, arg UpdateFavoriteFolderParams) error
 	UpdateFinePayments(ctx context.Context) error
 	UpdateFinePaymentsOfRental(ctx context.Context, rentalID int64) error
 	UpdateListing(ctx context.Context, arg UpdateListingParams) error
+	UpdateListingModerationFlags(ctx context.Context, arg UpdateListingModerationFlagsParams) error
 	UpdateListingPriority(ctx context.Context, arg UpdateListingPriorityParams) error
+	UpdateListingQuestion(ctx context.Context, arg UpdateListingQuestionParams) (int64, error)
 	UpdateListingStatus(ctx context.Context, arg UpdateListingStatusParams) error
+	UpdateListingViewingSlotBooked(ctx context.Context, arg UpdateListingViewingSlotBookedParams) error
+	UpdateListingViewingStatus(ctx context.Context, arg UpdateListingViewingStatusParams) (int64, error)
 	UpdateMessage(ctx context.Context, arg UpdateMessageParams) ([]int64, error)
 	UpdateNewPropertyManagerRequest(ctx context.Context, arg UpdateNewPropertyManagerRequestParams) error
 	UpdateNotification(ctx context.Context, arg UpdateNotificationParams) error
 	UpdateNotificationDeviceTokenTimestamp(ctx context.Context, arg UpdateNotificationDeviceTokenTimestampParams) error
 	UpdatePayment(ctx context.Context, arg UpdatePaymentParams) error
+	// Re-price the planned (not yet issued) payments of a rental service
+	UpdatePlannedRentalServicePayments(ctx context.Context, arg UpdatePlannedRentalServicePaymentsParams) error
 	UpdateProperty(ctx context.Context, arg UpdatePropertyParams) error
+	UpdatePropertyService(ctx context.Context, arg UpdatePropertyServiceParams) error
+	UpdatePropertyServicePriceChangeStatus(ctx context.Context, arg UpdatePropertyServicePriceChangeStatusParams) error
 	UpdatePropertyVerificationRequest(ctx context.Context, arg UpdatePropertyVerificationRequestParams) error
 	UpdateReminder(ctx context.Context, arg UpdateReminderParams) ([]Reminder, error)
 	UpdateRental(ctx context.Context, arg UpdateRentalParams) error
 	UpdateRentalComplaint(ctx context.Context, arg UpdateRentalComplaintParams) error
 	UpdateRentalPayment(ctx context.Context, arg UpdateRentalPaymentParams) error
+	UpdateReportScheduleLastSentAt(ctx context.Context, id int64) error
+	UpdateSavedSearch(ctx context.Context, arg UpdateSavedSearchParams) error
+	UpdateSavedSearchLastCheckedAt(ctx context.Context, arg UpdateSavedSearchLastCheckedAtParams) error
+	UpdateSavedSearchLastSentAt(ctx context.Context, id int64) error
 	UpdateSessionBlockingStatus(ctx context.Context, arg UpdateSessionBlockingStatusParams) error
+	UpdateSubscribedRentalServicesPrice(ctx context.Context, arg UpdateSubscribedRentalServicesPriceParams) ([]UpdateSubscribedRentalServicesPriceRow, error)
 	UpdateUnit(ctx context.Context, arg UpdateUnitParams) error
 	UpdateUser(ctx context.Context, arg UpdateUserParams) error
+	UpsertApplicationDocument(ctx context.Context, arg UpsertApplicationDocumentParams) (ApplicationDocument, error)
+	UpsertFavoriteListing(ctx context.Context, arg UpsertFavoriteListingParams) (FavoriteListing, error)
+	UpsertListingDailyStats(ctx context.Context, arg UpsertListingDailyStatsParams) error
+	UpsertListingSimilarity(ctx context.Context, arg UpsertListingSimilarityParams) error
+	UpsertListingTranslation(ctx context.Context, arg UpsertListingTranslationParams) (ListingTranslation, error)
+	UpsertPaymentToken(ctx context.Context, arg UpsertPaymentTokenParams) (PaymentToken, error)
+	UpsertPropertyMediaHash(ctx context.Context, arg UpsertPropertyMediaHashParams) error
+	UpsertPropertyScreeningConfig(ctx context.Context, arg UpsertPropertyScreeningConfigParams) (PropertyScreeningConfig, error)
+	WithdrawApplicationsOfListing(ctx context.Context, listingID uuid.UUID) ([]int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
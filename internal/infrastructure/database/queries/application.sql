@@ -183,6 +183,13 @@ LIMIT $1 OFFSET $2;
 -- name: GetApplicationsOfListing :many
 SELECT id FROM applications WHERE listing_id = $1;
 
+-- name: GetApplicationsOfUnit :many
+SELECT id FROM applications 
+WHERE 
+  unit_id = $1 
+  AND status::TEXT = ANY(sqlc.arg(statuses)::TEXT[])
+ORDER BY created_at ASC;
+
 -- name: CheckApplicationVisibility :one
 SELECT count(*) > 0 FROM applications WHERE 
   id = $1 
@@ -213,5 +220,39 @@ WHERE
   )
 RETURNING id;
 
+-- name: TransitApplicationStatus :execrows
+UPDATE applications 
+SET 
+  status = $1, 
+  updated_at = NOW() 
+WHERE 
+  id = $2
+  AND status::TEXT = ANY(sqlc.arg(from_statuses)::TEXT[]);
+
+-- name: ExpireStaleApplications :many
+UPDATE applications 
+SET 
+  status = 'EXPIRED', 
+  updated_at = NOW() 
+WHERE id IN (
+  SELECT applications.id FROM applications 
+    INNER JOIN listings ON listings.id = applications.listing_id
+  WHERE 
+    applications.status = 'PENDING'
+    AND applications.updated_at < NOW() - (INTERVAL '1 day' * listings.application_validity_days)
+  LIMIT $1
+)
+RETURNING id;
+
+-- name: WithdrawApplicationsOfListing :many
+UPDATE applications 
+SET 
+  status = 'WITHDRAWN', 
+  updated_at = NOW() 
+WHERE 
+  listing_id = $1
+  AND status IN ('PENDING', 'CONDITIONALLY_APPROVED', 'WAITLISTED')
+RETURNING id;
+
 -- name: DeleteApplication :exec
 DELETE FROM applications WHERE id = $1;
@@ -0,0 +1,17 @@
+-- name: CreateApplicationAnswer :one
+INSERT INTO application_answers (
+  application_id,
+  question_id,
+  question,
+  type,
+  values
+) VALUES (
+  sqlc.arg(application_id),
+  sqlc.arg(question_id),
+  sqlc.arg(question),
+  sqlc.arg(type),
+  sqlc.arg(values)
+) RETURNING *;
+
+-- name: GetApplicationAnswers :many
+SELECT * FROM application_answers WHERE application_id = $1 ORDER BY id;
@@ -0,0 +1,54 @@
+-- name: UpsertApplicationDocument :one
+INSERT INTO application_documents (
+  application_id,
+  type,
+  url
+) VALUES (
+  sqlc.arg(application_id),
+  sqlc.arg(type),
+  sqlc.arg(url)
+) ON CONFLICT (application_id, type) DO UPDATE SET
+  url = EXCLUDED.url,
+  status = 'UPLOADED',
+  note = NULL,
+  verified_by = NULL,
+  verified_at = NULL,
+  updated_at = NOW()
+RETURNING *;
+
+-- name: GetApplicationDocuments :many
+SELECT * FROM application_documents WHERE application_id = $1 ORDER BY type;
+
+-- name: UpdateApplicationDocumentStatus :execrows
+UPDATE application_documents SET
+  status = sqlc.arg(status),
+  note = sqlc.narg(note),
+  verified_by = sqlc.arg(verified_by),
+  verified_at = NOW(),
+  updated_at = NOW()
+WHERE application_id = sqlc.arg(application_id) AND type = sqlc.arg(type);
+
+-- name: GetApplicationsMissingDocuments :many
+SELECT id FROM applications
+WHERE
+  status IN ('PENDING', 'CONDITIONALLY_APPROVED')
+  AND creator_id IS NOT NULL
+  AND COALESCE(documents_reminded_at, created_at) < NOW() - sqlc.arg(days)::INTEGER * INTERVAL '1 day'
+  AND EXISTS (
+    SELECT 1 FROM listing_required_documents
+    WHERE
+      listing_required_documents.listing_id = applications.listing_id
+      AND listing_required_documents.mandatory
+      AND NOT EXISTS (
+        SELECT 1 FROM application_documents
+        WHERE
+          application_documents.application_id = applications.id
+          AND application_documents.type = listing_required_documents.type
+          AND application_documents.status <> 'REJECTED'
+      )
+  )
+ORDER BY id
+LIMIT sqlc.arg(lim);
+
+-- name: MarkApplicationDocumentsReminded :exec
+UPDATE applications SET documents_reminded_at = NOW() WHERE id = $1;
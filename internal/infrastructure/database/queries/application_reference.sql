@@ -0,0 +1,33 @@
+-- name: CreateApplicationReference :one
+INSERT INTO application_references (
+  application_id,
+  referee_name,
+  referee_email,
+  referee_phone,
+  rental_address,
+  expires_at
+) VALUES (
+  sqlc.arg(application_id),
+  sqlc.arg(referee_name),
+  sqlc.arg(referee_email),
+  sqlc.narg(referee_phone),
+  sqlc.narg(rental_address),
+  sqlc.arg(expires_at)
+) RETURNING *;
+
+-- name: GetApplicationReference :one
+SELECT * FROM application_references WHERE id = $1 LIMIT 1;
+
+-- name: GetApplicationReferences :many
+SELECT * FROM application_references WHERE application_id = $1 ORDER BY id;
+
+-- name: RespondApplicationReference :execrows
+UPDATE application_references SET
+  referee_id = sqlc.narg(referee_id),
+  paid_on_time = sqlc.arg(paid_on_time),
+  caused_damage = sqlc.arg(caused_damage),
+  would_rent_again = sqlc.arg(would_rent_again),
+  rental_duration = sqlc.narg(rental_duration),
+  comment = sqlc.narg(comment),
+  responded_at = NOW()
+WHERE id = sqlc.arg(id) AND responded_at IS NULL AND expires_at > NOW();
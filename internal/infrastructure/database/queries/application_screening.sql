@@ -0,0 +1,53 @@
+-- name: GetPropertyScreeningConfig :one
+SELECT * FROM property_screening_configs WHERE property_id = $1 LIMIT 1;
+
+-- name: UpsertPropertyScreeningConfig :one
+INSERT INTO property_screening_configs (
+  property_id,
+  income_weight,
+  household_income_weight,
+  rental_history_weight,
+  policy_weight,
+  payment_history_weight,
+  min_income_ratio,
+  min_household_income_ratio,
+  min_rental_history_months,
+  min_on_time_rate
+) VALUES (
+  sqlc.arg(property_id),
+  sqlc.arg(income_weight),
+  sqlc.arg(household_income_weight),
+  sqlc.arg(rental_history_weight),
+  sqlc.arg(policy_weight),
+  sqlc.arg(payment_history_weight),
+  sqlc.arg(min_income_ratio),
+  sqlc.arg(min_household_income_ratio),
+  sqlc.arg(min_rental_history_months),
+  sqlc.arg(min_on_time_rate)
+) ON CONFLICT (property_id) DO UPDATE SET
+  income_weight = EXCLUDED.income_weight,
+  household_income_weight = EXCLUDED.household_income_weight,
+  rental_history_weight = EXCLUDED.rental_history_weight,
+  policy_weight = EXCLUDED.policy_weight,
+  payment_history_weight = EXCLUDED.payment_history_weight,
+  min_income_ratio = EXCLUDED.min_income_ratio,
+  min_household_income_ratio = EXCLUDED.min_household_income_ratio,
+  min_rental_history_months = EXCLUDED.min_rental_history_months,
+  min_on_time_rate = EXCLUDED.min_on_time_rate,
+  updated_at = NOW()
+RETURNING *;
+
+-- name: GetTenantPaymentStats :one
+SELECT
+  COUNT(DISTINCT rentals.id)::INTEGER AS rentals,
+  COUNT(rental_payments.id) FILTER (WHERE rental_payments.status = 'PAID')::INTEGER AS paid_payments,
+  COUNT(rental_payments.id) FILTER (
+    WHERE rental_payments.status = 'PAID'
+    AND rental_payments.payment_date <= COALESCE(rental_payments.expiry_date, rental_payments.end_date)
+  )::INTEGER AS on_time_payments,
+  COUNT(rental_payments.id) FILTER (
+    WHERE rental_payments.status IN ('ISSUED', 'PENDING', 'REQUEST2PAY', 'PARTIALLYPAID', 'PAYFINE')
+    AND rental_payments.expiry_date < CURRENT_DATE
+  )::INTEGER AS overdue_payments
+FROM rentals LEFT JOIN rental_payments ON rental_payments.rental_id = rentals.id
+WHERE rentals.tenant_id = sqlc.arg(tenant_id);
@@ -0,0 +1,44 @@
+-- name: CreateFavoriteFolder :one
+INSERT INTO favorite_folders (
+  user_id,
+  name
+) VALUES (
+  $1, $2
+) RETURNING *;
+
+-- name: GetFavoriteFolder :one
+SELECT * FROM favorite_folders WHERE id = $1 LIMIT 1;
+
+-- name: GetFavoriteFoldersOfUser :many
+SELECT * FROM favorite_folders WHERE user_id = $1 ORDER BY name;
+
+-- name: UpdateFavoriteFolder :exec
+UPDATE favorite_folders SET name = $2 WHERE id = $1;
+
+-- name: DeleteFavoriteFolder :exec
+DELETE FROM favorite_folders WHERE id = $1;
+
+-- name: UpsertFavoriteListing :one
+INSERT INTO favorite_listings (
+  user_id,
+  listing_id,
+  folder_id,
+  note,
+  saved_price
+) VALUES (
+  $1, $2, $3, $4, $5
+) ON CONFLICT (user_id, listing_id) DO UPDATE SET
+  folder_id = EXCLUDED.folder_id,
+  note = EXCLUDED.note,
+  updated_at = NOW()
+RETURNING *;
+
+-- name: GetFavoriteListingsOfUser :many
+SELECT favorite_listings.*, listings.title, listings.price AS current_price, listings.active, listings.expired_at
+FROM favorite_listings INNER JOIN listings ON listings.id = favorite_listings.listing_id
+WHERE favorite_listings.user_id = sqlc.arg(user_id)
+  AND (sqlc.narg(folder_id)::BIGINT IS NULL OR favorite_listings.folder_id = sqlc.narg(folder_id))
+ORDER BY favorite_listings.created_at DESC;
+
+-- name: DeleteFavoriteListing :exec
+DELETE FROM favorite_listings WHERE user_id = $1 AND listing_id = $2;
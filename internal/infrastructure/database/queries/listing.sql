@@ -15,6 +15,9 @@ INSERT INTO listings (
   pets_allowed,
   number_of_residents,
   priority,
+  post_at,
+  auto_renew,
+  application_validity_days,
   created_at,
   updated_at,
   expired_at
@@ -34,8 +37,12 @@ INSERT INTO listings (
   sqlc.narg(pets_allowed),
   sqlc.narg(number_of_residents),
   sqlc.arg(priority),
+  sqlc.narg(post_at),
+  coalesce(sqlc.narg(auto_renew), FALSE),
+  coalesce(sqlc.narg(application_validity_days), 30),
   NOW(), NOW(), 
-  NOW() + (INTERVAL'1 day' * sqlc.arg(post_duration))
+  -- the listing stays public for as long as paid, from the time it goes public
+  coalesce(sqlc.narg(post_at), NOW()) + (INTERVAL'1 day' * sqlc.arg(post_duration))
 ) RETURNING *;
 
 -- name: CreateListingPolicy :one
@@ -147,17 +154,25 @@ UPDATE listings SET
   lease_term = coalesce(sqlc.narg(lease_term), lease_term),
   pets_allowed = coalesce(sqlc.narg(pets_allowed), pets_allowed),
   number_of_residents = coalesce(sqlc.narg(number_of_residents), number_of_residents),
+  post_at = coalesce(sqlc.narg(post_at), post_at),
+  -- the expiry follows the time the listing goes public
+  expired_at = expired_at + (coalesce(sqlc.narg(post_at), post_at, created_at) - coalesce(post_at, created_at)),
+  auto_renew = coalesce(sqlc.narg(auto_renew), auto_renew),
+  application_validity_days = coalesce(sqlc.narg(application_validity_days), application_validity_days),
   updated_at = NOW()
-  -- post_at = coalesce(sqlc.narg(post_at), post_at)
 WHERE id = sqlc.arg(id);
 
 -- name: UpdateListingStatus :exec
 UPDATE listings 
 SET 
   active = $1,
+  activated_at = CASE WHEN $1 AND NOT active THEN NOW() ELSE activated_at END,
   updated_at = NOW()
  WHERE id = $2;
 
+-- name: MarkListingExpiryWarned :exec
+UPDATE listings SET expiry_warned_at = NOW() WHERE id = $1;
+
 -- name: UpdateListingPriority :exec
 UPDATE listings
 SET
@@ -176,3 +191,45 @@ DELETE FROM listing_units WHERE listing_id = $1;
 
 -- name: DeleteListingTags :exec
 DELETE FROM listing_tags WHERE listing_id = $1;
+
+-- name: CountListings :one
+SELECT count(*) FROM listings;
+
+-- name: GetListingIdsAfter :many
+SELECT id, updated_at FROM listings WHERE id > $1 ORDER BY id LIMIT $2;
+
+-- name: GetExistingListingIds :many
+SELECT id FROM listings WHERE id = ANY(sqlc.arg(ids)::UUID[]);
+
+-- Approved listings whose time to go public has come, including the expired ones extended since
+-- name: GetListingsToPublish :many
+SELECT listings.id
+FROM listings
+WHERE
+  NOT listings.active
+  AND coalesce(listings.post_at, listings.created_at) <= NOW()
+  AND listings.expired_at > NOW()
+  AND (
+    SELECT listing_moderations.status
+    FROM listing_moderations
+    WHERE listing_moderations.listing_id = listings.id
+    ORDER BY listing_moderations.created_at DESC
+    LIMIT 1
+  ) = 'APPROVED'
+ORDER BY coalesce(listings.post_at, listings.created_at)
+LIMIT $1;
+
+-- name: GetExpiredActiveListings :many
+SELECT id FROM listings WHERE active AND expired_at <= NOW() ORDER BY expired_at LIMIT $1;
+
+-- name: GetListingsToWarnExpiry :many
+SELECT id
+FROM listings
+WHERE
+  active
+  AND NOT auto_renew
+  AND expiry_warned_at IS NULL
+  AND expired_at > NOW()
+  AND expired_at <= NOW() + (INTERVAL '1 day' * sqlc.arg(days)::INTEGER)
+ORDER BY expired_at
+LIMIT sqlc.arg(lim);
@@ -0,0 +1,89 @@
+-- name: GetListingDuplicateCandidates :many
+SELECT listings.id
+FROM listings INNER JOIN properties ON properties.id = listings.property_id
+WHERE
+  listings.id <> sqlc.arg(listing_id) AND
+  listings.expired_at > NOW() - INTERVAL '90 days' AND
+  (
+    listings.property_id = sqlc.arg(property_id) OR
+    (
+      properties.city = sqlc.arg(city) AND
+      properties.district = sqlc.arg(district) AND
+      listings.price BETWEEN sqlc.arg(min_price)::REAL AND sqlc.arg(max_price)::REAL
+    ) OR
+    -- about 100m around the property
+    (
+      properties.lat BETWEEN sqlc.narg(lat)::FLOAT8 - 0.001 AND sqlc.narg(lat)::FLOAT8 + 0.001 AND
+      properties.lng BETWEEN sqlc.narg(lng)::FLOAT8 - 0.001 AND sqlc.narg(lng)::FLOAT8 + 0.001
+    )
+  )
+ORDER BY listings.created_at DESC
+LIMIT sqlc.arg('limit');
+
+-- name: GetListingsDuplicateFeatures :many
+SELECT
+  listings.id,
+  listings.creator_id,
+  listings.property_id,
+  listings.description,
+  listings.price,
+  properties.full_address,
+  properties.city,
+  properties.district,
+  properties.lat,
+  properties.lng,
+  coalesce((
+    SELECT array_agg(units.id ORDER BY units.id)
+    FROM listing_units INNER JOIN units ON units.id = listing_units.unit_id
+    WHERE listing_units.listing_id = listings.id
+  ), '{}')::UUID[] AS unit_ids,
+  coalesce((
+    SELECT array_agg(units.area ORDER BY units.id)
+    FROM listing_units INNER JOIN units ON units.id = listing_units.unit_id
+    WHERE listing_units.listing_id = listings.id
+  ), '{}')::REAL[] AS unit_areas,
+  coalesce((
+    SELECT array_agg(coalesce(units.number_of_bedrooms, 0) ORDER BY units.id)
+    FROM listing_units INNER JOIN units ON units.id = listing_units.unit_id
+    WHERE listing_units.listing_id = listings.id
+  ), '{}')::INTEGER[] AS unit_bedrooms
+FROM listings INNER JOIN properties ON properties.id = listings.property_id
+WHERE listings.id = ANY(sqlc.arg(listing_ids)::UUID[]);
+
+-- name: GetPropertiesMediaHashes :many
+SELECT property_media.property_id, property_media_hashes.hash
+FROM property_media_hashes INNER JOIN property_media ON property_media.id = property_media_hashes.media_id
+WHERE property_media.property_id = ANY(sqlc.arg(property_ids)::UUID[]);
+
+-- name: UpsertListingSimilarity :exec
+INSERT INTO listing_similarities (listing_id, similar_listing_id, score, reasons) VALUES ($1, $2, $3, $4)
+ON CONFLICT (listing_id, similar_listing_id) DO UPDATE SET score = EXCLUDED.score, reasons = EXCLUDED.reasons;
+
+-- name: DeleteListingSimilarities :exec
+DELETE FROM listing_similarities WHERE listing_id = $1 OR similar_listing_id = $1;
+
+-- name: GetListingSimilarities :many
+SELECT * FROM listing_similarities
+WHERE listing_id = ANY(sqlc.arg(listing_ids)::UUID[]) OR similar_listing_id = ANY(sqlc.arg(listing_ids)::UUID[]);
+
+-- name: CreateListingDuplicateCluster :one
+INSERT INTO listing_duplicate_clusters DEFAULT VALUES RETURNING *;
+
+-- name: GetListingDuplicateCluster :one
+SELECT * FROM listing_duplicate_clusters WHERE id = $1 LIMIT 1;
+
+-- name: GetListingDuplicateClusters :many
+SELECT * FROM listing_duplicate_clusters ORDER BY created_at DESC LIMIT $1 OFFSET $2;
+
+-- name: DeleteListingDuplicateClusters :exec
+DELETE FROM listing_duplicate_clusters WHERE id = ANY(sqlc.arg(ids)::BIGINT[]);
+
+-- name: CreateListingDuplicates :exec
+INSERT INTO listing_duplicates (listing_id, cluster_id)
+SELECT unnest(sqlc.arg(listing_ids)::UUID[]), sqlc.arg(cluster_id)::BIGINT;
+
+-- name: GetListingDuplicates :many
+SELECT * FROM listing_duplicates WHERE listing_id = ANY(sqlc.arg(listing_ids)::UUID[]);
+
+-- name: GetListingDuplicateClusterMembers :many
+SELECT * FROM listing_duplicates WHERE cluster_id = ANY(sqlc.arg(cluster_ids)::BIGINT[]) ORDER BY cluster_id, listing_id;
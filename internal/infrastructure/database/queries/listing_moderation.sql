@@ -0,0 +1,58 @@
+-- name: CreateListingModeration :one
+INSERT INTO listing_moderations (listing_id) VALUES ($1) RETURNING *;
+
+-- name: GetListingModeration :one
+SELECT * FROM listing_moderations WHERE id = $1 LIMIT 1;
+
+-- name: GetLatestListingModeration :one
+SELECT * FROM listing_moderations WHERE listing_id = $1 ORDER BY created_at DESC LIMIT 1;
+
+-- name: GetListingModerations :many
+SELECT * FROM listing_moderations
+WHERE status = sqlc.arg(status)
+ORDER BY jsonb_array_length(flags) DESC, created_at
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: UpdateListingModerationFlags :exec
+UPDATE listing_moderations SET flags = $2, checked_at = NOW(), updated_at = NOW() WHERE id = $1;
+
+-- name: ReviewListingModeration :execrows
+UPDATE listing_moderations SET
+  status = sqlc.arg(status),
+  reason = sqlc.narg(reason),
+  reviewer_id = sqlc.arg(reviewer_id),
+  reviewed_at = NOW(),
+  updated_at = NOW()
+WHERE id = sqlc.arg(id) AND status = 'PENDING';
+
+-- name: GetModerationBannedWords :many
+SELECT word FROM moderation_banned_words ORDER BY word;
+
+-- name: GetDistrictListingPriceStats :one
+SELECT
+  count(*)::BIGINT AS samples,
+  coalesce(percentile_cont(0.5) WITHIN GROUP (ORDER BY listings.price / properties.area), 0)::FLOAT8 AS median_price_per_area
+FROM listings INNER JOIN properties ON properties.id = listings.property_id
+WHERE 
+  listings.active AND
+  listings.expired_at > NOW() AND
+  listings.id <> sqlc.arg(listing_id) AND
+  properties.city = sqlc.arg(city) AND
+  properties.district = sqlc.arg(district) AND
+  properties.type = sqlc.arg(type) AND
+  properties.area > 0;
+
+-- name: UpsertPropertyMediaHash :exec
+INSERT INTO property_media_hashes (media_id, hash) VALUES ($1, $2)
+ON CONFLICT (media_id) DO UPDATE SET hash = EXCLUDED.hash;
+
+-- name: GetPropertyMediaHashes :many
+SELECT * FROM property_media_hashes WHERE media_id = ANY(sqlc.arg(media_ids)::BIGINT[]);
+
+-- name: GetSimilarPropertyMedia :many
+SELECT property_media.id, property_media.property_id, property_media.url
+FROM property_media_hashes INNER JOIN property_media ON property_media.id = property_media_hashes.media_id
+WHERE 
+  property_media.property_id <> sqlc.arg(property_id) AND
+  bit_count((property_media_hashes.hash # sqlc.arg(hash)::BIGINT)::BIT(64)) <= sqlc.arg(max_distance)::INTEGER
+LIMIT sqlc.arg('limit');
@@ -0,0 +1,58 @@
+-- name: CreateListingPriceHistory :exec
+INSERT INTO listing_price_history (
+  listing_id,
+  unit_id,
+  old_price,
+  new_price
+) VALUES (
+  sqlc.arg(listing_id),
+  sqlc.narg(unit_id),
+  sqlc.arg(old_price),
+  sqlc.arg(new_price)
+);
+
+-- name: GetListingPriceHistory :many
+SELECT * FROM listing_price_history WHERE listing_id = $1 ORDER BY changed_at DESC, id DESC;
+
+-- Prices of the units comparable to the given one: units of the active listings and units rented recently,
+-- in the same district (and ward if given), in properties of the same type, with a close area and the same room counts if given
+-- name: GetRentComparablesStats :one
+WITH comparables AS (
+  SELECT listing_units.price::FLOAT8 AS price
+  FROM listing_units
+    INNER JOIN listings ON listings.id = listing_units.listing_id
+    INNER JOIN units ON units.id = listing_units.unit_id
+    INNER JOIN properties ON properties.id = listings.property_id
+  WHERE
+    listings.active AND
+    listings.expired_at > NOW() AND
+    properties.city = sqlc.arg(city) AND
+    properties.district = sqlc.arg(district) AND
+    (sqlc.narg(ward)::TEXT IS NULL OR properties.ward = sqlc.narg(ward)) AND
+    properties.type = sqlc.arg(type) AND
+    units.area BETWEEN sqlc.arg(min_area) AND sqlc.arg(max_area) AND
+    (sqlc.narg(number_of_bedrooms)::INTEGER IS NULL OR units.number_of_bedrooms = sqlc.narg(number_of_bedrooms)) AND
+    (sqlc.narg(number_of_bathrooms)::INTEGER IS NULL OR units.number_of_bathrooms = sqlc.narg(number_of_bathrooms))
+  UNION ALL
+  SELECT rentals.rental_price::FLOAT8
+  FROM rentals
+    INNER JOIN units ON units.id = rentals.unit_id
+    INNER JOIN properties ON properties.id = rentals.property_id
+  WHERE
+    rentals.created_at > NOW() - INTERVAL '1 day' * sqlc.arg(rented_within_days)::INTEGER AND
+    properties.city = sqlc.arg(city) AND
+    properties.district = sqlc.arg(district) AND
+    (sqlc.narg(ward)::TEXT IS NULL OR properties.ward = sqlc.narg(ward)) AND
+    properties.type = sqlc.arg(type) AND
+    units.area BETWEEN sqlc.arg(min_area) AND sqlc.arg(max_area) AND
+    (sqlc.narg(number_of_bedrooms)::INTEGER IS NULL OR units.number_of_bedrooms = sqlc.narg(number_of_bedrooms)) AND
+    (sqlc.narg(number_of_bathrooms)::INTEGER IS NULL OR units.number_of_bathrooms = sqlc.narg(number_of_bathrooms))
+)
+SELECT
+  count(*)::BIGINT AS samples,
+  coalesce(percentile_cont(0.1) WITHIN GROUP (ORDER BY price), 0)::FLOAT8 AS p10,
+  coalesce(percentile_cont(0.25) WITHIN GROUP (ORDER BY price), 0)::FLOAT8 AS p25,
+  coalesce(percentile_cont(0.5) WITHIN GROUP (ORDER BY price), 0)::FLOAT8 AS median,
+  coalesce(percentile_cont(0.75) WITHIN GROUP (ORDER BY price), 0)::FLOAT8 AS p75,
+  coalesce(percentile_cont(0.9) WITHIN GROUP (ORDER BY price), 0)::FLOAT8 AS p90
+FROM comparables;
@@ -0,0 +1,31 @@
+-- name: CreateListingQuestion :one
+INSERT INTO listing_questions (
+  listing_id,
+  question,
+  type,
+  required,
+  options,
+  position
+) VALUES (
+  sqlc.arg(listing_id),
+  sqlc.arg(question),
+  sqlc.arg(type),
+  sqlc.arg(required),
+  sqlc.arg(options),
+  sqlc.arg(position)
+) RETURNING *;
+
+-- name: GetListingQuestions :many
+SELECT * FROM listing_questions WHERE listing_id = $1 ORDER BY position, id;
+
+-- name: UpdateListingQuestion :execrows
+UPDATE listing_questions SET
+  question = coalesce(sqlc.narg(question), question),
+  required = coalesce(sqlc.narg(required), required),
+  options = coalesce(sqlc.narg(options), options),
+  position = coalesce(sqlc.narg(position), position),
+  updated_at = NOW()
+WHERE id = sqlc.arg(id) AND listing_id = sqlc.arg(listing_id);
+
+-- name: DeleteListingQuestion :execrows
+DELETE FROM listing_questions WHERE id = $1 AND listing_id = $2;
@@ -0,0 +1,18 @@
+-- name: CreateListingRequiredDocument :one
+INSERT INTO listing_required_documents (
+  listing_id,
+  type,
+  mandatory,
+  note
+) VALUES (
+  sqlc.arg(listing_id),
+  sqlc.arg(type),
+  sqlc.arg(mandatory),
+  sqlc.narg(note)
+) RETURNING *;
+
+-- name: GetListingRequiredDocuments :many
+SELECT * FROM listing_required_documents WHERE listing_id = $1 ORDER BY type;
+
+-- name: DeleteListingRequiredDocuments :exec
+DELETE FROM listing_required_documents WHERE listing_id = $1;
@@ -0,0 +1,42 @@
+-- name: UpsertListingDailyStats :exec
+INSERT INTO listing_daily_stats (listing_id, date, priority, impressions, views, contacts, application_links, applications, viewings)
+SELECT s.listing_id, sqlc.arg(date)::DATE, listings.priority, s.impressions, s.views, s.contacts, s.application_links, s.applications, s.viewings
+FROM unnest(
+  sqlc.arg(listing_ids)::UUID[],
+  sqlc.arg(impressions)::BIGINT[],
+  sqlc.arg(views)::BIGINT[],
+  sqlc.arg(contacts)::BIGINT[],
+  sqlc.arg(application_links)::BIGINT[],
+  sqlc.arg(applications)::BIGINT[],
+  sqlc.arg(viewings)::BIGINT[]
+) AS s(listing_id, impressions, views, contacts, application_links, applications, viewings)
+INNER JOIN listings ON listings.id = s.listing_id
+ON CONFLICT (listing_id, date) DO UPDATE SET
+  priority = EXCLUDED.priority,
+  impressions = GREATEST(listing_daily_stats.impressions, EXCLUDED.impressions),
+  views = GREATEST(listing_daily_stats.views, EXCLUDED.views),
+  contacts = GREATEST(listing_daily_stats.contacts, EXCLUDED.contacts),
+  application_links = GREATEST(listing_daily_stats.application_links, EXCLUDED.application_links),
+  applications = GREATEST(listing_daily_stats.applications, EXCLUDED.applications),
+  viewings = GREATEST(listing_daily_stats.viewings, EXCLUDED.viewings);
+
+-- name: GetListingDailyStats :many
+SELECT * FROM listing_daily_stats
+WHERE listing_id = sqlc.arg(listing_id) AND date >= sqlc.arg(start_date) AND date < sqlc.arg(end_date)
+ORDER BY date;
+
+-- name: GetListingStatsByPriority :many
+SELECT
+  priority,
+  COUNT(DISTINCT listing_id)::BIGINT AS listings,
+  COUNT(*)::BIGINT AS listing_days,
+  SUM(impressions)::BIGINT AS impressions,
+  SUM(views)::BIGINT AS views,
+  SUM(contacts)::BIGINT AS contacts,
+  SUM(application_links)::BIGINT AS application_links,
+  SUM(applications)::BIGINT AS applications,
+  SUM(viewings)::BIGINT AS viewings
+FROM listing_daily_stats
+WHERE date >= sqlc.arg(start_date) AND date < sqlc.arg(end_date)
+GROUP BY priority
+ORDER BY priority;
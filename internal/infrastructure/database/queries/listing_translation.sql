@@ -0,0 +1,25 @@
+-- name: UpsertListingTranslation :one
+INSERT INTO listing_translations (
+  listing_id,
+  language,
+  title,
+  description
+) VALUES (
+  sqlc.arg(listing_id),
+  sqlc.arg(language),
+  sqlc.arg(title),
+  sqlc.arg(description)
+) ON CONFLICT (listing_id, language) DO UPDATE SET
+  title = EXCLUDED.title,
+  description = EXCLUDED.description,
+  updated_at = NOW()
+RETURNING *;
+
+-- name: GetListingTranslations :many
+SELECT * FROM listing_translations WHERE listing_id = $1 ORDER BY language;
+
+-- name: GetListingsTranslations :many
+SELECT * FROM listing_translations WHERE listing_id = ANY(sqlc.arg(listing_ids)::UUID[]) AND language = sqlc.arg(language);
+
+-- name: DeleteListingTranslation :execrows
+DELETE FROM listing_translations WHERE listing_id = $1 AND language = $2;
@@ -0,0 +1,97 @@
+-- name: CreateListingViewingSlot :one
+INSERT INTO listing_viewing_slots (
+  listing_id,
+  manager_id,
+  start_at,
+  end_at,
+  capacity,
+  open_house,
+  series_id,
+  note
+) VALUES (
+  sqlc.arg(listing_id),
+  sqlc.arg(manager_id),
+  sqlc.arg(start_at),
+  sqlc.arg(end_at),
+  sqlc.arg(capacity),
+  sqlc.arg(open_house),
+  sqlc.narg(series_id),
+  sqlc.narg(note)
+) RETURNING *;
+
+-- Serializes the creation of the slots of a manager, so that concurrent slots cannot overlap
+-- name: LockViewingManager :exec
+SELECT pg_advisory_xact_lock(hashtext(sqlc.arg(manager_id)::TEXT));
+
+-- name: CheckOverlappingViewingSlot :one
+SELECT EXISTS (
+  SELECT 1 FROM listing_viewing_slots
+  WHERE manager_id = sqlc.arg(manager_id) AND start_at < sqlc.arg(end_at) AND end_at > sqlc.arg(start_at)
+);
+
+-- name: GetListingViewingSlot :one
+SELECT * FROM listing_viewing_slots WHERE id = $1 LIMIT 1;
+
+-- name: GetListingViewingSlotForUpdate :one
+SELECT * FROM listing_viewing_slots WHERE id = $1 LIMIT 1 FOR UPDATE;
+
+-- name: GetListingViewingSlots :many
+SELECT * FROM listing_viewing_slots
+WHERE listing_id = sqlc.arg(listing_id) AND end_at > sqlc.arg(after)
+ORDER BY start_at;
+
+-- name: GetListingViewingSlotsByIds :many
+SELECT * FROM listing_viewing_slots WHERE id = ANY(sqlc.arg(ids)::BIGINT[]);
+
+-- name: UpdateListingViewingSlotBooked :exec
+UPDATE listing_viewing_slots SET booked = booked + sqlc.arg(delta)::INTEGER WHERE id = sqlc.arg(id);
+
+-- name: SetListingViewingSlotReminder :exec
+UPDATE listing_viewing_slots SET reminder_id = $2 WHERE id = $1;
+
+-- name: DeleteListingViewingSlot :exec
+DELETE FROM listing_viewing_slots WHERE id = $1;
+
+-- name: CreateListingViewing :one
+INSERT INTO listing_viewings (
+  slot_id,
+  tenant_id,
+  note
+) VALUES (
+  sqlc.arg(slot_id),
+  sqlc.arg(tenant_id),
+  sqlc.narg(note)
+) RETURNING *;
+
+-- name: GetListingViewing :one
+SELECT * FROM listing_viewings WHERE id = $1 LIMIT 1;
+
+-- name: GetListingViewingsOfSlot :many
+SELECT * FROM listing_viewings WHERE slot_id = $1 AND status <> 'CANCELED' ORDER BY id;
+
+-- name: GetListingViewingsOfListing :many
+SELECT listing_viewings.* FROM listing_viewings INNER JOIN listing_viewing_slots ON listing_viewing_slots.id = listing_viewings.slot_id
+WHERE listing_viewing_slots.listing_id = $1
+ORDER BY listing_viewing_slots.start_at DESC, listing_viewings.id;
+
+-- name: GetListingViewingsOfTenant :many
+SELECT * FROM listing_viewings WHERE tenant_id = $1 ORDER BY created_at DESC;
+
+-- name: UpdateListingViewingStatus :execrows
+UPDATE listing_viewings SET
+  status = sqlc.arg(status),
+  updated_at = NOW()
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(from_status);
+
+-- name: GetListingViewingsToRemind :many
+SELECT listing_viewings.id FROM listing_viewings INNER JOIN listing_viewing_slots ON listing_viewing_slots.id = listing_viewings.slot_id
+WHERE
+  listing_viewings.status = 'BOOKED' AND
+  listing_viewings.reminded_at IS NULL AND
+  listing_viewing_slots.start_at > NOW() AND
+  listing_viewing_slots.start_at <= NOW() + INTERVAL '1 hour' * sqlc.arg(hours)::INTEGER
+ORDER BY listing_viewing_slots.start_at
+LIMIT sqlc.arg(lim);
+
+-- name: MarkListingViewingReminded :exec
+UPDATE listing_viewings SET reminded_at = NOW() WHERE id = $1;
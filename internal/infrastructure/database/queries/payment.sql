@@ -48,8 +48,56 @@ UPDATE "payments" SET
   order_info = coalesce(sqlc.narg(order_info), order_info),
   amount = coalesce(sqlc.narg(amount), amount),
   status = coalesce(sqlc.narg(status), status),
+  transaction_date = coalesce(sqlc.narg(transaction_date), transaction_date),
   updated_at = NOW()
 WHERE "id" = $1;
 
 -- name: DeletePayment :exec
 DELETE FROM "payments" WHERE "id" = $1;
+
+-- name: GetPaymentByOrderId :one
+SELECT * FROM "payments" WHERE "order_id" = $1 AND "status" = 'SUCCESS' ORDER BY "updated_at" DESC LIMIT 1;
+
+-- name: CreatePaymentRefund :one
+INSERT INTO "payment_refunds" (
+  "payment_id",
+  "amount",
+  "reason",
+  "created_by",
+  "created_at"
+) VALUES (
+  sqlc.arg(payment_id),
+  sqlc.arg(amount),
+  sqlc.arg(reason),
+  sqlc.arg(created_by),
+  NOW()
+) RETURNING *;
+
+-- name: GetPaymentRefunds :many
+SELECT * FROM "payment_refunds" WHERE "payment_id" = $1 ORDER BY "created_at";
+
+-- name: UpsertPaymentToken :one
+INSERT INTO "payment_tokens" (
+  "user_id",
+  "token",
+  "card_number",
+  "bank_code",
+  "created_at"
+) VALUES (
+  sqlc.arg(user_id),
+  sqlc.arg(token),
+  sqlc.narg(card_number),
+  sqlc.narg(bank_code),
+  NOW()
+) ON CONFLICT ("user_id") DO UPDATE SET
+  "token" = EXCLUDED."token",
+  "card_number" = EXCLUDED."card_number",
+  "bank_code" = EXCLUDED."bank_code",
+  "created_at" = EXCLUDED."created_at"
+RETURNING *;
+
+-- name: GetPaymentToken :one
+SELECT * FROM "payment_tokens" WHERE "user_id" = $1;
+
+-- name: DeletePaymentToken :exec
+DELETE FROM "payment_tokens" WHERE "user_id" = $1;
@@ -246,4 +246,139 @@ UPDATE "property_verification_requests" SET
   "feedback" = coalesce(sqlc.narg(feedback), feedback),
   "status" = coalesce(sqlc.narg(status), status),
   "updated_at" = NOW()
-WHERE "id" = $1;
\ No newline at end of file
+WHERE "id" = $1;
+
+-- name: CreatePropertyService :one
+INSERT INTO "property_services" (
+  "property_id",
+  "type",
+  "name",
+  "setup_by",
+  "provider",
+  "default_price",
+  "billing_frequency",
+  "created_at",
+  "updated_at"
+) VALUES (
+  sqlc.arg(property_id),
+  sqlc.arg(type),
+  sqlc.arg(name),
+  sqlc.arg(setup_by),
+  sqlc.narg(provider),
+  sqlc.narg(default_price),
+  sqlc.arg(billing_frequency),
+  NOW(),
+  NOW()
+) RETURNING *;
+
+-- name: GetPropertyService :one
+SELECT * FROM "property_services" WHERE "id" = $1 LIMIT 1;
+
+-- name: GetPropertyServices :many
+SELECT * FROM "property_services" WHERE "property_id" = $1 ORDER BY "id";
+
+-- name: UpdatePropertyService :exec
+UPDATE "property_services" SET
+  "type" = coalesce(sqlc.narg(type), type),
+  "name" = coalesce(sqlc.narg(name), name),
+  "setup_by" = coalesce(sqlc.narg(setup_by), setup_by),
+  "provider" = coalesce(sqlc.narg(provider), provider),
+  "default_price" = coalesce(sqlc.narg(default_price), default_price),
+  "billing_frequency" = coalesce(sqlc.narg(billing_frequency), billing_frequency),
+  "updated_at" = NOW()
+WHERE "id" = $1;
+
+-- name: DeletePropertyService :exec
+DELETE FROM "property_services" WHERE "id" = $1;
+
+-- name: GetPropertyServiceSubscribers :many
+SELECT "rental_services"."id", "rental_services"."rental_id" FROM "rental_services" INNER JOIN "rentals" ON "rentals"."id" = "rental_services"."rental_id"
+WHERE "rental_services"."catalog_service_id" = $1 AND "rentals"."status" = 'INPROGRESS';
+
+-- name: CreatePropertyServicePriceChange :one
+INSERT INTO "property_service_price_changes" (
+  "service_id",
+  "creator_id",
+  "old_price",
+  "new_price",
+  "old_billing_frequency",
+  "new_billing_frequency",
+  "created_at",
+  "updated_at"
+) VALUES (
+  sqlc.arg(service_id),
+  sqlc.arg(creator_id),
+  sqlc.narg(old_price),
+  sqlc.arg(new_price),
+  sqlc.arg(old_billing_frequency),
+  sqlc.arg(new_billing_frequency),
+  NOW(),
+  NOW()
+) RETURNING *;
+
+-- name: GetPropertyServicePriceChange :one
+SELECT * FROM "property_service_price_changes" WHERE "id" = $1 LIMIT 1;
+
+-- name: GetPropertyServicePriceChanges :many
+SELECT * FROM "property_service_price_changes" WHERE "service_id" = $1 ORDER BY "created_at" DESC;
+
+-- name: UpdatePropertyServicePriceChangeStatus :exec
+UPDATE "property_service_price_changes" SET
+  "status" = sqlc.arg(status),
+  "updated_at" = NOW()
+WHERE "id" = $1;
+
+-- Supersede older pending price changes of a catalog service when a new one is recorded
+-- name: DismissPendingPropertyServicePriceChanges :exec
+UPDATE "property_service_price_changes" SET
+  "status" = 'DISMISSED',
+  "updated_at" = NOW()
+WHERE "service_id" = $1 AND "status" = 'PENDING';
+
+-- name: UpdateSubscribedRentalServicesPrice :many
+UPDATE "rental_services" SET "price" = sqlc.arg(price)
+FROM "rentals"
+WHERE "rentals"."id" = "rental_services"."rental_id" AND "rental_services"."catalog_service_id" = sqlc.arg(service_id) AND "rentals"."status" = 'INPROGRESS'
+RETURNING "rental_services"."id", "rental_services"."rental_id";
+
+-- Re-price the planned (not yet issued) payments of a rental service
+-- name: UpdatePlannedRentalServicePayments :exec
+UPDATE "rental_payments" SET
+  "amount" = calculate_rental_fee("start_date", "end_date", 1, sqlc.arg(price)::REAL),
+  "updated_at" = NOW()
+WHERE "rental_id" = sqlc.arg(rental_id) AND "status" = 'PLAN'
+  -- the code of a service payment is <rental id>_SERVICE_<rental service id>_<period>
+  AND split_part("code", '_', 2) = 'SERVICE' AND split_part("code", '_', 3) = sqlc.arg(rental_service_id)::BIGINT::TEXT;
+
+-- name: CreatePropertyExpense :one
+INSERT INTO "property_expenses" (
+  "property_id",
+  "creator_id",
+  "category",
+  "amount",
+  "incurred_at",
+  "note",
+  "created_at"
+) VALUES (
+  sqlc.arg(property_id),
+  sqlc.arg(creator_id),
+  sqlc.arg(category),
+  sqlc.arg(amount),
+  sqlc.arg(incurred_at),
+  sqlc.narg(note),
+  NOW()
+) RETURNING *;
+
+-- name: GetPropertyExpense :one
+SELECT * FROM "property_expenses" WHERE "id" = $1 LIMIT 1;
+
+-- name: GetPropertyExpenses :many
+SELECT * FROM "property_expenses" 
+WHERE 
+  "property_id" = sqlc.arg(property_id) AND
+  "incurred_at" >= sqlc.arg(start_date) AND
+  "incurred_at" < sqlc.arg(end_date)
+ORDER BY "incurred_at" DESC, "id" DESC;
+
+-- name: DeletePropertyExpense :exec
+DELETE FROM "property_expenses" WHERE "id" = $1;
@@ -143,13 +143,15 @@ INSERT INTO "rental_services" (
   "name",
   "setup_by",
   "provider",
-  "price"
+  "price",
+  "catalog_service_id"
 ) VALUES (
   sqlc.arg(rental_id),
   sqlc.arg(name),
   sqlc.arg(setup_by),
   sqlc.narg(provider),
-  sqlc.narg(price)
+  sqlc.narg(price),
+  sqlc.narg(catalog_service_id)
 ) RETURNING *;
 
 -- name: CreateRentalPolicy :one
@@ -420,4 +422,27 @@ WHERE
         property_managers.property_id = prerentals.property_id 
         AND property_managers.manager_id = sqlc.arg(user_id)
       )
-  );
\ No newline at end of file
+  );
+
+-- name: GetExpiringRentals :many
+SELECT id, EXISTS (SELECT 1 FROM rental_expiry_notices WHERE rental_expiry_notices.rental_id = rentals.id) AS notified
+FROM rentals
+WHERE
+  status = 'INPROGRESS'
+  AND (start_date + INTERVAL '1 month' * rental_period)::DATE >= CURRENT_DATE
+  AND (start_date + INTERVAL '1 month' * rental_period)::DATE <= CURRENT_DATE + sqlc.arg(days_before)::INTEGER
+  AND NOT EXISTS (
+    SELECT 1 FROM rental_expiry_notices
+    WHERE
+      rental_expiry_notices.rental_id = rentals.id
+      AND rental_expiry_notices.days_before <= sqlc.arg(days_before)::INTEGER
+  );
+
+-- name: CreateRentalExpiryNotice :exec
+INSERT INTO rental_expiry_notices (
+  rental_id,
+  days_before
+) VALUES (
+  sqlc.arg(rental_id),
+  sqlc.arg(days_before)
+) ON CONFLICT DO NOTHING;
@@ -0,0 +1,139 @@
+-- name: GetRentRoll :many
+SELECT 
+  units.id AS unit_id, units.name AS unit_name, properties.id AS property_id, properties.name AS property_name, 
+  r.id AS rental_id, r.tenant_name, r.start_date, r.rental_period, r.rental_price, 
+  COALESCE(b.balance, 0)::REAL AS balance, COALESCE(b.deposit, 0)::REAL AS deposit
+FROM units 
+  INNER JOIN properties ON properties.id = units.property_id
+  LEFT JOIN LATERAL (
+    SELECT rentals.id, rentals.tenant_name, rentals.start_date, rentals.rental_period, rentals.rental_price FROM rentals 
+    WHERE rentals.unit_id = units.id AND rentals.status = 'INPROGRESS'
+    ORDER BY rentals.start_date DESC
+    LIMIT 1
+  ) AS r ON TRUE
+  LEFT JOIN LATERAL (
+    SELECT 
+      SUM(amount - COALESCE(discount, 0) + COALESCE(fine, 0) - paid) FILTER (WHERE status IN ('ISSUED', 'PENDING', 'REQUEST2PAY', 'PARTIALLYPAID', 'PAYFINE')) AS balance,
+      SUM(paid) FILTER (WHERE split_part(code, '_', 2) = 'DEPOSIT') AS deposit
+    FROM rental_payments WHERE rental_payments.rental_id = r.id
+  ) AS b ON TRUE
+WHERE 
+  EXISTS (
+    SELECT 1 FROM property_managers WHERE manager_id = sqlc.arg(manager_id) AND property_managers.property_id = units.property_id
+  ) AND
+  (sqlc.narg(property_id)::UUID IS NULL OR units.property_id = sqlc.narg(property_id)::UUID)
+ORDER BY properties.name, units.name
+;
+
+-- name: GetOwnerStatementIncomes :many
+SELECT split_part(rental_payments.code, '_', 2)::TEXT AS category, COALESCE(SUM(rental_payments.paid), 0)::REAL AS amount
+FROM rental_payments INNER JOIN rentals ON rentals.id = rental_payments.rental_id
+WHERE 
+  rentals.property_id = sqlc.arg(property_id) AND
+  rental_payments.status IN ('PAID', 'PARTIALLYPAID') AND
+  split_part(rental_payments.code, '_', 2) <> 'DEPOSIT' AND
+  rental_payments.payment_date >= sqlc.arg(start_date) AND
+  rental_payments.payment_date < sqlc.arg(end_date)
+GROUP BY category
+ORDER BY category
+;
+
+-- name: GetOwnerStatementExpenses :many
+SELECT category, COALESCE(SUM(amount), 0)::REAL AS amount
+FROM property_expenses
+WHERE 
+  property_id = sqlc.arg(property_id) AND
+  incurred_at >= sqlc.arg(start_date) AND
+  incurred_at < sqlc.arg(end_date)
+GROUP BY category
+ORDER BY category
+;
+
+-- name: CreateReportSchedule :one
+INSERT INTO "report_schedules" (
+  "user_id",
+  "property_id",
+  "type",
+  "format",
+  "management_fee_rate",
+  "recipients",
+  "created_at"
+) VALUES (
+  sqlc.arg(user_id),
+  sqlc.narg(property_id),
+  sqlc.arg(type),
+  sqlc.arg(format),
+  sqlc.arg(management_fee_rate),
+  sqlc.arg(recipients),
+  NOW()
+) RETURNING *;
+
+-- name: GetReportSchedule :one
+SELECT * FROM "report_schedules" WHERE "id" = $1 LIMIT 1;
+
+-- name: GetReportSchedulesOfUser :many
+SELECT * FROM "report_schedules" WHERE "user_id" = $1 ORDER BY "created_at" DESC;
+
+-- name: GetDueReportSchedules :many
+SELECT * FROM "report_schedules" 
+WHERE 
+  "last_sent_at" IS NULL OR 
+  DATE_TRUNC('month', "last_sent_at") < DATE_TRUNC('month', NOW())
+ORDER BY "id";
+
+-- name: UpdateReportScheduleLastSentAt :exec
+UPDATE "report_schedules" SET "last_sent_at" = NOW() WHERE "id" = $1;
+
+-- name: DeleteReportSchedule :exec
+DELETE FROM "report_schedules" WHERE "id" = $1;
+
+-- name: GetAccountingRentalPayments :many
+SELECT 
+  rental_payments.id, rental_payments.code, split_part(rental_payments.code, '_', 2)::TEXT AS category, rental_payments.paid, rental_payments.payment_date, 
+  rentals.property_id, properties.name AS property_name, rentals.tenant_name
+FROM rental_payments 
+  INNER JOIN rentals ON rentals.id = rental_payments.rental_id
+  INNER JOIN properties ON properties.id = rentals.property_id
+WHERE 
+  EXISTS (
+    SELECT 1 FROM property_managers WHERE manager_id = sqlc.arg(manager_id) AND property_managers.property_id = rentals.property_id
+  ) AND
+  (sqlc.narg(property_id)::UUID IS NULL OR rentals.property_id = sqlc.narg(property_id)::UUID) AND
+  rental_payments.status IN ('PAID', 'PARTIALLYPAID') AND
+  rental_payments.paid > 0 AND
+  rental_payments.payment_date >= sqlc.arg(start_date) AND
+  rental_payments.payment_date < sqlc.arg(end_date)
+ORDER BY rental_payments.payment_date, rental_payments.id
+;
+
+-- name: GetAccountingListingPayments :many
+SELECT 
+  payments.id, payments.order_id, payments.order_info, payments.amount, payments.updated_at, 
+  listings.property_id, properties.name AS property_name
+FROM payments
+  LEFT JOIN listings ON listings.id::TEXT = split_part(split_part(substr(payments.order_info, 2), ']', 1), '_', 2)
+  LEFT JOIN properties ON properties.id = listings.property_id
+WHERE 
+  payments.user_id = sqlc.arg(user_id) AND
+  payments.status = 'SUCCESS' AND
+  (sqlc.narg(property_id)::UUID IS NULL OR listings.property_id = sqlc.narg(property_id)::UUID) AND
+  payments.updated_at >= sqlc.arg(start_time) AND
+  payments.updated_at < sqlc.arg(end_time)
+ORDER BY payments.updated_at, payments.id
+;
+
+-- name: GetAccountingRefunds :many
+SELECT 
+  payment_refunds.id, payment_refunds.payment_id, payment_refunds.amount, payment_refunds.reason, payment_refunds.created_at, 
+  payments.order_id, listings.property_id, properties.name AS property_name
+FROM payment_refunds
+  INNER JOIN payments ON payments.id = payment_refunds.payment_id
+  LEFT JOIN listings ON listings.id::TEXT = split_part(split_part(substr(payments.order_info, 2), ']', 1), '_', 2)
+  LEFT JOIN properties ON properties.id = listings.property_id
+WHERE 
+  payments.user_id = sqlc.arg(user_id) AND
+  (sqlc.narg(property_id)::UUID IS NULL OR listings.property_id = sqlc.narg(property_id)::UUID) AND
+  payment_refunds.created_at >= sqlc.arg(start_time) AND
+  payment_refunds.created_at < sqlc.arg(end_time)
+ORDER BY payment_refunds.created_at, payment_refunds.id
+;
@@ -0,0 +1,52 @@
+-- name: CreateSavedSearch :one
+INSERT INTO saved_searches (
+  user_id,
+  name,
+  query,
+  frequency
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetSavedSearch :one
+SELECT * FROM saved_searches WHERE id = $1 LIMIT 1;
+
+-- name: GetSavedSearchesOfUser :many
+SELECT * FROM saved_searches WHERE user_id = $1 ORDER BY created_at DESC;
+
+-- name: GetActiveSavedSearchesAfter :many
+SELECT * FROM saved_searches WHERE active AND id > $1 ORDER BY id LIMIT $2;
+
+-- name: UpdateSavedSearch :exec
+UPDATE saved_searches SET
+  name = coalesce(sqlc.narg(name), name),
+  query = coalesce(sqlc.narg(query), query),
+  frequency = coalesce(sqlc.narg(frequency), frequency),
+  active = coalesce(sqlc.narg(active), active),
+  updated_at = NOW()
+WHERE id = sqlc.arg(id);
+
+-- name: UpdateSavedSearchLastCheckedAt :exec
+UPDATE saved_searches SET last_checked_at = $2 WHERE id = $1;
+
+-- name: DeleteSavedSearch :exec
+DELETE FROM saved_searches WHERE id = $1;
+
+-- name: CreateSavedSearchMatches :many
+INSERT INTO saved_search_matches (user_id, listing_id, saved_search_id)
+SELECT sqlc.arg(user_id)::UUID, unnest(sqlc.arg(listing_ids)::UUID[]), sqlc.arg(saved_search_id)::BIGINT
+ON CONFLICT (user_id, listing_id) DO NOTHING
+RETURNING listing_id;
+
+-- name: GetPendingSavedSearchMatches :many
+SELECT saved_search_matches.saved_search_id, saved_search_matches.listing_id
+FROM saved_search_matches INNER JOIN saved_searches ON saved_searches.id = saved_search_matches.saved_search_id
+WHERE saved_search_matches.notified_at IS NULL AND saved_searches.active AND saved_searches.frequency = $1
+ORDER BY saved_search_matches.saved_search_id, saved_search_matches.created_at;
+
+-- name: MarkSavedSearchMatchesNotified :exec
+UPDATE saved_search_matches SET notified_at = NOW()
+WHERE saved_search_id = sqlc.arg(saved_search_id)::BIGINT AND listing_id = ANY(sqlc.arg(listing_ids)::UUID[]);
+
+-- name: UpdateSavedSearchLastSentAt :exec
+UPDATE saved_searches SET last_sent_at = NOW() WHERE id = $1;
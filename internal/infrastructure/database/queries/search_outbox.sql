@@ -0,0 +1,73 @@
+-- name: CreateSearchOutboxEvent :exec
+INSERT INTO search_outbox (
+  listing_id,
+  source
+) VALUES (
+  $1, $2
+);
+
+-- name: CreatePropertySearchOutboxEvents :exec
+INSERT INTO search_outbox (listing_id, source)
+SELECT listings.id, sqlc.arg(source)::"SEARCHOUTBOXSOURCE" FROM listings WHERE listings.property_id = sqlc.arg(property_id);
+
+-- name: CreateUnitSearchOutboxEvents :exec
+INSERT INTO search_outbox (listing_id, source)
+SELECT DISTINCT listing_units.listing_id, sqlc.arg(source)::"SEARCHOUTBOXSOURCE" FROM listing_units WHERE listing_units.unit_id = sqlc.arg(unit_id);
+
+-- name: CreateVerificationSearchOutboxEvents :exec
+INSERT INTO search_outbox (listing_id, source)
+SELECT listings.id, 'VERIFICATION' FROM listings WHERE listings.property_id = (
+  SELECT property_id FROM property_verification_requests WHERE property_verification_requests.id = $1
+);
+
+-- name: ClaimSearchOutboxEvents :many
+UPDATE search_outbox SET next_attempt_at = NOW() + INTERVAL '5 minutes'
+WHERE id IN (
+  SELECT id FROM search_outbox
+  WHERE processed_at IS NULL AND dead_at IS NULL AND next_attempt_at <= NOW()
+  ORDER BY id
+  LIMIT $1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkSearchOutboxEventsProcessed :exec
+UPDATE search_outbox SET processed_at = NOW(), last_error = NULL WHERE id = ANY(sqlc.arg(ids)::BIGINT[]);
+
+-- name: FailSearchOutboxEvents :exec
+UPDATE search_outbox SET
+  attempts = attempts + 1,
+  last_error = sqlc.arg(last_error),
+  next_attempt_at = NOW() + make_interval(secs => LEAST(power(2, attempts + 1), 3600)),
+  dead_at = CASE WHEN attempts + 1 >= sqlc.arg(max_attempts)::INTEGER THEN NOW() ELSE NULL END
+WHERE id = ANY(sqlc.arg(ids)::BIGINT[]);
+
+-- name: GetSearchOutboxStats :one
+SELECT
+  count(*) FILTER (WHERE processed_at IS NULL AND dead_at IS NULL) AS pending,
+  count(*) FILTER (WHERE dead_at IS NOT NULL) AS dead,
+  COALESCE(min(created_at) FILTER (WHERE processed_at IS NULL AND dead_at IS NULL), NOW())::TIMESTAMPTZ AS oldest_pending_at
+FROM search_outbox;
+
+-- name: ReplayDeadSearchOutboxEvents :execrows
+UPDATE search_outbox SET
+  attempts = 0,
+  last_error = NULL,
+  next_attempt_at = NOW(),
+  dead_at = NULL
+WHERE dead_at IS NOT NULL;
+
+-- name: ReplaySearchOutboxEventsSince :execrows
+UPDATE search_outbox SET
+  attempts = 0,
+  last_error = NULL,
+  next_attempt_at = NOW(),
+  processed_at = NULL,
+  dead_at = NULL
+WHERE created_at >= $1;
+
+-- name: PurgeSearchOutboxEvents :execrows
+DELETE FROM search_outbox WHERE processed_at < $1;
+
+-- name: CountSearchOutboxEventsSince :one
+SELECT count(*) FROM search_outbox WHERE created_at >= $1;
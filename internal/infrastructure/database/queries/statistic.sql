@@ -289,4 +289,19 @@ FROM (
     FROM rental_minors
     GROUP BY rental_id
   ) rm ON rs.id = rm.rental_id
-) AS counts;
\ No newline at end of file
+) AS counts;
+
+-- name: GetOverdueRentals :many
+SELECT id, property_id, unit_id, tenant_id, tenant_name, start_date, rental_period, (start_date + INTERVAL '1 month' * rental_period)::DATE AS end_date, (CURRENT_DATE - (start_date + INTERVAL '1 month' * rental_period)::DATE) AS overdue_days
+FROM rentals
+WHERE
+  status = 'INPROGRESS' AND
+  EXISTS (
+    SELECT 1 FROM property_managers WHERE manager_id = $1 AND property_managers.property_id = rentals.property_id
+  ) AND
+  (start_date + INTERVAL '1 month' * rental_period)::DATE < CURRENT_DATE
+ORDER BY
+  end_date ASC
+LIMIT $2
+OFFSET $3
+;
@@ -102,3 +102,35 @@ DELETE FROM unit_media WHERE unit_id = $1 AND id = $2;
 
 -- name: DeleteUnitAmenity :exec
 DELETE FROM unit_amenities WHERE unit_id = $1 AND amenity_id = $2;
+
+-- name: CreateUnitMaintenanceBlock :one
+INSERT INTO "unit_maintenance_blocks" (
+  "unit_id",
+  "creator_id",
+  "start_date",
+  "end_date",
+  "reason"
+) VALUES (
+  sqlc.arg(unit_id),
+  sqlc.arg(creator_id),
+  sqlc.arg(start_date),
+  sqlc.arg(end_date),
+  sqlc.narg(reason)
+) RETURNING *;
+
+-- name: GetUnitMaintenanceBlock :one
+SELECT * FROM "unit_maintenance_blocks" WHERE "id" = $1 LIMIT 1;
+
+-- name: GetUnitMaintenanceBlocks :many
+SELECT * FROM "unit_maintenance_blocks" WHERE "unit_id" = $1 ORDER BY "start_date";
+
+-- name: DeleteUnitMaintenanceBlock :exec
+DELETE FROM "unit_maintenance_blocks" WHERE "id" = $1;
+
+-- name: GetUnitOccupancies :many
+SELECT * FROM "unit_occupancies"
+WHERE "unit_id" = ANY(sqlc.arg(unit_ids)::UUID[]) AND "start_date" < sqlc.arg(to_date) AND "end_date" > sqlc.arg(from_date)
+ORDER BY "unit_id", "start_date";
+
+-- name: GetUnitsAvailableFrom :many
+SELECT "id", unit_available_from("id", sqlc.arg(from_date)::DATE)::DATE AS "available_from" FROM "units" WHERE "id" = ANY(sqlc.arg(unit_ids)::UUID[]);
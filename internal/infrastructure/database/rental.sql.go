@@ -560,6 +560,26 @@ func (q *Queries) CreateRentalCoap(ctx context.Context, arg CreateRentalCoapPara
 	return i, err
 }
 
+const createRentalExpiryNotice = `-- name: CreateRentalExpiryNotice :exec
+INSERT INTO rental_expiry_notices (
+  rental_id,
+  days_before
+) VALUES (
+  $1,
+  $2
+) ON CONFLICT DO NOTHING
+`
+
+type CreateRentalExpiryNoticeParams struct {
+	RentalID   int64 `json:"rental_id"`
+	DaysBefore int32 `json:"days_before"`
+}
+
+func (q *Queries) CreateRentalExpiryNotice(ctx context.Context, arg CreateRentalExpiryNoticeParams) error {
+	_, err := q.db.Exec(ctx, createRentalExpiryNotice, arg.RentalID, arg.DaysBefore)
+	return err
+}
+
 const createRentalMinor = `-- name: CreateRentalMinor :one
 INSERT INTO "rental_minors" (
   "rental_id",
@@ -677,22 +697,25 @@ INSERT INTO "rental_services" (
   "name",
   "setup_by",
   "provider",
-  "price"
+  "price",
+  "catalog_service_id"
 ) VALUES (
   $1,
   $2,
   $3,
   $4,
-  $5
-) RETURNING id, rental_id, name, setup_by, provider, price
+  $5,
+  $6
+) RETURNING id, rental_id, name, setup_by, provider, price, catalog_service_id
 `
 
 type CreateRentalServiceParams struct {
-	RentalID int64         `json:"rental_id"`
-	Name     string        `json:"name"`
-	SetupBy  string        `json:"setup_by"`
-	Provider pgtype.Text   `json:"provider"`
-	Price    pgtype.Float4 `json:"price"`
+	RentalID         int64         `json:"rental_id"`
+	Name             string        `json:"name"`
+	SetupBy          string        `json:"setup_by"`
+	Provider         pgtype.Text   `json:"provider"`
+	Price            pgtype.Float4 `json:"price"`
+	CatalogServiceID pgtype.Int8   `json:"catalog_service_id"`
 }
 
 func (q *Queries) CreateRentalService(ctx context.Context, arg CreateRentalServiceParams) (RentalService, error) {
@@ -702,6 +725,7 @@ func (q *Queries) CreateRentalService(ctx context.Context, arg CreateRentalServi
 		arg.SetupBy,
 		arg.Provider,
 		arg.Price,
+		arg.CatalogServiceID,
 	)
 	var i RentalService
 	err := row.Scan(
@@ -711,6 +735,7 @@ func (q *Queries) CreateRentalService(ctx context.Context, arg CreateRentalServi
 		&i.SetupBy,
 		&i.Provider,
 		&i.Price,
+		&i.CatalogServiceID,
 	)
 	return i, err
 }
@@ -733,6 +758,46 @@ func (q *Queries) DeleteRental(ctx context.Context, id int64) error {
 	return err
 }
 
+const getExpiringRentals = `-- name: GetExpiringRentals :many
+SELECT id, EXISTS (SELECT 1 FROM rental_expiry_notices WHERE rental_expiry_notices.rental_id = rentals.id) AS notified
+FROM rentals
+WHERE
+  status = 'INPROGRESS'
+  AND (start_date + INTERVAL '1 month' * rental_period)::DATE >= CURRENT_DATE
+  AND (start_date + INTERVAL '1 month' * rental_period)::DATE <= CURRENT_DATE + $1::INTEGER
+  AND NOT EXISTS (
+    SELECT 1 FROM rental_expiry_notices
+    WHERE
+      rental_expiry_notices.rental_id = rentals.id
+      AND rental_expiry_notices.days_before <= $1::INTEGER
+  )
+`
+
+type GetExpiringRentalsRow struct {
+	ID       int64 `json:"id"`
+	Notified bool  `json:"notified"`
+}
+
+func (q *Queries) GetExpiringRentals(ctx context.Context, daysBefore int32) ([]GetExpiringRentalsRow, error) {
+	rows, err := q.db.Query(ctx, getExpiringRentals, daysBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetExpiringRentalsRow
+	for rows.Next() {
+		var i GetExpiringRentalsRow
+		if err := rows.Scan(&i.ID, &i.Notified); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getManagedPreRentals = `-- name: GetManagedPreRentals :many
 SELECT id, creator_id, property_id, unit_id, application_id, tenant_id, profile_image, tenant_type, tenant_name, tenant_phone, tenant_email, organization_name, organization_hq_address, start_date, movein_date, rental_period, payment_type, rental_price, rental_payment_basis, rental_intention, notice_period, grace_period, late_payment_penalty_scheme, late_payment_penalty_amount, electricity_setup_by, electricity_payment_type, electricity_customer_code, electricity_provider, electricity_price, water_setup_by, water_payment_type, water_customer_code, water_provider, water_price, note, coaps, minors, pets, services, policies, created_at FROM prerentals WHERE 
 EXISTS (
@@ -1242,7 +1307,7 @@ func (q *Queries) GetRentalPoliciesByRentalID(ctx context.Context, rentalID int6
 }
 
 const getRentalServicesByRentalID = `-- name: GetRentalServicesByRentalID :many
-SELECT id, rental_id, name, setup_by, provider, price FROM rental_services WHERE rental_id = $1
+SELECT id, rental_id, name, setup_by, provider, price, catalog_service_id FROM rental_services WHERE rental_id = $1
 `
 
 func (q *Queries) GetRentalServicesByRentalID(ctx context.Context, rentalID int64) ([]RentalService, error) {
@@ -1261,6 +1326,7 @@ func (q *Queries) GetRentalServicesByRentalID(ctx context.Context, rentalID int6
 			&i.SetupBy,
 			&i.Provider,
 			&i.Price,
+			&i.CatalogServiceID,
 		); err != nil {
 			return nil, err
 		}
@@ -412,6 +412,69 @@ func (q *Queries) GetOccupiedUnits(ctx context.Context, managerID uuid.UUID) ([]
 	return items, nil
 }
 
+const getOverdueRentals = `-- name: GetOverdueRentals :many
+SELECT id, property_id, unit_id, tenant_id, tenant_name, start_date, rental_period, (start_date + INTERVAL '1 month' * rental_period)::DATE AS end_date, (CURRENT_DATE - (start_date + INTERVAL '1 month' * rental_period)::DATE) AS overdue_days
+FROM rentals
+WHERE
+  status = 'INPROGRESS' AND
+  EXISTS (
+    SELECT 1 FROM property_managers WHERE manager_id = $1 AND property_managers.property_id = rentals.property_id
+  ) AND
+  (start_date + INTERVAL '1 month' * rental_period)::DATE < CURRENT_DATE
+ORDER BY
+  end_date ASC
+LIMIT $2
+OFFSET $3
+`
+
+type GetOverdueRentalsParams struct {
+	ManagerID uuid.UUID `json:"manager_id"`
+	Limit     int32     `json:"limit"`
+	Offset    int32     `json:"offset"`
+}
+
+type GetOverdueRentalsRow struct {
+	ID           int64       `json:"id"`
+	PropertyID   uuid.UUID   `json:"property_id"`
+	UnitID       uuid.UUID   `json:"unit_id"`
+	TenantID     pgtype.UUID `json:"tenant_id"`
+	TenantName   string      `json:"tenant_name"`
+	StartDate    pgtype.Date `json:"start_date"`
+	RentalPeriod int32       `json:"rental_period"`
+	EndDate      pgtype.Date `json:"end_date"`
+	OverdueDays  int32       `json:"overdue_days"`
+}
+
+func (q *Queries) GetOverdueRentals(ctx context.Context, arg GetOverdueRentalsParams) ([]GetOverdueRentalsRow, error) {
+	rows, err := q.db.Query(ctx, getOverdueRentals, arg.ManagerID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOverdueRentalsRow
+	for rows.Next() {
+		var i GetOverdueRentalsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PropertyID,
+			&i.UnitID,
+			&i.TenantID,
+			&i.TenantName,
+			&i.StartDate,
+			&i.RentalPeriod,
+			&i.EndDate,
+			&i.OverdueDays,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPaymentsStatistic = `-- name: GetPaymentsStatistic :one
 SELECT coalesce(SUM(amount), 0)::REAL 
 FROM payments 
@@ -149,6 +149,51 @@ func (q *Queries) CreateUnitAmenity(ctx context.Context, arg CreateUnitAmenityPa
 	return i, err
 }
 
+const createUnitMaintenanceBlock = `-- name: CreateUnitMaintenanceBlock :one
+INSERT INTO "unit_maintenance_blocks" (
+  "unit_id",
+  "creator_id",
+  "start_date",
+  "end_date",
+  "reason"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+) RETURNING id, unit_id, creator_id, start_date, end_date, reason, created_at
+`
+
+type CreateUnitMaintenanceBlockParams struct {
+	UnitID    uuid.UUID   `json:"unit_id"`
+	CreatorID uuid.UUID   `json:"creator_id"`
+	StartDate pgtype.Date `json:"start_date"`
+	EndDate   pgtype.Date `json:"end_date"`
+	Reason    pgtype.Text `json:"reason"`
+}
+
+func (q *Queries) CreateUnitMaintenanceBlock(ctx context.Context, arg CreateUnitMaintenanceBlockParams) (UnitMaintenanceBlock, error) {
+	row := q.db.QueryRow(ctx, createUnitMaintenanceBlock,
+		arg.UnitID,
+		arg.CreatorID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.Reason,
+	)
+	var i UnitMaintenanceBlock
+	err := row.Scan(
+		&i.ID,
+		&i.UnitID,
+		&i.CreatorID,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const createUnitMedia = `-- name: CreateUnitMedia :one
 INSERT INTO unit_media (
   unit_id,
@@ -211,6 +256,15 @@ func (q *Queries) DeleteUnitAmenity(ctx context.Context, arg DeleteUnitAmenityPa
 	return err
 }
 
+const deleteUnitMaintenanceBlock = `-- name: DeleteUnitMaintenanceBlock :exec
+DELETE FROM "unit_maintenance_blocks" WHERE "id" = $1
+`
+
+func (q *Queries) DeleteUnitMaintenanceBlock(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteUnitMaintenanceBlock, id)
+	return err
+}
+
 const deleteUnitMedia = `-- name: DeleteUnitMedia :exec
 DELETE FROM unit_media WHERE unit_id = $1 AND id = $2
 `
@@ -299,6 +353,57 @@ func (q *Queries) GetUnitById(ctx context.Context, id uuid.UUID) (Unit, error) {
 	return i, err
 }
 
+const getUnitMaintenanceBlock = `-- name: GetUnitMaintenanceBlock :one
+SELECT id, unit_id, creator_id, start_date, end_date, reason, created_at FROM "unit_maintenance_blocks" WHERE "id" = $1 LIMIT 1
+`
+
+func (q *Queries) GetUnitMaintenanceBlock(ctx context.Context, id int64) (UnitMaintenanceBlock, error) {
+	row := q.db.QueryRow(ctx, getUnitMaintenanceBlock, id)
+	var i UnitMaintenanceBlock
+	err := row.Scan(
+		&i.ID,
+		&i.UnitID,
+		&i.CreatorID,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getUnitMaintenanceBlocks = `-- name: GetUnitMaintenanceBlocks :many
+SELECT id, unit_id, creator_id, start_date, end_date, reason, created_at FROM "unit_maintenance_blocks" WHERE "unit_id" = $1 ORDER BY "start_date"
+`
+
+func (q *Queries) GetUnitMaintenanceBlocks(ctx context.Context, unitID uuid.UUID) ([]UnitMaintenanceBlock, error) {
+	rows, err := q.db.Query(ctx, getUnitMaintenanceBlocks, unitID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UnitMaintenanceBlock
+	for rows.Next() {
+		var i UnitMaintenanceBlock
+		if err := rows.Scan(
+			&i.ID,
+			&i.UnitID,
+			&i.CreatorID,
+			&i.StartDate,
+			&i.EndDate,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUnitManagers = `-- name: GetUnitManagers :many
 SELECT property_id, manager_id, role FROM property_managers WHERE property_id IN (SELECT property_id FROM units WHERE units.id = $1 LIMIT 1)
 `
@@ -353,6 +458,78 @@ func (q *Queries) GetUnitMedia(ctx context.Context, unitID uuid.UUID) ([]UnitMed
 	return items, nil
 }
 
+const getUnitOccupancies = `-- name: GetUnitOccupancies :many
+SELECT type, ref_id, unit_id, start_date, end_date FROM "unit_occupancies"
+WHERE "unit_id" = ANY($1::UUID[]) AND "start_date" < $2 AND "end_date" > $3
+ORDER BY "unit_id", "start_date"
+`
+
+type GetUnitOccupanciesParams struct {
+	UnitIds  []uuid.UUID `json:"unit_ids"`
+	ToDate   pgtype.Date `json:"to_date"`
+	FromDate pgtype.Date `json:"from_date"`
+}
+
+func (q *Queries) GetUnitOccupancies(ctx context.Context, arg GetUnitOccupanciesParams) ([]UnitOccupancy, error) {
+	rows, err := q.db.Query(ctx, getUnitOccupancies, arg.UnitIds, arg.ToDate, arg.FromDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UnitOccupancy
+	for rows.Next() {
+		var i UnitOccupancy
+		if err := rows.Scan(
+			&i.Type,
+			&i.RefID,
+			&i.UnitID,
+			&i.StartDate,
+			&i.EndDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnitsAvailableFrom = `-- name: GetUnitsAvailableFrom :many
+SELECT "id", unit_available_from("id", $1::DATE)::DATE AS "available_from" FROM "units" WHERE "id" = ANY($2::UUID[])
+`
+
+type GetUnitsAvailableFromParams struct {
+	FromDate pgtype.Date `json:"from_date"`
+	UnitIds  []uuid.UUID `json:"unit_ids"`
+}
+
+type GetUnitsAvailableFromRow struct {
+	ID            uuid.UUID   `json:"id"`
+	AvailableFrom pgtype.Date `json:"available_from"`
+}
+
+func (q *Queries) GetUnitsAvailableFrom(ctx context.Context, arg GetUnitsAvailableFromParams) ([]GetUnitsAvailableFromRow, error) {
+	rows, err := q.db.Query(ctx, getUnitsAvailableFrom, arg.FromDate, arg.UnitIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUnitsAvailableFromRow
+	for rows.Next() {
+		var i GetUnitsAvailableFromRow
+		if err := rows.Scan(&i.ID, &i.AvailableFrom); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUnitsOfProperty = `-- name: GetUnitsOfProperty :many
 SELECT id, property_id, name, area, floor, number_of_living_rooms, number_of_bedrooms, number_of_bathrooms, number_of_toilets, number_of_balconies, number_of_kitchens, type, created_at, updated_at FROM units WHERE property_id = $1
 `
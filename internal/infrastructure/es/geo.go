@@ -0,0 +1,18 @@
+package es
+
+// LISTINGLOCATIONFIELD is the geo_point field of the listings index
+const LISTINGLOCATIONFIELD = "location"
+
+// GeoPoint is the object form of an Elasticsearch geo_point
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// NewGeoPoint returns nil when the coordinates are unknown so that the field is left out of the document
+func NewGeoPoint(lat, lng *float64) *GeoPoint {
+	if lat == nil || lng == nil {
+		return nil
+	}
+	return &GeoPoint{Lat: *lat, Lon: *lng}
+}
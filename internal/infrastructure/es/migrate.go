@@ -3,6 +3,8 @@ package es
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 	"os"
 	"path/filepath"
@@ -43,6 +45,48 @@ func createIndex(ctx context.Context, client *elasticsearch.Client, fpath string
 	return nil
 }
 
+// putMapping adds the new fields of the mapping file to an existing index.
+// Fields already mapped cannot be changed this way, they require a reindex.
+func putMapping(ctx context.Context, client *elasticsearch.Client, fpath string, index string) error {
+	fContent, err := os.ReadFile(fpath)
+	if err != nil {
+		return err
+	}
+	var m struct {
+		Mappings json.RawMessage `json:"mappings"`
+	}
+	if err = json.Unmarshal(fContent, &m); err != nil {
+		return err
+	}
+
+	req := esapi.IndicesPutMappingRequest{
+		Index: []string{index},
+		Body:  bytes.NewReader(m.Mappings),
+	}
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return fmt.Errorf("failed to update mapping of index %s: %s", index, res.String())
+	}
+
+	return nil
+}
+
+func indexExists(ctx context.Context, client *elasticsearch.Client, index string) (bool, error) {
+	req := esapi.IndicesExistsRequest{
+		Index: []string{index},
+	}
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+	return res.StatusCode == 200, nil
+}
+
 func CreateMappingIndices(client *elasticsearch.Client) error {
 	// iterate over json files in the directory "./mappings", take the name of the file as the index name
 	// and create the index with the mapping in the file
@@ -55,6 +99,17 @@ func CreateMappingIndices(client *elasticsearch.Client) error {
 		if filepath.Ext(file.Name()) == ".json" {
 			fpath := filepath.Join("./mappings", file.Name())
 			nameWithoutExtension := strings.TrimSuffix(file.Name(), filepath.Ext(file.Name()))
+			exists, err := indexExists(context.Background(), client, nameWithoutExtension)
+			if err != nil {
+				return err
+			}
+			if exists {
+				log.Println("Updating mapping of index", nameWithoutExtension, fpath)
+				if err = putMapping(context.Background(), client, fpath, nameWithoutExtension); err != nil {
+					return err
+				}
+				continue
+			}
 			log.Println("Creating index", nameWithoutExtension, fpath)
 			if err = createIndex(context.Background(), client, fpath, nameWithoutExtension); err != nil {
 				return err
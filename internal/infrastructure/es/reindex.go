@@ -0,0 +1,242 @@
+package es
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"github.com/user2410/rrms-backend/internal/utils"
+)
+
+// VersionedIndexName returns the name of a new physical index behind the alias of the given index
+func VersionedIndexName(index INDICES, t time.Time) string {
+	return fmt.Sprintf("%s_v%s", index, t.UTC().Format("20060102150405"))
+}
+
+// CreateVersionedIndex creates the physical index name with the mapping of the given index
+func CreateVersionedIndex(ctx context.Context, client *elasticsearch.Client, index INDICES, name string) error {
+	fContent, err := os.ReadFile(filepath.Join(utils.GetBasePath(), "internal/infrastructure/es/mappings", string(index)+".json"))
+	if err != nil {
+		return err
+	}
+
+	req := esapi.IndicesCreateRequest{
+		Index: name,
+		Body:  bytes.NewReader(fContent),
+	}
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return fmt.Errorf("failed to create index %s: %s", name, res.String())
+	}
+
+	return nil
+}
+
+// GetAliasIndices returns the physical indices the alias points to, and whether the alias name is itself a concrete index
+func GetAliasIndices(ctx context.Context, client *elasticsearch.Client, alias string) ([]string, bool, error) {
+	req := esapi.IndicesGetAliasRequest{
+		Name: []string{alias},
+	}
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return nil, false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == 404 {
+		concrete, err := indexExists(ctx, client, alias)
+		return nil, concrete, err
+	}
+	if res.IsError() {
+		return nil, false, fmt.Errorf("failed to get alias %s: %s", alias, res.String())
+	}
+
+	var body map[string]json.RawMessage
+	if err = json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, false, err
+	}
+	indices := make([]string, 0, len(body))
+	for index := range body {
+		indices = append(indices, index)
+	}
+	return indices, false, nil
+}
+
+// aliasActions moves the alias to newIndex. A concrete index with the alias name is dropped,
+// because an alias cannot be created while an index of the same name exists.
+func aliasActions(alias, newIndex string, oldIndices []string, concrete bool) []map[string]any {
+	actions := []map[string]any{
+		{"add": map[string]any{"index": newIndex, "alias": alias, "is_write_index": true}},
+	}
+	if concrete {
+		actions = append(actions, map[string]any{"remove_index": map[string]any{"index": alias}})
+	}
+	for _, index := range oldIndices {
+		if index == newIndex {
+			continue
+		}
+		actions = append(actions, map[string]any{"remove": map[string]any{"index": index, "alias": alias}})
+	}
+	return actions
+}
+
+// SwapAlias atomically points the alias to newIndex and returns the indices it was removed from.
+// The old indices are kept so that the swap can be rolled back.
+func SwapAlias(ctx context.Context, client *elasticsearch.Client, alias, newIndex string) ([]string, error) {
+	oldIndices, concrete, err := GetAliasIndices(ctx, client, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"actions": aliasActions(alias, newIndex, oldIndices, concrete),
+	})
+	if err != nil {
+		return nil, err
+	}
+	req := esapi.IndicesUpdateAliasesRequest{
+		Body: bytes.NewReader(body),
+	}
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return nil, fmt.Errorf("failed to point alias %s to %s: %s", alias, newIndex, res.String())
+	}
+
+	return oldIndices, nil
+}
+
+func RefreshIndex(ctx context.Context, client *elasticsearch.Client, index string) error {
+	req := esapi.IndicesRefreshRequest{
+		Index: []string{index},
+	}
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return fmt.Errorf("failed to refresh index %s: %s", index, res.String())
+	}
+	return nil
+}
+
+func CountDocuments(ctx context.Context, client *elasticsearch.Client, index string) (int64, error) {
+	req := esapi.CountRequest{
+		Index: []string{index},
+	}
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return 0, fmt.Errorf("failed to count documents of index %s: %s", index, res.String())
+	}
+
+	var body struct {
+		Count int64 `json:"count"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return 0, err
+	}
+	return body.Count, nil
+}
+
+// MgetField returns the value of a source field of the documents with the given ids, keyed by id.
+// Documents that are not found are left out.
+func MgetField(ctx context.Context, client *elasticsearch.Client, index string, ids []string, field string) (map[string]json.RawMessage, error) {
+	body, err := json.Marshal(map[string]any{"ids": ids})
+	if err != nil {
+		return nil, err
+	}
+	req := esapi.MgetRequest{
+		Index:          index,
+		Body:           bytes.NewReader(body),
+		SourceIncludes: []string{field},
+	}
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return nil, fmt.Errorf("failed to get documents of index %s: %s", index, res.String())
+	}
+
+	var resBody struct {
+		Docs []struct {
+			ID     string                     `json:"_id"`
+			Found  bool                       `json:"found"`
+			Source map[string]json.RawMessage `json:"_source"`
+		} `json:"docs"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&resBody); err != nil {
+		return nil, err
+	}
+	result := make(map[string]json.RawMessage, len(resBody.Docs))
+	for _, doc := range resBody.Docs {
+		if doc.Found {
+			result[doc.ID] = doc.Source[field]
+		}
+	}
+	return result, nil
+}
+
+// SearchIdsAfter pages through the document ids of the index, sorted by the keyword field sortField
+// that holds the document id. Pass the last id of the previous page as after, or "" for the first page.
+func SearchIdsAfter(ctx context.Context, client *elasticsearch.Client, index, sortField, after string, size int) ([]string, error) {
+	query := map[string]any{
+		"size":    size,
+		"_source": false,
+		"sort":    []map[string]string{{sortField: "asc"}},
+	}
+	if after != "" {
+		query["search_after"] = []string{after}
+	}
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+	req := esapi.SearchRequest{
+		Index: []string{index},
+		Body:  bytes.NewReader(body),
+	}
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return nil, fmt.Errorf("failed to search index %s: %s", index, res.String())
+	}
+
+	var resBody struct {
+		Hits struct {
+			Hits []struct {
+				ID string `json:"_id"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&resBody); err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(resBody.Hits.Hits))
+	for _, hit := range resBody.Hits.Hits {
+		ids = append(ids, hit.ID)
+	}
+	return ids, nil
+}
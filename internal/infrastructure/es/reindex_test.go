@@ -0,0 +1,78 @@
+package es
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"github.com/stretchr/testify/require"
+	"github.com/user2410/rrms-backend/internal/utils/random"
+	"github.com/user2410/rrms-backend/internal/utils/types"
+)
+
+func deleteTestIndices(t *testing.T, indices ...string) {
+	req := esapi.IndicesDeleteRequest{
+		Index:             indices,
+		IgnoreUnavailable: types.Ptr(true),
+	}
+	res, err := req.Do(context.Background(), client)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.False(t, res.IsError(), res.String())
+}
+
+func TestSwapAlias(t *testing.T) {
+	ctx := context.Background()
+	alias := "test_" + strings.ToLower(random.RandomAlphabetStr(12))
+	now := time.Now()
+	v1 := strings.Replace(VersionedIndexName(LISTINGINDEX, now), string(LISTINGINDEX), alias, 1)
+	v2 := strings.Replace(VersionedIndexName(LISTINGINDEX, now.Add(time.Second)), string(LISTINGINDEX), alias, 1)
+	t.Cleanup(func() { deleteTestIndices(t, alias, v1, v2) })
+
+	// the alias name is still a concrete index, as before the first reindex
+	require.NoError(t, CreateVersionedIndex(ctx, client, LISTINGINDEX, alias))
+	indices, concrete, err := GetAliasIndices(ctx, client, alias)
+	require.NoError(t, err)
+	require.Empty(t, indices)
+	require.True(t, concrete)
+
+	// the concrete index is replaced by the alias
+	require.NoError(t, CreateVersionedIndex(ctx, client, LISTINGINDEX, v1))
+	old, err := SwapAlias(ctx, client, alias, v1)
+	require.NoError(t, err)
+	require.Empty(t, old)
+	indices, concrete, err = GetAliasIndices(ctx, client, alias)
+	require.NoError(t, err)
+	require.Equal(t, []string{v1}, indices)
+	require.False(t, concrete)
+
+	// the alias moves to the new index, the old one is kept for a rollback
+	require.NoError(t, CreateVersionedIndex(ctx, client, LISTINGINDEX, v2))
+	old, err = SwapAlias(ctx, client, alias, v2)
+	require.NoError(t, err)
+	require.Equal(t, []string{v1}, old)
+	indices, _, err = GetAliasIndices(ctx, client, alias)
+	require.NoError(t, err)
+	require.Equal(t, []string{v2}, indices)
+	exists, err := indexExists(ctx, client, v1)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// swapping again to the same index, as a resumed reindex may do, is a no-op
+	old, err = SwapAlias(ctx, client, alias, v2)
+	require.NoError(t, err)
+	require.Equal(t, []string{v2}, old)
+	indices, _, err = GetAliasIndices(ctx, client, alias)
+	require.NoError(t, err)
+	require.Equal(t, []string{v2}, indices)
+
+	// rollback
+	old, err = SwapAlias(ctx, client, alias, v1)
+	require.NoError(t, err)
+	require.Equal(t, []string{v2}, old)
+	indices, _, err = GetAliasIndices(ctx, client, alias)
+	require.NoError(t, err)
+	require.Equal(t, []string{v1}, indices)
+}
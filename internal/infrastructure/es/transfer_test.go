@@ -41,6 +41,7 @@ type AggregatedIndex struct {
 	Tags              []map[string]string      `json:"tags"`
 	ListingUnits      []map[string]interface{} `json:"listing_units"`
 	Property          map[string]interface{}   `json:"property"`
+	Location          *GeoPoint                `json:"location,omitempty"`
 }
 
 func BuildAggregatedIndex(listing *listing_model.ListingModel, property *property_model.PropertyModel, verificationStatus string, units []unit_model.UnitModel) ([]byte, error) {
@@ -67,6 +68,7 @@ func BuildAggregatedIndex(listing *listing_model.ListingModel, property *propert
 		Tags:              convertTags(listing.Tags),
 		ListingUnits:      convertListingUnits(units, listing.Units),
 		Property:          convertProperty(property, verificationStatus),
+		Location:          NewGeoPoint(property.Lat, property.Lng),
 	}
 	return json.Marshal(aggregated)
 }
@@ -14,6 +14,7 @@ type RedisClient interface {
 
 	HSet(ctx context.Context, key string, values ...interface{}) *redis.IntCmd
 	HGetAll(ctx context.Context, key string) *redis.MapStringStringCmd
+	HIncrBy(ctx context.Context, key, field string, incr int64) *redis.IntCmd
 
 	Sadd(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
 	SMembers(ctx context.Context, key string) *redis.StringSliceCmd
@@ -25,6 +26,8 @@ type RedisClient interface {
 	Exists(ctx context.Context, key string) *redis.IntCmd
 	Del(ctx context.Context, keys ...string) *redis.IntCmd
 	Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd
+
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) *redis.Cmd
 }
 
 type redisClient struct {
@@ -57,6 +60,10 @@ func (r *redisClient) HGetAll(ctx context.Context, key string) *redis.MapStringS
 	return r.client.HGetAll(ctx, key)
 }
 
+func (r *redisClient) HIncrBy(ctx context.Context, key, field string, incr int64) *redis.IntCmd {
+	return r.client.HIncrBy(ctx, key, field, incr)
+}
+
 func (r *redisClient) Sadd(ctx context.Context, key string, members ...interface{}) *redis.IntCmd {
 	return r.client.SAdd(ctx, key, members...)
 }
@@ -89,6 +96,10 @@ func (r *redisClient) Expire(ctx context.Context, key string, expiration time.Du
 	return r.client.Expire(ctx, key, expiration)
 }
 
+func (r *redisClient) Eval(ctx context.Context, script string, keys []string, args ...interface{}) *redis.Cmd {
+	return r.client.Eval(ctx, script, keys, args...)
+}
+
 func (r *redisClient) Close() error {
 	return r.client.Close()
 }
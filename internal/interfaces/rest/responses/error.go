@@ -30,8 +30,7 @@ func DBErrorResponse(ctx *fiber.Ctx, err *pgconn.PgError) error {
 }
 
 func DBTXErrorResponse(ctx *fiber.Ctx, err *database.TXError) error {
-	if err.Err != nil {
-		pgErr := err.Err.(*pgconn.PgError)
+	if pgErr, ok := err.Err.(*pgconn.PgError); ok {
 		return DBErrorResponse(ctx, pgErr)
 	}
 	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"message": "Internal Server Error"})
@@ -15,6 +15,12 @@ type TestRepoConfig struct {
 	RedisAddr     string `mapstructure:"REDIS_TEST_ADDR" validate:"required"`
 	RedisPassword string `mapstructure:"REDIS_TEST_PASSWORD" validate:"omitempty"`
 	RedisDB       int    `mapstructure:"REDIS_TEST_DB" validate:"required"`
+
+	// Elasticsearch, the tests that need it are skipped when it is not configured
+	ElasticsearchAddresses  *string `mapstructure:"ELASTICSEARCH_TEST_ADDRESSES" validate:"omitempty"`
+	ElasticsearchUsername   *string `mapstructure:"ELASTICSEARCH_TEST_USERNAME" validate:"omitempty"`
+	ElasticsearchPassword   *string `mapstructure:"ELASTICSEARCH_TEST_PASSWORD" validate:"omitempty"`
+	ElasticsearchCACertPath *string `mapstructure:"ELASTICSEARCH_TEST_CACERT_PATH" validate:"omitempty"`
 }
 
 func NewTestRepoConfig(envPath string) (*TestRepoConfig, error) {
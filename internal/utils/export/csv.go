@@ -0,0 +1,39 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// WriteCSV writes the tables one after another, each one preceded by its title and separated by an empty line.
+// The output starts with an UTF-8 BOM so that spreadsheet applications detect the encoding.
+func WriteCSV(w io.Writer, doc *Document) error {
+	if _, err := w.Write([]byte("\xef\xbb\xbf")); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	for i, t := range doc.Tables {
+		if i > 0 {
+			if err := cw.Write([]string{}); err != nil {
+				return err
+			}
+		}
+		if t.Title != "" {
+			if err := cw.Write([]string{t.Title}); err != nil {
+				return err
+			}
+		}
+		header := make([]string, len(t.Columns))
+		for j, c := range t.Columns {
+			header[j] = c.Name
+		}
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+		if err := cw.WriteAll(t.Rows); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
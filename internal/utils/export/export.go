@@ -0,0 +1,64 @@
+package export
+
+import (
+	"errors"
+	"io"
+	"strings"
+)
+
+type Format string
+
+const (
+	FORMAT_CSV  Format = "CSV"
+	FORMAT_XLSX Format = "XLSX"
+	FORMAT_PDF  Format = "PDF"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported export format")
+
+type Column struct {
+	Name    string
+	Numeric bool
+}
+
+// Table is a titled grid of cells, every row holds one cell per column
+type Table struct {
+	Title   string
+	Columns []Column
+	Rows    [][]string
+}
+
+// Document is a list of tables rendered one after another (CSV, PDF) or one per sheet (XLSX)
+type Document struct {
+	Title  string
+	Tables []Table
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case FORMAT_CSV:
+		return "text/csv; charset=utf-8"
+	case FORMAT_XLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case FORMAT_PDF:
+		return "application/pdf"
+	}
+	return "application/octet-stream"
+}
+
+func (f Format) Extension() string {
+	return "." + strings.ToLower(string(f))
+}
+
+// Write renders the document in the given format
+func Write(w io.Writer, f Format, doc *Document) error {
+	switch f {
+	case FORMAT_CSV:
+		return WriteCSV(w, doc)
+	case FORMAT_XLSX:
+		return WriteXLSX(w, doc)
+	case FORMAT_PDF:
+		return WritePDF(w, doc)
+	}
+	return ErrUnsupportedFormat
+}
@@ -0,0 +1,164 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDocument() *Document {
+	return &Document{
+		Title: "Báo cáo tháng 05/2024",
+		Tables: []Table{
+			{
+				Title:   "Danh sách thuê",
+				Columns: []Column{{Name: "Căn hộ"}, {Name: "Người thuê"}, {Name: "Giá thuê", Numeric: true}},
+				Rows: [][]string{
+					{"P.101", "Nguyễn Văn Đạt", "5000000"},
+					{"P.102", "Trần (Thị) B, C", "4500000.5"},
+				},
+			},
+			{
+				Title:   "Tổng",
+				Columns: []Column{{Name: "Khoản"}, {Name: "Số tiền", Numeric: true}},
+				Rows:    [][]string{{"Thu nhập", "9500000.5"}},
+			},
+		},
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FORMAT_CSV, newTestDocument()))
+
+	out := buf.String()
+	require.True(t, strings.HasPrefix(out, "\xef\xbb\xbf"))
+	require.Equal(t, strings.Join([]string{
+		"\xef\xbb\xbfDanh sách thuê",
+		"Căn hộ,Người thuê,Giá thuê",
+		"P.101,Nguyễn Văn Đạt,5000000",
+		`P.102,"Trần (Thị) B, C",4500000.5`,
+		"",
+		"Tổng",
+		"Khoản,Số tiền",
+		"Thu nhập,9500000.5",
+		"",
+	}, "\n"), out)
+}
+
+func TestWriteXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FORMAT_XLSX, newTestDocument()))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		b, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = string(b)
+	}
+
+	require.Contains(t, files, "[Content_Types].xml")
+	require.Contains(t, files["xl/workbook.xml"], `<sheet name="Danh sách thuê" sheetId="1" r:id="rId1"/>`)
+	require.Contains(t, files["xl/workbook.xml"], `<sheet name="Tổng" sheetId="2" r:id="rId2"/>`)
+	sheet := files["xl/worksheets/sheet1.xml"]
+	require.Contains(t, sheet, `<c r="B3" s="0" t="inlineStr"><is><t xml:space="preserve">Nguyễn Văn Đạt</t></is></c>`)
+	require.Contains(t, sheet, `<c r="C4" s="0"><v>4500000.5</v></c>`)
+	require.Contains(t, files["xl/worksheets/sheet2.xml"], `<c r="B3" s="0"><v>9500000.5</v></c>`)
+}
+
+func TestWritePDF(t *testing.T) {
+	doc := newTestDocument()
+	// enough rows to span several pages
+	for i := 0; i < 100; i++ {
+		doc.Tables[0].Rows = append(doc.Tables[0].Rows, []string{"P.201", "Lê Thị Hồng", "3000000"})
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FORMAT_PDF, doc))
+
+	out := buf.String()
+	require.True(t, strings.HasPrefix(out, "%PDF-"))
+	require.True(t, strings.HasSuffix(out, "%%EOF\n"))
+	require.Contains(t, out, "/Count 3\n")
+	require.Contains(t, out, "/FontFile2 ")
+
+	texts, glyphs := pdfDecode(t, buf.Bytes())
+	require.Contains(t, texts, "Báo cáo tháng 05/2024")
+	require.Contains(t, texts, "Nguyễn Văn Đạt")
+	require.Contains(t, texts, "Trần (Thị) B, C")
+	require.Contains(t, texts, "Lê Thị Hồng")
+	// the embedded fonts hold a glyph for every character of the text
+	for _, r := range "Nguyễn Văn Đạt" {
+		require.NotZero(t, glyphs[r], "no glyph for %q", r)
+	}
+}
+
+// pdfDecode returns the texts shown in the content streams and the glyph of every character in the embedded fonts.
+// The UTF-8 fonts are Identity-H encoded with an identity ToUnicode map, the texts are therefore UTF-16BE.
+func pdfDecode(t *testing.T, pdf []byte) ([]string, map[rune]uint16) {
+	var (
+		texts  []string
+		glyphs = make(map[rune]uint16)
+		tj     = regexp.MustCompile(`\(((?:[^\\)]|\\.)*)\)\s*Tj`)
+		unesc  = strings.NewReplacer(`\\`, `\`, `\(`, `(`, `\)`, `)`, `\r`, "\r")
+	)
+	for rest := pdf; ; {
+		i := bytes.Index(rest, []byte(">>\nstream\n"))
+		if i < 0 {
+			break
+		}
+		rest = rest[i+len(">>\nstream\n"):]
+		j := bytes.Index(rest, []byte("\nendstream"))
+		require.GreaterOrEqual(t, j, 0)
+		data := rest[:j]
+		rest = rest[j:]
+		if zr, err := zlib.NewReader(bytes.NewReader(data)); err == nil {
+			if data, err = io.ReadAll(zr); err != nil {
+				continue
+			}
+		}
+
+		if len(data) == 256*256*2 {
+			// CIDToGIDMap
+			for cid := 0; cid < 256*256; cid++ {
+				if gid := binary.BigEndian.Uint16(data[2*cid:]); gid != 0 {
+					glyphs[rune(cid)] = gid
+				}
+			}
+			continue
+		}
+		for _, m := range tj.FindAllSubmatch(data, -1) {
+			s := []byte(unesc.Replace(string(m[1])))
+			u := make([]uint16, len(s)/2)
+			for k := range u {
+				u[k] = binary.BigEndian.Uint16(s[2*k:])
+			}
+			texts = append(texts, string(utf16.Decode(u)))
+		}
+	}
+	return texts, glyphs
+}
+
+func TestCellRef(t *testing.T) {
+	require.Equal(t, "A1", cellRef(0, 1))
+	require.Equal(t, "Z2", cellRef(25, 2))
+	require.Equal(t, "AA3", cellRef(26, 3))
+	require.Equal(t, "AZ4", cellRef(51, 4))
+}
+
+func TestWriteUnsupportedFormat(t *testing.T) {
+	require.ErrorIs(t, Write(io.Discard, Format("DOCX"), newTestDocument()), ErrUnsupportedFormat)
+}
@@ -0,0 +1,144 @@
+package export
+
+import (
+	_ "embed"
+	"io"
+	"unicode/utf8"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// sizes in points, on an A4 landscape page
+const (
+	pdfMargin     = 36.0
+	pdfFontSize   = 9.0
+	pdfLineHeight = 13.0
+	pdfColumnGap  = 6.0
+	pdfFontFamily = "DejaVuSansCondensed"
+)
+
+// DejaVu covers the Vietnamese alphabet, the fonts are embedded (subsetted) in every document
+var (
+	//go:embed fonts/DejaVuSansCondensed.ttf
+	pdfFontRegular []byte
+	//go:embed fonts/DejaVuSansCondensed-Bold.ttf
+	pdfFontBold []byte
+)
+
+// WritePDF writes a simple paginated PDF listing the tables, the table header is repeated on every page
+func WritePDF(w io.Writer, doc *Document) error {
+	pdf := fpdf.New("L", "pt", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(false, pdfMargin)
+	pdf.AddUTF8FontFromBytes(pdfFontFamily, "", pdfFontRegular)
+	pdf.AddUTF8FontFromBytes(pdfFontFamily, "B", pdfFontBold)
+	pdf.AddPage()
+
+	if doc.Title != "" {
+		pdf.SetFont(pdfFontFamily, "B", 14)
+		pdf.CellFormat(0, pdfLineHeight*2, doc.Title, "", 1, "L", false, 0, "")
+	}
+	for i := range doc.Tables {
+		pdfTable(pdf, &doc.Tables[i])
+		pdf.Ln(pdfLineHeight)
+	}
+	return pdf.Output(w)
+}
+
+// pdfBreak starts a new page when a line of height h does not fit in the current one
+func pdfBreak(pdf *fpdf.Fpdf, h float64) bool {
+	_, pageHeight := pdf.GetPageSize()
+	if pdf.GetY()+h <= pageHeight-pdfMargin {
+		return false
+	}
+	pdf.AddPage()
+	return true
+}
+
+func pdfTable(pdf *fpdf.Fpdf, t *Table) {
+	if t.Title != "" {
+		pdfBreak(pdf, pdfLineHeight*2.5)
+		pdf.SetFont(pdfFontFamily, "B", 11)
+		pdf.CellFormat(0, pdfLineHeight*1.5, t.Title, "", 1, "L", false, 0, "")
+	}
+	widths := pdfColumnWidths(pdf, t)
+
+	writeRow := func(cells []string, bold bool) {
+		style, border := "", ""
+		if bold {
+			// the header is underlined
+			style, border = "B", "B"
+		}
+		pdf.SetFont(pdfFontFamily, style, pdfFontSize)
+		for j, w := range widths {
+			var c string
+			if j < len(cells) {
+				c = pdfFit(pdf, cells[j], w)
+			}
+			align := "L"
+			if !bold && t.Columns[j].Numeric {
+				align = "R"
+			}
+			pdf.CellFormat(w, pdfLineHeight, c, border, 0, align, false, 0, "")
+			if j < len(widths)-1 {
+				pdf.CellFormat(pdfColumnGap, pdfLineHeight, "", border, 0, "", false, 0, "")
+			}
+		}
+		pdf.Ln(pdfLineHeight)
+	}
+	header := make([]string, len(t.Columns))
+	for j, c := range t.Columns {
+		header[j] = c.Name
+	}
+
+	pdfBreak(pdf, pdfLineHeight*2)
+	writeRow(header, true)
+	for _, r := range t.Rows {
+		if pdfBreak(pdf, pdfLineHeight) {
+			writeRow(header, true)
+		}
+		writeRow(r, false)
+	}
+}
+
+// pdfColumnWidths shares the printable width between columns proportionally to their content length
+func pdfColumnWidths(pdf *fpdf.Fpdf, t *Table) []float64 {
+	n := len(t.Columns)
+	if n == 0 {
+		return nil
+	}
+	lens := make([]float64, n)
+	total := 0.0
+	for j, c := range t.Columns {
+		l := utf8.RuneCountInString(c.Name)
+		for _, r := range t.Rows {
+			if j < len(r) {
+				l = max(l, utf8.RuneCountInString(r[j]))
+			}
+		}
+		lens[j] = float64(min(max(l, 4), 40))
+		total += lens[j]
+	}
+	pageWidth, _ := pdf.GetPageSize()
+	avail := pageWidth - 2*pdfMargin - pdfColumnGap*float64(n-1)
+	for j := range lens {
+		lens[j] = avail * lens[j] / total
+	}
+	return lens
+}
+
+// pdfFit truncates s with the current font so that it fits in a cell of the given width
+func pdfFit(pdf *fpdf.Fpdf, s string, width float64) string {
+	width -= 2 * pdf.GetCellMargin()
+	if pdf.GetStringWidth(s) <= width {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		if c := string(runes) + "…"; pdf.GetStringWidth(c) <= width {
+			return c
+		}
+	}
+	return ""
+}
@@ -291,6 +291,18 @@ func TimeN(s time.Time) pgtype.Time {
 	}
 }
 
+func TimestamptzN(s *time.Time) pgtype.Timestamptz {
+	if s == nil {
+		return pgtype.Timestamptz{
+			Valid: false,
+		}
+	}
+	return pgtype.Timestamptz{
+		Valid: true,
+		Time:  *s,
+	}
+}
+
 func TimeNStr(s *time.Time) pgtype.Text {
 	if s == nil {
 		return pgtype.Text{